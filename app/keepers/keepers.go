@@ -2,6 +2,7 @@ package keepers
 
 import (
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -361,7 +362,7 @@ func (appKeepers *AppKeepers) InitKeepers(
 
 	// claimsmanagerModule := claimsmanager.NewAppModule(appCodec, appKeepers.ClaimsManagerKeeper)
 
-	appKeepers.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(appCodec, appKeepers.keys[interchainquerytypes.StoreKey], appKeepers.IBCKeeper)
+	appKeepers.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(appCodec, appKeepers.keys[interchainquerytypes.StoreKey], appKeepers.GetSubspace(interchainquerytypes.ModuleName), appKeepers.BankKeeper, appKeepers.IBCKeeper)
 
 	// interchainQueryModule := interchainquery.NewAppModule(appCodec, appKeepers.InterchainQueryKeeper)
 
@@ -370,6 +371,7 @@ func (appKeepers *AppKeepers) InitKeepers(
 		appKeepers.keys[interchainstakingtypes.StoreKey],
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
 		appKeepers.ICAControllerKeeper,
 		&scopedInterchainStakingKeeper,
 		appKeepers.InterchainQueryKeeper,
@@ -422,7 +424,7 @@ func (appKeepers *AppKeepers) InitKeepers(
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,osmosis"
-	wasmOpts = append(wasmbinding.RegisterCustomPlugins(&appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper), wasmOpts...)
+	wasmOpts = append(wasmbinding.RegisterCustomPlugins(&appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper, &appKeepers.InterchainQueryKeeper), wasmOpts...)
 	wasmOpts = append(wasmbinding.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
 
 	appKeepers.WasmKeeper = wasm.NewKeeper(
@@ -445,6 +447,10 @@ func (appKeepers *AppKeepers) InitKeepers(
 		wasmOpts...,
 	)
 
+	if err := appKeepers.InterchainQueryKeeper.SetCallbackHandler(wasm.ModuleName, wasmbinding.NewContractCallbacks(wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper), appKeepers.InterchainQueryKeeper)); err != nil {
+		panic(err)
+	}
+
 	icaControllerIBCModule := icacontroller.NewIBCMiddleware(interchainstakingIBCModule, appKeepers.ICAControllerKeeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
@@ -0,0 +1,59 @@
+package bindings
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+// InterchainQueryMsg contains the interchain query custom messages.
+type InterchainQueryMsg struct {
+	/// Contracts can register store key queries against open connections,
+	/// the results of which are delivered to the contract through sudo.
+	RegisterInterchainQuery *RegisterInterchainQuery `json:"register_interchain_query,omitempty"`
+	/// Contracts can remove the queries they registered, refunding the deposit.
+	RemoveInterchainQuery *RemoveInterchainQuery `json:"remove_interchain_query,omitempty"`
+}
+
+// RegisterInterchainQuery registers a query for the given key of the remote
+// store, e.g. "store/bank/key". The query is repeated every UpdatePeriod
+// blocks, or made once for an UpdatePeriod of zero. The contract pays the
+// owner query deposit, held until the query is removed, and Fee is escrowed
+// for the relayer of the first response.
+type RegisterInterchainQuery struct {
+	ConnectionID string            `json:"connection_id"`
+	QueryType    string            `json:"query_type"`
+	Key          []byte            `json:"key"`
+	UpdatePeriod uint64            `json:"update_period"`
+	Fee          wasmvmtypes.Coins `json:"fee"`
+}
+
+type RegisterInterchainQueryResponse struct {
+	QueryID string `json:"query_id"`
+}
+
+type RemoveInterchainQuery struct {
+	QueryID string `json:"query_id"`
+}
+
+// QueryResult returns the latest retained result of an interchain query.
+type QueryResult struct {
+	QueryID string `json:"query_id"`
+}
+
+type QueryResultResponse struct {
+	Result       []byte `json:"result"`
+	RemoteHeight int64  `json:"remote_height"`
+	LocalHeight  int64  `json:"local_height"`
+}
+
+// SudoMsg is sent to contracts through sudo on the outcome of their interchain queries.
+type SudoMsg struct {
+	InterchainQueryResult    *InterchainQueryResult    `json:"interchain_query_result,omitempty"`
+	InterchainQueryAbandoned *InterchainQueryAbandoned `json:"interchain_query_abandoned,omitempty"`
+}
+
+type InterchainQueryResult struct {
+	QueryID string `json:"query_id"`
+	Result  []byte `json:"result"`
+}
+
+type InterchainQueryAbandoned struct {
+	QueryID string `json:"query_id"`
+}
@@ -9,6 +9,8 @@ type QuickSilverQuery struct {
 	/// Warning: this can easily be manipulated via sandwich attacks, do not use as price oracle.
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Returns the latest retained result of an interchain query.
+	QueryResult *QueryResult `json:"query_result,omitempty"`
 }
 
 type FullDenom struct {
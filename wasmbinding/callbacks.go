@@ -0,0 +1,132 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/wasmbinding/bindings"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// ContractCallbackID is the callback id of interchain queries registered by contracts.
+const ContractCallbackID = "contract"
+
+// ContractSudoer calls the sudo entry point of a contract.
+type ContractSudoer interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ParamsKeeper returns the interchainquery params, which set the gas limit of
+// contract callbacks.
+type ParamsKeeper interface {
+	GetParams(ctx sdk.Context) icqtypes.Params
+}
+
+// ContractCallbacks delivers the outcome of interchain queries registered by
+// contracts to the owning contract through sudo.
+type ContractCallbacks struct {
+	sudoer ContractSudoer
+	params ParamsKeeper
+}
+
+var _ icqtypes.QueryCallbacks = ContractCallbacks{}
+
+// NewContractCallbacks returns the interchain query callback handler for contracts.
+func NewContractCallbacks(sudoer ContractSudoer, params ParamsKeeper) ContractCallbacks {
+	return ContractCallbacks{sudoer: sudoer, params: params}
+}
+
+func (c ContractCallbacks) AddCallback(_ string, _ interface{}) icqtypes.QueryCallbacks {
+	return c
+}
+
+func (c ContractCallbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
+	return c
+}
+
+// Call sends the query result to the contract that owns the query.
+func (c ContractCallbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
+	if !c.Has(id) {
+		return fmt.Errorf("callback %s not found", id)
+	}
+	return c.sudo(ctx, query, bindings.SudoMsg{
+		InterchainQueryResult: &bindings.InterchainQueryResult{QueryID: query.Id, Result: args},
+	})
+}
+
+func (c ContractCallbacks) Has(id string) bool {
+	return id == ContractCallbackID
+}
+
+func (c ContractCallbacks) AddFailureCallback(_ string, _ interface{}) icqtypes.QueryCallbacks {
+	return c
+}
+
+// CallFailure notifies the contract that owns the query that it was abandoned.
+func (c ContractCallbacks) CallFailure(ctx sdk.Context, id string, query icqtypes.Query) error {
+	if !c.Has(id) {
+		return nil
+	}
+	return c.sudo(ctx, query, bindings.SudoMsg{
+		InterchainQueryAbandoned: &bindings.InterchainQueryAbandoned{QueryID: query.Id},
+	})
+}
+
+func (c ContractCallbacks) RequireProof(_ string) icqtypes.QueryCallbacks {
+	return c
+}
+
+// RequiresProof returns true; contracts may only register store key queries,
+// the responses to which are always proven.
+func (c ContractCallbacks) RequiresProof(id string) bool {
+	return c.Has(id)
+}
+
+// sudo calls the contract that owns the query. Contract errors are logged
+// rather than returned, as they must fail neither the query response nor the
+// end blocker abandoning the query. The call is made in a cache context, with
+// the gas limit set by the ContractCallbackGasLimit param, and its state
+// changes are only written if it succeeds.
+func (c ContractCallbacks) sudo(ctx sdk.Context, query icqtypes.Query, msg bindings.SudoMsg) error {
+	contract, err := sdk.AccAddressFromBech32(query.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid owner of contract query", "id", query.Id, "owner", query.Owner, "error", err)
+		return nil
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(c.params.GetParams(ctx).ContractCallbackGasLimit))
+	err = c.sudoWithGasLimit(cacheCtx, contract, bz)
+	ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "contract callback")
+	if err != nil {
+		ctx.Logger().Error("contract callback failed", "id", query.Id, "contract", query.Owner, "error", err)
+		return nil
+	}
+
+	write()
+	return nil
+}
+
+// sudoWithGasLimit calls the contract, recovering from the contract running
+// out of gas.
+func (c ContractCallbacks) sudoWithGasLimit(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("out of gas in location: %v; gas limit: %d", oog.Descriptor, ctx.GasMeter().Limit())
+		}
+	}()
+
+	_, err = c.sudoer.Sudo(ctx, contract, msg)
+	return err
+}
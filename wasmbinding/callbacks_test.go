@@ -0,0 +1,105 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/app"
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/wasmbinding"
+	"github.com/ingenuity-build/quicksilver/wasmbinding/bindings"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+type testSudoer struct {
+	contract sdk.AccAddress
+	msg      bindings.SudoMsg
+	err      error
+	gas      uint64
+	// execute is called on each sudo, before the error is returned.
+	execute func(ctx sdk.Context)
+}
+
+func (s *testSudoer) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	s.contract = contractAddress
+	s.msg = bindings.SudoMsg{}
+	if err := json.Unmarshal(msg, &s.msg); err != nil {
+		return nil, err
+	}
+	if s.execute != nil {
+		s.execute(ctx)
+	}
+	ctx.GasMeter().ConsumeGas(s.gas, "test sudo")
+	return nil, s.err
+}
+
+func TestContractCallbacks(t *testing.T) {
+	quicksilver, ctx := app.GetAppWithContext(t, true)
+	sudoer := &testSudoer{}
+	callbacks := wasmbinding.NewContractCallbacks(sudoer, quicksilver.InterchainQueryKeeper)
+	contract := utils.GenerateAccAddressForTest()
+	query := icqtypes.Query{Id: "abc", Owner: contract.String(), QueryType: "store/bank/key"}
+
+	require.True(t, callbacks.Has(wasmbinding.ContractCallbackID))
+	require.False(t, callbacks.Has("valset"))
+	require.True(t, callbacks.RequiresProof(wasmbinding.ContractCallbackID))
+
+	// results are delivered to the owning contract.
+	require.NoError(t, callbacks.Call(ctx, wasmbinding.ContractCallbackID, []byte{0x01, 0x02}, query))
+	require.Equal(t, contract, sudoer.contract)
+	require.NotNil(t, sudoer.msg.InterchainQueryResult)
+	require.Nil(t, sudoer.msg.InterchainQueryAbandoned)
+	require.Equal(t, "abc", sudoer.msg.InterchainQueryResult.QueryID)
+	require.Equal(t, []byte{0x01, 0x02}, sudoer.msg.InterchainQueryResult.Result)
+
+	// abandonment is notified to the owning contract.
+	require.NoError(t, callbacks.CallFailure(ctx, wasmbinding.ContractCallbackID, query))
+	require.NotNil(t, sudoer.msg.InterchainQueryAbandoned)
+	require.Equal(t, "abc", sudoer.msg.InterchainQueryAbandoned.QueryID)
+
+	// queries without a valid owner cannot be delivered, but do not fail the caller.
+	sudoer.contract = nil
+	require.NoError(t, callbacks.Call(ctx, wasmbinding.ContractCallbackID, []byte{0x01}, icqtypes.Query{Id: "abc"}))
+	require.Nil(t, sudoer.contract)
+}
+
+func TestContractCallbacksFailure(t *testing.T) {
+	quicksilver, ctx := app.GetAppWithContext(t, true)
+	params := icqtypes.DefaultParams()
+	params.ContractCallbackGasLimit = 100000
+	quicksilver.InterchainQueryKeeper.SetParams(ctx, params)
+
+	// the contract mints to the recipient on each call.
+	recipient := utils.GenerateAccAddressForTest()
+	coins := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(100)))
+	sudoer := &testSudoer{execute: func(ctx sdk.Context) {
+		require.NoError(t, quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, recipient, coins))
+	}}
+	callbacks := wasmbinding.NewContractCallbacks(sudoer, quicksilver.InterchainQueryKeeper)
+	query := icqtypes.Query{Id: "abc", Owner: utils.GenerateAccAddressForTest().String(), QueryType: "store/bank/key"}
+
+	// contract errors are logged, and the state changes of the call discarded.
+	sudoer.err = errors.New("contract error")
+	require.NoError(t, callbacks.Call(ctx, wasmbinding.ContractCallbackID, []byte{0x01}, query))
+	require.NoError(t, callbacks.CallFailure(ctx, wasmbinding.ContractCallbackID, query))
+	require.True(t, quicksilver.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+
+	// running out of gas is recovered, charging the gas limit of the call to the caller.
+	sudoer.err = nil
+	sudoer.gas = params.ContractCallbackGasLimit + 1
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.NoError(t, callbacks.CallFailure(gasCtx, wasmbinding.ContractCallbackID, query))
+	require.GreaterOrEqual(t, gasCtx.GasMeter().GasConsumed(), params.ContractCallbackGasLimit)
+	require.True(t, quicksilver.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+
+	// successful calls are written.
+	sudoer.gas = 0
+	require.NoError(t, callbacks.Call(ctx, wasmbinding.ContractCallbackID, []byte{0x01}, query))
+	require.True(t, coins.IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, recipient)))
+}
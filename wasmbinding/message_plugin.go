@@ -5,30 +5,34 @@ import (
 
 	sdkioerrors "cosmossdk.io/errors"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"github.com/ingenuity-build/quicksilver/wasmbinding/bindings"
+	interchainquerykeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
 	tokenfactorykeeper "github.com/ingenuity-build/quicksilver/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/ingenuity-build/quicksilver/x/tokenfactory/types"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper, interchainQuery *interchainquerykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
-			wrapped:      old,
-			bank:         bank,
-			tokenFactory: tokenFactory,
+			wrapped:         old,
+			bank:            bank,
+			tokenFactory:    tokenFactory,
+			interchainQuery: interchainQuery,
 		}
 	}
 }
 
 type CustomMessenger struct {
-	wrapped      wasmkeeper.Messenger
-	bank         *bankkeeper.BaseKeeper
-	tokenFactory *tokenfactorykeeper.Keeper
+	wrapped         wasmkeeper.Messenger
+	bank            *bankkeeper.BaseKeeper
+	tokenFactory    *tokenfactorykeeper.Keeper
+	interchainQuery *interchainquerykeeper.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -54,6 +58,17 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.BurnTokens != nil {
 			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
 		}
+
+		var queryMsg bindings.InterchainQueryMsg
+		if err := json.Unmarshal(msg.Custom, &queryMsg); err != nil {
+			return nil, nil, sdkioerrors.Wrap(err, "InterchainQueryMsg msg")
+		}
+		if queryMsg.RegisterInterchainQuery != nil {
+			return m.registerInterchainQuery(ctx, contractAddr, queryMsg.RegisterInterchainQuery)
+		}
+		if queryMsg.RemoveInterchainQuery != nil {
+			return m.removeInterchainQuery(ctx, contractAddr, queryMsg.RemoveInterchainQuery)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -195,6 +210,63 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	return nil
 }
 
+// registerInterchainQuery registers an interchain query owned by the contract.
+func (m *CustomMessenger) registerInterchainQuery(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindings.RegisterInterchainQuery) ([]sdk.Event, [][]byte, error) {
+	queryID, err := PerformRegisterInterchainQuery(m.interchainQuery, ctx, contractAddr, register)
+	if err != nil {
+		return nil, nil, sdkioerrors.Wrap(err, "perform register interchain query")
+	}
+
+	bz, err := json.Marshal(bindings.RegisterInterchainQueryResponse{QueryID: queryID})
+	if err != nil {
+		return nil, nil, sdkioerrors.Wrap(err, "register interchain query response")
+	}
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformRegisterInterchainQuery validates the register message and registers the query, returning its id.
+func PerformRegisterInterchainQuery(icq *interchainquerykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, register *bindings.RegisterInterchainQuery) (string, error) {
+	if register == nil {
+		return "", wasmvmtypes.InvalidRequest{Err: "register interchain query null register"}
+	}
+	if len(register.Key) == 0 {
+		return "", wasmvmtypes.InvalidRequest{Err: "register interchain query empty key"}
+	}
+
+	fee, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(register.Fee)
+	if err != nil {
+		return "", err
+	}
+
+	period := sdk.NewInt(-1)
+	if register.UpdatePeriod > 0 {
+		period = sdk.NewIntFromUint64(register.UpdatePeriod)
+	}
+
+	return icq.RegisterOwnedQuery(
+		ctx,
+		contractAddr,
+		register.ConnectionID,
+		register.QueryType,
+		register.Key,
+		period,
+		wasmtypes.ModuleName,
+		ContractCallbackID,
+		fee,
+	)
+}
+
+// removeInterchainQuery removes an interchain query owned by the contract.
+func (m *CustomMessenger) removeInterchainQuery(ctx sdk.Context, contractAddr sdk.AccAddress, remove *bindings.RemoveInterchainQuery) ([]sdk.Event, [][]byte, error) {
+	if remove == nil {
+		return nil, nil, wasmvmtypes.InvalidRequest{Err: "remove interchain query null remove"}
+	}
+	if err := m.interchainQuery.RemoveOwnedQuery(ctx, contractAddr, remove.QueryID); err != nil {
+		return nil, nil, sdkioerrors.Wrap(err, "removing interchain query")
+	}
+	return nil, nil, nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
@@ -6,17 +6,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ingenuity-build/quicksilver/wasmbinding/bindings"
+	interchainquerykeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
 	tokenfactorykeeper "github.com/ingenuity-build/quicksilver/x/tokenfactory/keeper"
 )
 
 type QueryPlugin struct {
-	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	tokenFactoryKeeper    *tokenfactorykeeper.Keeper
+	interchainQueryKeeper *interchainquerykeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper, icqk *interchainquerykeeper.Keeper) *QueryPlugin {
 	return &QueryPlugin{
-		tokenFactoryKeeper: tfk,
+		tokenFactoryKeeper:    tfk,
+		interchainQueryKeeper: icqk,
 	}
 }
 
@@ -29,3 +32,17 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindings.De
 
 	return &bindings.DenomAdminResponse{Admin: metadata.Admin}, nil
 }
+
+// GetQueryResult is a query to get the latest retained result of an interchain query.
+func (qp QueryPlugin) GetQueryResult(ctx sdk.Context, queryID string) (*bindings.QueryResultResponse, error) {
+	datapoint, err := qp.interchainQueryKeeper.GetDatapointForID(ctx, queryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get result for query: %s", queryID)
+	}
+
+	return &bindings.QueryResultResponse{
+		Result:       datapoint.Value,
+		RemoteHeight: datapoint.RemoteHeight.Int64(),
+		LocalHeight:  datapoint.LocalHeight.Int64(),
+	}, nil
+}
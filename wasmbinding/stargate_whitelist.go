@@ -14,6 +14,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	epochtypes "github.com/ingenuity-build/quicksilver/x/epochs/types"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 	minttypes "github.com/ingenuity-build/quicksilver/x/mint/types"
 	tokenfactorytypes "github.com/ingenuity-build/quicksilver/x/tokenfactory/types"
 )
@@ -63,6 +64,10 @@ func init() {
 	setWhitelistedQuery("/quicksilver.epochs.v1.Query/EpochInfos", &epochtypes.QueryEpochsInfoResponse{})
 	setWhitelistedQuery("/quicksilver.epochs.v1.Query/CurrentEpoch", &epochtypes.QueryCurrentEpochResponse{})
 
+	// interchainstaking
+	setWhitelistedQuery("/quicksilver.interchainstaking.v1.Query/RedemptionRateHistory", &icstypes.QueryRedemptionRateHistoryResponse{})
+	setWhitelistedQuery("/quicksilver.interchainstaking.v1.Query/RedemptionRateTWAP", &icstypes.QueryRedemptionRateTWAPResponse{})
+
 	// mint
 	setWhitelistedQuery("/quicksilver.mint.v1beta1.Query/EpochProvisions", &minttypes.QueryEpochProvisionsResponse{})
 	setWhitelistedQuery("/quicksilver.mint.v1beta1.Query/Params", &minttypes.QueryParamsResponse{})
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/hex"
+	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -11,7 +12,18 @@ import (
 )
 
 const (
+	// RetryInterval is the number of blocks after which an unanswered one-shot
+	// query is first re-emitted; the interval doubles with every retry.
 	RetryInterval = 25
+	// MaxRetries is the number of times an unanswered one-shot query is
+	// re-emitted before it is abandoned.
+	MaxRetries = 6
+	// QueryDeadline is the number of blocks after a one-shot query is requested
+	// by which it must be answered before it is abandoned.
+	QueryDeadline = 5000
+	// AbandonedQueryRetention is the number of blocks for which abandoned query
+	// records are retained.
+	AbandonedQueryRetention = 100000
 )
 
 // EndBlocker of interchainquery module
@@ -19,33 +31,50 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	_ = k.Logger(ctx)
 	events := sdk.Events{}
-	// emit events for periodic queries
+	height := ctx.BlockHeight()
+	abandoned := []types.AbandonedQuery{}
+	// emit events for new and periodic queries, and re-emit unanswered one-shot queries with backoff
 	k.IterateQueries(ctx, func(_ int64, queryInfo types.Query) (stop bool) {
-		// if queryInfo.QueryType == "ibc.ClientUpdate" && queryInfo.LastEmission.AddRaw(1000).LTE(sdk.NewInt(ctx.BlockHeight())) {
-		// 	k.DeleteQuery(ctx, queryInfo.Id)
-		// 	k.Logger(ctx).Error("Deleting stale query")
-		// 	return false
-		// }
-		if queryInfo.LastEmission.IsNil() || queryInfo.LastEmission.IsZero() || queryInfo.LastEmission.Add(queryInfo.Period).Equal(sdk.NewInt(ctx.BlockHeight())) {
-			k.Logger(ctx).Debug("Interchainquery event emitted", "id", queryInfo.Id)
-			event := sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
-				sdk.NewAttribute(types.AttributeKeyQueryID, queryInfo.Id),
-				sdk.NewAttribute(types.AttributeKeyChainID, queryInfo.ChainId),
-				sdk.NewAttribute(types.AttributeKeyConnectionID, queryInfo.ConnectionId),
-				sdk.NewAttribute(types.AttributeKeyType, queryInfo.QueryType),
-				// TODO: add height to request type
-				sdk.NewAttribute(types.AttributeKeyHeight, "0"),
-				sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(queryInfo.Request)),
-			)
+		switch {
+		case queryInfo.LastEmission.IsNil() || queryInfo.LastEmission.IsZero():
+			// first emission
+		case queryInfo.Period.IsNegative():
+			// unanswered one-shot query
+			if queryInfo.HasExpired(height) {
+				abandoned = append(abandoned, types.AbandonedQuery{Query: queryInfo, Reason: types.AbandonReasonDeadline})
+				return false
+			}
+			if height < queryInfo.NextEmission(RetryInterval) {
+				return false
+			}
+			if queryInfo.Retries >= MaxRetries {
+				abandoned = append(abandoned, types.AbandonedQuery{Query: queryInfo, Reason: types.AbandonReasonMaxRetries})
+				return false
+			}
+			queryInfo.Retries++
+		case queryInfo.LastEmission.Add(queryInfo.Period).Equal(sdk.NewInt(height)):
+			// periodic query
+		default:
+			return false
+		}
 
-			events = append(events, event)
-			queryInfo.LastEmission = sdk.NewInt(ctx.BlockHeight())
-			k.SetQuery(ctx, queryInfo)
+		k.Logger(ctx).Debug("Interchainquery event emitted", "id", queryInfo.Id, "retries", queryInfo.Retries)
+		event := sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
+			sdk.NewAttribute(types.AttributeKeyQueryID, queryInfo.Id),
+			sdk.NewAttribute(types.AttributeKeyChainID, queryInfo.ChainId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, queryInfo.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyType, queryInfo.QueryType),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(queryInfo.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(queryInfo.Request)),
+		)
+
+		events = append(events, event)
+		queryInfo.LastEmission = sdk.NewInt(height)
+		k.SetQuery(ctx, queryInfo)
 
-		}
 		return false
 	})
 
@@ -53,13 +82,25 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		ctx.EventManager().EmitEvents(events)
 	}
 
+	for _, aq := range abandoned {
+		k.AbandonQuery(ctx, aq.Query, aq.Reason)
+	}
+
+	// gc abandoned query records beyond the retention window
+	k.IterateAbandonedQueries(ctx, func(_ int64, aq types.AbandonedQuery) bool {
+		if aq.Height+AbandonedQueryRetention < height {
+			k.DeleteAbandonedQuery(ctx, aq.Query.Id)
+		}
+		return false
+	})
+
 	k.IterateDatapoints(ctx, func(_ int64, dp types.DataPoint) bool {
 		q, found := k.GetQuery(ctx, dp.Id)
 		if !found {
 			// query was removed; delete datapoint
 			k.DeleteDatapoint(ctx, dp.Id)
-		} else if dp.LocalHeight.Int64()+int64(q.Ttl) > ctx.BlockHeader().Height {
-			// gc old data
+		} else if dp.LocalHeight.Int64()+int64(q.Ttl) < ctx.BlockHeader().Height {
+			// gc data older than the query ttl
 			k.DeleteDatapoint(ctx, dp.Id)
 		}
 
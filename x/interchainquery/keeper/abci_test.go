@@ -5,6 +5,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 )
 
 func (suite *KeeperTestSuite) TestEndBlocker() {
@@ -53,3 +54,100 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 	// call end blocker
 	suite.GetSimApp(suite.chainA).InterchainQueryKeeper.EndBlocker(suite.chainA.GetContext())
 }
+
+func (suite *KeeperTestSuite) TestEndBlockerRetryBackoff() {
+	icqk := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	icqk.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(-1), "", "", 0)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "")
+
+	query, found := icqk.GetQuery(ctx, id)
+	suite.True(found)
+	suite.Equal(uint64(ctx.BlockHeight()+keeper.QueryDeadline), query.Deadline)
+
+	// first emission
+	start := ctx.BlockHeight()
+	icqk.EndBlocker(ctx)
+	query, found = icqk.GetQuery(ctx, id)
+	suite.True(found)
+	suite.Equal(start, query.LastEmission.Int64())
+	suite.Equal(uint64(0), query.Retries)
+
+	// not yet due for re-emission
+	icqk.EndBlocker(ctx.WithBlockHeight(start + keeper.RetryInterval - 1))
+	query, _ = icqk.GetQuery(ctx, id)
+	suite.Equal(start, query.LastEmission.Int64())
+
+	// each retry doubles the interval
+	height := start
+	for retry := uint64(1); retry <= keeper.MaxRetries; retry++ {
+		height += keeper.RetryInterval << (retry - 1)
+		icqk.EndBlocker(ctx.WithBlockHeight(height))
+		query, found = icqk.GetQuery(ctx, id)
+		suite.True(found)
+		suite.Equal(retry, query.Retries)
+		suite.Equal(height, query.LastEmission.Int64())
+		suite.True(query.IsStale(height, keeper.RetryInterval))
+	}
+
+	// retries exhausted; the query is abandoned once the next retry is due
+	height += keeper.RetryInterval << keeper.MaxRetries
+	suite.Less(uint64(height), query.Deadline)
+	icqk.EndBlocker(ctx.WithBlockHeight(height))
+	_, found = icqk.GetQuery(ctx, id)
+	suite.False(found)
+
+	abandoned, found := icqk.GetAbandonedQuery(ctx, id)
+	suite.True(found)
+	suite.Equal(height, abandoned.Height)
+	suite.Equal(icqtypes.AbandonReasonMaxRetries, abandoned.Reason)
+	suite.Equal(uint64(keeper.MaxRetries), abandoned.Query.Retries)
+
+	// a new request supersedes the abandoned record
+	icqk.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(-1), "", "", 0)
+	_, found = icqk.GetAbandonedQuery(ctx, id)
+	suite.False(found)
+	query, found = icqk.GetQuery(ctx, id)
+	suite.True(found)
+	suite.Equal(uint64(0), query.Retries)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerDeadline() {
+	icqk := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	query := icqk.NewQuery("", suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(-1), "", 0)
+	query.LastEmission = sdk.NewInt(ctx.BlockHeight())
+	query.Deadline = uint64(ctx.BlockHeight() + 10)
+	icqk.SetQuery(ctx, *query)
+
+	icqk.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	_, found := icqk.GetQuery(ctx, query.Id)
+	suite.True(found)
+
+	icqk.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight() + 11))
+	_, found = icqk.GetQuery(ctx, query.Id)
+	suite.False(found)
+
+	abandoned, found := icqk.GetAbandonedQuery(ctx, query.Id)
+	suite.True(found)
+	suite.Equal(icqtypes.AbandonReasonDeadline, abandoned.Reason)
+
+	// abandoned records are pruned after the retention window
+	icqk.EndBlocker(ctx.WithBlockHeight(abandoned.Height + keeper.AbandonedQueryRetention))
+	_, found = icqk.GetAbandonedQuery(ctx, query.Id)
+	suite.True(found)
+
+	icqk.EndBlocker(ctx.WithBlockHeight(abandoned.Height + keeper.AbandonedQueryRetention + 1))
+	_, found = icqk.GetAbandonedQuery(ctx, query.Id)
+	suite.False(found)
+}
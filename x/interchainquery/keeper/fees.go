@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// EscrowDefaultFee escrows the governance set default fee for the query type
+// from the module account of the requesting module. The query is left without
+// a fee if the module account is unable to cover it. The fee is drawn from the
+// funds held by the module account, e.g. the protocol fees held by
+// interchainstaking, or the rewards pool held by participationrewards, and is
+// returned to the module account if the query is removed without response.
+func (k Keeper) EscrowDefaultFee(ctx sdk.Context, module string, query *types.Query) {
+	if module == "" {
+		return
+	}
+
+	fee := k.GetParams(ctx).FeeForQueryType(query.QueryType).Fee
+	if fee.IsZero() {
+		return
+	}
+
+	depositor := authtypes.NewModuleAddress(module)
+	if !k.bankKeeper.SpendableCoins(ctx, depositor).IsAllGTE(fee) {
+		k.Logger(ctx).Info("insufficient funds to escrow query fee", "module", module, "id", query.Id, "fee", fee)
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, module, types.ModuleName, fee); err != nil {
+		k.Logger(ctx).Error("unable to escrow query fee", "module", module, "id", query.Id, "error", err)
+		return
+	}
+
+	query.FeeDeposits = addFeeDeposit(query.FeeDeposits, types.QueryFeeDeposit{Depositor: depositor.String(), Module: module, Amount: fee})
+}
+
+// SponsorQuery escrows the given amount from the sponsor against a pending query.
+func (k Keeper) SponsorQuery(ctx sdk.Context, id string, sponsor sdk.AccAddress, amount sdk.Coins) error {
+	query, found := k.GetQuery(ctx, id)
+	if !found {
+		return fmt.Errorf("no pending query found for id %s", id)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsor, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	query.FeeDeposits = addFeeDeposit(query.FeeDeposits, types.QueryFeeDeposit{Depositor: sponsor.String(), Amount: amount})
+	k.SetQuery(ctx, query)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSponsorQuery,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryID, id),
+			sdk.NewAttribute(types.AttributeKeyDepositor, sponsor.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// PayQueryFees releases the given escrowed deposits to the relayer of a query
+// response and records the relayer's earnings.
+func (k Keeper) PayQueryFees(ctx sdk.Context, query types.Query, deposits []types.QueryFeeDeposit, relayer sdk.AccAddress) error {
+	total := types.TotalFeeDeposits(deposits)
+	if total.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, total); err != nil {
+		return err
+	}
+
+	earnings, found := k.GetRelayerEarnings(ctx, relayer.String())
+	if !found {
+		earnings = types.RelayerEarnings{Relayer: relayer.String()}
+	}
+	earnings.Earned = earnings.Earned.Add(total...)
+	earnings.Responses++
+	k.SetRelayerEarnings(ctx, earnings)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueryFeePaid,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+			sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+			sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+		),
+	)
+
+	return nil
+}
+
+// RefundQueryFees returns the escrowed deposits of a query to their depositors.
+// Deposits escrowed from module accounts are returned to the module account,
+// as module accounts may not receive funds sent to their address.
+func (k Keeper) RefundQueryFees(ctx sdk.Context, query types.Query) {
+	for _, deposit := range query.FeeDeposits {
+		if deposit.Module != "" {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, deposit.Module, deposit.Amount); err != nil {
+				k.Logger(ctx).Error("unable to refund query fee", "id", query.Id, "module", deposit.Module, "error", err)
+			}
+			continue
+		}
+
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			k.Logger(ctx).Error("invalid query fee depositor", "id", query.Id, "depositor", deposit.Depositor, "error", err)
+			continue
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount); err != nil {
+			k.Logger(ctx).Error("unable to refund query fee", "id", query.Id, "depositor", deposit.Depositor, "error", err)
+		}
+	}
+}
+
+func addFeeDeposit(deposits []types.QueryFeeDeposit, deposit types.QueryFeeDeposit) []types.QueryFeeDeposit {
+	for i, existing := range deposits {
+		if existing.Depositor == deposit.Depositor {
+			deposits[i].Amount = existing.Amount.Add(deposit.Amount...)
+			return deposits
+		}
+	}
+	return append(deposits, deposit)
+}
+
+// GetRelayerEarnings returns the earnings record of the given relayer.
+func (k Keeper) GetRelayerEarnings(ctx sdk.Context, relayer string) (types.RelayerEarnings, bool) {
+	earnings := types.RelayerEarnings{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerEarnings)
+	bz := store.Get([]byte(relayer))
+	if len(bz) == 0 {
+		return earnings, false
+	}
+	k.cdc.MustUnmarshal(bz, &earnings)
+	return earnings, true
+}
+
+// SetRelayerEarnings set relayer earnings record
+func (k Keeper) SetRelayerEarnings(ctx sdk.Context, earnings types.RelayerEarnings) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerEarnings)
+	bz := k.cdc.MustMarshal(&earnings)
+	store.Set([]byte(earnings.Relayer), bz)
+}
+
+// IterateRelayerEarnings iterate through relayer earnings records
+func (k Keeper) IterateRelayerEarnings(ctx sdk.Context, fn func(index int64, earnings types.RelayerEarnings) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerEarnings)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		earnings := types.RelayerEarnings{}
+		k.cdc.MustUnmarshal(iterator.Value(), &earnings)
+		stop := fn(i, earnings)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllRelayerEarningsRecords returns every relayer earnings record in the store
+func (k Keeper) AllRelayerEarningsRecords(ctx sdk.Context) []types.RelayerEarnings {
+	earnings := []types.RelayerEarnings{}
+	k.IterateRelayerEarnings(ctx, func(_ int64, re types.RelayerEarnings) (stop bool) {
+		earnings = append(earnings, re)
+		return false
+	})
+	return earnings
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+	prtypes "github.com/ingenuity-build/quicksilver/x/participationrewards/types"
+)
+
+const testQueryType = "cosmos.staking.v1beta1.Query/Validators"
+
+func (suite *KeeperTestSuite) TestQueryFees() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqk := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	fee := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(100)))
+	icqk.SetParams(ctx, icqtypes.NewParams([]icqtypes.QueryTypeFee{{QueryType: testQueryType, Fee: fee}}, icqtypes.DefaultMaxOwnerQueries, icqtypes.DefaultOwnerQueryDeposit, icqtypes.DefaultContractCallbackGasLimit))
+
+	moduleAddress := authtypes.NewModuleAddress(icstypes.ModuleName)
+	suite.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, icstypes.ModuleName, fee))
+	moduleBalance := quicksilver.BankKeeper.GetAllBalances(ctx, moduleAddress)
+
+	sponsor := utils.GenerateAccAddressForTest()
+	sponsorship := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(50)))
+	suite.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sponsorship))
+	suite.Require().NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sponsor, sponsorship))
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.Require().NoError(err)
+
+	icqk.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, testQueryType, bz, sdk.NewInt(-1), icstypes.ModuleName, "", 0)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, testQueryType, bz, icstypes.ModuleName)
+
+	// the default fee is escrowed from the requesting module.
+	q, found := icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Len(q.FeeDeposits, 1)
+	suite.Require().Equal(moduleAddress.String(), q.FeeDeposits[0].Depositor)
+	suite.Require().Equal(icstypes.ModuleName, q.FeeDeposits[0].Module)
+	suite.Require().Equal(fee, q.FeeDeposits[0].Amount)
+	suite.Require().True(moduleBalance.Sub(fee...).IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, moduleAddress)))
+
+	icqmsgSrv := keeper.NewMsgServerImpl(icqk)
+
+	_, err = icqmsgSrv.SponsorQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgSponsorQuery(id, sponsorship, sponsor))
+	suite.Require().NoError(err)
+	suite.Require().True(quicksilver.BankKeeper.GetAllBalances(ctx, sponsor).IsZero())
+
+	_, err = icqmsgSrv.SponsorQuery(sdk.WrapSDKContext(ctx), icqtypes.NewMsgSponsorQuery(keeper.GenerateQueryHash("", "", "", nil, ""), sponsorship, sponsor))
+	suite.Require().Error(err)
+
+	q, found = icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Len(q.FeeDeposits, 2)
+	suite.Require().Equal(fee.Add(sponsorship...), icqtypes.TotalFeeDeposits(q.FeeDeposits))
+
+	qvr := stakingtypes.QueryValidatorsResponse{
+		Validators: suite.GetSimApp(suite.chainB).StakingKeeper.GetBondedValidatorsByPower(suite.chainB.GetContext()),
+	}
+	relayer := sdk.MustAccAddressFromBech32(TestOwnerAddress)
+	relayerBalance := quicksilver.BankKeeper.GetAllBalances(ctx, relayer)
+
+	qmsg := icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     id,
+		Result:      suite.GetSimApp(suite.chainB).AppCodec().MustMarshalJSON(&qvr),
+		Height:      suite.chainB.CurrentHeader.Height,
+		FromAddress: TestOwnerAddress,
+	}
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.Require().NoError(err)
+
+	// the relayer of the first valid response is paid the escrowed fees.
+	_, found = icqk.GetQuery(ctx, id)
+	suite.Require().False(found)
+	suite.Require().True(relayerBalance.Add(fee...).Add(sponsorship...).IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, relayer)))
+	suite.Require().True(quicksilver.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(icqtypes.ModuleName)).IsZero())
+
+	earnings, found := icqk.GetRelayerEarnings(ctx, TestOwnerAddress)
+	suite.Require().True(found)
+	suite.Require().Equal(fee.Add(sponsorship...), earnings.Earned)
+	suite.Require().Equal(uint64(1), earnings.Responses)
+
+	res, err := icqk.RelayerEarnings(sdk.WrapSDKContext(ctx), &icqtypes.QueryRelayerEarningsRequest{Relayer: TestOwnerAddress})
+	suite.Require().NoError(err)
+	suite.Require().Equal(earnings, res.Earnings)
+
+	all, err := icqk.AllRelayerEarnings(sdk.WrapSDKContext(ctx), &icqtypes.QueryAllRelayerEarningsRequest{Pagination: &query.PageRequest{}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]icqtypes.RelayerEarnings{earnings}, all.Earnings)
+
+	params, err := icqk.Params(sdk.WrapSDKContext(ctx), &icqtypes.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee, params.Params.FeeForQueryType(testQueryType).Fee)
+}
+
+func (suite *KeeperTestSuite) TestQueryFeesRefund() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqk := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	fee := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(100)))
+	icqk.SetParams(ctx, icqtypes.NewParams([]icqtypes.QueryTypeFee{{QueryType: testQueryType, Fee: fee}}, icqtypes.DefaultMaxOwnerQueries, icqtypes.DefaultOwnerQueryDeposit, icqtypes.DefaultContractCallbackGasLimit))
+
+	// the participationrewards module account is blocked from receiving funds sent to its address.
+	moduleAddress := authtypes.NewModuleAddress(prtypes.ModuleName)
+	suite.Require().True(quicksilver.BankKeeper.BlockedAddr(moduleAddress))
+	moduleBalance := quicksilver.BankKeeper.GetAllBalances(ctx, moduleAddress)
+
+	// a module unable to cover the fee makes the request without one.
+	icqk.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, testQueryType, []byte{0x01}, sdk.NewInt(-1), prtypes.ModuleName, "", 0)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, testQueryType, []byte{0x01}, prtypes.ModuleName)
+	q, found := icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Empty(q.FeeDeposits)
+
+	suite.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+	suite.Require().NoError(quicksilver.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, prtypes.ModuleName, fee))
+	icqk.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, testQueryType, []byte{0x01}, sdk.NewInt(-1), prtypes.ModuleName, "", 0)
+	q, found = icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Len(q.FeeDeposits, 1)
+	suite.Require().Equal(prtypes.ModuleName, q.FeeDeposits[0].Module)
+	suite.Require().True(moduleBalance.IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, moduleAddress)))
+
+	// abandoned queries refund their escrowed fees to the module account.
+	icqk.AbandonQuery(ctx, q, icqtypes.AbandonReasonDeadline)
+	suite.Require().True(moduleBalance.Add(fee...).IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, moduleAddress)))
+	suite.Require().True(quicksilver.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(icqtypes.ModuleName)).IsZero())
+
+	abandoned, found := icqk.GetAbandonedQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Empty(abandoned.Query.FeeDeposits)
+}
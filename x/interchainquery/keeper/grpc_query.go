@@ -47,3 +47,135 @@ func (k Keeper) Queries(c context.Context, req *types.QueryRequestsRequest) (*ty
 		Pagination: pageRes,
 	}, nil
 }
+
+// StaleQueries returns the pending queries that have gone unanswered beyond the retry interval.
+func (k Keeper) StaleQueries(c context.Context, req *types.QueryStaleRequestsRequest) (*types.QueryStaleRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var queries []types.Query
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var query types.Query
+		if err := k.cdc.Unmarshal(value, &query); err != nil {
+			return false, err
+		}
+
+		if query.ChainId == req.ChainId && query.IsStale(ctx.BlockHeight(), RetryInterval) {
+			if accumulate {
+				queries = append(queries, query)
+			}
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStaleRequestsResponse{
+		Queries:    queries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AbandonedQueries returns the queries that were abandoned without response.
+func (k Keeper) AbandonedQueries(c context.Context, req *types.QueryAbandonedRequestsRequest) (*types.QueryAbandonedRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var queries []types.AbandonedQuery
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAbandonedQuery)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var abandoned types.AbandonedQuery
+		if err := k.cdc.Unmarshal(value, &abandoned); err != nil {
+			return false, err
+		}
+
+		if abandoned.Query.ChainId == req.ChainId {
+			if accumulate {
+				queries = append(queries, abandoned)
+			}
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAbandonedRequestsResponse{
+		Queries:    queries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Params returns the total set of interchainquery parameters.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// RelayerEarnings returns the query fees earned by the given relayer.
+func (k Keeper) RelayerEarnings(c context.Context, req *types.QueryRelayerEarningsRequest) (*types.QueryRelayerEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Relayer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	earnings, found := k.GetRelayerEarnings(ctx, req.Relayer)
+	if !found {
+		earnings = types.RelayerEarnings{Relayer: req.Relayer}
+	}
+
+	return &types.QueryRelayerEarningsResponse{Earnings: earnings}, nil
+}
+
+// AllRelayerEarnings returns the query fees earned by all relayers.
+func (k Keeper) AllRelayerEarnings(c context.Context, req *types.QueryAllRelayerEarningsRequest) (*types.QueryAllRelayerEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var earnings []types.RelayerEarnings
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayerEarnings)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var re types.RelayerEarnings
+		if err := k.cdc.Unmarshal(value, &re); err != nil {
+			return err
+		}
+		earnings = append(earnings, re)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRelayerEarningsResponse{
+		Earnings:   earnings,
+		Pagination: pageRes,
+	}, nil
+}
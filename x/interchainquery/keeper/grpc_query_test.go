@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
 	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 )
 
@@ -37,3 +38,52 @@ func (suite *KeeperTestSuite) TestQueries() {
 	suite.Equal(sdk.NewInt(200), res.Queries[0].Period)
 	suite.Equal("", res.Queries[0].CallbackId)
 }
+
+func (suite *KeeperTestSuite) TestStaleAndAbandonedQueries() {
+	icqk := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	// unanswered periodic query, emitted beyond the retry interval
+	periodic := icqk.NewQuery("", suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, sdk.NewInt(200), "", 0)
+	periodic.LastEmission = sdk.NewInt(ctx.BlockHeight() - keeper.RetryInterval)
+	icqk.SetQuery(ctx, *periodic)
+
+	// answered periodic query
+	answered := icqk.NewQuery("", suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validator", bz, sdk.NewInt(200), "", 0)
+	answered.LastEmission = sdk.NewInt(ctx.BlockHeight() - keeper.RetryInterval)
+	answered.LastHeight = sdk.NewInt(ctx.BlockHeight() - 1)
+	icqk.SetQuery(ctx, *answered)
+
+	// abandoned query
+	abandoned := icqk.NewQuery("", suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Pool", bz, sdk.NewInt(-1), "", 0)
+	icqk.SetQuery(ctx, *abandoned)
+	icqk.AbandonQuery(ctx, *abandoned, icqtypes.AbandonReasonDeadline)
+
+	icqsrvSrv := icqtypes.QuerySrvrServer(icqk)
+
+	_, err = icqsrvSrv.StaleQueries(sdk.WrapSDKContext(ctx), nil)
+	suite.Error(err)
+
+	staleRes, err := icqsrvSrv.StaleQueries(sdk.WrapSDKContext(ctx), &icqtypes.QueryStaleRequestsRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Len(staleRes.Queries, 1)
+	suite.Equal(periodic.Id, staleRes.Queries[0].Id)
+
+	_, err = icqsrvSrv.AbandonedQueries(sdk.WrapSDKContext(ctx), nil)
+	suite.Error(err)
+
+	abandonedRes, err := icqsrvSrv.AbandonedQueries(sdk.WrapSDKContext(ctx), &icqtypes.QueryAbandonedRequestsRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Len(abandonedRes.Queries, 1)
+	suite.Equal(abandoned.Id, abandonedRes.Queries[0].Query.Id)
+	suite.Equal(icqtypes.AbandonReasonDeadline, abandonedRes.Queries[0].Reason)
+
+	abandonedRes, err = icqsrvSrv.AbandonedQueries(sdk.WrapSDKContext(ctx), &icqtypes.QueryAbandonedRequestsRequest{ChainId: "unknown-1"})
+	suite.NoError(err)
+	suite.Empty(abandonedRes.Queries)
+}
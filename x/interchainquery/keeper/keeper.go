@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibckeeper "github.com/cosmos/ibc-go/v5/modules/core/keeper"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -17,19 +18,28 @@ import (
 
 // Keeper of this module maintains collections of registered zones.
 type Keeper struct {
-	cdc       codec.Codec
-	storeKey  storetypes.StoreKey
-	callbacks map[string]types.QueryCallbacks
-	IBCKeeper *ibckeeper.Keeper
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+	callbacks  map[string]types.QueryCallbacks
+	bankKeeper types.BankKeeper
+	IBCKeeper  *ibckeeper.Keeper
 }
 
 // NewKeeper returns a new instance of zones Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, ibckeeper *ibckeeper.Keeper) Keeper {
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, ps paramtypes.Subspace, bankKeeper types.BankKeeper, ibckeeper *ibckeeper.Keeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		callbacks: make(map[string]types.QueryCallbacks),
-		IBCKeeper: ibckeeper,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: ps,
+		callbacks:  make(map[string]types.QueryCallbacks),
+		bankKeeper: bankKeeper,
+		IBCKeeper:  ibckeeper,
 	}
 }
 
@@ -42,6 +52,33 @@ func (k *Keeper) SetCallbackHandler(module string, handler types.QueryCallbacks)
 	return nil
 }
 
+// GetParams returns the total set of interchainquery parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	// params were added after genesis, so are unset until first updated; unset params take their default values.
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of interchainquery parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// requiresProof returns true if the module handling the given callback id only
+// accepts proven responses for it.
+func (k Keeper) requiresProof(callbackID string) bool {
+	if callbackID == "" {
+		return false
+	}
+	for _, module := range k.callbacks {
+		if module.Has(callbackID) && module.RequiresProof(callbackID) {
+			return true
+		}
+	}
+	return false
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -114,6 +151,17 @@ func (k *Keeper) GetDatapointOrRequest(ctx sdk.Context, module string, connectio
 }
 
 func (k *Keeper) MakeRequest(ctx sdk.Context, connectionID string, chainID string, queryType string, request []byte, period math.Int, module string, callbackID string, ttl uint64) {
+	k.makeRequest(ctx, connectionID, chainID, queryType, request, period, module, callbackID, ttl, 0)
+}
+
+// MakeHistoricalRequest requests a one-shot query for the remote state at the
+// given height. Only store key queries may be historical, so that the proof of
+// the response is verified against the consensus state at the requested height.
+func (k *Keeper) MakeHistoricalRequest(ctx sdk.Context, connectionID string, chainID string, queryType string, request []byte, height uint64, module string, callbackID string, ttl uint64) {
+	k.makeRequest(ctx, connectionID, chainID, queryType, request, sdk.NewInt(-1), module, callbackID, ttl, height)
+}
+
+func (k *Keeper) makeRequest(ctx sdk.Context, connectionID string, chainID string, queryType string, request []byte, period math.Int, module string, callbackID string, ttl uint64, height uint64) {
 	k.Logger(ctx).Info(
 		"MakeRequest",
 		"connection_id", connectionID,
@@ -124,8 +172,14 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, connectionID string, chainID strin
 		"module", module,
 		"callback", callbackID,
 		"ttl", ttl,
+		"height", height,
 	)
-	key := GenerateQueryHash(connectionID, chainID, queryType, request, module)
+	if height > 0 && !types.IsKeyQueryType(queryType) {
+		err := fmt.Errorf("historical query must be a store key query, not %s", queryType)
+		k.Logger(ctx).Error(err.Error())
+		panic(err)
+	}
+	key := GenerateHistoricalQueryHash(connectionID, chainID, queryType, request, module, height)
 	existingQuery, found := k.GetQuery(ctx, key)
 	if !found {
 		if module != "" && callbackID != "" {
@@ -139,13 +193,37 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, connectionID string, chainID strin
 				k.Logger(ctx).Error(err.Error())
 				panic(err)
 			}
+			if k.callbacks[module].RequiresProof(callbackID) && !types.IsProvableQueryType(queryType) {
+				err := fmt.Errorf("callback %s registered for module %s requires a provable query, not %s", callbackID, module, queryType)
+				k.Logger(ctx).Error(err.Error())
+				panic(err)
+			}
 		}
 		newQuery := k.NewQuery(module, connectionID, chainID, queryType, request, period, callbackID, ttl)
+		if height > 0 {
+			newQuery.Id = key
+			newQuery.Height = height
+		}
+		if period.IsNegative() {
+			newQuery.Deadline = uint64(ctx.BlockHeight()) + QueryDeadline
+		}
+		k.EscrowDefaultFee(ctx, module, newQuery)
 		k.SetQuery(ctx, *newQuery)
+		// a previously abandoned query is superseded by the new request.
+		k.DeleteAbandonedQuery(ctx, key)
 	} else {
 		// a re-request of an existing query triggers resetting of height to trigger immediately.
 		k.Logger(ctx).Info("re-request", "LastHeight", existingQuery.LastHeight)
 		existingQuery.LastHeight = sdk.ZeroInt()
+		// a re-request also resets the retry backoff and deadline.
+		existingQuery.Retries = 0
+		if existingQuery.Period.IsNegative() {
+			existingQuery.Deadline = uint64(ctx.BlockHeight()) + QueryDeadline
+		}
+		// a query already paid out is incentivised again for the next response.
+		if len(existingQuery.FeeDeposits) == 0 {
+			k.EscrowDefaultFee(ctx, module, &existingQuery)
+		}
 		k.SetQuery(ctx, existingQuery)
 	}
 }
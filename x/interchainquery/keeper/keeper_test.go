@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"errors"
+	"strconv"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -188,6 +190,62 @@ func (suite *KeeperTestSuite) TestSubmitQueryResponse() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMakeHistoricalRequest() {
+	icqk := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	connectionID := suite.path.EndpointB.ConnectionID
+	chainID := suite.chainB.ChainID
+	height := uint64(suite.chainB.CurrentHeader.Height - 1)
+	request := []byte{0x02, 0x01}
+
+	// only store key queries may be historical.
+	suite.Require().Panics(func() {
+		icqk.MakeHistoricalRequest(ctx, connectionID, chainID, "cosmos.staking.v1beta1.Query/Validators", request, height, "", "", 0)
+	})
+
+	icqk.MakeHistoricalRequest(ctx, connectionID, chainID, "store/bank/key", request, height, "", "", 0)
+
+	id := keeper.GenerateHistoricalQueryHash(connectionID, chainID, "store/bank/key", request, "", height)
+	suite.Require().NotEqual(keeper.GenerateQueryHash(connectionID, chainID, "store/bank/key", request, ""), id)
+	suite.Require().Equal(keeper.GenerateQueryHash(connectionID, chainID, "store/bank/key", request, ""), keeper.GenerateHistoricalQueryHash(connectionID, chainID, "store/bank/key", request, "", 0))
+
+	q, found := icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(height, q.Height)
+	suite.Require().True(q.Period.IsNegative())
+
+	// the requested height is emitted to relayers.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	icqk.EndBlocker(ctx)
+	emitted := false
+	for _, event := range ctx.EventManager().Events() {
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		if attrs[icqtypes.AttributeKeyQueryID] == id {
+			suite.Require().Equal(strconv.FormatUint(height, 10), attrs[icqtypes.AttributeKeyHeight])
+			emitted = true
+		}
+	}
+	suite.Require().True(emitted)
+
+	// responses at any other height are rejected.
+	icqmsgSrv := keeper.NewMsgServerImpl(icqk)
+	qmsg := icqtypes.MsgSubmitQueryResponse{
+		ChainId:     chainID,
+		QueryId:     id,
+		Result:      []byte{},
+		Height:      int64(height) + 1,
+		FromAddress: TestOwnerAddress,
+	}
+	_, err := icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.Require().True(errors.Is(err, icqtypes.ErrHeightMismatch))
+
+	_, found = icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+}
+
 func (suite *KeeperTestSuite) TestDataPoints() {
 	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
 	bz, err := bondedQuery.Marshal()
@@ -43,12 +43,36 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return &types.MsgSubmitQueryResponseResponse{}, nil
 	}
 
-	pathParts := strings.Split(q.QueryType, "/")
-	if pathParts[len(pathParts)-1] == "key" {
+	// historical queries must be answered at the requested height; for key
+	// queries, the proof is then verified against the consensus state at that height.
+	if q.Height > 0 && msg.Height != int64(q.Height) {
+		k.Logger(ctx).Error("response height does not match requested height", "id", q.Id, "requested", q.Height, "height", msg.Height)
+		return nil, fmt.Errorf("%w: requested %d, got %d", types.ErrHeightMismatch, q.Height, msg.Height)
+	}
+
+	switch {
+	case q.IsKeyQuery():
+		pathParts := strings.Split(q.QueryType, "/")
 		if err := utils.ValidateProofOps(ctx, k.IBCKeeper, q.ConnectionId, q.ChainId, msg.Height, pathParts[1], q.Request, msg.Result, msg.ProofOps); err != nil {
 			k.Logger(ctx).Error("failed to validate proofops", "id", q.Id, "type", q.QueryType)
 			return nil, err
 		}
+	case q.QueryType == types.QueryTypeTx:
+		if err := k.ValidateTxProof(ctx, q, msg.Result); err != nil {
+			k.Logger(ctx).Error("failed to validate tx proof", "id", q.Id, "type", q.QueryType, "error", err)
+			return nil, err
+		}
+	case k.requiresProof(q.CallbackId):
+		// the response cannot be proven, but the callback only accepts proven responses.
+		k.Logger(ctx).Error("rejecting unproven response", "id", q.Id, "type", q.QueryType, "callback", q.CallbackId)
+		return nil, fmt.Errorf("%w: %s for query type %s", types.ErrProofRequired, q.CallbackId, q.QueryType)
+	}
+
+	// detach escrowed fees from the query, to be paid out once the callback succeeds.
+	deposits := q.FeeDeposits
+	if len(deposits) > 0 {
+		q.FeeDeposits = nil
+		k.SetQuery(ctx, q)
 	}
 
 	noDelete := false
@@ -95,6 +119,11 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		}
 	}
 
+	// the callback may have updated the query, e.g. by re-requesting it.
+	if updated, found := k.GetQuery(ctx, q.Id); found {
+		q = updated
+	}
+
 	// check for and delete non-repeating queries, update any other
 	// - Period.IsNegative() indicates a single query;
 	// - noDelete indicates a response that triggered a re-query;
@@ -107,6 +136,18 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		k.SetQuery(ctx, q)
 	}
 
+	// the first response to pass validation and callback execution earns the escrowed fees.
+	if len(deposits) > 0 {
+		relayer, err := sdk.AccAddressFromBech32(msg.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.PayQueryFees(ctx, q, deposits, relayer); err != nil {
+			k.Logger(ctx).Error("failed to pay query fees", "id", q.Id, "error", err)
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -116,3 +157,25 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 
 	return &types.MsgSubmitQueryResponseResponse{}, nil
 }
+
+func (k msgServer) SponsorQuery(goCtx context.Context, msg *types.MsgSponsorQuery) (*types.MsgSponsorQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsor, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SponsorQuery(ctx, msg.QueryId, sponsor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgSponsorQueryResponse{}, nil
+}
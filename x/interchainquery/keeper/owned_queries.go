@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/v5/modules/core/03-connection/types"
+	tmclienttypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// RegisterOwnedQuery registers a store key query against an open connection on
+// behalf of the given owner, e.g. a contract. The query is periodic for a
+// positive period, or one-shot for a period of -1. A deposit is held from the
+// owner for the lifetime of the query, and the given fee is escrowed for the
+// relayer of the first response. Responses are delivered to the callback
+// registered by the given module for the callback id, and periodic query
+// results are retained as datapoints for one period.
+func (k Keeper) RegisterOwnedQuery(ctx sdk.Context, owner sdk.AccAddress, connectionID string, queryType string, request []byte, period math.Int, module string, callbackID string, fee sdk.Coins) (string, error) {
+	if !types.IsKeyQueryType(queryType) {
+		return "", fmt.Errorf("owned query must be a store key query, not %s", queryType)
+	}
+
+	if !period.IsPositive() && !period.Equal(sdk.NewInt(-1)) {
+		return "", fmt.Errorf("invalid period %s; must be positive, or -1 for a one-shot query", period)
+	}
+
+	if _, found := k.callbacks[module]; !found || !k.callbacks[module].Has(callbackID) {
+		return "", fmt.Errorf("no callback %s registered for module %s", callbackID, module)
+	}
+
+	chainID, err := k.chainIDForConnection(ctx, connectionID)
+	if err != nil {
+		return "", err
+	}
+
+	params := k.GetParams(ctx)
+	if k.OwnerQueryCount(ctx, owner) >= params.MaxOwnerQueries {
+		return "", fmt.Errorf("%w: %d", types.ErrOwnerQueryLimit, params.MaxOwnerQueries)
+	}
+
+	query := k.NewQuery(owner.String(), connectionID, chainID, queryType, request, period, callbackID, 0)
+	if _, found := k.GetQuery(ctx, query.Id); found {
+		return "", fmt.Errorf("%w: %s", types.ErrQueryExists, query.Id)
+	}
+	query.Owner = owner.String()
+	if period.IsPositive() {
+		query.Ttl = period.Uint64()
+	} else {
+		query.Deadline = uint64(ctx.BlockHeight()) + QueryDeadline
+	}
+
+	if !params.OwnerQueryDeposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, params.OwnerQueryDeposit); err != nil {
+			return "", err
+		}
+		query.OwnerDeposit = params.OwnerQueryDeposit
+	}
+
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, fee); err != nil {
+			return "", err
+		}
+		query.FeeDeposits = addFeeDeposit(query.FeeDeposits, types.QueryFeeDeposit{Depositor: owner.String(), Amount: fee})
+	}
+
+	k.SetQuery(ctx, *query)
+	k.DeleteAbandonedQuery(ctx, query.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterQuery,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyType, queryType),
+		),
+	)
+
+	return query.Id, nil
+}
+
+// RemoveOwnedQuery removes a query registered by the given owner, refunding
+// the owner deposit and any escrowed fees.
+func (k Keeper) RemoveOwnedQuery(ctx sdk.Context, owner sdk.AccAddress, id string) error {
+	query, found := k.GetQuery(ctx, id)
+	if !found {
+		return fmt.Errorf("no pending query found for id %s", id)
+	}
+
+	if query.Owner != owner.String() {
+		return fmt.Errorf("%w: %s", types.ErrNotQueryOwner, id)
+	}
+
+	k.DeleteQuery(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveQuery,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryID, id),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		),
+	)
+
+	return nil
+}
+
+// OwnerQueryCount returns the number of queries owned by the given account.
+func (k Keeper) OwnerQueryCount(ctx sdk.Context, owner sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOwnerQuery)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOwnerQueriesKey(owner))
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// setOwnerQueryIndex indexes the query against its owner.
+func (k Keeper) setOwnerQueryIndex(ctx sdk.Context, query types.Query) {
+	owner, err := sdk.AccAddressFromBech32(query.Owner)
+	if err != nil {
+		k.Logger(ctx).Error("invalid query owner", "id", query.Id, "owner", query.Owner, "error", err)
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOwnerQuery)
+	store.Set(types.GetOwnerQueryKey(owner, query.Id), []byte{0x01})
+}
+
+// releaseOwnerDeposit refunds the owner deposit of the query and removes the
+// query from the owner index.
+func (k Keeper) releaseOwnerDeposit(ctx sdk.Context, query types.Query) {
+	owner, err := sdk.AccAddressFromBech32(query.Owner)
+	if err != nil {
+		k.Logger(ctx).Error("invalid query owner", "id", query.Id, "owner", query.Owner, "error", err)
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOwnerQuery)
+	store.Delete(types.GetOwnerQueryKey(owner, query.Id))
+
+	if query.OwnerDeposit.IsZero() {
+		return
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, query.OwnerDeposit); err != nil {
+		k.Logger(ctx).Error("unable to refund owner deposit", "id", query.Id, "owner", query.Owner, "error", err)
+	}
+}
+
+// chainIDForConnection returns the chain id of the counterparty of the given
+// open connection.
+func (k Keeper) chainIDForConnection(ctx sdk.Context, connectionID string) (string, error) {
+	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return "", fmt.Errorf("connection %s not found", connectionID)
+	}
+	if connection.State != connectiontypes.OPEN {
+		return "", fmt.Errorf("connection %s is not open", connectionID)
+	}
+
+	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return "", fmt.Errorf("client state not found for connection %s", connectionID)
+	}
+
+	tmClientState, ok := clientState.(*tmclienttypes.ClientState)
+	if !ok {
+		return "", fmt.Errorf("unsupported client type for connection %s", connectionID)
+	}
+
+	return tmClientState.ChainId, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"errors"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/wasmbinding"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterOwnedQuery() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqk := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	connectionID := suite.path.EndpointA.ConnectionID
+
+	deposit := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(1000)))
+	icqk.SetParams(ctx, icqtypes.NewParams(icqtypes.DefaultDefaultFees, 1, deposit, icqtypes.DefaultContractCallbackGasLimit))
+
+	owner := utils.GenerateAccAddressForTest()
+	fee := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(100)))
+	funds := deposit.Add(fee...)
+	suite.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	suite.Require().NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, owner, funds))
+
+	register := func(connectionID string, queryType string, key []byte, period sdk.Int, callbackID string) (string, error) {
+		return icqk.RegisterOwnedQuery(ctx, owner, connectionID, queryType, key, period, wasmtypes.ModuleName, callbackID, fee)
+	}
+
+	// only store key queries, against open connections, with known callbacks may be registered.
+	_, err := register(connectionID, "cosmos.bank.v1beta1.Query/AllBalances", []byte{0x02}, sdk.NewInt(10), wasmbinding.ContractCallbackID)
+	suite.Require().Error(err)
+	_, err = register(connectionID, "store/bank/key", []byte{0x02}, sdk.ZeroInt(), wasmbinding.ContractCallbackID)
+	suite.Require().Error(err)
+	_, err = register("connection-99", "store/bank/key", []byte{0x02}, sdk.NewInt(10), wasmbinding.ContractCallbackID)
+	suite.Require().Error(err)
+	_, err = register(connectionID, "store/bank/key", []byte{0x02}, sdk.NewInt(10), "unknown")
+	suite.Require().Error(err)
+	suite.Require().True(funds.IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, owner)))
+
+	id, err := register(connectionID, "store/bank/key", []byte{0x02}, sdk.NewInt(10), wasmbinding.ContractCallbackID)
+	suite.Require().NoError(err)
+	suite.Require().True(quicksilver.BankKeeper.GetAllBalances(ctx, owner).IsZero())
+	suite.Require().Equal(uint64(1), icqk.OwnerQueryCount(ctx, owner))
+
+	q, found := icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.chainB.ChainID, q.ChainId)
+	suite.Require().Equal(owner.String(), q.Owner)
+	suite.Require().Equal(deposit, q.OwnerDeposit)
+	suite.Require().Equal(fee, icqtypes.TotalFeeDeposits(q.FeeDeposits))
+	suite.Require().Equal(uint64(10), q.Ttl)
+
+	// the owner query limit is enforced.
+	_, err = register(connectionID, "store/bank/key", []byte{0x03}, sdk.NewInt(-1), wasmbinding.ContractCallbackID)
+	suite.Require().True(errors.Is(err, icqtypes.ErrOwnerQueryLimit))
+
+	// only the owner may remove the query; the deposit and fee are refunded.
+	suite.Require().True(errors.Is(icqk.RemoveOwnedQuery(ctx, utils.GenerateAccAddressForTest(), id), icqtypes.ErrNotQueryOwner))
+	suite.Require().NoError(icqk.RemoveOwnedQuery(ctx, owner, id))
+	suite.Require().True(funds.IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, owner)))
+	suite.Require().Equal(uint64(0), icqk.OwnerQueryCount(ctx, owner))
+	_, found = icqk.GetQuery(ctx, id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestAbandonOwnedQuery() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqk := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	deposit := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(1000)))
+	icqk.SetParams(ctx, icqtypes.NewParams(icqtypes.DefaultDefaultFees, icqtypes.DefaultMaxOwnerQueries, deposit, icqtypes.DefaultContractCallbackGasLimit))
+
+	owner := utils.GenerateAccAddressForTest()
+	suite.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, deposit))
+	suite.Require().NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, owner, deposit))
+
+	id, err := icqk.RegisterOwnedQuery(ctx, owner, suite.path.EndpointA.ConnectionID, "store/bank/key", []byte{0x02}, sdk.NewInt(-1), wasmtypes.ModuleName, wasmbinding.ContractCallbackID, sdk.NewCoins())
+	suite.Require().NoError(err)
+
+	// the owner is not a contract, so the failure callback errors; the deposit is refunded regardless.
+	q, found := icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+	icqk.AbandonQuery(ctx, q, icqtypes.AbandonReasonDeadline)
+
+	suite.Require().True(deposit.IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, owner)))
+	suite.Require().Equal(uint64(0), icqk.OwnerQueryCount(ctx, owner))
+
+	aq, found := icqk.GetAbandonedQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().True(aq.Query.OwnerDeposit.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestRegisterOwnedQueryUnsetParams() {
+	quicksilver := suite.GetSimApp(suite.chainA)
+	icqk := quicksilver.InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	// a chain upgraded from a version without the owned query params has none set.
+	store := prefix.NewStore(ctx.KVStore(quicksilver.GetKey(paramstypes.StoreKey)), []byte(icqtypes.ModuleName+"/"))
+	store.Delete(icqtypes.KeyMaxOwnerQueries)
+	store.Delete(icqtypes.KeyOwnerQueryDeposit)
+	store.Delete(icqtypes.KeyContractCallbackGasLimit)
+	suite.Require().Equal(icqtypes.DefaultMaxOwnerQueries, icqk.GetParams(ctx).MaxOwnerQueries)
+	suite.Require().Equal(icqtypes.DefaultOwnerQueryDeposit, icqk.GetParams(ctx).OwnerQueryDeposit)
+	suite.Require().Equal(icqtypes.DefaultContractCallbackGasLimit, icqk.GetParams(ctx).ContractCallbackGasLimit)
+
+	owner := utils.GenerateAccAddressForTest()
+	suite.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, minttypes.ModuleName, icqtypes.DefaultOwnerQueryDeposit))
+	suite.Require().NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, owner, icqtypes.DefaultOwnerQueryDeposit))
+
+	id, err := icqk.RegisterOwnedQuery(ctx, owner, suite.path.EndpointA.ConnectionID, "store/bank/key", []byte{0x02}, sdk.NewInt(10), wasmtypes.ModuleName, wasmbinding.ContractCallbackID, sdk.NewCoins())
+	suite.Require().NoError(err)
+
+	q, found := icqk.GetQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(icqtypes.DefaultOwnerQueryDeposit, q.OwnerDeposit)
+}
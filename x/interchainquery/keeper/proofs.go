@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	tmclienttypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// ValidateTxProof verifies the response to a transaction query: the header must
+// be valid against the consensus state of the query's connection, the proof
+// must prove inclusion of the transaction in the header, and the transaction
+// must be the one requested.
+func (k Keeper) ValidateTxProof(ctx sdk.Context, query types.Query, result []byte) error {
+	req := tx.GetTxRequest{}
+	if err := k.cdc.Unmarshal(query.Request, &req); err != nil {
+		return err
+	}
+
+	res := types.GetTxWithProofResponse{}
+	if err := k.cdc.Unmarshal(result, &res); err != nil {
+		return err
+	}
+
+	if res.GetTxResponse() == nil || res.GetProof() == nil || res.GetHeader() == nil {
+		return errors.New("unable to validate proof. No proof submitted")
+	}
+
+	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, query.ConnectionId)
+	if !found {
+		return fmt.Errorf("unable to fetch connection %s", query.ConnectionId)
+	}
+	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return errors.New("unable to fetch client state")
+	}
+	/*
+	   We can call ClientKeeper.CheckHeaderAndUpdateState() here, but this causes state changes inside the IBCKeeper
+	   which feels bad. so instead we copy the below two functions wholesale from ibc-go (this sucks too, but with
+	   predictable behaviour) and validate the inbound header manually.
+	*/
+	consensusState, found := k.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, res.Header.TrustedHeight)
+	if !found {
+		return fmt.Errorf("unable to fetch consensus state for trusted height: %s", res.Header.TrustedHeight.String())
+	}
+
+	tmclientState, ok := clientState.(*tmclienttypes.ClientState)
+	if !ok {
+		return errors.New("unable to marshal client state")
+	}
+
+	tmconsensusState, ok := consensusState.(*tmclienttypes.ConsensusState)
+	if !ok {
+		return errors.New("unable to marshal consensus state")
+	}
+
+	// validate tendermint statefor
+	err := checkTMStateValidity(tmclientState, tmconsensusState, res.GetHeader(), ctx.BlockHeader().Time)
+	if err != nil {
+		return fmt.Errorf("unable to validate header; %w", err)
+	}
+
+	tmproof, err := tmtypes.TxProofFromProto(*res.GetProof())
+	if err != nil {
+		return fmt.Errorf("unable to marshal proof: %w", err)
+	}
+	err = tmproof.Validate(res.Header.Header.DataHash)
+	if err != nil {
+		return fmt.Errorf("unable to validate proof: %w", err)
+	}
+
+	// the proven transaction must be the one requested.
+	provenHash := fmt.Sprintf("%X", tmproof.Data.Hash())
+	if !strings.EqualFold(provenHash, req.Hash) {
+		return fmt.Errorf("proven tx hash %s does not match requested hash %s", provenHash, req.Hash)
+	}
+	if !strings.EqualFold(res.GetTxResponse().TxHash, req.Hash) {
+		return fmt.Errorf("tx response hash %s does not match requested hash %s", res.GetTxResponse().TxHash, req.Hash)
+	}
+
+	return nil
+}
+
+// pulled directly from ibc-go tm light client
+// checkTrustedHeader checks that consensus state matches trusted fields of Header
+func checkTrustedHeader(header *tmclienttypes.Header, consState *tmclienttypes.ConsensusState) error {
+	tmTrustedValidators, err := tmtypes.ValidatorSetFromProto(header.TrustedValidators)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "trusted validator set in not tendermint validator set type")
+	}
+
+	// assert that trustedVals is NextValidators of last trusted header
+	// to do this, we check that trustedVals.Hash() == consState.NextValidatorsHash
+	tvalHash := tmTrustedValidators.Hash()
+	if !bytes.Equal(consState.NextValidatorsHash, tvalHash) {
+		return sdkioerrors.Wrapf(
+			tmclienttypes.ErrInvalidValidatorSet,
+			"trusted validators %s, does not hash to latest trusted validators. Expected: %X, got: %X",
+			header.TrustedValidators, consState.NextValidatorsHash, tvalHash,
+		)
+	}
+	return nil
+}
+
+// checkTMStateValidity checks if the Tendermint header is valid.
+// CONTRACT: consState.Height == header.TrustedHeight
+// pulled directly from ibc-go tm light client
+func checkTMStateValidity(
+	clientState *tmclienttypes.ClientState, consState *tmclienttypes.ConsensusState,
+	header *tmclienttypes.Header, currentTimestamp time.Time,
+) error {
+	if err := checkTrustedHeader(header, consState); err != nil {
+		return err
+	}
+
+	// UpdateClient only accepts updates with a header at the same revision
+	// as the trusted consensus state
+	if header.GetHeight().GetRevisionNumber() != header.TrustedHeight.RevisionNumber {
+		return sdkioerrors.Wrapf(
+			tmclienttypes.ErrInvalidHeaderHeight,
+			"header height revision %d does not match trusted header revision %d",
+			header.GetHeight().GetRevisionNumber(), header.TrustedHeight.RevisionNumber,
+		)
+	}
+
+	tmTrustedValidators, err := tmtypes.ValidatorSetFromProto(header.TrustedValidators)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "trusted validator set in not tendermint validator set type")
+	}
+
+	tmSignedHeader, err := tmtypes.SignedHeaderFromProto(header.SignedHeader)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "signed header in not tendermint signed header type")
+	}
+
+	tmValidatorSet, err := tmtypes.ValidatorSetFromProto(header.ValidatorSet)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "validator set in not tendermint validator set type")
+	}
+
+	// assert header height is newer than consensus state
+	// if header.GetHeight().LTE(header.TrustedHeight) {
+	// 	return sdkioerrors.Wrapf(
+	// 		tmclienttypes.ErrInvalidHeader,
+	// 		"header height ≤ consensus state height (%s ≤ %s)", header.GetHeight(), header.TrustedHeight,
+	// 	)
+	// }
+
+	chainID := clientState.GetChainID()
+	// If chainID is in revision format, then set revision number of chainID with the revision number
+	// of the header we are verifying
+	// This is useful if the update is at a previous revision rather than an update to the latest revision
+	// of the client.
+	// The chainID must be set correctly for the previous revision before attempting verification.
+	// Updates for previous revisions are not supported if the chainID is not in revision format.
+	if clienttypes.IsRevisionFormat(chainID) {
+		chainID, _ = clienttypes.SetRevisionNumber(chainID, header.GetHeight().GetRevisionNumber())
+	}
+
+	// Construct a trusted header using the fields in consensus state
+	// Only Height, Time, and NextValidatorsHash are necessary for verification
+	trustedHeader := tmtypes.Header{
+		ChainID:            chainID,
+		Height:             int64(header.TrustedHeight.RevisionHeight),
+		Time:               consState.Timestamp,
+		NextValidatorsHash: consState.NextValidatorsHash,
+	}
+	signedHeader := tmtypes.SignedHeader{
+		Header: &trustedHeader,
+	}
+
+	// Verify next header with the passed-in trustedVals
+	// - asserts trusting period not passed
+	// - assert header timestamp is not past the trusting period
+	// - assert header timestamp is past latest stored consensus state timestamp
+	// - assert that a TrustLevel proportion of TrustedValidators signed new Commit
+	err = utils.Verify(
+		&signedHeader,
+		tmTrustedValidators, tmSignedHeader, tmValidatorSet,
+		clientState.TrustingPeriod, currentTimestamp, clientState.MaxClockDrift, clientState.TrustLevel.ToTendermint(),
+	)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "failed to verify header")
+	}
+	return nil
+}
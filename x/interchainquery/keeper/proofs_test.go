@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (suite *KeeperTestSuite) TestProofRequired() {
+	icqk := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.Require().NoError(err)
+
+	// requests that cannot be proven are refused for callbacks that require proof.
+	suite.Require().Panics(func() {
+		icqk.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, testQueryType, bz, sdk.NewInt(-1), icstypes.ModuleName, "delegation", 0)
+	})
+	suite.Require().NotPanics(func() {
+		icqk.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, testQueryType, bz, sdk.NewInt(-1), icstypes.ModuleName, "valset", 0)
+	})
+
+	// unproven responses are rejected for callbacks that require proof.
+	q := icqk.NewQuery(icstypes.ModuleName, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, testQueryType, bz, sdk.NewInt(-1), "delegation", 0)
+	icqk.SetQuery(ctx, *q)
+
+	icqmsgSrv := keeper.NewMsgServerImpl(icqk)
+	qmsg := icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     q.Id,
+		Result:      []byte{},
+		Height:      suite.chainB.CurrentHeader.Height,
+		FromAddress: TestOwnerAddress,
+	}
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.Require().True(errors.Is(err, icqtypes.ErrProofRequired))
+
+	_, found := icqk.GetQuery(ctx, q.Id)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestValidateTxProof() {
+	icqk := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	req := tx.GetTxRequest{Hash: "ABCDEF"}
+	bz, err := req.Marshal()
+	suite.Require().NoError(err)
+
+	q := icqk.NewQuery(icstypes.ModuleName, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, icqtypes.QueryTypeTx, bz, sdk.NewInt(-1), "deposittx", 0)
+	icqk.SetQuery(ctx, *q)
+
+	// tx responses without proof are rejected, regardless of callback.
+	res := icqtypes.GetTxWithProofResponse{TxResponse: &sdk.TxResponse{TxHash: "ABCDEF"}}
+	resbz, err := res.Marshal()
+	suite.Require().NoError(err)
+	suite.Require().Error(icqk.ValidateTxProof(ctx, *q, resbz))
+
+	icqmsgSrv := keeper.NewMsgServerImpl(icqk)
+	qmsg := icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     q.Id,
+		Result:      resbz,
+		Height:      suite.chainB.CurrentHeader.Height,
+		FromAddress: TestOwnerAddress,
+	}
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.Require().Error(err)
+}
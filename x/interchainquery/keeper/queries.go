@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -15,6 +16,17 @@ func GenerateQueryHash(connectionID string, chainID string, queryType string, re
 	return fmt.Sprintf("%x", crypto.Sha256(append([]byte(module+connectionID+chainID+queryType), request...)))
 }
 
+// GenerateHistoricalQueryHash returns the id of a query for the remote state at
+// the given height. A zero height denotes the latest state, for which the id is
+// that returned by GenerateQueryHash.
+func GenerateHistoricalQueryHash(connectionID string, chainID string, queryType string, request []byte, module string, height uint64) string {
+	if height == 0 {
+		return GenerateQueryHash(connectionID, chainID, queryType, request, module)
+	}
+	key := append(append([]byte{}, request...), sdk.Uint64ToBigEndian(height)...)
+	return GenerateQueryHash(connectionID, chainID, queryType, key, module)
+}
+
 // ----------------------------------------------------------------
 
 func (k Keeper) NewQuery(module string, connectionID string, chainID string, queryType string, request []byte, period math.Int, callbackID string, ttl uint64) *types.Query {
@@ -38,10 +50,20 @@ func (k Keeper) SetQuery(ctx sdk.Context, query types.Query) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
 	bz := k.cdc.MustMarshal(&query)
 	store.Set([]byte(query.Id), bz)
+	if query.Owner != "" {
+		k.setOwnerQueryIndex(ctx, query)
+	}
 }
 
-// DeleteQuery delete query info
+// DeleteQuery delete query info, refunding any fees escrowed against it, and
+// the owner deposit of owned queries.
 func (k Keeper) DeleteQuery(ctx sdk.Context, id string) {
+	if query, found := k.GetQuery(ctx, id); found {
+		k.RefundQueryFees(ctx, query)
+		if query.Owner != "" {
+			k.releaseOwnerDeposit(ctx, query)
+		}
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
 	store.Delete([]byte(id))
 }
@@ -74,3 +96,107 @@ func (k Keeper) AllQueries(ctx sdk.Context) []types.Query {
 	})
 	return queries
 }
+
+// GetAbandonedQuery returns the abandoned query record for the given id
+func (k Keeper) GetAbandonedQuery(ctx sdk.Context, id string) (types.AbandonedQuery, bool) {
+	abandoned := types.AbandonedQuery{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAbandonedQuery)
+	bz := store.Get([]byte(id))
+	if len(bz) == 0 {
+		return abandoned, false
+	}
+	k.cdc.MustUnmarshal(bz, &abandoned)
+	return abandoned, true
+}
+
+// SetAbandonedQuery set abandoned query record
+func (k Keeper) SetAbandonedQuery(ctx sdk.Context, abandoned types.AbandonedQuery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAbandonedQuery)
+	bz := k.cdc.MustMarshal(&abandoned)
+	store.Set([]byte(abandoned.Query.Id), bz)
+}
+
+// DeleteAbandonedQuery delete abandoned query record
+func (k Keeper) DeleteAbandonedQuery(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAbandonedQuery)
+	store.Delete([]byte(id))
+}
+
+// IterateAbandonedQueries iterate through abandoned query records
+func (k Keeper) IterateAbandonedQueries(ctx sdk.Context, fn func(index int64, abandoned types.AbandonedQuery) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAbandonedQuery)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		abandoned := types.AbandonedQuery{}
+		k.cdc.MustUnmarshal(iterator.Value(), &abandoned)
+		stop := fn(i, abandoned)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllAbandonedQueries returns every abandoned query record in the store
+func (k Keeper) AllAbandonedQueries(ctx sdk.Context) []types.AbandonedQuery {
+	abandoned := []types.AbandonedQuery{}
+	k.IterateAbandonedQueries(ctx, func(_ int64, aq types.AbandonedQuery) (stop bool) {
+		abandoned = append(abandoned, aq)
+		return false
+	})
+	return abandoned
+}
+
+// AbandonQuery removes an unanswered query, records it as abandoned and calls
+// the failure callback registered for it, if any, so the requesting module may
+// unwind any state awaiting the response.
+func (k Keeper) AbandonQuery(ctx sdk.Context, query types.Query, reason string) {
+	k.Logger(ctx).Error("abandoning query", "id", query.Id, "chain_id", query.ChainId, "type", query.QueryType, "retries", query.Retries, "reason", reason)
+
+	k.DeleteQuery(ctx, query.Id)
+	// escrowed fees and owner deposits are refunded on deletion.
+	query.FeeDeposits = nil
+	query.OwnerDeposit = nil
+	k.SetAbandonedQuery(ctx, types.AbandonedQuery{Query: query, Height: ctx.BlockHeight(), Reason: reason})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAbandonQuery,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+			sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, query.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+			sdk.NewAttribute(types.AttributeKeyRetries, fmt.Sprintf("%d", query.Retries)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	if query.CallbackId == "" {
+		return
+	}
+
+	keys := []string{}
+	for k := range k.callbacks {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		module := k.callbacks[key]
+		if module.Has(query.CallbackId) {
+			// failure callbacks must not halt the chain; discard any state changes on error.
+			cacheCtx, write := ctx.CacheContext()
+			if err := module.CallFailure(cacheCtx, query.CallbackId, query); err != nil {
+				k.Logger(ctx).Error("error in failure callback", "error", err, "id", query.Id, "callback", query.CallbackId)
+				return
+			}
+			write()
+			return
+		}
+	}
+}
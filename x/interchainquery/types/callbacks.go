@@ -9,4 +9,14 @@ type QueryCallbacks interface {
 	RegisterCallbacks() QueryCallbacks
 	Call(ctx sdk.Context, id string, args []byte, query Query) error
 	Has(id string) bool
+	// AddFailureCallback registers fn to be called when a query with the given
+	// callback id is abandoned without response.
+	AddFailureCallback(id string, fn interface{}) QueryCallbacks
+	// CallFailure calls the failure callback registered for the given id, if any.
+	CallFailure(ctx sdk.Context, id string, query Query) error
+	// RequireProof marks the given callback id as requiring proven responses;
+	// unproven responses for it are rejected.
+	RequireProof(id string) QueryCallbacks
+	// RequiresProof returns true if responses for the given callback id must be proven.
+	RequiresProof(id string) bool
 }
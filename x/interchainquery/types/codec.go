@@ -15,11 +15,13 @@ var (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitQueryResponse{}, "quicksilver/MsgSubmitQueryResponse", nil)
+	cdc.RegisterConcrete(&MsgSponsorQuery{}, "quicksilver/MsgSponsorQuery", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitQueryResponse{},
+		&MsgSponsorQuery{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
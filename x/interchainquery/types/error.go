@@ -5,4 +5,9 @@ import "errors"
 var (
 	ErrAlreadyFulfilled  = errors.New("query already fulfilled")
 	ErrSucceededNoDelete = errors.New("query succeeded; do not not execute default behavior")
+	ErrProofRequired     = errors.New("callback requires a proven response")
+	ErrHeightMismatch    = errors.New("response height does not match requested height")
+	ErrQueryExists       = errors.New("query already registered")
+	ErrOwnerQueryLimit   = errors.New("owner query limit reached")
+	ErrNotQueryOwner     = errors.New("account is not the owner of the query")
 )
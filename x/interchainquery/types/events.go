@@ -8,6 +8,17 @@ const (
 	AttributeKeyParams       = "parameters"
 	AttributeKeyRequest      = "request"
 	AttributeKeyHeight       = "height"
+	AttributeKeyRetries      = "retries"
+	AttributeKeyReason       = "reason"
+	AttributeKeyRelayer      = "relayer"
+	AttributeKeyDepositor    = "depositor"
+	AttributeKeyOwner        = "owner"
+
+	EventTypeAbandonQuery  = "abandon_query"
+	EventTypeQueryFeePaid  = "query_fee_paid"
+	EventTypeSponsorQuery  = "sponsor_query"
+	EventTypeRegisterQuery = "register_query"
+	EventTypeRemoveQuery   = "remove_query"
 
 	AttributeValueCategory = ModuleName
 	AttributeValueQuery    = "query"
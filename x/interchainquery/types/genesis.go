@@ -1,18 +1,36 @@
 package types
 
-func NewGenesisState(queries []Query) *GenesisState {
-	return &GenesisState{Queries: queries}
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewGenesisState(queries []Query, params Params) *GenesisState {
+	return &GenesisState{Queries: queries, Params: params}
 }
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesisState() *GenesisState {
 	queries := []Query{}
-	return NewGenesisState(queries)
+	return NewGenesisState(queries, DefaultParams())
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: validate genesis state.
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, earnings := range gs.RelayerEarnings {
+		if _, err := sdk.AccAddressFromBech32(earnings.Relayer); err != nil {
+			return fmt.Errorf("invalid relayer address %s: %w", earnings.Relayer, err)
+		}
+		if err := earnings.Earned.Validate(); err != nil {
+			return fmt.Errorf("invalid earnings for relayer %s: %w", earnings.Relayer, err)
+		}
+	}
+
 	return nil
 }
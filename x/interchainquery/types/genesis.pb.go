@@ -25,7 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
-	Queries []Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Queries          []Query           `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	AbandonedQueries []AbandonedQuery  `protobuf:"bytes,2,rep,name=abandoned_queries,json=abandonedQueries,proto3" json:"abandoned_queries"`
+	Params           Params            `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	RelayerEarnings  []RelayerEarnings `protobuf:"bytes,4,rep,name=relayer_earnings,json=relayerEarnings,proto3" json:"relayer_earnings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,22 +73,28 @@ func init() {
 }
 
 var fileDescriptor_90232048b76e95cc = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0x2c, 0xcd, 0x4c,
-	0xce, 0x2e, 0xce, 0xcc, 0x29, 0x4b, 0x2d, 0xd2, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48,
-	0xcc, 0xcc, 0x2b, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x43, 0x52, 0xad, 0x87, 0xa6, 0x5a,
-	0xaf, 0xcc, 0x50, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x54, 0x1f, 0xc4, 0x82, 0xe8, 0x92,
-	0x32, 0x21, 0x60, 0x07, 0xba, 0x41, 0x60, 0x5d, 0x4a, 0xd1, 0x5c, 0x3c, 0xee, 0x10, 0xcb, 0x83,
-	0x4b, 0x12, 0x4b, 0x52, 0x85, 0x5c, 0xb9, 0xd8, 0x41, 0xd2, 0x99, 0xa9, 0xc5, 0x12, 0x8c, 0x0a,
-	0xcc, 0x1a, 0xdc, 0x46, 0xaa, 0x7a, 0xf8, 0x5d, 0xa3, 0x17, 0x08, 0x62, 0x38, 0xb1, 0x9c, 0xb8,
-	0x27, 0xcf, 0x10, 0x04, 0xd3, 0x6b, 0xc5, 0xd2, 0xb1, 0x40, 0x9e, 0xc1, 0x29, 0xe2, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xec, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
-	0x92, 0xf3, 0x73, 0xf5, 0x33, 0xf3, 0xd2, 0x53, 0xf3, 0x4a, 0x33, 0x4b, 0x2a, 0x75, 0x93, 0x4a,
-	0x33, 0x73, 0x52, 0xf4, 0x91, 0xfd, 0x51, 0x81, 0xe1, 0x93, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
-	0x36, 0xb0, 0xeb, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xdb, 0x0d, 0xe8, 0x59, 0x01,
-	0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0x02, 0x41,
+	0x18, 0xc7, 0x77, 0x55, 0x0c, 0xd6, 0x20, 0x5b, 0x3a, 0x88, 0x87, 0x51, 0x82, 0xc2, 0x43, 0xcd,
+	0xa0, 0x75, 0xea, 0x10, 0x24, 0x49, 0xd7, 0xb2, 0x4b, 0x74, 0xb1, 0x59, 0xfd, 0x18, 0x87, 0x74,
+	0x46, 0x67, 0x66, 0xa5, 0x7d, 0x83, 0x8e, 0x3d, 0x42, 0x8f, 0xe3, 0xd1, 0x63, 0xa7, 0x08, 0x85,
+	0x9e, 0x23, 0x76, 0x5c, 0x61, 0x33, 0x68, 0x6f, 0xc3, 0x37, 0xff, 0xdf, 0xef, 0xfb, 0xe0, 0xef,
+	0x9d, 0x4c, 0x43, 0xde, 0x7f, 0xd6, 0x7c, 0x34, 0x03, 0x45, 0xb8, 0x30, 0xa0, 0xfa, 0x43, 0xca,
+	0xc5, 0x34, 0x04, 0x15, 0x91, 0x59, 0x93, 0x30, 0x10, 0xa0, 0xb9, 0xc6, 0x13, 0x25, 0x8d, 0xf4,
+	0x51, 0x2a, 0x8d, 0xb7, 0xd2, 0x78, 0xd6, 0xac, 0x1e, 0x30, 0xc9, 0xa4, 0x8d, 0x92, 0xf8, 0xb5,
+	0xa6, 0xaa, 0xe7, 0x19, 0x3b, 0xb6, 0x45, 0x96, 0x3a, 0xfc, 0xce, 0x79, 0xbb, 0x37, 0xeb, 0xed,
+	0xf7, 0x86, 0x1a, 0xf0, 0x3b, 0xde, 0x4e, 0xfc, 0xcf, 0x41, 0x57, 0xdc, 0x7a, 0xbe, 0x51, 0x6a,
+	0x1d, 0xe1, 0xff, 0xcf, 0xc1, 0x77, 0xf1, 0xa3, 0x5d, 0x98, 0x7f, 0xd6, 0x9c, 0xee, 0x86, 0xf5,
+	0xa9, 0xb7, 0x4f, 0x03, 0x2a, 0x06, 0x52, 0xc0, 0xa0, 0xb7, 0x11, 0xe6, 0xac, 0x10, 0x67, 0x09,
+	0xaf, 0x36, 0x60, 0xda, 0x5c, 0xa6, 0xe9, 0x69, 0xbc, 0xe2, 0xda, 0x2b, 0x4e, 0xa8, 0xa2, 0x63,
+	0x5d, 0xc9, 0xd7, 0xdd, 0x46, 0xa9, 0x75, 0x9c, 0xe5, 0xbd, 0xb5, 0xe9, 0xc4, 0x97, 0xb0, 0xfe,
+	0x93, 0x57, 0x56, 0x30, 0xa2, 0x11, 0xa8, 0x1e, 0x50, 0x25, 0xb8, 0x60, 0xba, 0x52, 0xb0, 0x77,
+	0x92, 0x2c, 0x5f, 0x77, 0xcd, 0x75, 0x12, 0x2c, 0x11, 0xef, 0xa9, 0xdf, 0xe3, 0x8b, 0xc2, 0xeb,
+	0x7b, 0xcd, 0x69, 0x3f, 0xcc, 0x97, 0xc8, 0x5d, 0x2c, 0x91, 0xfb, 0xb5, 0x44, 0xee, 0xdb, 0x0a,
+	0x39, 0x8b, 0x15, 0x72, 0x3e, 0x56, 0xc8, 0x79, 0xbc, 0x64, 0xdc, 0x0c, 0xc3, 0x00, 0xf7, 0xe5,
+	0x98, 0x70, 0xc1, 0x40, 0x84, 0xdc, 0x44, 0xa7, 0x41, 0xc8, 0x47, 0x03, 0x92, 0xee, 0xf4, 0xe5,
+	0x4f, 0xab, 0x26, 0x9a, 0x80, 0x0e, 0x8a, 0xb6, 0xc9, 0xb3, 0x9f, 0x01, 0x00, 0x31, 0xde, 0x8a,
+	0xe6, 0x65, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -108,6 +117,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RelayerEarnings) > 0 {
+		for iNdEx := len(m.RelayerEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AbandonedQueries) > 0 {
+		for iNdEx := len(m.AbandonedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AbandonedQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Queries) > 0 {
 		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -148,6 +195,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AbandonedQueries) > 0 {
+		for _, e := range m.AbandonedQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RelayerEarnings) > 0 {
+		for _, e := range m.RelayerEarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -220,6 +281,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbandonedQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbandonedQueries = append(m.AbandonedQueries, AbandonedQuery{})
+			if err := m.AbandonedQueries[len(m.AbandonedQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerEarnings = append(m.RelayerEarnings, RelayerEarnings{})
+			if err := m.RelayerEarnings[len(m.RelayerEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
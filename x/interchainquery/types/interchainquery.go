@@ -1,11 +1,87 @@
 package types
 
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	AbandonReasonDeadline   = "deadline exceeded"
+	AbandonReasonMaxRetries = "max retries exceeded"
+
+	// QueryTypeTx is the query type of transaction queries, the responses to
+	// which are proven by a transaction inclusion proof.
+	QueryTypeTx = "tendermint.Tx"
+
+	// maxBackoffShift bounds the retry backoff, to avoid overflow.
+	maxBackoffShift = 32
+)
+
 func (q Query) ValidateBasic() error {
 	// TODO: implement
 	return nil
 }
 
+// IsKeyQuery returns true if the query is a store key query, the responses to
+// which are proven by a merkle proof of the remote store.
+func (q Query) IsKeyQuery() bool {
+	return IsKeyQueryType(q.QueryType)
+}
+
+// IsProvableQueryType returns true if responses to queries of the given type
+// are verified against a proof; responses to other queries are trusted as
+// submitted.
+func IsProvableQueryType(queryType string) bool {
+	return IsKeyQueryType(queryType) || queryType == QueryTypeTx
+}
+
+// IsKeyQueryType returns true if queries of the given type are store key queries.
+func IsKeyQueryType(queryType string) bool {
+	pathParts := strings.Split(queryType, "/")
+	return pathParts[len(pathParts)-1] == "key"
+}
+
+// NextEmission returns the block height at which an unanswered one-shot query
+// is next due to be emitted; the interval doubles with every retry.
+func (q Query) NextEmission(interval int64) int64 {
+	shift := q.Retries
+	if shift > maxBackoffShift {
+		shift = maxBackoffShift
+	}
+	return q.LastEmission.Int64() + interval<<shift
+}
+
+// IsStale returns true if the query has been emitted and remains unanswered
+// for at least the given interval.
+func (q Query) IsStale(height int64, interval int64) bool {
+	if q.Retries > 0 {
+		return true
+	}
+	if q.LastEmission.IsNil() || q.LastEmission.IsZero() {
+		return false
+	}
+	if !q.LastHeight.IsNil() && q.LastHeight.GTE(q.LastEmission) {
+		return false
+	}
+	return height >= q.LastEmission.Int64()+interval
+}
+
+// HasExpired returns true if the query has a deadline that has passed.
+func (q Query) HasExpired(height int64) bool {
+	return q.Deadline > 0 && uint64(height) > q.Deadline
+}
+
 func (dp DataPoint) ValidateBasic() error {
 	// TODO: implement
 	return nil
 }
+
+// TotalFeeDeposits returns the sum of the given fee deposits.
+func TotalFeeDeposits(deposits []QueryFeeDeposit) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, deposit := range deposits {
+		total = total.Add(deposit.Amount...)
+	}
+	return total
+}
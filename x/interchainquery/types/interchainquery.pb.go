@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -37,6 +38,24 @@ type Query struct {
 	CallbackId   string                                 `protobuf:"bytes,8,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	Ttl          uint64                                 `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	LastEmission github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=last_emission,json=lastEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_emission"`
+	// retries is the number of times an unanswered one-shot query has been
+	// re-emitted.
+	Retries uint64 `protobuf:"varint,11,opt,name=retries,proto3" json:"retries,omitempty"`
+	// deadline is the block height by which a one-shot query must be answered
+	// before it is abandoned; zero denotes no deadline.
+	Deadline uint64 `protobuf:"varint,12,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// fee_deposits are the fees escrowed against the query, paid to the relayer
+	// of the first valid response.
+	FeeDeposits []QueryFeeDeposit `protobuf:"bytes,13,rep,name=fee_deposits,json=feeDeposits,proto3" json:"fee_deposits"`
+	// height is the remote block height at which the query is to be answered;
+	// zero denotes the latest height.
+	Height uint64 `protobuf:"varint,14,opt,name=height,proto3" json:"height,omitempty"`
+	// owner is the account, e.g. a contract, that registered the query; empty
+	// for queries requested by modules.
+	Owner string `protobuf:"bytes,15,opt,name=owner,proto3" json:"owner,omitempty"`
+	// owner_deposit is the deposit held from the owner for the lifetime of the
+	// query, refunded on its removal.
+	OwnerDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,16,rep,name=owner_deposit,json=ownerDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"owner_deposit"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -121,6 +140,334 @@ func (m *Query) GetTtl() uint64 {
 	return 0
 }
 
+func (m *Query) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Query) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Query) GetFeeDeposits() []QueryFeeDeposit {
+	if m != nil {
+		return m.FeeDeposits
+	}
+	return nil
+}
+
+func (m *Query) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Query) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Query) GetOwnerDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OwnerDeposit
+	}
+	return nil
+}
+
+// QueryFeeDeposit is an amount escrowed against a query by a depositor.
+type QueryFeeDeposit struct {
+	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// module is the name of the module account from which the deposit was
+	// escrowed, to which it is refunded; empty for other depositors.
+	Module string `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (m *QueryFeeDeposit) Reset()         { *m = QueryFeeDeposit{} }
+func (m *QueryFeeDeposit) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDeposit) ProtoMessage()    {}
+func (*QueryFeeDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12f0828e1ddee43, []int{1}
+}
+func (m *QueryFeeDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDeposit.Merge(m, src)
+}
+func (m *QueryFeeDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDeposit proto.InternalMessageInfo
+
+func (m *QueryFeeDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *QueryFeeDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *QueryFeeDeposit) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+// QueryTypeFee is the default fee escrowed by a requesting module for queries
+// of the given type.
+type QueryTypeFee struct {
+	QueryType string                                   `protobuf:"bytes,1,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	Fee       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *QueryTypeFee) Reset()         { *m = QueryTypeFee{} }
+func (m *QueryTypeFee) String() string { return proto.CompactTextString(m) }
+func (*QueryTypeFee) ProtoMessage()    {}
+func (*QueryTypeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12f0828e1ddee43, []int{2}
+}
+func (m *QueryTypeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTypeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTypeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTypeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTypeFee.Merge(m, src)
+}
+func (m *QueryTypeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTypeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTypeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTypeFee proto.InternalMessageInfo
+
+func (m *QueryTypeFee) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
+	}
+	return ""
+}
+
+func (m *QueryTypeFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// Params holds parameters for the interchainquery module.
+type Params struct {
+	DefaultFees []QueryTypeFee `protobuf:"bytes,1,rep,name=default_fees,json=defaultFees,proto3" json:"default_fees"`
+	// max_owner_queries is the maximum number of queries an account may own.
+	MaxOwnerQueries uint64 `protobuf:"varint,2,opt,name=max_owner_queries,json=maxOwnerQueries,proto3" json:"max_owner_queries,omitempty"`
+	// owner_query_deposit is the deposit held from an account for each query it
+	// owns.
+	OwnerQueryDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=owner_query_deposit,json=ownerQueryDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"owner_query_deposit"`
+	// contract_callback_gas_limit is the gas limit of the sudo call delivering
+	// the outcome of a query to the contract that owns it.
+	ContractCallbackGasLimit uint64 `protobuf:"varint,4,opt,name=contract_callback_gas_limit,json=contractCallbackGasLimit,proto3" json:"contract_callback_gas_limit,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12f0828e1ddee43, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// RelayerEarnings is the record of query fees paid to a relayer.
+type RelayerEarnings struct {
+	Relayer string                                   `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Earned  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=earned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earned"`
+	// responses is the number of fee paying responses submitted.
+	Responses uint64 `protobuf:"varint,3,opt,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *RelayerEarnings) Reset()         { *m = RelayerEarnings{} }
+func (m *RelayerEarnings) String() string { return proto.CompactTextString(m) }
+func (*RelayerEarnings) ProtoMessage()    {}
+func (*RelayerEarnings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12f0828e1ddee43, []int{4}
+}
+func (m *RelayerEarnings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerEarnings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerEarnings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerEarnings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerEarnings.Merge(m, src)
+}
+func (m *RelayerEarnings) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerEarnings) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerEarnings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerEarnings proto.InternalMessageInfo
+
+func (m *RelayerEarnings) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerEarnings) GetEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earned
+	}
+	return nil
+}
+
+func (m *RelayerEarnings) GetResponses() uint64 {
+	if m != nil {
+		return m.Responses
+	}
+	return 0
+}
+
+// AbandonedQuery is a record of a query that was abandoned without response.
+type AbandonedQuery struct {
+	Query Query `protobuf:"bytes,1,opt,name=query,proto3" json:"query"`
+	// height is the block height at which the query was abandoned.
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *AbandonedQuery) Reset()         { *m = AbandonedQuery{} }
+func (m *AbandonedQuery) String() string { return proto.CompactTextString(m) }
+func (*AbandonedQuery) ProtoMessage()    {}
+func (*AbandonedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12f0828e1ddee43, []int{5}
+}
+func (m *AbandonedQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbandonedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbandonedQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AbandonedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonedQuery.Merge(m, src)
+}
+func (m *AbandonedQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *AbandonedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbandonedQuery proto.InternalMessageInfo
+
+func (m *AbandonedQuery) GetQuery() Query {
+	if m != nil {
+		return m.Query
+	}
+	return Query{}
+}
+
+func (m *AbandonedQuery) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AbandonedQuery) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type DataPoint struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// change these to uint64 in v0.5.0
@@ -133,7 +480,7 @@ func (m *DataPoint) Reset()         { *m = DataPoint{} }
 func (m *DataPoint) String() string { return proto.CompactTextString(m) }
 func (*DataPoint) ProtoMessage()    {}
 func (*DataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e12f0828e1ddee43, []int{1}
+	return fileDescriptor_e12f0828e1ddee43, []int{6}
 }
 func (m *DataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,6 +525,11 @@ func (m *DataPoint) GetValue() []byte {
 
 func init() {
 	proto.RegisterType((*Query)(nil), "quicksilver.interchainquery.v1.Query")
+	proto.RegisterType((*QueryFeeDeposit)(nil), "quicksilver.interchainquery.v1.QueryFeeDeposit")
+	proto.RegisterType((*QueryTypeFee)(nil), "quicksilver.interchainquery.v1.QueryTypeFee")
+	proto.RegisterType((*Params)(nil), "quicksilver.interchainquery.v1.Params")
+	proto.RegisterType((*RelayerEarnings)(nil), "quicksilver.interchainquery.v1.RelayerEarnings")
+	proto.RegisterType((*AbandonedQuery)(nil), "quicksilver.interchainquery.v1.AbandonedQuery")
 	proto.RegisterType((*DataPoint)(nil), "quicksilver.interchainquery.v1.DataPoint")
 }
 
@@ -186,38 +538,67 @@ func init() {
 }
 
 var fileDescriptor_e12f0828e1ddee43 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x8b, 0xd3, 0x50,
-	0x10, 0x6e, 0xda, 0x6d, 0xbb, 0x9d, 0xb6, 0xb2, 0x3c, 0xf6, 0x90, 0x5d, 0x30, 0x2d, 0x2b, 0x48,
-	0x11, 0xdb, 0xb2, 0xe8, 0x51, 0x3c, 0x14, 0x05, 0x73, 0xd3, 0xb0, 0x07, 0x11, 0x24, 0xbc, 0x26,
-	0x8f, 0xf4, 0xd1, 0x97, 0xf7, 0xd2, 0xbc, 0x49, 0x31, 0xbf, 0xc1, 0x8b, 0x3f, 0xc6, 0x1f, 0xb1,
-	0xc7, 0xc5, 0x93, 0x28, 0x14, 0x69, 0x6f, 0xfe, 0x0a, 0xc9, 0x4b, 0x8a, 0x65, 0xf7, 0xda, 0x53,
-	0x66, 0xe6, 0x9b, 0xf9, 0xe6, 0x9b, 0xc9, 0x1b, 0x78, 0xb9, 0xca, 0x78, 0xb0, 0xd4, 0x5c, 0xac,
-	0x59, 0x3a, 0xe5, 0x12, 0x59, 0x1a, 0x2c, 0x28, 0x97, 0xab, 0x8c, 0xa5, 0xf9, 0x74, 0x7d, 0x7d,
-	0x3f, 0x34, 0x49, 0x52, 0x85, 0x8a, 0x38, 0x07, 0x55, 0x93, 0xfb, 0x29, 0xeb, 0xeb, 0xcb, 0xf3,
-	0x48, 0x45, 0xca, 0xa4, 0x4e, 0x0b, 0xab, 0xac, 0xba, 0xbc, 0x08, 0x94, 0x8e, 0x95, 0xf6, 0x4b,
-	0xa0, 0x74, 0x4a, 0xe8, 0xea, 0x77, 0x03, 0x9a, 0x1f, 0x8a, 0x6a, 0xf2, 0x08, 0xea, 0x3c, 0xb4,
-	0xad, 0xa1, 0x35, 0xea, 0x78, 0x75, 0x1e, 0x92, 0x27, 0xd0, 0x0f, 0x94, 0x94, 0x2c, 0x40, 0xae,
-	0xa4, 0xcf, 0x43, 0xbb, 0x6e, 0xa0, 0xde, 0xff, 0xa0, 0x1b, 0x92, 0x0b, 0x38, 0x35, 0x02, 0x0a,
-	0xbc, 0x61, 0xf0, 0xb6, 0xf1, 0xdd, 0x90, 0x3c, 0x06, 0x30, 0xb2, 0x7c, 0xcc, 0x13, 0x66, 0x9f,
-	0x18, 0xb0, 0x63, 0x22, 0x37, 0x79, 0xc2, 0x88, 0x0d, 0xed, 0x94, 0xad, 0x32, 0xa6, 0xd1, 0x6e,
-	0x0e, 0xad, 0x51, 0xcf, 0xdb, 0xbb, 0xe4, 0x06, 0x5a, 0x09, 0x4b, 0xb9, 0x0a, 0xed, 0x56, 0x51,
-	0x34, 0x7b, 0x75, 0xbb, 0x19, 0xd4, 0x7e, 0x6d, 0x06, 0x4f, 0x23, 0x8e, 0x8b, 0x6c, 0x3e, 0x09,
-	0x54, 0x5c, 0xcd, 0x50, 0x7d, 0xc6, 0x3a, 0x5c, 0x4e, 0x8b, 0x2e, 0x7a, 0xe2, 0x4a, 0xfc, 0xf1,
-	0x7d, 0x0c, 0xd5, 0x88, 0xae, 0x44, 0xaf, 0xe2, 0x22, 0x9f, 0xa1, 0x2b, 0xa8, 0x46, 0x7f, 0xc1,
-	0x78, 0xb4, 0x40, 0xbb, 0x7d, 0x04, 0x6a, 0x28, 0x08, 0xdf, 0x19, 0x3e, 0x32, 0x80, 0x6e, 0x40,
-	0x85, 0x98, 0xd3, 0x60, 0x59, 0xec, 0xe2, 0xd4, 0x8c, 0x0b, 0xfb, 0x90, 0x1b, 0x92, 0x33, 0x68,
-	0x20, 0x0a, 0xbb, 0x33, 0xb4, 0x46, 0x27, 0x5e, 0x61, 0x12, 0x0a, 0x7d, 0xa3, 0x88, 0xc5, 0x5c,
-	0x6b, 0xae, 0xa4, 0x0d, 0x47, 0xd0, 0xd4, 0x2b, 0x28, 0xdf, 0x56, 0x8c, 0x57, 0x5f, 0xeb, 0xd0,
-	0x79, 0x43, 0x91, 0xbe, 0x57, 0x5c, 0xe2, 0x83, 0x3f, 0x4c, 0xa1, 0x9f, 0xb2, 0x58, 0x21, 0xdb,
-	0x2f, 0xa5, 0x7e, 0x0c, 0x01, 0x25, 0x65, 0xb5, 0x16, 0x1f, 0x7a, 0x42, 0x05, 0x54, 0xec, 0x3b,
-	0x34, 0x8e, 0xd0, 0xa1, 0x6b, 0x18, 0xab, 0x06, 0xcf, 0xa0, 0xb9, 0xa6, 0x22, 0x2b, 0x1f, 0x58,
-	0x6f, 0x76, 0xfe, 0x77, 0x33, 0x38, 0x4b, 0x99, 0xce, 0x04, 0x3e, 0x57, 0x31, 0x47, 0x16, 0x27,
-	0x98, 0x7b, 0x65, 0xca, 0xec, 0xe3, 0xed, 0xd6, 0xb1, 0xee, 0xb6, 0x8e, 0xf5, 0x67, 0xeb, 0x58,
-	0xdf, 0x76, 0x4e, 0xed, 0x6e, 0xe7, 0xd4, 0x7e, 0xee, 0x9c, 0xda, 0xa7, 0xd7, 0x07, 0x42, 0xb8,
-	0x8c, 0x98, 0xcc, 0x38, 0xe6, 0xe3, 0x79, 0xc6, 0x45, 0x38, 0x3d, 0xbc, 0xd3, 0x2f, 0x0f, 0x2e,
-	0xd5, 0x88, 0x9c, 0xb7, 0xcc, 0x31, 0xbd, 0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x48, 0xc3,
-	0x47, 0xd5, 0x03, 0x00, 0x00,
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6b, 0x24, 0x45,
+	0x14, 0x9e, 0x9e, 0x49, 0x66, 0x33, 0x6f, 0x3a, 0x3f, 0xb6, 0x0c, 0x4b, 0x27, 0xea, 0x4c, 0x18,
+	0x51, 0xc2, 0xb2, 0xe9, 0x31, 0x51, 0x3c, 0x88, 0x0a, 0xc9, 0xee, 0x46, 0x03, 0x82, 0x9b, 0x76,
+	0x85, 0x45, 0x58, 0x9a, 0x9a, 0xee, 0x97, 0x49, 0x91, 0xee, 0xaa, 0x49, 0x55, 0x75, 0xcc, 0xe0,
+	0xd1, 0x8b, 0xa0, 0x07, 0x8f, 0x1e, 0xf7, 0xe2, 0xc5, 0xf3, 0xfe, 0x0d, 0x92, 0xe3, 0xba, 0x27,
+	0xf1, 0x10, 0x25, 0xb9, 0xf9, 0x57, 0x48, 0x55, 0x57, 0x27, 0x21, 0x0b, 0x6e, 0xc0, 0xec, 0x69,
+	0xfa, 0xfd, 0xfa, 0xde, 0xf7, 0xde, 0x7c, 0x55, 0xdd, 0xf0, 0xfe, 0x7e, 0xc1, 0x92, 0x3d, 0xc5,
+	0xb2, 0x03, 0x94, 0x7d, 0xc6, 0x35, 0xca, 0x64, 0x97, 0x32, 0xbe, 0x5f, 0xa0, 0x1c, 0xf7, 0x0f,
+	0x56, 0x2f, 0xbb, 0xc2, 0x91, 0x14, 0x5a, 0x90, 0xce, 0x85, 0xaa, 0xf0, 0x72, 0xca, 0xc1, 0xea,
+	0xe2, 0xfc, 0x50, 0x0c, 0x85, 0x4d, 0xed, 0x9b, 0xa7, 0xb2, 0x6a, 0x71, 0x21, 0x11, 0x2a, 0x17,
+	0x2a, 0x2e, 0x03, 0xa5, 0xe1, 0x42, 0x9d, 0xd2, 0xea, 0x0f, 0xa8, 0xc2, 0xfe, 0xc1, 0xea, 0x00,
+	0x35, 0x5d, 0xed, 0x27, 0x82, 0xf1, 0x32, 0xde, 0xfb, 0xa5, 0x09, 0x93, 0xdb, 0x06, 0x9d, 0xcc,
+	0x40, 0x9d, 0xa5, 0x81, 0xb7, 0xe4, 0x2d, 0xb7, 0xa2, 0x3a, 0x4b, 0xc9, 0x5b, 0x30, 0x9d, 0x08,
+	0xce, 0x31, 0xd1, 0x4c, 0xf0, 0x98, 0xa5, 0x41, 0xdd, 0x86, 0xfc, 0x73, 0xe7, 0x56, 0x4a, 0x16,
+	0x60, 0xca, 0x12, 0x34, 0xf1, 0x86, 0x8d, 0xdf, 0xb0, 0xf6, 0x56, 0x4a, 0xde, 0x04, 0xb0, 0xb4,
+	0x63, 0x3d, 0x1e, 0x61, 0x30, 0x61, 0x83, 0x2d, 0xeb, 0x79, 0x38, 0x1e, 0x21, 0x09, 0xe0, 0x86,
+	0xc4, 0xfd, 0x02, 0x95, 0x0e, 0x26, 0x97, 0xbc, 0x65, 0x3f, 0xaa, 0x4c, 0xf2, 0x10, 0x9a, 0x23,
+	0x94, 0x4c, 0xa4, 0x41, 0xd3, 0x14, 0x6d, 0x7c, 0x74, 0x74, 0xdc, 0xad, 0xfd, 0x79, 0xdc, 0x7d,
+	0x67, 0xc8, 0xf4, 0x6e, 0x31, 0x08, 0x13, 0x91, 0xbb, 0x19, 0xdd, 0xcf, 0x8a, 0x4a, 0xf7, 0xfa,
+	0xa6, 0x8b, 0x0a, 0xb7, 0xb8, 0x7e, 0xfe, 0x74, 0x05, 0xdc, 0x0a, 0xb6, 0xb8, 0x8e, 0x1c, 0x16,
+	0x79, 0x0c, 0xed, 0x8c, 0x2a, 0x1d, 0xef, 0x22, 0x1b, 0xee, 0xea, 0xe0, 0xc6, 0x35, 0x40, 0x83,
+	0x01, 0xfc, 0xcc, 0xe2, 0x91, 0x2e, 0xb4, 0x13, 0x9a, 0x65, 0x03, 0x9a, 0xec, 0x99, 0x5d, 0x4c,
+	0xd9, 0x71, 0xa1, 0x72, 0x6d, 0xa5, 0x64, 0x0e, 0x1a, 0x5a, 0x67, 0x41, 0x6b, 0xc9, 0x5b, 0x9e,
+	0x88, 0xcc, 0x23, 0xa1, 0x30, 0x6d, 0x19, 0x61, 0xce, 0x94, 0x62, 0x82, 0x07, 0x70, 0x0d, 0x9c,
+	0x7c, 0x03, 0x79, 0xdf, 0x21, 0x96, 0x4b, 0xd6, 0x92, 0xa1, 0x0a, 0xda, 0xb6, 0x71, 0x65, 0x92,
+	0x45, 0x98, 0x4a, 0x91, 0xa6, 0x19, 0xe3, 0x18, 0xf8, 0x36, 0x74, 0x66, 0x93, 0x47, 0xe0, 0xef,
+	0x20, 0xc6, 0x29, 0x8e, 0x84, 0x62, 0x5a, 0x05, 0xd3, 0x4b, 0x8d, 0xe5, 0xf6, 0x5a, 0x3f, 0xfc,
+	0x6f, 0x6d, 0x86, 0x56, 0x46, 0x9b, 0x88, 0xf7, 0xca, 0xba, 0x8d, 0x09, 0x33, 0x48, 0xd4, 0xde,
+	0x39, 0xf3, 0x28, 0x72, 0x0b, 0x9a, 0x6e, 0xff, 0x33, 0xb6, 0xa7, 0xb3, 0x48, 0x08, 0x93, 0xe2,
+	0x1b, 0x8e, 0x32, 0x98, 0xb5, 0x2b, 0x08, 0x9e, 0x3f, 0x5d, 0x99, 0x77, 0x43, 0xad, 0xa7, 0xa9,
+	0x44, 0xa5, 0xbe, 0xd4, 0x92, 0xf1, 0x61, 0x54, 0xa6, 0x91, 0x11, 0x4c, 0xdb, 0x87, 0x8a, 0x63,
+	0x30, 0x67, 0x29, 0x2e, 0x84, 0xae, 0xc8, 0xa8, 0x3d, 0x74, 0x6a, 0x0f, 0xef, 0x0a, 0xc6, 0x37,
+	0xde, 0x35, 0x64, 0x7e, 0xfd, 0xab, 0xbb, 0x7c, 0x85, 0xad, 0x9a, 0x02, 0x15, 0xf9, 0xb6, 0x83,
+	0xa3, 0xde, 0xfb, 0xcd, 0x83, 0xd9, 0x4b, 0x03, 0x92, 0x0f, 0xa0, 0xe5, 0xfa, 0x0b, 0x19, 0x78,
+	0x2f, 0x61, 0x7e, 0x9e, 0x4a, 0x12, 0x68, 0xd2, 0x5c, 0x14, 0x5c, 0x07, 0xf5, 0xeb, 0xa7, 0xed,
+	0xa0, 0xcd, 0xaa, 0x73, 0x91, 0x16, 0x19, 0xba, 0x73, 0xe9, 0xac, 0xde, 0x8f, 0x1e, 0xf8, 0xdb,
+	0xd5, 0x29, 0xdc, 0x44, 0xbc, 0x74, 0x4e, 0xbd, 0xcb, 0xe7, 0xf4, 0x31, 0x34, 0x76, 0x10, 0x5f,
+	0x05, 0x53, 0x83, 0xdb, 0xfb, 0xbd, 0x0e, 0xcd, 0x07, 0x54, 0xd2, 0x5c, 0x91, 0xaf, 0xc0, 0x4f,
+	0x71, 0x87, 0x16, 0x99, 0x8e, 0x77, 0x10, 0x55, 0xe0, 0xd9, 0x96, 0x77, 0xae, 0x24, 0x3b, 0x37,
+	0x4c, 0xa5, 0x39, 0x87, 0xb3, 0x89, 0xa8, 0xc8, 0x6d, 0xb8, 0x99, 0xd3, 0xc3, 0xb8, 0xd4, 0x8b,
+	0xa9, 0x32, 0xa7, 0xa1, 0x6e, 0xe5, 0x37, 0x9b, 0xd3, 0xc3, 0x2f, 0x8c, 0x7f, 0xbb, 0x74, 0x93,
+	0x6f, 0xe1, 0xb5, 0xf3, 0xbc, 0xf1, 0x99, 0xba, 0x1a, 0xd7, 0x3f, 0xfc, 0x4d, 0x51, 0xf5, 0x1d,
+	0x57, 0x72, 0xfa, 0x18, 0x5e, 0x4f, 0x04, 0xd7, 0x92, 0x26, 0x3a, 0x3e, 0xbb, 0x4b, 0x86, 0x54,
+	0xc5, 0x19, 0xcb, 0x99, 0xb6, 0x37, 0xe8, 0x44, 0x14, 0x54, 0x29, 0x77, 0x5d, 0xc6, 0xa7, 0x54,
+	0x7d, 0x6e, 0xe2, 0x1f, 0x4e, 0x7d, 0xff, 0xa4, 0x5b, 0xfb, 0xf9, 0x49, 0xb7, 0xd6, 0x3b, 0xf2,
+	0x60, 0x36, 0xc2, 0x8c, 0x8e, 0x51, 0xde, 0xa7, 0x92, 0x33, 0x3e, 0x54, 0x64, 0xcd, 0xdc, 0x04,
+	0xd6, 0xf5, 0x52, 0xa5, 0x56, 0x89, 0x46, 0xa7, 0x48, 0x25, 0xc7, 0xf4, 0x95, 0xe8, 0xb4, 0x84,
+	0x26, 0x6f, 0x40, 0x4b, 0xa2, 0x1a, 0x09, 0xae, 0x50, 0x59, 0xa9, 0x4e, 0x44, 0xe7, 0x8e, 0xde,
+	0x77, 0x1e, 0xcc, 0xac, 0x0f, 0x28, 0x4f, 0x05, 0xc7, 0xb4, 0x7c, 0x4f, 0xad, 0xc3, 0xa4, 0xfd,
+	0x77, 0xec, 0x1c, 0xed, 0xb5, 0xb7, 0xaf, 0xa4, 0x0f, 0x27, 0x8c, 0xb2, 0xf2, 0xc2, 0x35, 0x64,
+	0x74, 0xd0, 0x38, 0xbb, 0x86, 0x6e, 0x41, 0x53, 0x22, 0x55, 0x82, 0x57, 0x67, 0xa6, 0xb4, 0x7a,
+	0x3f, 0xd4, 0xa1, 0x75, 0x8f, 0x6a, 0xfa, 0x40, 0x30, 0xae, 0x5f, 0x78, 0x51, 0x52, 0x98, 0x96,
+	0x98, 0x0b, 0x8d, 0xf1, 0x05, 0xd0, 0xff, 0x7d, 0x8f, 0x97, 0x90, 0xee, 0xed, 0x12, 0x83, 0x9f,
+	0x89, 0x84, 0x66, 0x55, 0x87, 0xc6, 0x35, 0x74, 0x68, 0x5b, 0x44, 0xd7, 0xe0, 0x36, 0x4c, 0x1e,
+	0xd0, 0xac, 0x28, 0xdf, 0xd3, 0xfe, 0xc6, 0xfc, 0x3f, 0xc7, 0xdd, 0x39, 0x89, 0xaa, 0xc8, 0xf4,
+	0x1d, 0x91, 0x33, 0x8d, 0xf9, 0x48, 0x8f, 0xa3, 0x32, 0x65, 0xe3, 0xd1, 0xd1, 0x49, 0xc7, 0x7b,
+	0x76, 0xd2, 0xf1, 0xfe, 0x3e, 0xe9, 0x78, 0x3f, 0x9d, 0x76, 0x6a, 0xcf, 0x4e, 0x3b, 0xb5, 0x3f,
+	0x4e, 0x3b, 0xb5, 0xaf, 0x3f, 0xb9, 0x40, 0x84, 0xf1, 0x21, 0xf2, 0x82, 0xe9, 0xf1, 0xca, 0xa0,
+	0x60, 0x59, 0xda, 0xbf, 0xf8, 0x39, 0x74, 0xf8, 0xc2, 0x07, 0x91, 0x25, 0x39, 0x68, 0xda, 0x6f,
+	0x92, 0xf7, 0xfe, 0x1d, 0x00, 0x3e, 0x2b, 0xde, 0xc1, 0x3c, 0x09, 0x00, 0x00,
 }
 
 func (m *Query) Marshal() (dAtA []byte, err error) {
@@ -240,6 +621,58 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OwnerDeposit) > 0 {
+		for iNdEx := len(m.OwnerDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Height != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.FeeDeposits) > 0 {
+		for iNdEx := len(m.FeeDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Retries != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size := m.LastEmission.Size()
 		i -= size
@@ -320,7 +753,7 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+func (m *QueryFeeDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -330,63 +763,313 @@ func (m *DataPoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFeeDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFeeDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Value)))
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Module)))
 		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.LocalHeight.Size()
-		i -= size
-		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RemoteHeight.Size()
-		i -= size
-		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Id)))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Depositor)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintInterchainquery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovInterchainquery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTypeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryTypeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTypeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractCallbackGasLimit != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.ContractCallbackGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OwnerQueryDeposit) > 0 {
+		for iNdEx := len(m.OwnerQueryDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerQueryDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxOwnerQueries != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.MaxOwnerQueries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DefaultFees) > 0 {
+		for iNdEx := len(m.DefaultFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DefaultFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerEarnings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerEarnings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerEarnings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Responses != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Responses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Earned) > 0 {
+		for iNdEx := len(m.Earned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbandonedQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbandonedQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbandonedQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.LocalHeight.Size()
+		i -= size
+		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RemoteHeight.Size()
+		i -= size
+		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterchainquery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterchainquery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *Query) Size() (n int) {
 	if m == nil {
@@ -425,39 +1108,959 @@ func (m *Query) Size() (n int) {
 	if m.Ttl != 0 {
 		n += 1 + sovInterchainquery(uint64(m.Ttl))
 	}
-	l = m.LastEmission.Size()
-	n += 1 + l + sovInterchainquery(uint64(l))
-	return n
-}
+	l = m.LastEmission.Size()
+	n += 1 + l + sovInterchainquery(uint64(l))
+	if m.Retries != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Retries))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Deadline))
+	}
+	if len(m.FeeDeposits) > 0 {
+		for _, e := range m.FeeDeposits {
+			l = e.Size()
+			n += 1 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Height))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	if len(m.OwnerDeposit) > 0 {
+		for _, e := range m.OwnerDeposit {
+			l = e.Size()
+			n += 2 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTypeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DefaultFees) > 0 {
+		for _, e := range m.DefaultFees {
+			l = e.Size()
+			n += 1 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	if m.MaxOwnerQueries != 0 {
+		n += 1 + sovInterchainquery(uint64(m.MaxOwnerQueries))
+	}
+	if len(m.OwnerQueryDeposit) > 0 {
+		for _, e := range m.OwnerQueryDeposit {
+			l = e.Size()
+			n += 1 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	if m.ContractCallbackGasLimit != 0 {
+		n += 1 + sovInterchainquery(uint64(m.ContractCallbackGasLimit))
+	}
+	return n
+}
+
+func (m *RelayerEarnings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	if len(m.Earned) > 0 {
+		for _, e := range m.Earned {
+			l = e.Size()
+			n += 1 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	if m.Responses != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Responses))
+	}
+	return n
+}
+
+func (m *AbandonedQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Query.Size()
+	n += 1 + l + sovInterchainquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Height))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	return n
+}
+
+func (m *DataPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	l = m.RemoteHeight.Size()
+	n += 1 + l + sovInterchainquery(uint64(l))
+	l = m.LocalHeight.Size()
+	n += 1 + l + sovInterchainquery(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	return n
+}
+
+func sovInterchainquery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterchainquery(x uint64) (n int) {
+	return sovInterchainquery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDeposits = append(m.FeeDeposits, QueryFeeDeposit{})
+			if err := m.FeeDeposits[len(m.FeeDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerDeposit = append(m.OwnerDeposit, types.Coin{})
+			if err := m.OwnerDeposit[len(m.OwnerDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTypeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTypeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTypeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *DataPoint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovInterchainquery(uint64(l))
-	}
-	l = m.RemoteHeight.Size()
-	n += 1 + l + sovInterchainquery(uint64(l))
-	l = m.LocalHeight.Size()
-	n += 1 + l + sovInterchainquery(uint64(l))
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovInterchainquery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovInterchainquery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozInterchainquery(x uint64) (n int) {
-	return sovInterchainquery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -480,17 +2083,17 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -500,29 +2103,31 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.DefaultFees = append(m.DefaultFees, QueryTypeFee{})
+			if err := m.DefaultFees[len(m.DefaultFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOwnerQueries", wireType)
 			}
-			var stringLen uint64
+			m.MaxOwnerQueries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -532,29 +2137,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxOwnerQueries |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainquery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainquery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerQueryDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -564,29 +2156,31 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.OwnerQueryDeposit = append(m.OwnerQueryDeposit, types.Coin{})
+			if err := m.OwnerQueryDeposit[len(m.OwnerQueryDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallbackGasLimit", wireType)
 			}
-			var stringLen uint64
+			m.ContractCallbackGasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -596,29 +2190,66 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ContractCallbackGasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainquery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerEarnings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerEarnings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerEarnings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -628,31 +2259,29 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
-			if m.Request == nil {
-				m.Request = []byte{}
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Earned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -662,31 +2291,31 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Earned = append(m.Earned, types.Coin{})
+			if err := m.Earned[len(m.Earned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
 			}
-			var stringLen uint64
+			m.Responses = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -696,31 +2325,66 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Responses |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainquery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbandonedQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainquery
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbandonedQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbandonedQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -730,29 +2394,30 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Ttl = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainquery
@@ -762,14 +2427,14 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ttl |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -797,9 +2462,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
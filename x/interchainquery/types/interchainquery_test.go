@@ -84,3 +84,38 @@ func TestDataPoint_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestQuery_Backoff(t *testing.T) {
+	q := Query{Period: sdk.NewInt(-1), LastHeight: sdk.ZeroInt(), LastEmission: sdk.NewInt(100)}
+	require.Equal(t, int64(125), q.NextEmission(25))
+	require.False(t, q.IsStale(124, 25))
+	require.True(t, q.IsStale(125, 25))
+
+	q.Retries = 3
+	require.Equal(t, int64(300), q.NextEmission(25))
+	require.True(t, q.IsStale(101, 25))
+
+	// answered periodic queries are not stale
+	q = Query{Period: sdk.NewInt(10), LastHeight: sdk.NewInt(105), LastEmission: sdk.NewInt(100)}
+	require.False(t, q.IsStale(200, 25))
+
+	// never emitted queries are not stale
+	q = Query{Period: sdk.NewInt(-1), LastHeight: sdk.ZeroInt(), LastEmission: sdk.ZeroInt()}
+	require.False(t, q.IsStale(200, 25))
+
+	require.False(t, q.HasExpired(200))
+	q.Deadline = 150
+	require.False(t, q.HasExpired(150))
+	require.True(t, q.HasExpired(151))
+}
+
+func TestIsProvableQueryType(t *testing.T) {
+	require.True(t, IsProvableQueryType("store/bank/key"))
+	require.True(t, IsProvableQueryType("store/staking/key"))
+	require.True(t, IsProvableQueryType(QueryTypeTx))
+	require.False(t, IsProvableQueryType("cosmos.bank.v1beta1.Query/AllBalances"))
+	require.False(t, IsProvableQueryType("cosmos.tx.v1beta1.Service/GetTxsEvent"))
+
+	require.True(t, Query{QueryType: "store/bank/key"}.IsKeyQuery())
+	require.False(t, Query{QueryType: QueryTypeTx}.IsKeyQuery())
+}
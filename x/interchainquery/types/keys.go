@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "interchainquery"
@@ -16,11 +21,27 @@ const (
 
 // prefix bytes for the interchainquery persistent store
 const (
-	prefixData  = iota + 1
-	prefixQuery = iota + 1
+	prefixData            = iota + 1
+	prefixQuery           = iota + 1
+	prefixAbandonedQuery  = iota + 1
+	prefixRelayerEarnings = iota + 1
+	prefixOwnerQuery      = iota + 1
 )
 
 var (
-	KeyPrefixData  = []byte{prefixData}
-	KeyPrefixQuery = []byte{prefixQuery}
+	KeyPrefixData            = []byte{prefixData}
+	KeyPrefixQuery           = []byte{prefixQuery}
+	KeyPrefixAbandonedQuery  = []byte{prefixAbandonedQuery}
+	KeyPrefixRelayerEarnings = []byte{prefixRelayerEarnings}
+	KeyPrefixOwnerQuery      = []byte{prefixOwnerQuery}
 )
+
+// GetOwnerQueriesKey returns the key prefix of the queries owned by the given account.
+func GetOwnerQueriesKey(owner sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(owner)
+}
+
+// GetOwnerQueryKey returns the key of the given query in the index of the queries owned by the given account.
+func GetOwnerQueryKey(owner sdk.AccAddress, id string) []byte {
+	return append(GetOwnerQueriesKey(owner), []byte(id)...)
+}
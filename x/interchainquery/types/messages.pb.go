@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,9 +114,89 @@ func (m *MsgSubmitQueryResponseResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitQueryResponseResponse proto.InternalMessageInfo
 
+// MsgSponsorQuery represents a message type to escrow a relayer fee against a
+// pending query.
+type MsgSponsorQuery struct {
+	QueryId     string                                   `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty" yaml:"query_id"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	FromAddress string                                   `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgSponsorQuery) Reset()         { *m = MsgSponsorQuery{} }
+func (m *MsgSponsorQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgSponsorQuery) ProtoMessage()    {}
+func (*MsgSponsorQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0640fcbc3e895a79, []int{2}
+}
+func (m *MsgSponsorQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSponsorQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSponsorQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSponsorQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSponsorQuery.Merge(m, src)
+}
+func (m *MsgSponsorQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSponsorQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSponsorQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSponsorQuery proto.InternalMessageInfo
+
+// MsgSponsorQueryResponse defines the MsgSponsorQuery response type.
+type MsgSponsorQueryResponse struct {
+}
+
+func (m *MsgSponsorQueryResponse) Reset()         { *m = MsgSponsorQueryResponse{} }
+func (m *MsgSponsorQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSponsorQueryResponse) ProtoMessage()    {}
+func (*MsgSponsorQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0640fcbc3e895a79, []int{3}
+}
+func (m *MsgSponsorQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSponsorQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSponsorQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSponsorQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSponsorQueryResponse.Merge(m, src)
+}
+func (m *MsgSponsorQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSponsorQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSponsorQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSponsorQueryResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitQueryResponse)(nil), "quicksilver.interchainquery.v1.MsgSubmitQueryResponse")
 	proto.RegisterType((*MsgSubmitQueryResponseResponse)(nil), "quicksilver.interchainquery.v1.MsgSubmitQueryResponseResponse")
+	proto.RegisterType((*MsgSponsorQuery)(nil), "quicksilver.interchainquery.v1.MsgSponsorQuery")
+	proto.RegisterType((*MsgSponsorQueryResponse)(nil), "quicksilver.interchainquery.v1.MsgSponsorQueryResponse")
 }
 
 func init() {
@@ -122,40 +204,49 @@ func init() {
 }
 
 var fileDescriptor_0640fcbc3e895a79 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3f, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc7, 0xeb, 0xf6, 0xf7, 0xeb, 0xdd, 0xe5, 0x8a, 0x80, 0x5c, 0x85, 0x42, 0x81, 0xa4, 0xca,
-	0x42, 0x41, 0xd4, 0x56, 0x8b, 0xc4, 0x50, 0xa4, 0x93, 0xe8, 0x76, 0xc3, 0xf1, 0x27, 0xb7, 0x20,
-	0x96, 0x2a, 0x6d, 0x7c, 0xae, 0x45, 0x63, 0xe7, 0x6c, 0xa7, 0xba, 0xae, 0x4c, 0x8c, 0x48, 0x2c,
-	0x8c, 0x7d, 0x11, 0xbc, 0x01, 0x36, 0xd8, 0x4e, 0xb0, 0x30, 0x55, 0xa8, 0x65, 0x80, 0xb5, 0xaf,
-	0x00, 0xc5, 0x4e, 0xa1, 0xba, 0xab, 0x18, 0x98, 0xfc, 0xe4, 0xf9, 0x7e, 0x9e, 0x7f, 0xf1, 0x63,
-	0xab, 0x79, 0x92, 0xd2, 0xc1, 0x4b, 0x49, 0x47, 0x63, 0x2c, 0x10, 0x65, 0x0a, 0x8b, 0xc1, 0x30,
-	0xa4, 0xec, 0x24, 0xc5, 0x62, 0x82, 0xc6, 0x2d, 0x14, 0x63, 0x29, 0x43, 0x82, 0x25, 0x4c, 0x04,
-	0x57, 0xdc, 0x76, 0xd7, 0x70, 0x78, 0x0e, 0x87, 0xe3, 0x56, 0xad, 0x4a, 0x38, 0xe1, 0x1a, 0x45,
-	0x99, 0x65, 0xa2, 0x6a, 0xd7, 0x07, 0x5c, 0xc6, 0x5c, 0xf6, 0x8c, 0x60, 0x3e, 0x72, 0xe9, 0x26,
-	0xe1, 0x9c, 0x8c, 0x30, 0x0a, 0x13, 0x8a, 0x42, 0xc6, 0xb8, 0x0a, 0x15, 0xe5, 0x6c, 0xa5, 0xde,
-	0x52, 0x98, 0x45, 0x58, 0xc4, 0x94, 0x29, 0x34, 0x10, 0x93, 0x44, 0x71, 0x94, 0x08, 0xce, 0x8f,
-	0x8d, 0xec, 0xff, 0x2c, 0x5a, 0xd7, 0x0e, 0x25, 0x39, 0x4a, 0xfb, 0x31, 0x55, 0xcf, 0xb2, 0x1e,
-	0x02, 0x2c, 0x13, 0xce, 0x24, 0xb6, 0xa1, 0xb5, 0xad, 0x3b, 0xeb, 0xd1, 0xc8, 0x01, 0x75, 0xd0,
-	0xd8, 0xe9, 0xee, 0x2d, 0x67, 0xde, 0xe5, 0x49, 0x18, 0x8f, 0x3a, 0xfe, 0x4a, 0xf1, 0x83, 0x2d,
-	0x6d, 0x1e, 0x44, 0x19, 0xaf, 0x87, 0xc8, 0xf8, 0xe2, 0x79, 0x7e, 0xa5, 0xf8, 0xc1, 0x96, 0x36,
-	0x0f, 0x22, 0xfb, 0x8e, 0x55, 0x16, 0x58, 0xa6, 0x23, 0xe5, 0x94, 0xea, 0xa0, 0x51, 0xe9, 0x5e,
-	0x5d, 0xce, 0xbc, 0x4b, 0x86, 0x36, 0x7e, 0x3f, 0xc8, 0x01, 0xfb, 0xb1, 0xb5, 0xa3, 0x9b, 0xee,
-	0xf1, 0x44, 0x3a, 0xff, 0xd5, 0x41, 0x63, 0xb7, 0x7d, 0x03, 0xfe, 0x19, 0x0c, 0x9a, 0xc1, 0xe0,
-	0xd3, 0x8c, 0x79, 0x92, 0xc8, 0x6e, 0x75, 0x39, 0xf3, 0xae, 0x98, 0x54, 0xbf, 0xe3, 0xfc, 0x60,
-	0x3b, 0xc9, 0xf5, 0xac, 0xf4, 0x10, 0x53, 0x32, 0x54, 0xce, 0xff, 0x75, 0xd0, 0x28, 0xad, 0x97,
-	0x36, 0x7e, 0x3f, 0xc8, 0x01, 0xfb, 0xa1, 0x55, 0x39, 0x16, 0x3c, 0xee, 0x85, 0x51, 0x24, 0xb0,
-	0x94, 0x4e, 0x59, 0x4f, 0xe6, 0x7c, 0x7e, 0xdf, 0xac, 0xe6, 0xb7, 0xf0, 0xc8, 0x28, 0x47, 0x4a,
-	0x50, 0x46, 0x82, 0xdd, 0x8c, 0xce, 0x5d, 0x9d, 0xca, 0xeb, 0xa9, 0x57, 0x78, 0x37, 0xf5, 0xc0,
-	0x8f, 0xa9, 0x57, 0xf0, 0xeb, 0x96, 0xbb, 0xf9, 0x57, 0xaf, 0xce, 0xf6, 0x27, 0x60, 0x95, 0x0e,
-	0x25, 0xb1, 0x3f, 0x00, 0x6b, 0x6f, 0xd3, 0x95, 0x3c, 0x80, 0x7f, 0x5f, 0x1e, 0xb8, 0x39, 0x7f,
-	0x6d, 0xff, 0xdf, 0xe2, 0x56, 0xa7, 0xdf, 0x7e, 0xf5, 0xe5, 0xfb, 0xdb, 0xe2, 0xbd, 0x0e, 0xb8,
-	0xeb, 0xdf, 0xbe, 0xb0, 0xe2, 0xea, 0x14, 0x8d, 0x5b, 0x7d, 0xac, 0xc2, 0x16, 0x92, 0x3a, 0x87,
-	0x76, 0x77, 0x9f, 0x7f, 0x9c, 0xbb, 0xe0, 0x6c, 0xee, 0x82, 0x6f, 0x73, 0x17, 0xbc, 0x59, 0xb8,
-	0x85, 0xb3, 0x85, 0x5b, 0xf8, 0xba, 0x70, 0x0b, 0x2f, 0xf6, 0x09, 0x55, 0xc3, 0xb4, 0x0f, 0x07,
-	0x3c, 0x46, 0x94, 0x11, 0xcc, 0x52, 0xaa, 0x26, 0xcd, 0x7e, 0x4a, 0x47, 0x11, 0x5a, 0x7f, 0x4b,
-	0xa7, 0x17, 0x4b, 0x4d, 0x12, 0x2c, 0xfb, 0x65, 0xbd, 0xba, 0xf7, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x32, 0x55, 0x4b, 0xfd, 0x79, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x18, 0xce, 0x25, 0xdf, 0x97, 0xb6, 0xd7, 0x7c, 0xea, 0x87, 0x5b, 0x81, 0x1b, 0xc0, 0x8e, 0xbc,
+	0x60, 0x10, 0xb9, 0x23, 0xa9, 0x04, 0x52, 0x91, 0x2a, 0x11, 0xa6, 0x0e, 0xe5, 0x87, 0xbb, 0x20,
+	0x96, 0xc8, 0xb1, 0xaf, 0xce, 0xa9, 0xf1, 0x9d, 0xeb, 0x3b, 0x47, 0xcd, 0xca, 0xc4, 0x88, 0xc4,
+	0xc2, 0xd8, 0x19, 0x89, 0x8d, 0x7f, 0x80, 0xad, 0x63, 0x05, 0x0b, 0x53, 0x40, 0x2d, 0x03, 0x0c,
+	0x2c, 0xdd, 0xd8, 0x90, 0xcf, 0x4e, 0x48, 0x7f, 0xa8, 0x6a, 0x99, 0x7c, 0x7e, 0x9f, 0xe7, 0x7d,
+	0xef, 0x79, 0x9f, 0x7b, 0xef, 0x60, 0x7d, 0x2b, 0xa1, 0xde, 0xa6, 0xa0, 0xbd, 0x3e, 0x89, 0x31,
+	0x65, 0x92, 0xc4, 0x5e, 0xd7, 0xa5, 0x6c, 0x2b, 0x21, 0xf1, 0x00, 0xf7, 0x1b, 0x38, 0x24, 0x42,
+	0xb8, 0x01, 0x11, 0x28, 0x8a, 0xb9, 0xe4, 0x9a, 0x31, 0x41, 0x47, 0xc7, 0xe8, 0xa8, 0xdf, 0xa8,
+	0x2e, 0x04, 0x3c, 0xe0, 0x8a, 0x8a, 0xd3, 0x55, 0x96, 0x55, 0x5d, 0xf4, 0xb8, 0x08, 0xb9, 0x68,
+	0x67, 0x40, 0xf6, 0x93, 0x43, 0xd7, 0x02, 0xce, 0x83, 0x1e, 0xc1, 0x6e, 0x44, 0xb1, 0xcb, 0x18,
+	0x97, 0xae, 0xa4, 0x9c, 0x8d, 0xd0, 0xeb, 0x92, 0x30, 0x9f, 0xc4, 0x21, 0x65, 0x12, 0x7b, 0xf1,
+	0x20, 0x92, 0x1c, 0x47, 0x31, 0xe7, 0x1b, 0x39, 0x6c, 0x64, 0xa5, 0x70, 0xc7, 0x15, 0x04, 0xf7,
+	0x1b, 0x1d, 0x22, 0xdd, 0x06, 0xf6, 0x38, 0x65, 0x19, 0x6e, 0xfd, 0x28, 0xc2, 0xcb, 0x6b, 0x22,
+	0x58, 0x4f, 0x3a, 0x21, 0x95, 0x4f, 0x53, 0x8d, 0x0e, 0x11, 0x11, 0x67, 0x82, 0x68, 0x08, 0x4e,
+	0x2b, 0xe5, 0x6d, 0xea, 0xeb, 0xa0, 0x06, 0xec, 0x99, 0xd6, 0xfc, 0xe1, 0xd0, 0x9c, 0x1b, 0xb8,
+	0x61, 0x6f, 0xd9, 0x1a, 0x21, 0x96, 0x33, 0xa5, 0x96, 0xab, 0x7e, 0xca, 0x57, 0x4d, 0xa6, 0xfc,
+	0xe2, 0x71, 0xfe, 0x08, 0xb1, 0x9c, 0x29, 0xb5, 0x5c, 0xf5, 0xb5, 0x9b, 0xb0, 0x1c, 0x13, 0x91,
+	0xf4, 0xa4, 0x5e, 0xaa, 0x01, 0xbb, 0xd2, 0xba, 0x74, 0x38, 0x34, 0xff, 0xcb, 0xd8, 0x59, 0xdc,
+	0x72, 0x72, 0x82, 0xf6, 0x08, 0xce, 0xa8, 0xa6, 0xda, 0x3c, 0x12, 0xfa, 0x3f, 0x35, 0x60, 0xcf,
+	0x36, 0xaf, 0xa2, 0x3f, 0x8d, 0xa3, 0xac, 0x71, 0xf4, 0x24, 0xe5, 0x3c, 0x8e, 0x44, 0x6b, 0xe1,
+	0x70, 0x68, 0xfe, 0x9f, 0x95, 0x1a, 0xe7, 0x59, 0xce, 0x74, 0x94, 0xe3, 0xe9, 0xd6, 0x5d, 0x42,
+	0x83, 0xae, 0xd4, 0xff, 0xad, 0x01, 0xbb, 0x34, 0xb9, 0x75, 0x16, 0xb7, 0x9c, 0x9c, 0xa0, 0xdd,
+	0x87, 0x95, 0x8d, 0x98, 0x87, 0x6d, 0xd7, 0xf7, 0x63, 0x22, 0x84, 0x5e, 0x56, 0x9d, 0xe9, 0x1f,
+	0xdf, 0xd7, 0x17, 0xf2, 0x53, 0x7a, 0x90, 0x21, 0xeb, 0x32, 0xa6, 0x2c, 0x70, 0x66, 0x53, 0x76,
+	0x1e, 0x5a, 0xae, 0xbc, 0xdc, 0x31, 0x0b, 0x6f, 0x76, 0x4c, 0xf0, 0x7d, 0xc7, 0x2c, 0x58, 0x35,
+	0x68, 0x9c, 0x6e, 0xf5, 0xe8, 0x6b, 0xfd, 0x04, 0x70, 0x2e, 0xa5, 0xa4, 0x7f, 0x3c, 0x56, 0x9c,
+	0x23, 0xb6, 0x82, 0x73, 0xd8, 0xea, 0xc1, 0xb2, 0x1b, 0xf2, 0x84, 0x49, 0xbd, 0x58, 0x2b, 0xd9,
+	0xb3, 0xcd, 0x45, 0x94, 0xeb, 0x4c, 0x47, 0x00, 0xe5, 0x23, 0x80, 0x1e, 0x72, 0xca, 0x5a, 0x77,
+	0x76, 0x87, 0x66, 0xe1, 0xed, 0x17, 0xd3, 0x0e, 0xa8, 0xec, 0x26, 0x1d, 0xe4, 0xf1, 0x30, 0x1f,
+	0xbd, 0xfc, 0x53, 0x17, 0xfe, 0x26, 0x96, 0x83, 0x88, 0x08, 0x95, 0x20, 0x9c, 0xbc, 0xf4, 0x09,
+	0x57, 0x4a, 0x17, 0x71, 0x65, 0x3a, 0x75, 0x45, 0x39, 0xb2, 0x08, 0xaf, 0x1c, 0x6b, 0x77, 0x64,
+	0x45, 0xf3, 0x57, 0x11, 0x96, 0xd6, 0x44, 0xa0, 0x7d, 0x00, 0x70, 0xfe, 0xb4, 0xe9, 0xbc, 0x8b,
+	0xce, 0xbe, 0x67, 0xe8, 0x74, 0xab, 0xab, 0x2b, 0x7f, 0x97, 0x37, 0x3e, 0xa2, 0xe6, 0x8b, 0x4f,
+	0xdf, 0x5e, 0x17, 0x6f, 0x5b, 0x37, 0x4e, 0x3c, 0x05, 0x72, 0x7b, 0x7c, 0xc1, 0x84, 0x2a, 0xa0,
+	0xc2, 0xcb, 0xe0, 0x96, 0xf6, 0x0e, 0xc0, 0xca, 0x91, 0x33, 0xc5, 0xe7, 0x11, 0x31, 0x91, 0x50,
+	0xbd, 0x77, 0xc1, 0x84, 0xb1, 0xdc, 0x25, 0x25, 0xb7, 0x6e, 0xd9, 0x67, 0xca, 0xcd, 0x32, 0x47,
+	0x7a, 0x5b, 0xcf, 0x76, 0xf7, 0x0d, 0xb0, 0xb7, 0x6f, 0x80, 0xaf, 0xfb, 0x06, 0x78, 0x75, 0x60,
+	0x14, 0xf6, 0x0e, 0x8c, 0xc2, 0xe7, 0x03, 0xa3, 0xf0, 0x7c, 0x65, 0x62, 0x52, 0x28, 0x0b, 0x08,
+	0x4b, 0xa8, 0x1c, 0xd4, 0x3b, 0x09, 0xed, 0xf9, 0x78, 0xf2, 0x99, 0xdc, 0x3e, 0xb9, 0x5d, 0x3a,
+	0x45, 0x9d, 0xb2, 0x7a, 0x75, 0x96, 0x7e, 0x0f, 0x00, 0xd5, 0xaf, 0x7c, 0x77, 0x54, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -172,6 +263,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// SubmitQueryResponse defines a method for submit query responses.
 	SubmitQueryResponse(ctx context.Context, in *MsgSubmitQueryResponse, opts ...grpc.CallOption) (*MsgSubmitQueryResponseResponse, error)
+	// SponsorQuery defines a method for escrowing a relayer fee against a
+	// pending query.
+	SponsorQuery(ctx context.Context, in *MsgSponsorQuery, opts ...grpc.CallOption) (*MsgSponsorQueryResponse, error)
 }
 
 type msgClient struct {
@@ -191,10 +285,22 @@ func (c *msgClient) SubmitQueryResponse(ctx context.Context, in *MsgSubmitQueryR
 	return out, nil
 }
 
+func (c *msgClient) SponsorQuery(ctx context.Context, in *MsgSponsorQuery, opts ...grpc.CallOption) (*MsgSponsorQueryResponse, error) {
+	out := new(MsgSponsorQueryResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.Msg/SponsorQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitQueryResponse defines a method for submit query responses.
 	SubmitQueryResponse(context.Context, *MsgSubmitQueryResponse) (*MsgSubmitQueryResponseResponse, error)
+	// SponsorQuery defines a method for escrowing a relayer fee against a
+	// pending query.
+	SponsorQuery(context.Context, *MsgSponsorQuery) (*MsgSponsorQueryResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -204,6 +310,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitQueryResponse(ctx context.Context, req *MsgSubmitQueryResponse) (*MsgSubmitQueryResponseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResponse not implemented")
 }
+func (*UnimplementedMsgServer) SponsorQuery(ctx context.Context, req *MsgSponsorQuery) (*MsgSponsorQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorQuery not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -227,6 +336,24 @@ func _Msg_SubmitQueryResponse_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SponsorQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSponsorQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SponsorQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.Msg/SponsorQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SponsorQuery(ctx, req.(*MsgSponsorQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "quicksilver.interchainquery.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -235,6 +362,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitQueryResponse",
 			Handler:    _Msg_SubmitQueryResponse_Handler,
 		},
+		{
+			MethodName: "SponsorQuery",
+			Handler:    _Msg_SponsorQuery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quicksilver/interchainquery/v1/messages.proto",
@@ -331,6 +462,80 @@ func (m *MsgSubmitQueryResponseResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSponsorQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSponsorQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSponsorQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSponsorQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSponsorQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSponsorQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessages(v)
 	base := offset
@@ -383,6 +588,38 @@ func (m *MsgSubmitQueryResponseResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSponsorQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSponsorQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMessages(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -674,6 +911,204 @@ func (m *MsgSubmitQueryResponseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSponsorQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSponsorQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSponsorQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSponsorQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSponsorQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSponsorQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,9 +10,13 @@ import (
 // interchainquery message types
 const (
 	TypeMsgSubmitQueryResponse = "submitqueryresponse"
+	TypeMsgSponsorQuery        = "sponsorquery"
 )
 
-var _ sdk.Msg = &MsgSubmitQueryResponse{}
+var (
+	_ sdk.Msg = &MsgSubmitQueryResponse{}
+	_ sdk.Msg = &MsgSponsorQuery{}
+)
 
 // Route Implements Msg.
 func (msg MsgSubmitQueryResponse) Route() string { return RouterKey }
@@ -54,3 +58,47 @@ func (msg MsgSubmitQueryResponse) GetSigners() []sdk.AccAddress {
 	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
 	return []sdk.AccAddress{fromAddress}
 }
+
+// NewMsgSponsorQuery - construct a msg to escrow a relayer fee against a query.
+func NewMsgSponsorQuery(queryID string, amount sdk.Coins, fromAddress sdk.AccAddress) *MsgSponsorQuery {
+	return &MsgSponsorQuery{QueryId: queryID, Amount: amount, FromAddress: fromAddress.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgSponsorQuery) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSponsorQuery) Type() string { return TypeMsgSponsorQuery }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSponsorQuery) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return err
+	}
+
+	if len(msg.QueryId) != 64 {
+		return errors.New("invalid query id")
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if msg.Amount.IsZero() {
+		return errors.New("amount must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSponsorQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSponsorQuery) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
@@ -73,3 +73,18 @@ func TestMsgSubmitQueryResponse(t *testing.T) {
 	require.Equal(t, types.TypeMsgSubmitQueryResponse, msg.Type())
 	require.Equal(t, testAddress.String(), msg.GetSigners()[0].String())
 }
+
+func TestMsgSponsorQuery(t *testing.T) {
+	id := keeper.GenerateQueryHash("connection-0", "testchain-1", "cosmos.staking.v1beta1.Query/Validators", nil, "")
+	amount := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(100)))
+
+	msg := types.NewMsgSponsorQuery(id, amount, testAddress)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgSponsorQuery, msg.Type())
+	require.Equal(t, testAddress.String(), msg.GetSigners()[0].String())
+
+	require.Error(t, types.NewMsgSponsorQuery("invalid", amount, testAddress).ValidateBasic())
+	require.Error(t, types.NewMsgSponsorQuery(id, sdk.NewCoins(), testAddress).ValidateBasic())
+	require.Error(t, types.MsgSponsorQuery{QueryId: id, Amount: amount}.ValidateBasic())
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	KeyDefaultFees              = []byte("DefaultFees")
+	KeyMaxOwnerQueries          = []byte("MaxOwnerQueries")
+	KeyOwnerQueryDeposit        = []byte("OwnerQueryDeposit")
+	KeyContractCallbackGasLimit = []byte("ContractCallbackGasLimit")
+
+	DefaultDefaultFees              = []QueryTypeFee{}
+	DefaultMaxOwnerQueries          = uint64(10)
+	DefaultOwnerQueryDeposit        = sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(1000000)))
+	DefaultContractCallbackGasLimit = uint64(1000000)
+)
+
+// ParamKeyTable for interchainquery module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new interchainquery Params instance
+func NewParams(defaultFees []QueryTypeFee, maxOwnerQueries uint64, ownerQueryDeposit sdk.Coins, contractCallbackGasLimit uint64) Params {
+	return Params{
+		DefaultFees:              defaultFees,
+		MaxOwnerQueries:          maxOwnerQueries,
+		OwnerQueryDeposit:        ownerQueryDeposit,
+		ContractCallbackGasLimit: contractCallbackGasLimit,
+	}
+}
+
+// DefaultParams default interchainquery params
+func DefaultParams() Params {
+	return NewParams(DefaultDefaultFees, DefaultMaxOwnerQueries, DefaultOwnerQueryDeposit, DefaultContractCallbackGasLimit)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDefaultFees, &p.DefaultFees, validateDefaultFees),
+		paramtypes.NewParamSetPair(KeyMaxOwnerQueries, &p.MaxOwnerQueries, validateMaxOwnerQueries),
+		paramtypes.NewParamSetPair(KeyOwnerQueryDeposit, &p.OwnerQueryDeposit, validateOwnerQueryDeposit),
+		paramtypes.NewParamSetPair(KeyContractCallbackGasLimit, &p.ContractCallbackGasLimit, validateContractCallbackGasLimit),
+	}
+}
+
+func validateDefaultFees(i interface{}) error {
+	fees, ok := i.([]QueryTypeFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(fees))
+	for _, fee := range fees {
+		if fee.QueryType == "" {
+			return fmt.Errorf("default fee query type must not be empty")
+		}
+		if seen[fee.QueryType] {
+			return fmt.Errorf("duplicate default fee for query type %s", fee.QueryType)
+		}
+		seen[fee.QueryType] = true
+
+		if err := fee.Fee.Validate(); err != nil {
+			return fmt.Errorf("invalid default fee for query type %s: %w", fee.QueryType, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxOwnerQueries(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max owner queries must be positive")
+	}
+
+	return nil
+}
+
+func validateOwnerQueryDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid owner query deposit: %w", err)
+	}
+
+	return nil
+}
+
+func validateContractCallbackGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("contract callback gas limit must be positive")
+	}
+
+	return nil
+}
+
+// FeeForQueryType returns the default fee for the given query type, if any.
+func (p Params) FeeForQueryType(queryType string) QueryTypeFee {
+	for _, fee := range p.DefaultFees {
+		if fee.QueryType == queryType {
+			return fee
+		}
+	}
+	return QueryTypeFee{QueryType: queryType}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateDefaultFees(p.DefaultFees); err != nil {
+		return err
+	}
+	if err := validateMaxOwnerQueries(p.MaxOwnerQueries); err != nil {
+		return err
+	}
+	if err := validateOwnerQueryDeposit(p.OwnerQueryDeposit); err != nil {
+		return err
+	}
+	return validateContractCallbackGasLimit(p.ContractCallbackGasLimit)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(100)))
+
+	tests := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid", NewParams([]QueryTypeFee{{QueryType: "store/bank/key", Fee: fee}, {QueryType: "cosmos.tx.v1beta1.Service/GetTx", Fee: fee}}, DefaultMaxOwnerQueries, DefaultOwnerQueryDeposit, DefaultContractCallbackGasLimit), false},
+		{"empty query type", NewParams([]QueryTypeFee{{QueryType: "", Fee: fee}}, DefaultMaxOwnerQueries, DefaultOwnerQueryDeposit, DefaultContractCallbackGasLimit), true},
+		{"duplicate query type", NewParams([]QueryTypeFee{{QueryType: "store/bank/key", Fee: fee}, {QueryType: "store/bank/key", Fee: fee}}, DefaultMaxOwnerQueries, DefaultOwnerQueryDeposit, DefaultContractCallbackGasLimit), true},
+		{"zero max owner queries", NewParams(DefaultDefaultFees, 0, DefaultOwnerQueryDeposit, DefaultContractCallbackGasLimit), true},
+		{"invalid owner query deposit", NewParams(DefaultDefaultFees, DefaultMaxOwnerQueries, sdk.Coins{sdk.Coin{Denom: "uqck", Amount: sdk.NewInt(-1)}}, DefaultContractCallbackGasLimit), true},
+		{"invalid fee", NewParams([]QueryTypeFee{{QueryType: "store/bank/key", Fee: sdk.Coins{sdk.Coin{Denom: "uqck", Amount: sdk.NewInt(-1)}}}}, DefaultMaxOwnerQueries, DefaultOwnerQueryDeposit, DefaultContractCallbackGasLimit), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -141,30 +141,25 @@ func (m *QueryRequestsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// GetTxResponse is the response type for the Service.GetTx method.
-type GetTxWithProofResponse struct {
-	// tx is the queried transaction.
-	Tx *tx.Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// tx_response is the queried TxResponses.
-	TxResponse *types.TxResponse `protobuf:"bytes,2,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
-	// proof is the tmproto.TxProof for the queried tx
-	Proof *types1.TxProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
-	// ibc-go header to validate txs
-	Header *types2.Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+// QueryStaleRequestsRequest is the request type for the Query/StaleQueries RPC
+// method.
+type QueryStaleRequestsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *GetTxWithProofResponse) Reset()         { *m = GetTxWithProofResponse{} }
-func (m *GetTxWithProofResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTxWithProofResponse) ProtoMessage()    {}
-func (*GetTxWithProofResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStaleRequestsRequest) Reset()         { *m = QueryStaleRequestsRequest{} }
+func (m *QueryStaleRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStaleRequestsRequest) ProtoMessage()    {}
+func (*QueryStaleRequestsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e4aadfdae61bcbb1, []int{2}
 }
-func (m *GetTxWithProofResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStaleRequestsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStaleRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTxWithProofResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStaleRequestsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -174,419 +169,2600 @@ func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetTxWithProofResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTxWithProofResponse.Merge(m, src)
+func (m *QueryStaleRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleRequestsRequest.Merge(m, src)
 }
-func (m *GetTxWithProofResponse) XXX_Size() int {
+func (m *QueryStaleRequestsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTxWithProofResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTxWithProofResponse.DiscardUnknown(m)
+func (m *QueryStaleRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleRequestsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTxWithProofResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStaleRequestsRequest proto.InternalMessageInfo
 
-func (m *GetTxWithProofResponse) GetTx() *tx.Tx {
+func (m *QueryStaleRequestsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Tx
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *GetTxWithProofResponse) GetTxResponse() *types.TxResponse {
+func (m *QueryStaleRequestsRequest) GetChainId() string {
 	if m != nil {
-		return m.TxResponse
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetTxWithProofResponse) GetProof() *types1.TxProof {
+// QueryStaleRequestsResponse is the response type for the Query/StaleQueries
+// RPC method.
+type QueryStaleRequestsResponse struct {
+	Queries    []Query             `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStaleRequestsResponse) Reset()         { *m = QueryStaleRequestsResponse{} }
+func (m *QueryStaleRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStaleRequestsResponse) ProtoMessage()    {}
+func (*QueryStaleRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{3}
+}
+func (m *QueryStaleRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStaleRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleRequestsResponse.Merge(m, src)
+}
+func (m *QueryStaleRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStaleRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryStaleRequestsResponse) GetQueries() []Query {
 	if m != nil {
-		return m.Proof
+		return m.Queries
 	}
 	return nil
 }
 
-func (m *GetTxWithProofResponse) GetHeader() *types2.Header {
+func (m *QueryStaleRequestsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Header
+		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryRequestsRequest)(nil), "quicksilver.interchainquery.v1.QueryRequestsRequest")
-	proto.RegisterType((*QueryRequestsResponse)(nil), "quicksilver.interchainquery.v1.QueryRequestsResponse")
-	proto.RegisterType((*GetTxWithProofResponse)(nil), "quicksilver.interchainquery.v1.GetTxWithProofResponse")
+// QueryAbandonedRequestsRequest is the request type for the
+// Query/AbandonedQueries RPC method.
+type QueryAbandonedRequestsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/interchainquery/v1/query.proto", fileDescriptor_e4aadfdae61bcbb1)
+func (m *QueryAbandonedRequestsRequest) Reset()         { *m = QueryAbandonedRequestsRequest{} }
+func (m *QueryAbandonedRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAbandonedRequestsRequest) ProtoMessage()    {}
+func (*QueryAbandonedRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{4}
 }
-
-var fileDescriptor_e4aadfdae61bcbb1 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xcd, 0xa4, 0xfd, 0xda, 0xaf, 0xd3, 0x9d, 0xd5, 0xa2, 0x34, 0x42, 0x26, 0x0a, 0x14, 0xa2,
-	0x4a, 0xcc, 0x28, 0x21, 0x6c, 0x58, 0x74, 0x51, 0xa9, 0x14, 0x76, 0xad, 0x89, 0x04, 0x62, 0x53,
-	0xd9, 0xce, 0xe0, 0x8c, 0x48, 0x66, 0x1c, 0xcf, 0xb5, 0xe5, 0x08, 0xb1, 0xe1, 0x09, 0x90, 0x78,
-	0x08, 0xf6, 0x3c, 0x01, 0xcb, 0x2e, 0x2b, 0xb1, 0x61, 0x85, 0x50, 0xc2, 0x3b, 0xb0, 0x45, 0x1e,
-	0x8f, 0x8d, 0x13, 0x24, 0x5a, 0x36, 0xf6, 0x8c, 0xee, 0x39, 0xf7, 0x9c, 0xfb, 0x63, 0xe3, 0x83,
-	0x69, 0xcc, 0xfd, 0xd7, 0x8a, 0x8f, 0x13, 0x16, 0x51, 0x2e, 0x80, 0x45, 0xfe, 0xc8, 0xe5, 0x62,
-	0x1a, 0xb3, 0x68, 0x46, 0x93, 0x2e, 0xd5, 0x07, 0x12, 0x46, 0x12, 0xa4, 0x65, 0x57, 0xb0, 0x64,
-	0x05, 0x4b, 0x92, 0x6e, 0x73, 0x27, 0x90, 0x81, 0xd4, 0x50, 0x9a, 0x9d, 0x72, 0x56, 0xf3, 0x66,
-	0x20, 0x65, 0x30, 0x66, 0xd4, 0x0d, 0x39, 0x75, 0x85, 0x90, 0xe0, 0x02, 0x97, 0x42, 0x99, 0x68,
-	0xff, 0x0a, 0xfd, 0x55, 0x99, 0x9c, 0x75, 0xe0, 0x4b, 0x35, 0x91, 0x8a, 0x7a, 0xae, 0x62, 0xb4,
-	0xc0, 0x7a, 0x0c, 0xdc, 0x2e, 0x0d, 0xdd, 0x80, 0x0b, 0x2d, 0x61, 0xb0, 0xb7, 0xab, 0x58, 0xd7,
-	0xf3, 0x79, 0x09, 0xcd, 0x2e, 0x06, 0xd4, 0x34, 0x20, 0x48, 0xcb, 0x28, 0xa4, 0x45, 0x01, 0xc0,
-	0xc4, 0x90, 0x45, 0x13, 0x2e, 0x80, 0xc2, 0x2c, 0x64, 0x2a, 0x7f, 0x9a, 0x28, 0xe5, 0x9e, 0x4f,
-	0xc7, 0x3c, 0x18, 0x81, 0x3f, 0xe6, 0x4c, 0x80, 0xa2, 0x15, 0x78, 0xd2, 0xad, 0xdc, 0x72, 0x42,
-	0x7b, 0x86, 0x77, 0xce, 0x32, 0xc7, 0x0e, 0x9b, 0xc6, 0x4c, 0x81, 0x32, 0x6f, 0xeb, 0x31, 0xc6,
-	0xbf, 0xbd, 0x37, 0x50, 0x0b, 0x75, 0xb6, 0x7b, 0x77, 0x49, 0xee, 0x8b, 0x64, 0xe6, 0x49, 0xd1,
-	0x68, 0xed, 0x8f, 0x9c, 0xba, 0x01, 0x33, 0x5c, 0xa7, 0xc2, 0xb4, 0xf6, 0xf0, 0xff, 0xba, 0x5f,
-	0xe7, 0x7c, 0xd8, 0xa8, 0xb7, 0x50, 0x67, 0xcb, 0xd9, 0xd4, 0xf7, 0xa7, 0xc3, 0xf6, 0x47, 0x84,
-	0x77, 0x57, 0xb4, 0x55, 0x28, 0x85, 0x62, 0xd6, 0x31, 0xde, 0xcc, 0xb2, 0x73, 0xa6, 0x1a, 0xa8,
-	0xb5, 0xd6, 0xd9, 0xee, 0xed, 0x93, 0xbf, 0x0f, 0x9b, 0xe8, 0x3c, 0x47, 0xeb, 0x17, 0xdf, 0x6e,
-	0xd5, 0x9c, 0x82, 0x6b, 0x9d, 0x2c, 0xd5, 0x50, 0xd7, 0x35, 0xdc, 0xbb, 0xb2, 0x86, 0xdc, 0x43,
-	0xb5, 0x88, 0xf6, 0x4f, 0x84, 0x6f, 0x9c, 0x30, 0x18, 0xa4, 0xcf, 0x39, 0x8c, 0x4e, 0x23, 0x29,
-	0x5f, 0x95, 0x56, 0xf7, 0x71, 0x1d, 0x52, 0xd3, 0x9f, 0xdd, 0x22, 0x37, 0xa4, 0x65, 0xce, 0x41,
-	0xea, 0xd4, 0x21, 0xb5, 0x8e, 0xf1, 0x36, 0xa4, 0xe7, 0x91, 0x61, 0x19, 0x2f, 0x77, 0x96, 0xbc,
-	0xe8, 0xf9, 0x57, 0x68, 0xa5, 0x11, 0x28, 0xcf, 0x16, 0xc5, 0xff, 0x85, 0x99, 0x7c, 0x63, 0x4d,
-	0x27, 0xd8, 0x23, 0x95, 0x79, 0xe6, 0x6b, 0x30, 0x48, 0x73, 0x7f, 0x39, 0xce, 0x3a, 0xc4, 0x1b,
-	0x23, 0xe6, 0x0e, 0x59, 0xd4, 0x58, 0x37, 0x23, 0xe4, 0x9e, 0x4f, 0xaa, 0x0b, 0x52, 0x4d, 0x91,
-	0x74, 0xc9, 0x13, 0x8d, 0x76, 0x0c, 0xab, 0xf7, 0x19, 0xe1, 0x2d, 0xdd, 0xdb, 0x67, 0x51, 0x12,
-	0x59, 0x9f, 0x10, 0xde, 0x3c, 0x33, 0xcd, 0xed, 0x5f, 0x6b, 0x24, 0x2b, 0x6b, 0xd5, 0x7c, 0xf8,
-	0x8f, 0xac, 0xbc, 0xee, 0xf6, 0xa3, 0x77, 0x5f, 0x7e, 0x7c, 0xa8, 0xf7, 0xad, 0x1e, 0xbd, 0xc6,
-	0x0f, 0x82, 0x33, 0x45, 0xdf, 0x14, 0x4b, 0xf7, 0xf6, 0xe8, 0xc5, 0xc5, 0xdc, 0x46, 0x97, 0x73,
-	0x1b, 0x7d, 0x9f, 0xdb, 0xe8, 0xfd, 0xc2, 0xae, 0x5d, 0x2e, 0xec, 0xda, 0xd7, 0x85, 0x5d, 0x7b,
-	0x79, 0x18, 0x70, 0x18, 0xc5, 0x1e, 0xf1, 0xe5, 0x84, 0x72, 0x11, 0x30, 0x11, 0x73, 0x98, 0xdd,
-	0xf7, 0x62, 0x3e, 0x1e, 0x2e, 0xe9, 0xa4, 0x7f, 0x28, 0xe9, 0x5e, 0x7b, 0x1b, 0xfa, 0x13, 0x7a,
-	0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x89, 0x06, 0x2a, 0x3e, 0xb6, 0x04, 0x00, 0x00,
+func (m *QueryAbandonedRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAbandonedRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAbandonedRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAbandonedRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAbandonedRequestsRequest.Merge(m, src)
+}
+func (m *QueryAbandonedRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAbandonedRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAbandonedRequestsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAbandonedRequestsRequest proto.InternalMessageInfo
 
-// QuerySrvrClient is the client API for QuerySrvr service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QuerySrvrClient interface {
-	// Params returns the total set of minting parameters.
-	Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error)
+func (m *QueryAbandonedRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type querySrvrClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAbandonedRequestsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func NewQuerySrvrClient(cc grpc1.ClientConn) QuerySrvrClient {
-	return &querySrvrClient{cc}
+// QueryAbandonedRequestsResponse is the response type for the
+// Query/AbandonedQueries RPC method.
+type QueryAbandonedRequestsResponse struct {
+	Queries    []AbandonedQuery    `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *querySrvrClient) Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error) {
-	out := new(QueryRequestsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Queries", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAbandonedRequestsResponse) Reset()         { *m = QueryAbandonedRequestsResponse{} }
+func (m *QueryAbandonedRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAbandonedRequestsResponse) ProtoMessage()    {}
+func (*QueryAbandonedRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{5}
+}
+func (m *QueryAbandonedRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAbandonedRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAbandonedRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QuerySrvrServer is the server API for QuerySrvr service.
-type QuerySrvrServer interface {
-	// Params returns the total set of minting parameters.
-	Queries(context.Context, *QueryRequestsRequest) (*QueryRequestsResponse, error)
+func (m *QueryAbandonedRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAbandonedRequestsResponse.Merge(m, src)
 }
-
-// UnimplementedQuerySrvrServer can be embedded to have forward compatible implementations.
-type UnimplementedQuerySrvrServer struct {
+func (m *QueryAbandonedRequestsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedQuerySrvrServer) Queries(ctx context.Context, req *QueryRequestsRequest) (*QueryRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Queries not implemented")
+func (m *QueryAbandonedRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAbandonedRequestsResponse.DiscardUnknown(m)
 }
 
-func RegisterQuerySrvrServer(s grpc1.Server, srv QuerySrvrServer) {
-	s.RegisterService(&_QuerySrvr_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAbandonedRequestsResponse proto.InternalMessageInfo
 
-func _QuerySrvr_Queries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QuerySrvrServer).Queries(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Queries",
+func (m *QueryAbandonedRequestsResponse) GetQueries() []AbandonedQuery {
+	if m != nil {
+		return m.Queries
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QuerySrvrServer).Queries(ctx, req.(*QueryRequestsRequest))
+	return nil
+}
+
+func (m *QueryAbandonedRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _QuerySrvr_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "quicksilver.interchainquery.v1.QuerySrvr",
-	HandlerType: (*QuerySrvrServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Queries",
-			Handler:    _QuerySrvr_Queries_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "quicksilver/interchainquery/v1/query.proto",
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRelayerEarningsRequest is the request type for the
+// Query/RelayerEarnings RPC method.
+type QueryRelayerEarningsRequest struct {
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
-func (m *QueryRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRelayerEarningsRequest) Reset()         { *m = QueryRelayerEarningsRequest{} }
+func (m *QueryRelayerEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerEarningsRequest) ProtoMessage()    {}
+func (*QueryRelayerEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{8}
+}
+func (m *QueryRelayerEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerEarningsRequest.Merge(m, src)
+}
+func (m *QueryRelayerEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerEarningsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerEarningsRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerEarningsRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+// QueryRelayerEarningsResponse is the response type for the
+// Query/RelayerEarnings RPC method.
+type QueryRelayerEarningsResponse struct {
+	Earnings RelayerEarnings `protobuf:"bytes,1,opt,name=earnings,proto3" json:"earnings"`
+}
+
+func (m *QueryRelayerEarningsResponse) Reset()         { *m = QueryRelayerEarningsResponse{} }
+func (m *QueryRelayerEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerEarningsResponse) ProtoMessage()    {}
+func (*QueryRelayerEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{9}
+}
+func (m *QueryRelayerEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerEarningsResponse.Merge(m, src)
+}
+func (m *QueryRelayerEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerEarningsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerEarningsResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerEarningsResponse) GetEarnings() RelayerEarnings {
+	if m != nil {
+		return m.Earnings
+	}
+	return RelayerEarnings{}
+}
+
+// QueryAllRelayerEarningsRequest is the request type for the
+// Query/AllRelayerEarnings RPC method.
+type QueryAllRelayerEarningsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRelayerEarningsRequest) Reset()         { *m = QueryAllRelayerEarningsRequest{} }
+func (m *QueryAllRelayerEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRelayerEarningsRequest) ProtoMessage()    {}
+func (*QueryAllRelayerEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{10}
+}
+func (m *QueryAllRelayerEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRelayerEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRelayerEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRelayerEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRelayerEarningsRequest.Merge(m, src)
+}
+func (m *QueryAllRelayerEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRelayerEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRelayerEarningsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRelayerEarningsRequest proto.InternalMessageInfo
+
+func (m *QueryAllRelayerEarningsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllRelayerEarningsResponse is the response type for the
+// Query/AllRelayerEarnings RPC method.
+type QueryAllRelayerEarningsResponse struct {
+	Earnings   []RelayerEarnings   `protobuf:"bytes,1,rep,name=earnings,proto3" json:"earnings"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRelayerEarningsResponse) Reset()         { *m = QueryAllRelayerEarningsResponse{} }
+func (m *QueryAllRelayerEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRelayerEarningsResponse) ProtoMessage()    {}
+func (*QueryAllRelayerEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{11}
+}
+func (m *QueryAllRelayerEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRelayerEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRelayerEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRelayerEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRelayerEarningsResponse.Merge(m, src)
+}
+func (m *QueryAllRelayerEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRelayerEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRelayerEarningsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRelayerEarningsResponse proto.InternalMessageInfo
+
+func (m *QueryAllRelayerEarningsResponse) GetEarnings() []RelayerEarnings {
+	if m != nil {
+		return m.Earnings
+	}
+	return nil
+}
+
+func (m *QueryAllRelayerEarningsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetTxResponse is the response type for the Service.GetTx method.
+type GetTxWithProofResponse struct {
+	// tx is the queried transaction.
+	Tx *tx.Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// tx_response is the queried TxResponses.
+	TxResponse *types.TxResponse `protobuf:"bytes,2,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
+	// proof is the tmproto.TxProof for the queried tx
+	Proof *types1.TxProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// ibc-go header to validate txs
+	Header *types2.Header `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *GetTxWithProofResponse) Reset()         { *m = GetTxWithProofResponse{} }
+func (m *GetTxWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxWithProofResponse) ProtoMessage()    {}
+func (*GetTxWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4aadfdae61bcbb1, []int{12}
+}
+func (m *GetTxWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxWithProofResponse.Merge(m, src)
+}
+func (m *GetTxWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxWithProofResponse proto.InternalMessageInfo
+
+func (m *GetTxWithProofResponse) GetTx() *tx.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *GetTxWithProofResponse) GetTxResponse() *types.TxResponse {
+	if m != nil {
+		return m.TxResponse
+	}
+	return nil
+}
+
+func (m *GetTxWithProofResponse) GetProof() *types1.TxProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *GetTxWithProofResponse) GetHeader() *types2.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryRequestsRequest)(nil), "quicksilver.interchainquery.v1.QueryRequestsRequest")
+	proto.RegisterType((*QueryRequestsResponse)(nil), "quicksilver.interchainquery.v1.QueryRequestsResponse")
+	proto.RegisterType((*QueryStaleRequestsRequest)(nil), "quicksilver.interchainquery.v1.QueryStaleRequestsRequest")
+	proto.RegisterType((*QueryStaleRequestsResponse)(nil), "quicksilver.interchainquery.v1.QueryStaleRequestsResponse")
+	proto.RegisterType((*QueryAbandonedRequestsRequest)(nil), "quicksilver.interchainquery.v1.QueryAbandonedRequestsRequest")
+	proto.RegisterType((*QueryAbandonedRequestsResponse)(nil), "quicksilver.interchainquery.v1.QueryAbandonedRequestsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "quicksilver.interchainquery.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "quicksilver.interchainquery.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRelayerEarningsRequest)(nil), "quicksilver.interchainquery.v1.QueryRelayerEarningsRequest")
+	proto.RegisterType((*QueryRelayerEarningsResponse)(nil), "quicksilver.interchainquery.v1.QueryRelayerEarningsResponse")
+	proto.RegisterType((*QueryAllRelayerEarningsRequest)(nil), "quicksilver.interchainquery.v1.QueryAllRelayerEarningsRequest")
+	proto.RegisterType((*QueryAllRelayerEarningsResponse)(nil), "quicksilver.interchainquery.v1.QueryAllRelayerEarningsResponse")
+	proto.RegisterType((*GetTxWithProofResponse)(nil), "quicksilver.interchainquery.v1.GetTxWithProofResponse")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/interchainquery/v1/query.proto", fileDescriptor_e4aadfdae61bcbb1)
+}
+
+var fileDescriptor_e4aadfdae61bcbb1 = []byte{
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0xa4, 0x4b, 0xb2, 0x99, 0x22, 0x81, 0x86, 0x2e, 0x4a, 0xcd, 0xe2, 0xad, 0x0c, 0xbb,
+	0x44, 0x2b, 0xe1, 0x21, 0xd9, 0x22, 0x96, 0x50, 0x02, 0x54, 0x2d, 0x85, 0x0b, 0x6a, 0x43, 0x25,
+	0x10, 0x1c, 0xaa, 0xb1, 0x33, 0x38, 0x23, 0x9c, 0x71, 0x62, 0x4f, 0x22, 0x47, 0x55, 0x39, 0xf4,
+	0x2f, 0x40, 0xe2, 0x2f, 0xe0, 0xc4, 0x05, 0x2e, 0xdc, 0x38, 0x70, 0xa6, 0x37, 0x2a, 0x71, 0x80,
+	0x13, 0x42, 0x2d, 0xff, 0x03, 0x57, 0xe4, 0xf1, 0xd8, 0x38, 0x69, 0x42, 0x7e, 0xa8, 0xa8, 0x5c,
+	0x5a, 0x7b, 0xe6, 0x7d, 0xef, 0x7d, 0xdf, 0x9b, 0xc9, 0xf7, 0x0c, 0x1f, 0xf6, 0xfa, 0xcc, 0xfe,
+	0x3c, 0x60, 0xee, 0x80, 0xfa, 0x98, 0x71, 0x41, 0x7d, 0xbb, 0x4d, 0x18, 0xef, 0xf5, 0xa9, 0x3f,
+	0xc4, 0x83, 0x2a, 0x96, 0x0f, 0x66, 0xd7, 0xf7, 0x84, 0x87, 0xf4, 0x4c, 0xac, 0x39, 0x16, 0x6b,
+	0x0e, 0xaa, 0xda, 0x9a, 0xe3, 0x39, 0x9e, 0x0c, 0xc5, 0xd1, 0x53, 0x8c, 0xd2, 0xee, 0x3a, 0x9e,
+	0xe7, 0xb8, 0x14, 0x93, 0x2e, 0xc3, 0x84, 0x73, 0x4f, 0x10, 0xc1, 0x3c, 0x1e, 0xa8, 0xdd, 0xcd,
+	0x19, 0xf5, 0xc7, 0xcb, 0xc4, 0xa8, 0x87, 0xb6, 0x17, 0x74, 0xbc, 0x00, 0x5b, 0x24, 0xa0, 0x38,
+	0x89, 0xb5, 0xa8, 0x20, 0x55, 0xdc, 0x25, 0x0e, 0xe3, 0xb2, 0x84, 0x8a, 0x7d, 0x21, 0x1b, 0x4b,
+	0x2c, 0x9b, 0xa5, 0xa1, 0xd1, 0x8b, 0x0a, 0xd2, 0x54, 0x90, 0x08, 0xd3, 0x5d, 0x11, 0x26, 0x02,
+	0x04, 0xe5, 0x2d, 0xea, 0x77, 0x18, 0x17, 0x58, 0x0c, 0xbb, 0x34, 0x88, 0xff, 0xaa, 0x5d, 0xcc,
+	0x2c, 0x1b, 0xbb, 0xcc, 0x69, 0x0b, 0xdb, 0x65, 0x94, 0x8b, 0x00, 0x67, 0xc2, 0x07, 0xd5, 0xcc,
+	0x5b, 0x0c, 0x30, 0x86, 0x70, 0xed, 0x20, 0x62, 0xdc, 0xa4, 0xbd, 0x3e, 0x0d, 0x44, 0xa0, 0xfe,
+	0xa3, 0x77, 0x21, 0xfc, 0x87, 0x7b, 0x19, 0x6c, 0x80, 0xca, 0x6a, 0xed, 0x81, 0x19, 0xf3, 0x32,
+	0x23, 0xf2, 0x66, 0xd2, 0x68, 0xc9, 0xcf, 0xdc, 0x27, 0x0e, 0x55, 0xd8, 0x66, 0x06, 0x89, 0xd6,
+	0xe1, 0x6d, 0xd9, 0xaf, 0x23, 0xd6, 0x2a, 0xe7, 0x37, 0x40, 0xa5, 0xd4, 0x2c, 0xca, 0xf7, 0xf7,
+	0x5b, 0xc6, 0x37, 0x00, 0xde, 0x19, 0xab, 0x1d, 0x74, 0x3d, 0x1e, 0x50, 0xb4, 0x0b, 0x8b, 0x51,
+	0x76, 0x46, 0x83, 0x32, 0xd8, 0x58, 0xa9, 0xac, 0xd6, 0xee, 0x9b, 0xff, 0x7e, 0xd8, 0xa6, 0xcc,
+	0xb3, 0x7d, 0xeb, 0xec, 0xf7, 0x7b, 0xb9, 0x66, 0x82, 0x45, 0x7b, 0x23, 0x1a, 0xf2, 0x52, 0xc3,
+	0x4b, 0x33, 0x35, 0xc4, 0x1c, 0xb2, 0x22, 0x8c, 0x2f, 0xe0, 0xba, 0x2c, 0xf0, 0xa1, 0x20, 0x2e,
+	0xbd, 0x81, 0x4e, 0x7d, 0x0b, 0xa0, 0x36, 0x89, 0xc0, 0xff, 0xb4, 0x5d, 0xa7, 0x00, 0x3e, 0x2f,
+	0x2b, 0xbc, 0x63, 0x11, 0xde, 0xf2, 0x38, 0x6d, 0xdd, 0x40, 0xcf, 0x7e, 0x00, 0x50, 0x9f, 0x46,
+	0x42, 0xf5, 0xed, 0x83, 0xf1, 0xbe, 0x99, 0xb3, 0xfa, 0x96, 0xe6, 0xfa, 0x6f, 0x1b, 0xb8, 0x06,
+	0x91, 0x2c, 0xb0, 0x4f, 0x7c, 0xd2, 0x49, 0x9a, 0x66, 0x7c, 0x0a, 0x9f, 0x19, 0x59, 0x55, 0x2a,
+	0x76, 0x60, 0xa1, 0x2b, 0x57, 0xd2, 0x3e, 0xce, 0x10, 0x11, 0xe3, 0x15, 0x79, 0x85, 0x35, 0x5e,
+	0x83, 0xcf, 0xa9, 0xdf, 0xa2, 0x4b, 0x86, 0xd4, 0xdf, 0x25, 0x3e, 0x67, 0xdc, 0x49, 0x0f, 0xac,
+	0x0c, 0x8b, 0x7e, 0xbc, 0x23, 0xab, 0x94, 0x9a, 0xc9, 0xab, 0xd1, 0x83, 0x77, 0x27, 0x03, 0x15,
+	0xbd, 0x03, 0x78, 0x9b, 0xaa, 0x35, 0x45, 0x10, 0xcf, 0x22, 0x38, 0x96, 0x4a, 0x31, 0x4d, 0xd3,
+	0x18, 0xed, 0xe4, 0x64, 0x5d, 0x77, 0x0a, 0xdd, 0x6b, 0xba, 0x5f, 0xc6, 0x8f, 0x00, 0xde, 0x9b,
+	0x5a, 0x6a, 0xa2, 0xc0, 0x95, 0x6b, 0x10, 0x78, 0x7d, 0x17, 0xe9, 0x2f, 0x00, 0x9f, 0xdd, 0xa3,
+	0xe2, 0x30, 0xfc, 0x88, 0x89, 0xf6, 0xbe, 0xef, 0x79, 0x9f, 0xa5, 0xb4, 0xef, 0xc3, 0xbc, 0x08,
+	0x55, 0x6b, 0xee, 0x24, 0xb9, 0x45, 0x98, 0xe6, 0x3c, 0x0c, 0x9b, 0x79, 0x11, 0xa2, 0x5d, 0xb8,
+	0x2a, 0xc2, 0x23, 0x5f, 0xa1, 0x14, 0x97, 0x17, 0x47, 0xb8, 0xc8, 0xc1, 0x95, 0x81, 0xa5, 0x44,
+	0x44, 0xfa, 0x8c, 0x30, 0x7c, 0xa2, 0x1b, 0x95, 0x2f, 0xaf, 0xc8, 0x04, 0xeb, 0x66, 0x66, 0x10,
+	0xc5, 0xf3, 0xeb, 0x30, 0x8c, 0xf9, 0xc5, 0x71, 0xa8, 0x01, 0x0b, 0x6d, 0x4a, 0x5a, 0xd4, 0x2f,
+	0xdf, 0x52, 0xa7, 0xc7, 0x2c, 0xdb, 0xcc, 0x4e, 0xb6, 0x6c, 0x8a, 0x41, 0xd5, 0x7c, 0x4f, 0x46,
+	0x37, 0x15, 0xaa, 0xf6, 0x5d, 0x09, 0x96, 0x62, 0xcb, 0xf4, 0x07, 0x3e, 0xfa, 0x1e, 0xc0, 0xe2,
+	0x81, 0xfa, 0x95, 0x6e, 0xce, 0x65, 0x8e, 0x63, 0x8e, 0xa5, 0xbd, 0xba, 0x20, 0x2a, 0xd6, 0x6d,
+	0xd4, 0x4f, 0x7f, 0xf9, 0xf3, 0xab, 0xfc, 0x26, 0xaa, 0xe1, 0x39, 0xbe, 0x6c, 0x18, 0x0d, 0xf0,
+	0x71, 0xe2, 0x67, 0x27, 0xe8, 0x27, 0x00, 0x9f, 0x94, 0x86, 0x9f, 0x30, 0x7f, 0x7d, 0x2e, 0x0e,
+	0x93, 0x86, 0x94, 0x56, 0x5f, 0x06, 0xaa, 0x34, 0xbc, 0x2d, 0x35, 0xd4, 0xd1, 0xe3, 0xc5, 0x35,
+	0xe0, 0x20, 0xca, 0x88, 0x7e, 0x05, 0xf0, 0xe9, 0x11, 0xeb, 0x8c, 0xd4, 0xbc, 0x39, 0x17, 0xa5,
+	0x69, 0x23, 0x44, 0x6b, 0x2c, 0x0b, 0x57, 0xaa, 0x76, 0xa4, 0xaa, 0x06, 0xda, 0x5a, 0x42, 0x15,
+	0x49, 0xb2, 0xa2, 0xaf, 0x01, 0x2c, 0xc4, 0x7e, 0x8a, 0x6a, 0x73, 0x11, 0x1a, 0xb1, 0x74, 0xed,
+	0xd1, 0x42, 0x18, 0xc5, 0xdc, 0x94, 0xcc, 0x2b, 0xe8, 0xc1, 0x2c, 0xe6, 0xb1, 0xb5, 0xa3, 0x73,
+	0x00, 0x9f, 0x1a, 0x73, 0x1c, 0xf4, 0xc6, 0x9c, 0xd7, 0x79, 0x92, 0xbb, 0x6a, 0x5b, 0xcb, 0x81,
+	0x15, 0xfd, 0x6d, 0x49, 0x7f, 0x0b, 0xd5, 0x67, 0xd1, 0x57, 0x13, 0xe6, 0x28, 0xb1, 0x45, 0x7c,
+	0xac, 0x56, 0x4e, 0xd0, 0xcf, 0x00, 0xa2, 0xab, 0x96, 0x8c, 0xe6, 0xbc, 0x13, 0xd3, 0xc6, 0x86,
+	0xf6, 0xd6, 0xd2, 0x78, 0xa5, 0xed, 0xb1, 0xd4, 0x56, 0x43, 0xaf, 0x2c, 0xaa, 0x6d, 0xfb, 0xe3,
+	0xb3, 0x0b, 0x1d, 0x9c, 0x5f, 0xe8, 0xe0, 0x8f, 0x0b, 0x1d, 0x7c, 0x79, 0xa9, 0xe7, 0xce, 0x2f,
+	0xf5, 0xdc, 0x6f, 0x97, 0x7a, 0xee, 0x93, 0x86, 0xc3, 0x44, 0xbb, 0x6f, 0x99, 0xb6, 0xd7, 0xc1,
+	0x8c, 0x3b, 0x94, 0xf7, 0x99, 0x18, 0xbe, 0x6c, 0xf5, 0x99, 0xdb, 0x1a, 0xa9, 0x12, 0x5e, 0xa9,
+	0x23, 0x8d, 0xd5, 0x2a, 0xc8, 0x0f, 0xfd, 0x47, 0x7f, 0x0f, 0x00, 0x7a, 0x17, 0xb3, 0x4e, 0x5c,
+	0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QuerySrvrClient is the client API for QuerySrvr service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QuerySrvrClient interface {
+	// Params returns the total set of minting parameters.
+	Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error)
+	// StaleQueries returns the pending queries that have gone unanswered beyond
+	// the retry interval.
+	StaleQueries(ctx context.Context, in *QueryStaleRequestsRequest, opts ...grpc.CallOption) (*QueryStaleRequestsResponse, error)
+	// AbandonedQueries returns the queries that were abandoned without response.
+	AbandonedQueries(ctx context.Context, in *QueryAbandonedRequestsRequest, opts ...grpc.CallOption) (*QueryAbandonedRequestsResponse, error)
+	// Params returns the total set of interchainquery parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RelayerEarnings returns the query fees earned by a relayer.
+	RelayerEarnings(ctx context.Context, in *QueryRelayerEarningsRequest, opts ...grpc.CallOption) (*QueryRelayerEarningsResponse, error)
+	// AllRelayerEarnings returns the query fees earned by all relayers.
+	AllRelayerEarnings(ctx context.Context, in *QueryAllRelayerEarningsRequest, opts ...grpc.CallOption) (*QueryAllRelayerEarningsResponse, error)
+}
+
+type querySrvrClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQuerySrvrClient(cc grpc1.ClientConn) QuerySrvrClient {
+	return &querySrvrClient{cc}
+}
+
+func (c *querySrvrClient) Queries(ctx context.Context, in *QueryRequestsRequest, opts ...grpc.CallOption) (*QueryRequestsResponse, error) {
+	out := new(QueryRequestsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Queries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) StaleQueries(ctx context.Context, in *QueryStaleRequestsRequest, opts ...grpc.CallOption) (*QueryStaleRequestsResponse, error) {
+	out := new(QueryStaleRequestsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/StaleQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) AbandonedQueries(ctx context.Context, in *QueryAbandonedRequestsRequest, opts ...grpc.CallOption) (*QueryAbandonedRequestsResponse, error) {
+	out := new(QueryAbandonedRequestsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/AbandonedQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) RelayerEarnings(ctx context.Context, in *QueryRelayerEarningsRequest, opts ...grpc.CallOption) (*QueryRelayerEarningsResponse, error) {
+	out := new(QueryRelayerEarningsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/RelayerEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *querySrvrClient) AllRelayerEarnings(ctx context.Context, in *QueryAllRelayerEarningsRequest, opts ...grpc.CallOption) (*QueryAllRelayerEarningsResponse, error) {
+	out := new(QueryAllRelayerEarningsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainquery.v1.QuerySrvr/AllRelayerEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuerySrvrServer is the server API for QuerySrvr service.
+type QuerySrvrServer interface {
+	// Params returns the total set of minting parameters.
+	Queries(context.Context, *QueryRequestsRequest) (*QueryRequestsResponse, error)
+	// StaleQueries returns the pending queries that have gone unanswered beyond
+	// the retry interval.
+	StaleQueries(context.Context, *QueryStaleRequestsRequest) (*QueryStaleRequestsResponse, error)
+	// AbandonedQueries returns the queries that were abandoned without response.
+	AbandonedQueries(context.Context, *QueryAbandonedRequestsRequest) (*QueryAbandonedRequestsResponse, error)
+	// Params returns the total set of interchainquery parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RelayerEarnings returns the query fees earned by a relayer.
+	RelayerEarnings(context.Context, *QueryRelayerEarningsRequest) (*QueryRelayerEarningsResponse, error)
+	// AllRelayerEarnings returns the query fees earned by all relayers.
+	AllRelayerEarnings(context.Context, *QueryAllRelayerEarningsRequest) (*QueryAllRelayerEarningsResponse, error)
+}
+
+// UnimplementedQuerySrvrServer can be embedded to have forward compatible implementations.
+type UnimplementedQuerySrvrServer struct {
+}
+
+func (*UnimplementedQuerySrvrServer) Queries(ctx context.Context, req *QueryRequestsRequest) (*QueryRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Queries not implemented")
+}
+func (*UnimplementedQuerySrvrServer) StaleQueries(ctx context.Context, req *QueryStaleRequestsRequest) (*QueryStaleRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaleQueries not implemented")
+}
+func (*UnimplementedQuerySrvrServer) AbandonedQueries(ctx context.Context, req *QueryAbandonedRequestsRequest) (*QueryAbandonedRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonedQueries not implemented")
+}
+func (*UnimplementedQuerySrvrServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQuerySrvrServer) RelayerEarnings(ctx context.Context, req *QueryRelayerEarningsRequest) (*QueryRelayerEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerEarnings not implemented")
+}
+func (*UnimplementedQuerySrvrServer) AllRelayerEarnings(ctx context.Context, req *QueryAllRelayerEarningsRequest) (*QueryAllRelayerEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRelayerEarnings not implemented")
+}
+
+func RegisterQuerySrvrServer(s grpc1.Server, srv QuerySrvrServer) {
+	s.RegisterService(&_QuerySrvr_serviceDesc, srv)
+}
+
+func _QuerySrvr_Queries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Queries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Queries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Queries(ctx, req.(*QueryRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_StaleQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStaleRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).StaleQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/StaleQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).StaleQueries(ctx, req.(*QueryStaleRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_AbandonedQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAbandonedRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).AbandonedQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/AbandonedQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).AbandonedQueries(ctx, req.(*QueryAbandonedRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_RelayerEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).RelayerEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/RelayerEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).RelayerEarnings(ctx, req.(*QueryRelayerEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuerySrvr_AllRelayerEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRelayerEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuerySrvrServer).AllRelayerEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainquery.v1.QuerySrvr/AllRelayerEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuerySrvrServer).AllRelayerEarnings(ctx, req.(*QueryAllRelayerEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QuerySrvr_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "quicksilver.interchainquery.v1.QuerySrvr",
+	HandlerType: (*QuerySrvrServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Queries",
+			Handler:    _QuerySrvr_Queries_Handler,
+		},
+		{
+			MethodName: "StaleQueries",
+			Handler:    _QuerySrvr_StaleQueries_Handler,
+		},
+		{
+			MethodName: "AbandonedQueries",
+			Handler:    _QuerySrvr_AbandonedQueries_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _QuerySrvr_Params_Handler,
+		},
+		{
+			MethodName: "RelayerEarnings",
+			Handler:    _QuerySrvr_RelayerEarnings_Handler,
+		},
+		{
+			MethodName: "AllRelayerEarnings",
+			Handler:    _QuerySrvr_AllRelayerEarnings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "quicksilver/interchainquery/v1/query.proto",
+}
+
+func (m *QueryRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAbandonedRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAbandonedRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAbandonedRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAbandonedRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAbandonedRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAbandonedRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Earnings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRelayerEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRelayerEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRelayerEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRelayerEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRelayerEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRelayerEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaleRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaleRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAbandonedRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAbandonedRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRelayerEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Earnings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRelayerEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRelayerEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxWithProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaleRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaleRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaleRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaleRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaleRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaleRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAbandonedRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAbandonedRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAbandonedRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAbandonedRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Queries) > 0 {
-		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAbandonedRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAbandonedRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, AbandonedQuery{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *GetTxWithProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTxWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *GetTxWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.TxResponse != nil {
-		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Queries) > 0 {
-		for _, e := range m.Queries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRelayerEarningsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerEarningsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *GetTxWithProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TxResponse != nil {
-		l = m.TxResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerEarningsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -609,15 +2785,15 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerEarningsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -644,18 +2820,65 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Earnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRelayerEarningsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRelayerEarningsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRelayerEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -665,23 +2888,27 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -704,7 +2931,7 @@ func (m *QueryRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRelayerEarningsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -727,15 +2954,15 @@ func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRelayerEarningsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRelayerEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -762,8 +2989,8 @@ func (m *QueryRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queries = append(m.Queries, Query{})
-			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Earnings = append(m.Earnings, RelayerEarnings{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
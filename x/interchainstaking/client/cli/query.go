@@ -1,17 +1,24 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
+const flagOutputFile = "output-file"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group epochs queries under a subcommand
@@ -28,6 +35,20 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdZonesInfos(),
 		GetDelegatorIntentCmd(),
 		GetDepositAccountCmd(),
+		GetRebalancePlanCmd(),
+		GetDelegationPlanCmd(),
+		GetUndelegationPlanCmd(),
+		GetRateLimitsCmd(),
+		GetRedemptionRateHistoryCmd(),
+		GetRedemptionRateTWAPCmd(),
+		GetSlashRecordsCmd(),
+		GetInFlightICAPacketsCmd(),
+		GetFailedICAPacketsCmd(),
+		GetOffboardingStatusCmd(),
+		GetZoneFeesCmd(),
+		GetEpochReportsCmd(),
+		GetEpochReportCmd(),
+		GetExportEpochReportsCmd(),
 	)
 
 	return cmd
@@ -145,3 +166,576 @@ func GetDepositAccountCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetRebalancePlanCmd returns the redelegations that would be issued were the
+// given chainID (zone) rebalanced at the current state.
+func GetRebalancePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-plan [chain_id]",
+		Short: "Query the rebalancing redelegations for a given chain at the current state.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRebalancePlanRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.RebalancePlan(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDelegationPlanCmd returns the per-validator allocation of the given amount
+// were it delegated for the given chainID (zone) at the current state.
+func GetDelegationPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-plan [chain_id] [amount]",
+		Short: "Query the per-validator allocation of a delegation for a given chain at the current state.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking delegation-plan cosmoshub-4 1000000uatom`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			amount := args[1]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryDelegationPlanRequest{
+				ChainId: chainID,
+				Amount:  amount,
+			}
+
+			res, err := queryClient.DelegationPlan(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUndelegationPlanCmd returns the per-validator allocation of the given amount
+// were it undelegated for the given chainID (zone) at the current state.
+func GetUndelegationPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegation-plan [chain_id] [amount]",
+		Short: "Query the per-validator allocation of an undelegation for a given chain at the current state.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking undelegation-plan cosmoshub-4 1000000uatom`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			amount := args[1]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryUndelegationPlanRequest{
+				ChainId: chainID,
+				Amount:  amount,
+			}
+
+			res, err := queryClient.UndelegationPlan(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRateLimitsCmd returns the remaining deposit and redemption headroom for
+// the given chainID (zone) in the current epoch.
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits [chain_id]",
+		Short: "Query the remaining deposit and redemption headroom for a given chain this epoch.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRateLimitsRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.RateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRedemptionRateHistoryCmd returns the recorded redemption rates for the given zone.
+func GetRedemptionRateHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-history [chain_id]",
+		Short: "Query the recorded redemption rates for a given chain, oldest first.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRedemptionRateHistoryRequest{
+				ChainId:    chainID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RedemptionRateHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redemption-rate-history")
+
+	return cmd
+}
+
+// GetRedemptionRateTWAPCmd returns the time-weighted average redemption rate for the given zone.
+func GetRedemptionRateTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-twap [chain_id] [epochs]",
+		Short: "Query the time-weighted average redemption rate for a given chain over the most recent epochs.",
+		Long:  "Query the time-weighted average redemption rate for a given chain over the most recent epochs. Where epochs is omitted or zero, all recorded epochs are used.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking redemption-rate-twap cosmoshub-4 10`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			epochs := uint64(0)
+			if len(args) > 1 {
+				epochs, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRedemptionRateTWAPRequest{
+				ChainId: chainID,
+				Epochs:  epochs,
+			}
+
+			res, err := queryClient.RedemptionRateTWAP(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSlashRecordsCmd returns the recorded slashes of validators for the given zone.
+func GetSlashRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-records [chain_id] [valoper]",
+		Short: "Query the recorded slashes of validators for a given chain, optionally filtered by validator.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			valoper := ""
+			if len(args) > 1 {
+				valoper = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QuerySlashRecordsRequest{
+				ChainId:    chainID,
+				Valoper:    valoper,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SlashRecords(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash-records")
+
+	return cmd
+}
+
+// GetInFlightICAPacketsCmd returns the in-flight outbound interchain account packets for the given zone.
+func GetInFlightICAPacketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "in-flight-ica-packets [chain_id]",
+		Short: "Query the in-flight outbound interchain account packets for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryICAPacketsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InFlightICAPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight-ica-packets")
+
+	return cmd
+}
+
+// GetFailedICAPacketsCmd returns the failed outbound interchain account packets for the given zone.
+func GetFailedICAPacketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-ica-packets [chain_id]",
+		Short: "Query the failed outbound interchain account packets for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryICAPacketsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FailedICAPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "failed-ica-packets")
+
+	return cmd
+}
+
+// GetOffboardingStatusCmd returns the offboarding progress of the given zone.
+func GetOffboardingStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offboarding-status [chain_id]",
+		Short: "Query the offboarding progress of a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryOffboardingStatusRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.OffboardingStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetZoneFeesCmd returns the cumulative protocol fees collected from the given zone.
+func GetZoneFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fees [chain_id]",
+		Short: "Query the cumulative protocol fees collected from a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryZoneFeesRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.ZoneFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetEpochReportsCmd returns the epoch reward reports for the given zone.
+func GetEpochReportsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-reports [chain_id]",
+		Short: "Query the epoch reward reports for a given chain, oldest first.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryEpochReportsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.EpochReports(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-reports")
+
+	return cmd
+}
+
+// GetEpochReportCmd returns the reward report for the given zone and epoch.
+func GetEpochReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-report [chain_id] [epoch]",
+		Short: "Query the reward report for a given chain and epoch.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch %s: %w", args[1], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryEpochReportRequest{
+				ChainId: args[0],
+				Epoch:   epoch,
+			}
+
+			res, err := queryClient.EpochReport(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetExportEpochReportsCmd exports every epoch reward report for the given zone as CSV.
+func GetExportEpochReportsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-epoch-reports [chain_id]",
+		Short: "Export the epoch reward reports for a given chain as CSV, oldest first.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query %s export-epoch-reports cosmoshub-4 --%s reports.csv`,
+				version.AppName, types.ModuleName, flagOutputFile,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFile, err := cmd.Flags().GetString(flagOutputFile)
+			if err != nil {
+				return err
+			}
+
+			var out io.Writer = cmd.OutOrStdout()
+			if outputFile != "" {
+				file, err := os.Create(outputFile)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			writer := csv.NewWriter(out)
+			if err := writer.Write(types.EpochReportCSVHeader); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq := &query.PageRequest{Limit: query.DefaultLimit}
+			for {
+				res, err := queryClient.EpochReports(cmd.Context(), &types.QueryEpochReportsRequest{
+					ChainId:    args[0],
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				for _, report := range res.Reports {
+					if err := writer.Write(report.CSVRecord()); err != nil {
+						return err
+					}
+				}
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: query.DefaultLimit}
+			}
+
+			writer.Flush()
+			return writer.Error()
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagOutputFile, "", "write the CSV to the given file, rather than stdout")
+
+	return cmd
+}
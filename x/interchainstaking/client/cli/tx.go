@@ -31,7 +31,10 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	txCmd.AddCommand(GetSignalIntentTxCmd())
+	txCmd.AddCommand(GetConfirmIntentTxCmd())
 	txCmd.AddCommand(GetRequestRedemptionTxCmd())
+	txCmd.AddCommand(GetCancelRedemptionTxCmd())
+	txCmd.AddCommand(GetUpdateRedemptionDestinationTxCmd())
 	txCmd.AddCommand(GetReopenChannelTxCmd())
 
 	return txCmd
@@ -66,6 +69,31 @@ e.g. "0.3cosmosvaloper1xxxxxxxxx,0.3cosmosvaloper1yyyyyyyyy,0.4cosmosvaloper1zzz
 	return cmd
 }
 
+// GetConfirmIntentTxCmd returns a CLI command handler for refreshing signalled
+// validator delegation intent.
+func GetConfirmIntentTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-intent [chain_id]",
+		Short: `Confirm validator delegation intent.`,
+		Long: `Refresh signalled validator delegation intent, without changing it, such that
+it does not expire.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConfirmIntent(args[0], clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetRequestRedemptionTxCmd returns a CLI command handler for creating a Request transaction.
 func GetRequestRedemptionTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -94,6 +122,54 @@ func GetRequestRedemptionTxCmd() *cobra.Command {
 	return cmd
 }
 
+// GetCancelRedemptionTxCmd returns a CLI command handler for creating a CancelRedemption transaction.
+func GetCancelRedemptionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-redemption [chain_id] [hash]",
+		Short: `Cancel a queued redemption.`,
+		Long: `Cancel a redemption that has not yet been unbonded, returning the escrowed
+qAssets to the sender.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRedemption(args[0], args[1], clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUpdateRedemptionDestinationTxCmd returns a CLI command handler for creating an UpdateRedemptionDestination transaction.
+func GetUpdateRedemptionDestinationTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-redemption [chain_id] [hash] [destination_address]",
+		Short: `Update the destination address of a queued redemption.`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRedemptionDestination(args[0], args[1], args[2], clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetReopenChannelTxCmd returns a CLI command handler for creating a Reopen ICA port transaction.
 func GetReopenChannelTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -276,3 +352,72 @@ func ParseZoneUpdateProposal(cdc codec.JSONCodec, proposalFile string) (types.Up
 
 	return proposal, nil
 }
+
+// GetCmdSubmitOffboardProposal implements the command to submit an offboard-zone proposal
+func GetCmdSubmitOffboardProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offboard-zone [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a zone offboarding proposal",
+		Long: strings.TrimSpace(
+			`Submit a zone offboarding proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal offboard-zone <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Offboard cosmoshub-4",
+  "description": "Unbond all cosmoshub-4 delegations and return the native assets to qatom holders",
+  "chain_id": "cosmoshub-4",
+  "deposit": "512000000uqck"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseZoneOffboardProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewOffboardZoneProposal(proposal.Title, proposal.Description, proposal.ChainId)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func ParseZoneOffboardProposal(cdc codec.JSONCodec, proposalFile string) (types.OffboardZoneProposalWithDeposit, error) {
+	proposal := types.OffboardZoneProposalWithDeposit{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	if reflect.DeepEqual(proposal, types.OffboardZoneProposalWithDeposit{}) {
+		return proposal, errors.New("cannot unmarshal empty JSON object")
+	}
+
+	return proposal, nil
+}
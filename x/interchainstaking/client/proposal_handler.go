@@ -10,4 +10,5 @@ import (
 var (
 	RegisterProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRegisterProposal)
 	UpdateProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateProposal)
+	OffboardProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitOffboardProposal)
 )
@@ -60,6 +60,34 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, withdrawal := range genState.WithdrawalRecords {
 		k.SetWithdrawalRecord(ctx, withdrawal)
 	}
+
+	for _, record := range genState.RedemptionRateRecords {
+		k.SetRedemptionRateRecord(ctx, record)
+	}
+
+	for _, record := range genState.SlashRecords {
+		k.SetSlashRecord(ctx, record)
+	}
+
+	for _, record := range genState.IcaPacketRecords {
+		k.SetICAPacketRecord(ctx, record)
+	}
+
+	for _, record := range genState.ConversionRecords {
+		k.SetConversionRecord(ctx, record)
+	}
+
+	for _, fees := range genState.ZoneFees {
+		k.SetZoneFees(ctx, fees)
+	}
+
+	for _, report := range genState.EpochReports {
+		k.SetEpochReport(ctx, report)
+	}
+
+	for _, record := range genState.TimedOutDelegations {
+		k.SetTimedOutDelegation(ctx, record)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -73,6 +101,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		DelegatorIntents:       ExportDelegatorIntentsPerZone(ctx, k),
 		PortConnections:        k.AllPortConnections(ctx),
 		WithdrawalRecords:      k.AllWithdrawalRecords(ctx),
+		RedemptionRateRecords:  k.AllRedemptionRateRecords(ctx),
+		SlashRecords:           k.AllSlashRecords(ctx),
+		IcaPacketRecords:       k.AllICAPacketRecords(ctx),
+		ConversionRecords:      k.AllConversionRecords(ctx),
+		ZoneFees:               k.AllZoneFees(ctx),
+		EpochReports:           k.AllEpochReports(ctx),
+		TimedOutDelegations:    k.AllTimedOutDelegations(ctx),
 	}
 }
 
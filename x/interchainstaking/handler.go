@@ -17,6 +17,8 @@ func NewProposalHandler(k keeper.Keeper) govv1beta1.Handler {
 			return k.HandleRegisterZoneProposal(ctx, c)
 		case *types.UpdateZoneProposal:
 			return k.HandleUpdateZoneProposal(ctx, c)
+		case *types.OffboardZoneProposal:
+			return k.HandleOffboardZoneProposal(ctx, c)
 
 		default:
 			return sdkioerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchainstaking proposal content type: %T", c)
@@ -142,7 +142,20 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	_ sdk.AccAddress,
 ) error {
-	return im.keeper.HandleTimeout(ctx, packet)
+	connectionID, _, err := im.keeper.IBCKeeper.ChannelKeeper.GetChannelConnection(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		// a failed MsgTimeout cannot be relayed again; log rather than block the channel.
+		im.keeper.Logger(ctx).Error("packet connection not found", "error", err.Error())
+		return nil
+	}
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), connectionID))
+
+	// HandleTimeout only returns an error for undecodable packet data.
+	err = im.keeper.HandleTimeout(ctx, packet)
+	if err != nil {
+		im.keeper.Logger(ctx).Error("CALLBACK ERROR:", "error", err.Error())
+	}
+	return err
 }
 
 // NegotiateAppVersion implements the IBCModule interface
@@ -43,6 +43,10 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 			if err := k.GCCompletedUnbondings(ctx, zone); err != nil {
 				k.Logger(ctx).Error("error in GCCompletedUnbondings", "error", err.Error())
 			}
+			k.GCAcknowledgedICAPackets(ctx, zone)
+			if err := k.ReopenClosedICAs(ctx, zone); err != nil {
+				k.Logger(ctx).Error("error in ReopenClosedICAs", "error", err.Error())
+			}
 		}
 
 		connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, zone.ConnectionId)
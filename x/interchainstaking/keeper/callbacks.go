@@ -1,24 +1,16 @@
 package keeper
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
-	"time"
 
-	sdkioerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
-	tmclienttypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
-	tmtypes "github.com/tendermint/tendermint/types"
 
-	"github.com/ingenuity-build/quicksilver/utils"
 	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
@@ -27,16 +19,21 @@ import (
 
 type Callback func(*Keeper, sdk.Context, []byte, icqtypes.Query) error
 
+// FailureCallback is called when a query is abandoned without response.
+type FailureCallback func(*Keeper, sdk.Context, icqtypes.Query) error
+
 // Callbacks wrapper struct for interchainstaking keeper
 type Callbacks struct {
 	k         *Keeper
 	callbacks map[string]Callback
+	failures  map[string]FailureCallback
+	proofs    map[string]bool
 }
 
 var _ icqtypes.QueryCallbacks = Callbacks{}
 
 func (k *Keeper) CallbackHandler() Callbacks {
-	return Callbacks{k, make(map[string]Callback)}
+	return Callbacks{k, make(map[string]Callback), make(map[string]FailureCallback), make(map[string]bool)}
 }
 
 // callback handler
@@ -54,11 +51,34 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 	return c
 }
 
+// failure callback handler
+func (c Callbacks) CallFailure(ctx sdk.Context, id string, query icqtypes.Query) error {
+	fn, found := c.failures[id]
+	if !found {
+		return nil
+	}
+	return fn(c.k, ctx, query)
+}
+
+func (c Callbacks) AddFailureCallback(id string, fn interface{}) icqtypes.QueryCallbacks {
+	c.failures[id] = fn.(FailureCallback)
+	return c
+}
+
+// proof policy
+func (c Callbacks) RequireProof(id string) icqtypes.QueryCallbacks {
+	c.proofs[id] = true
+	return c
+}
+
+func (c Callbacks) RequiresProof(id string) bool {
+	return c.proofs[id]
+}
+
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	a := c.
 		AddCallback("valset", Callback(ValsetCallback)).
 		AddCallback("validator", Callback(ValidatorCallback)).
-		AddCallback("rewards", Callback(RewardsCallback)).
 		AddCallback("delegations", Callback(DelegationsCallback)).
 		AddCallback("delegation", Callback(DelegationCallback)).
 		AddCallback("distributerewards", Callback(DistributeRewardsFromWithdrawAccount)).
@@ -66,7 +86,22 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 		AddCallback("deposittx", Callback(DepositTxCallback)).
 		AddCallback("perfbalance", Callback(PerfBalanceCallback)).
 		AddCallback("accountbalance", Callback(AccountBalanceCallback)).
-		AddCallback("allbalances", Callback(AllBalancesCallback))
+		AddCallback("allbalances", Callback(AllBalancesCallback)).
+		AddCallback("conversionbalance", Callback(ConversionBalanceCallback)).
+		AddFailureCallback("accountbalance", FailureCallback(AccountBalanceFailureCallback)).
+		AddFailureCallback("deposittx", FailureCallback(DepositTxFailureCallback)).
+		AddFailureCallback("delegation", FailureCallback(RequeryFailureCallback)).
+		AddFailureCallback("validator", FailureCallback(RequeryFailureCallback)).
+		// callbacks that update delegations, validators or balances, or that handle deposits, must be proven.
+		// valset, delegations, allbalances and depositinterval only trigger the proven queries below.
+		// delegation rewards are withdrawn from the proven delegation records, rather than queried.
+		RequireProof("validator").
+		RequireProof("delegation").
+		RequireProof("distributerewards").
+		RequireProof("deposittx").
+		RequireProof("perfbalance").
+		RequireProof("accountbalance").
+		RequireProof("conversionbalance")
 
 	return a.(Callbacks)
 }
@@ -91,33 +126,6 @@ func ValidatorCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Q
 	return k.SetValidatorForZone(ctx, &zone, args)
 }
 
-func RewardsCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
-	zone, found := k.GetZone(ctx, query.GetChainId())
-	if !found {
-		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
-	}
-
-	k.Logger(ctx).Debug("rewards callback", "zone", query.ChainId)
-
-	// unmarshal request payload
-	rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{}
-	if len(query.Request) == 0 {
-		return errors.New("attempted to unmarshal zero length byte slice (2)")
-	}
-	err := k.cdc.Unmarshal(query.Request, &rewardsQuery)
-	if err != nil {
-		return err
-	}
-
-	// decrement waitgroup as we have received back the query
-	// (initially incremented in AfterEpochEnd)
-	zone.WithdrawalWaitgroup--
-
-	k.Logger(ctx).Debug("QueryDelegationRewards callback", "wg", zone.WithdrawalWaitgroup, "delegatorAddress", rewardsQuery.DelegatorAddress, "zone", query.ChainId)
-
-	return k.WithdrawDelegationRewardsForResponse(ctx, &zone, rewardsQuery.DelegatorAddress, args)
-}
-
 func DelegationsCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
 	zone, found := k.GetZone(ctx, query.GetChainId())
 	if !found {
@@ -238,163 +246,13 @@ func DepositIntervalCallback(k *Keeper, ctx sdk.Context, args []byte, query icqt
 			continue
 		}
 		k.Logger(ctx).Info("Found previously unhandled tx. Processing.", "txhash", txn.TxHash)
-		k.ICQKeeper.MakeRequest(ctx, query.ConnectionId, query.ChainId, "tendermint.Tx", hashBytes, sdk.NewInt(-1), types.ModuleName, "deposittx", 0)
-	}
-	return nil
-}
-
-// pulled directly from ibc-go tm light client
-// checkTrustedHeader checks that consensus state matches trusted fields of Header
-func checkTrustedHeader(header *tmclienttypes.Header, consState *tmclienttypes.ConsensusState) error {
-	tmTrustedValidators, err := tmtypes.ValidatorSetFromProto(header.TrustedValidators)
-	if err != nil {
-		return sdkioerrors.Wrap(err, "trusted validator set in not tendermint validator set type")
-	}
-
-	// assert that trustedVals is NextValidators of last trusted header
-	// to do this, we check that trustedVals.Hash() == consState.NextValidatorsHash
-	tvalHash := tmTrustedValidators.Hash()
-	if !bytes.Equal(consState.NextValidatorsHash, tvalHash) {
-		return sdkioerrors.Wrapf(
-			tmclienttypes.ErrInvalidValidatorSet,
-			"trusted validators %s, does not hash to latest trusted validators. Expected: %X, got: %X",
-			header.TrustedValidators, consState.NextValidatorsHash, tvalHash,
-		)
-	}
-	return nil
-}
-
-// checkTMStateValidity checks if the Tendermint header is valid.
-// CONTRACT: consState.Height == header.TrustedHeight
-// pulled directly from ibc-go tm light client
-func checkTMStateValidity(
-	clientState *tmclienttypes.ClientState, consState *tmclienttypes.ConsensusState,
-	header *tmclienttypes.Header, currentTimestamp time.Time,
-) error {
-	if err := checkTrustedHeader(header, consState); err != nil {
-		return err
-	}
-
-	// UpdateClient only accepts updates with a header at the same revision
-	// as the trusted consensus state
-	if header.GetHeight().GetRevisionNumber() != header.TrustedHeight.RevisionNumber {
-		return sdkioerrors.Wrapf(
-			tmclienttypes.ErrInvalidHeaderHeight,
-			"header height revision %d does not match trusted header revision %d",
-			header.GetHeight().GetRevisionNumber(), header.TrustedHeight.RevisionNumber,
-		)
-	}
-
-	tmTrustedValidators, err := tmtypes.ValidatorSetFromProto(header.TrustedValidators)
-	if err != nil {
-		return sdkioerrors.Wrap(err, "trusted validator set in not tendermint validator set type")
-	}
-
-	tmSignedHeader, err := tmtypes.SignedHeaderFromProto(header.SignedHeader)
-	if err != nil {
-		return sdkioerrors.Wrap(err, "signed header in not tendermint signed header type")
-	}
-
-	tmValidatorSet, err := tmtypes.ValidatorSetFromProto(header.ValidatorSet)
-	if err != nil {
-		return sdkioerrors.Wrap(err, "validator set in not tendermint validator set type")
-	}
-
-	// assert header height is newer than consensus state
-	// if header.GetHeight().LTE(header.TrustedHeight) {
-	// 	return sdkioerrors.Wrapf(
-	// 		tmclienttypes.ErrInvalidHeader,
-	// 		"header height ≤ consensus state height (%s ≤ %s)", header.GetHeight(), header.TrustedHeight,
-	// 	)
-	// }
-
-	chainID := clientState.GetChainID()
-	// If chainID is in revision format, then set revision number of chainID with the revision number
-	// of the header we are verifying
-	// This is useful if the update is at a previous revision rather than an update to the latest revision
-	// of the client.
-	// The chainID must be set correctly for the previous revision before attempting verification.
-	// Updates for previous revisions are not supported if the chainID is not in revision format.
-	if clienttypes.IsRevisionFormat(chainID) {
-		chainID, _ = clienttypes.SetRevisionNumber(chainID, header.GetHeight().GetRevisionNumber())
-	}
-
-	// Construct a trusted header using the fields in consensus state
-	// Only Height, Time, and NextValidatorsHash are necessary for verification
-	trustedHeader := tmtypes.Header{
-		ChainID:            chainID,
-		Height:             int64(header.TrustedHeight.RevisionHeight),
-		Time:               consState.Timestamp,
-		NextValidatorsHash: consState.NextValidatorsHash,
-	}
-	signedHeader := tmtypes.SignedHeader{
-		Header: &trustedHeader,
-	}
-
-	// Verify next header with the passed-in trustedVals
-	// - asserts trusting period not passed
-	// - assert header timestamp is not past the trusting period
-	// - assert header timestamp is past latest stored consensus state timestamp
-	// - assert that a TrustLevel proportion of TrustedValidators signed new Commit
-	err = utils.Verify(
-		&signedHeader,
-		tmTrustedValidators, tmSignedHeader, tmValidatorSet,
-		clientState.TrustingPeriod, currentTimestamp, clientState.MaxClockDrift, clientState.TrustLevel.ToTendermint(),
-	)
-	if err != nil {
-		return sdkioerrors.Wrap(err, "failed to verify header")
-	}
-	return nil
-}
-
-// CheckTMHeaderForZone verifies the Tendermint consensus and client states for a given zone. Returns error if unable
-// to verify.
-func (k *Keeper) CheckTMHeaderForZone(ctx sdk.Context, zone *types.Zone, res icqtypes.GetTxWithProofResponse) error {
-	connection, _ := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, zone.ConnectionId)
-	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
-	if !found {
-		return errors.New("unable to fetch client state")
-	}
-	/*
-	   We can call ClientKeeper.CheckHeaderAndUpdateState() here, but this causes state changes inside the IBCKeeper
-	   which feels bad. so instead we copy the above two functions wholesale from ibc-go (this sucks too, but with
-	   predictable behaviour) and validate the inbound header manually.
-	*/
-	consensusState, found := k.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, res.Header.TrustedHeight)
-	if !found {
-		return fmt.Errorf("unable to fetch consensus state for trusted height: %s", res.Header.TrustedHeight.String())
+		k.ICQKeeper.MakeRequest(ctx, query.ConnectionId, query.ChainId, icqtypes.QueryTypeTx, hashBytes, sdk.NewInt(-1), types.ModuleName, "deposittx", 0)
 	}
-
-	tmclientState, ok := clientState.(*tmclienttypes.ClientState)
-	if !ok {
-		return errors.New("unable to marshal client state")
-	}
-
-	tmconsensusState, ok := consensusState.(*tmclienttypes.ConsensusState)
-	if !ok {
-		return errors.New("unable to marshal consensus state")
-	}
-
-	// validate tendermint statefor
-	err := checkTMStateValidity(tmclientState, tmconsensusState, res.GetHeader(), ctx.BlockHeader().Time)
-	if err != nil {
-		return fmt.Errorf("unable to validate header; %w", err)
-	}
-
-	tmproof, err := tmtypes.TxProofFromProto(*res.GetProof())
-	if err != nil {
-		return fmt.Errorf("unable to marshal proof: %w", err)
-	}
-	err = tmproof.Validate(res.Header.Header.DataHash)
-	if err != nil {
-		return fmt.Errorf("unable to validate proof: %w", err)
-	}
-
 	return nil
 }
 
-// DepositTxCallback is a callback that verifies client chain state validity, gets Tx receipt and calls
-// HandleReceiptForTransaction.
+// DepositTxCallback is a callback that gets Tx receipt and calls HandleReceiptForTransaction. The response is
+// verified against the client chain state by the interchainquery module before the callback is called.
 func DepositTxCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
 	// check validity
 	if len(args) == 0 {
@@ -424,15 +282,48 @@ func DepositTxCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Q
 		return nil
 	}
 
-	err = k.CheckTMHeaderForZone(ctx, &zone, res)
+	return k.HandleReceiptForTransaction(ctx, res.GetTxResponse(), res.GetTx(), &zone)
+}
+
+// AccountBalanceCallback is a callback handler for Balance queries.
+// ConversionBalanceCallback receives the base denom balance of a delegation account, confirming the receipt of
+// converted deposit assets.
+func ConversionBalanceCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+	if len(query.Request) < 2 {
+		k.Logger(ctx).Error("unable to unmarshal balance request", "zone", zone.ChainId, "error", "request length is too short")
+		return errors.New("conversion balance icq request must always have a length of at least 2 bytes")
+	}
+	accAddr, denom, err := banktypes.AddressAndDenomFromBalancesStore(query.Request[1:])
+	if err != nil {
+		return err
+	}
+
+	coin, err := bankkeeper.UnmarshalBalanceCompat(k.cdc, args, denom)
 	if err != nil {
-		return fmt.Errorf("unable  to verify proof: %w", err)
+		return err
 	}
 
-	return k.HandleReceiptForTransaction(ctx, res.GetTxResponse(), res.GetTx(), &zone)
+	if coin.Denom != zone.BaseDenom {
+		return fmt.Errorf("received coin denom %s does not match zone base denom %s", coin.Denom, zone.BaseDenom)
+	}
+
+	if err := coin.Validate(); err != nil {
+		k.Logger(ctx).Error("invalid coin for zone", "zone", zone.ChainId, "err", err)
+		return err
+	}
+
+	address, err := bech32.ConvertAndEncode(zone.AccountPrefix, accAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.HandleConversionBalance(ctx, &zone, address, coin)
 }
 
-// AccountBalanceCallback is a callback handler for Balance queries.
 func AccountBalanceCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
 	zone, found := k.GetZone(ctx, query.GetChainId())
 	if !found {
@@ -504,9 +395,9 @@ func AllBalancesCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes
 			zone.WithdrawalAddress.BalanceWaitgroup = 0
 			k.Logger(ctx).Error("Zeroing withdrawal balance waitgroup")
 		}
-	case zone.DelegationAddress != nil && balanceQuery.Address == zone.DelegationAddress.Address:
-		if zone.DelegationAddress.BalanceWaitgroup != 0 {
-			zone.DelegationAddress.BalanceWaitgroup = 0
+	case zone.IsDelegateAddress(balanceQuery.Address):
+		if account, _ := zone.GetDelegationAccountByAddress(balanceQuery.Address); account.BalanceWaitgroup != 0 {
+			account.BalanceWaitgroup = 0
 			k.Logger(ctx).Error("Zeroing delegation balance waitgroup")
 		}
 	case zone.PerformanceAddress != nil && balanceQuery.Address == zone.PerformanceAddress.Address:
@@ -519,3 +410,91 @@ func AllBalancesCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes
 
 	return k.SetAccountBalance(ctx, zone, balanceQuery.Address, args)
 }
+
+// -----------------------------------
+// Failure Callback Handlers
+// -----------------------------------
+
+// AccountBalanceFailureCallback releases the balance waitgroup held on an ICA
+// account for an abandoned account balance query.
+func AccountBalanceFailureCallback(k *Keeper, ctx sdk.Context, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	if len(query.Request) < 2 {
+		return errors.New("account balance icq request must always have a length of at least 2 bytes")
+	}
+	accAddr, _, err := banktypes.AddressAndDenomFromBalancesStore(query.Request[1:])
+	if err != nil {
+		return err
+	}
+
+	address, err := bech32.ConvertAndEncode(zone.AccountPrefix, accAddr)
+	if err != nil {
+		return err
+	}
+
+	var account *types.ICAAccount
+	switch {
+	case zone.DepositAddress != nil && address == zone.DepositAddress.Address:
+		account = zone.DepositAddress
+	case zone.WithdrawalAddress != nil && address == zone.WithdrawalAddress.Address:
+		account = zone.WithdrawalAddress
+	case zone.IsDelegateAddress(address):
+		account, _ = zone.GetDelegationAccountByAddress(address)
+	default:
+		return nil
+	}
+
+	if err := account.DecrementBalanceWaitgroup(); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("account balance query abandoned; decremented waitgroup", "address", address, "wg", account.BalanceWaitgroup, "zone", query.ChainId)
+
+	if account == zone.DepositAddress && account.BalanceWaitgroup == 0 {
+		k.depositInterval(ctx)(0, &zone)
+	}
+	k.SetZone(ctx, &zone)
+	return nil
+}
+
+// DepositTxFailureCallback re-requests an abandoned deposit tx query, unless the deposit has since been handled, such
+// that the deposit is not left without a receipt.
+func DepositTxFailureCallback(k *Keeper, ctx sdk.Context, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	if !zone.DepositsEnabled {
+		return fmt.Errorf("chain id %s does not current allow deposits", query.GetChainId())
+	}
+
+	req := tx.GetTxRequest{}
+	if err := k.cdc.Unmarshal(query.Request, &req); err != nil {
+		return err
+	}
+
+	if _, found := k.GetReceipt(ctx, types.GetReceiptKey(zone.ChainId, req.Hash)); found {
+		k.Logger(ctx).Debug("Found previously handled tx. Ignoring.", "txhash", req.Hash)
+		return nil
+	}
+
+	k.Logger(ctx).Info("deposit tx query abandoned; re-requesting", "txhash", req.Hash, "zone", zone.ChainId)
+	k.ICQKeeper.MakeRequest(ctx, query.ConnectionId, query.ChainId, query.QueryType, query.Request, query.Period, types.ModuleName, query.CallbackId, query.Ttl)
+	return nil
+}
+
+// RequeryFailureCallback re-requests an abandoned query, such that the delegation or validator record it updates is
+// not left stale.
+func RequeryFailureCallback(k *Keeper, ctx sdk.Context, query icqtypes.Query) error {
+	if _, found := k.GetZone(ctx, query.GetChainId()); !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	k.Logger(ctx).Info("query abandoned; re-requesting", "callback", query.CallbackId, "zone", query.ChainId)
+	k.ICQKeeper.MakeRequest(ctx, query.ConnectionId, query.ChainId, query.QueryType, query.Request, query.Period, types.ModuleName, query.CallbackId, query.Ttl)
+	return nil
+}
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"fmt"
 	"testing"
 	"time"
 
@@ -12,12 +14,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ingenuity-build/quicksilver/app"
 	"github.com/ingenuity-build/quicksilver/utils"
+	icqkeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
 	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
 	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -678,141 +681,143 @@ func (s *KeeperTestSuite) TestHandleValidatorCallbackJailedWithSlashing() {
 // 	}
 // }
 
-func (s *KeeperTestSuite) TestHandleRewardsCallbackBadChain() {
-	s.Run("bad chain", func() {
-		s.SetupTest()
-		s.setupTestZones()
+func (s *KeeperTestSuite) TestDistributeRewardsFromWithdrawAccount() {
+	s.SetupTest()
+	s.setupTestZones()
 
-		app := s.GetQuicksilverApp(s.chainA)
-		app.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
-		ctx := s.chainA.GetContext()
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
 
-		query := distrtypes.QueryDelegationTotalRewardsResponse{}
-		bz, err := app.AppCodec().Marshal(&query)
-		s.Require().NoError(err)
+	zone, _ := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	zone.WithdrawalAddress.Balance = sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)), sdk.NewCoin("ufee", sdk.NewInt(50)))
+	app.InterchainstakingKeeper.SetZone(ctx, &zone)
 
-		err = keeper.RewardsCallback(&app.InterchainstakingKeeper, ctx, bz, icqtypes.Query{ChainId: "badchain"})
-		// this should bail on a non-matching chain id.
-		s.Require().Error(err)
+	channelID := app.IBCKeeper.ChannelKeeper.GenerateChannelIdentifier(ctx)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, icstypes.TransferPort, channelID, channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		Ordering:       channeltypes.UNORDERED,
+		Counterparty:   channeltypes.Counterparty{PortId: icstypes.TransferPort, ChannelId: channelID},
+		ConnectionHops: []string{zone.ConnectionId},
 	})
-}
 
-func (s *KeeperTestSuite) TestHandleRewardsEmptyRequestCallback() {
-	s.Run("empty request", func() {
-		s.SetupTest()
-		s.setupTestZones()
+	withdrawalAddress, err := sdk.AccAddressFromBech32(zone.WithdrawalAddress.Address)
+	s.Require().NoError(err)
+	amount := sdk.NewInt(1000)
+	respbz, err := amount.Marshal()
+	s.Require().NoError(err)
 
-		app := s.GetQuicksilverApp(s.chainA)
-		app.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
-		ctx := s.chainA.GetContext()
+	// the rewards balance must be that of the withdrawal account in the base denom.
+	for _, data := range [][]byte{
+		append(banktypes.CreateAccountBalancesPrefix(withdrawalAddress), []byte("ufee")...),
+		append(banktypes.CreateAccountBalancesPrefix(utils.GenerateAccAddressForTest()), []byte(zone.BaseDenom)...),
+	} {
+		err = keeper.DistributeRewardsFromWithdrawAccount(&app.InterchainstakingKeeper, ctx, respbz, icqtypes.Query{ChainId: s.chainB.ChainID, Request: data})
+		s.Require().Error(err)
+	}
 
-		query := distrtypes.QueryDelegationTotalRewardsRequest{}
-		bz, err := app.AppCodec().Marshal(&query)
-		s.Require().NoError(err)
+	data := append(banktypes.CreateAccountBalancesPrefix(withdrawalAddress), []byte(zone.BaseDenom)...)
+	err = keeper.DistributeRewardsFromWithdrawAccount(&app.InterchainstakingKeeper, ctx, respbz, icqtypes.Query{ChainId: s.chainB.ChainID, Request: data})
+	s.Require().NoError(err)
 
-		err = keeper.RewardsCallback(&app.InterchainstakingKeeper, ctx, bz, icqtypes.Query{ChainId: s.chainB.ChainID})
-		// this should fail because the waitgroup becomes negative.
-		s.Require().Errorf(err, "attempted to unmarshal zero length byte slice (2)")
-	})
+	zone, _ = app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(zone.WithdrawalAddress.Balance.IsZero())
 }
 
-func (s *KeeperTestSuite) TestHandleRewardsCallbackNonDelegator() {
-	s.Run("valid response, bad delegator", func() {
-		s.SetupTest()
-		s.setupTestZones()
+func (s *KeeperTestSuite) TestCallbacksRequireProof() {
+	app := s.GetQuicksilverApp(s.chainA)
+	callbacks := app.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
 
-		app := s.GetQuicksilverApp(s.chainA)
-		app.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
-		ctx := s.chainA.GetContext()
+	for _, id := range []string{"validator", "delegation", "distributerewards", "deposittx", "perfbalance", "accountbalance", "conversionbalance"} {
+		s.Require().True(callbacks.RequiresProof(id), id)
+	}
+	for _, id := range []string{"valset", "delegations", "allbalances", "depositinterval"} {
+		s.Require().False(callbacks.RequiresProof(id), id)
+	}
 
-		zone, _ := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
-		zone.WithdrawalWaitgroup++
-		app.InterchainstakingKeeper.SetZone(ctx, &zone)
+	// outstanding delegation rewards cannot be proven, so are not queried.
+	s.Require().False(callbacks.Has("rewards"))
+}
 
-		user := utils.GenerateAccAddressForTest()
+func (s *KeeperTestSuite) TestAccountBalanceFailureCallback() {
+	s.SetupTest()
+	s.setupTestZones()
 
-		query := distrtypes.QueryDelegationTotalRewardsRequest{
-			DelegatorAddress: user.String(),
-		}
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
 
-		response := distrtypes.QueryDelegationTotalRewardsResponse{
-			Rewards: []distrtypes.DelegationDelegatorReward{
-				{ValidatorAddress: s.chainB.Vals.Validators[0].String(), Reward: sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt((1000))))},
-			},
-			Total: sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt((1000)))),
-		}
-		reqbz, err := app.AppCodec().Marshal(&query)
-		s.Require().NoError(err)
+	zone, _ := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	zone.DepositAddress.BalanceWaitgroup = 0
+	zone.DepositAddress.IncrementBalanceWaitgroup()
+	zone.WithdrawalAddress.BalanceWaitgroup = 0
+	zone.WithdrawalAddress.IncrementBalanceWaitgroup()
+	zone.WithdrawalAddress.IncrementBalanceWaitgroup()
+	app.InterchainstakingKeeper.SetZone(ctx, &zone)
 
-		respbz, err := app.AppCodec().Marshal(&response)
+	for _, addr := range []string{zone.DepositAddress.Address, zone.WithdrawalAddress.Address} {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
 		s.Require().NoError(err)
-		err = keeper.RewardsCallback(&app.InterchainstakingKeeper, ctx, respbz, icqtypes.Query{ChainId: s.chainB.ChainID, Request: reqbz})
-		//
-		s.Require().Errorf(err, "failed attempting to withdraw rewards from non-delegation account")
-	})
-}
+		data := append(banktypes.CreateAccountBalancesPrefix(accAddr), []byte("qck")...)
 
-func (s *KeeperTestSuite) TestHandleRewardsCallbackEmptyResponse() {
-	s.Run("empty response", func() {
-		s.SetupTest()
-		s.setupTestZones()
+		err = keeper.AccountBalanceFailureCallback(&app.InterchainstakingKeeper, ctx, icqtypes.Query{ChainId: s.chainB.ChainID, Request: data})
+		s.Require().NoError(err)
+	}
 
-		app := s.GetQuicksilverApp(s.chainA)
-		app.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
-		ctx := s.chainA.GetContext()
+	zone, _ = app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().Equal(uint32(0), zone.DepositAddress.BalanceWaitgroup)
+	s.Require().Equal(uint32(1), zone.WithdrawalAddress.BalanceWaitgroup)
 
-		zone, _ := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
-		zone.WithdrawalWaitgroup++
-		app.InterchainstakingKeeper.SetZone(ctx, &zone)
+	// a released waitgroup cannot be decremented further.
+	accAddr, err := sdk.AccAddressFromBech32(zone.DepositAddress.Address)
+	s.Require().NoError(err)
+	data := append(banktypes.CreateAccountBalancesPrefix(accAddr), []byte("qck")...)
+	err = keeper.AccountBalanceFailureCallback(&app.InterchainstakingKeeper, ctx, icqtypes.Query{ChainId: s.chainB.ChainID, Request: data})
+	s.Require().Error(err)
+}
 
-		query := distrtypes.QueryDelegationTotalRewardsRequest{
-			DelegatorAddress: zone.DelegationAddress.Address,
-		}
+func (s *KeeperTestSuite) TestAbandonedQueryFailureCallbacks() {
+	s.SetupTest()
+	s.setupTestZones()
 
-		response := distrtypes.QueryDelegationTotalRewardsResponse{}
-		reqbz, err := app.AppCodec().Marshal(&query)
-		s.Require().NoError(err)
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
 
-		respbz, err := app.AppCodec().Marshal(&response)
-		s.Require().NoError(err)
-		err = keeper.RewardsCallback(&app.InterchainstakingKeeper, ctx, respbz, icqtypes.Query{ChainId: s.chainB.ChainID, Request: reqbz})
-		//
-		s.Require().NoError(err)
-	})
-}
+	zone, _ := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	icqKeeper := app.InterchainQueryKeeper
 
-func (s *KeeperTestSuite) TestHandleValideRewardsCallback() {
-	s.Run("valid response, negative waitgroup", func() {
-		s.SetupTest()
-		s.setupTestZones()
+	// abandon requests and abandons the query, returning the query if it was re-requested.
+	abandon := func(queryType string, request []byte, callbackID string) (icqtypes.Query, bool) {
+		icqKeeper.MakeRequest(ctx, zone.ConnectionId, zone.ChainId, queryType, request, sdk.NewInt(-1), icstypes.ModuleName, callbackID, 0)
+		id := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, queryType, request, icstypes.ModuleName)
+		query, found := icqKeeper.GetQuery(ctx, id)
+		s.Require().True(found)
+		query.Retries = icqkeeper.MaxRetries
+		icqKeeper.AbandonQuery(ctx, query, icqtypes.AbandonReasonMaxRetries)
+		return icqKeeper.GetQuery(ctx, id)
+	}
 
-		app := s.GetQuicksilverApp(s.chainA)
-		app.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
-		ctx := s.chainA.GetContext()
+	// abandoned delegation and validator queries are re-requested.
+	_, delAddr, err := bech32.DecodeAndConvert(zone.DelegationAddress.Address)
+	s.Require().NoError(err)
+	_, valAddr, err := bech32.DecodeAndConvert(zone.Validators[0].ValoperAddress)
+	s.Require().NoError(err)
 
-		zone, _ := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
-		zone.WithdrawalWaitgroup++
-		app.InterchainstakingKeeper.SetZone(ctx, &zone)
+	query, found := abandon("store/staking/key", stakingtypes.GetDelegationKey(delAddr, valAddr), "delegation")
+	s.Require().True(found)
+	s.Require().Equal(uint64(0), query.Retries)
 
-		query := distrtypes.QueryDelegationTotalRewardsRequest{
-			DelegatorAddress: zone.DelegationAddress.Address,
-		}
+	query, found = abandon("store/staking/key", stakingtypes.GetValidatorKey(valAddr), "validator")
+	s.Require().True(found)
+	s.Require().Equal(uint64(0), query.Retries)
 
-		response := distrtypes.QueryDelegationTotalRewardsResponse{
-			Rewards: []distrtypes.DelegationDelegatorReward{
-				{ValidatorAddress: s.chainB.Vals.Validators[0].String(), Reward: sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt((1000))))},
-			},
-			Total: sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt((1000)))),
-		}
-		reqbz, err := app.AppCodec().Marshal(&query)
-		s.Require().NoError(err)
+	// abandoned deposit tx queries are re-requested, unless the deposit has since been handled.
+	unhandled := app.AppCodec().MustMarshal(&tx.GetTxRequest{Hash: fmt.Sprintf("%X", sha256.Sum256([]byte{0x01}))})
+	_, found = abandon(icqtypes.QueryTypeTx, unhandled, "deposittx")
+	s.Require().True(found)
 
-		respbz, err := app.AppCodec().Marshal(&response)
-		s.Require().NoError(err)
-		err = keeper.RewardsCallback(&app.InterchainstakingKeeper, ctx, respbz, icqtypes.Query{ChainId: s.chainB.ChainID, Request: reqbz})
-		//
-		s.Require().NoError(err)
-	})
+	hash := fmt.Sprintf("%X", sha256.Sum256([]byte{0x02}))
+	app.InterchainstakingKeeper.SetReceipt(ctx, *app.InterchainstakingKeeper.NewReceipt(ctx, &zone, utils.GenerateAccAddressForTest().String(), hash, sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)))))
+	_, found = abandon(icqtypes.QueryTypeTx, app.AppCodec().MustMarshal(&tx.GetTxRequest{Hash: hash}), "deposittx")
+	s.Require().False(found)
 }
 
 func (s *KeeperTestSuite) TestAllBalancesCallback() {
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	// setting ConversionStatusSent as 0 causes the value to be omitted when (un)marshalling.
+	ConversionStatusSent       int32 = iota + 1
+	ConversionStatusConverting int32 = iota + 1
+	ConversionStatusFailed     int32 = iota + 1
+	ConversionStatusConverted  int32 = iota + 1
+)
+
+// ConversionDeadline is the time after a deposit asset is first sent for conversion at which a failed conversion is
+// refunded, or a conversion the output of which has yet to be received by the delegator is abandoned.
+const ConversionDeadline = 14 * 24 * time.Hour
+
+// GetConversionRecord returns the conversion record for the given zone, status, deposit hash and denom.
+func (k *Keeper) GetConversionRecord(ctx sdk.Context, chainID string, status int32, txhash string, denom string) (types.ConversionRecord, bool) {
+	record := types.ConversionRecord{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := store.Get(types.GetConversionRecordKey(chainID, status, txhash, denom))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetConversionRecord stores the conversion record.
+func (k *Keeper) SetConversionRecord(ctx sdk.Context, record types.ConversionRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetConversionRecordKey(record.ChainId, record.Status, record.Txhash, record.Amount.Denom), bz)
+}
+
+// DeleteConversionRecord deletes the conversion record.
+func (k *Keeper) DeleteConversionRecord(ctx sdk.Context, chainID string, status int32, txhash string, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	store.Delete(types.GetConversionRecordKey(chainID, status, txhash, denom))
+}
+
+// UpdateConversionRecordStatus moves the conversion record to the given status.
+func (k *Keeper) UpdateConversionRecordStatus(ctx sdk.Context, record *types.ConversionRecord, status int32) {
+	k.DeleteConversionRecord(ctx, record.ChainId, record.Status, record.Txhash, record.Amount.Denom)
+	record.Status = status
+	k.SetConversionRecord(ctx, *record)
+}
+
+// IteratePrefixedConversionRecords iterates through all conversion records with the given prefix.
+func (k *Keeper) IteratePrefixedConversionRecords(ctx sdk.Context, prefixBytes []byte, fn func(index int64, record types.ConversionRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixBytes)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ConversionRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		stop := fn(i, record)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllConversionRecords returns every conversion record in the store.
+func (k *Keeper) AllConversionRecords(ctx sdk.Context) []types.ConversionRecord {
+	records := []types.ConversionRecord{}
+	k.IteratePrefixedConversionRecords(ctx, types.KeyPrefixConversionRecord, func(_ int64, record types.ConversionRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// ZoneConversionRecords returns every conversion record in the store for the specified zone and status.
+func (k *Keeper) ZoneConversionRecords(ctx sdk.Context, chainID string, status int32) []types.ConversionRecord {
+	records := []types.ConversionRecord{}
+	k.IteratePrefixedConversionRecords(ctx, types.GetConversionRecordsKey(chainID, status), func(_ int64, record types.ConversionRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
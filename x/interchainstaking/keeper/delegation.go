@@ -1,7 +1,7 @@
 package keeper
 
 import (
-	"errors"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -179,21 +179,21 @@ func (k *Keeper) IterateDelegatorDelegations(ctx sdk.Context, zone *types.Zone,
 	}
 }
 
-func (k *Keeper) PrepareDelegationMessagesForCoins(zone *types.Zone, allocations map[string]sdkmath.Int) []sdk.Msg {
+func (k *Keeper) PrepareDelegationMessagesForCoins(zone *types.Zone, allocations map[string]sdkmath.Int, delegator string) []sdk.Msg {
 	var msgs []sdk.Msg
 	for _, valoper := range utils.Keys(allocations) {
 		if !allocations[valoper].IsZero() {
-			msgs = append(msgs, &stakingTypes.MsgDelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: valoper, Amount: sdk.NewCoin(zone.BaseDenom, allocations[valoper])})
+			msgs = append(msgs, &stakingTypes.MsgDelegate{DelegatorAddress: delegator, ValidatorAddress: valoper, Amount: sdk.NewCoin(zone.BaseDenom, allocations[valoper])})
 		}
 	}
 	return msgs
 }
 
-func (k *Keeper) PrepareDelegationMessagesForShares(zone *types.Zone, coins sdk.Coins) []sdk.Msg {
+func (k *Keeper) PrepareDelegationMessagesForShares(_ *types.Zone, coins sdk.Coins, delegator string) []sdk.Msg {
 	var msgs []sdk.Msg
 	for _, coin := range coins.Sort() {
 		if !coin.IsZero() {
-			msgs = append(msgs, &lsmstakingTypes.MsgRedeemTokensforShares{DelegatorAddress: zone.DelegationAddress.Address, Amount: coin})
+			msgs = append(msgs, &lsmstakingTypes.MsgRedeemTokensforShares{DelegatorAddress: delegator, Amount: coin})
 		}
 	}
 	return msgs
@@ -206,41 +206,106 @@ func (k *Keeper) DeterminePlanForDelegation(ctx sdk.Context, zone *types.Zone, a
 	return allocations
 }
 
-func (k *Keeper) WithdrawDelegationRewardsForResponse(ctx sdk.Context, zone *types.Zone, delegator string, response []byte) error {
-	var msgs []sdk.Msg
-
-	delegatorRewards := distrTypes.QueryDelegationTotalRewardsResponse{}
-	err := k.cdc.Unmarshal(response, &delegatorRewards)
+// WithdrawDelegationRewards withdraws the rewards of each delegation recorded for the delegation account, adding a
+// tally of the withdrawal messages sent to the zone WithdrawalWaitgroup. HandleWithdrawRewards contains the opposing
+// decrement.
+func (k *Keeper) WithdrawDelegationRewards(ctx sdk.Context, zone *types.Zone, account *types.ICAAccount) error {
+	_, delAddr, err := bech32.DecodeAndConvert(account.Address)
 	if err != nil {
 		return err
 	}
 
-	if zone.DelegationAddress.Address != delegator {
-		return errors.New("failed attempting to withdraw rewards from non-delegation account")
-	}
-
-	for _, del := range delegatorRewards.Rewards {
-		if !del.Reward.IsZero() && !del.Reward.Empty() {
-			k.Logger(ctx).Info("Withdraw rewards", "delegator", delegator, "validator", del.ValidatorAddress, "amount", del.Reward)
-
-			msgs = append(msgs, &distrTypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: del.ValidatorAddress})
+	var msgs []sdk.Msg
+	k.IterateDelegatorDelegations(ctx, zone, delAddr, func(delegation types.Delegation) bool {
+		if delegation.Amount.IsPositive() {
+			k.Logger(ctx).Info("Withdraw rewards", "delegator", account.Address, "validator", delegation.ValidatorAddress)
+			msgs = append(msgs, &distrTypes.MsgWithdrawDelegatorReward{DelegatorAddress: account.Address, ValidatorAddress: delegation.ValidatorAddress})
 		}
-	}
+		return false
+	})
 
 	if len(msgs) == 0 {
-		// always setZone here because calling method update waitgroup.
-		k.SetZone(ctx, zone)
 		return nil
 	}
+
+	if err := k.SubmitTx(ctx, msgs, account, ""); err != nil {
+		return err
+	}
+
 	// increment withdrawal waitgroup for every withdrawal msg sent
 	// this allows us to track individual msg responses and ensure all
 	// responses have been received and handled...
-	// HandleWithdrawRewards contains the opposing decrement.
 	zone.WithdrawalWaitgroup += uint32(len(msgs))
-	k.SetZone(ctx, zone)
-	k.Logger(ctx).Info("Received WithdrawDelegationRewardsForResponse acknowledgement", "wg", zone.WithdrawalWaitgroup, "address", delegator)
+	k.Logger(ctx).Info("Incrementing waitgroup for delegation rewards withdrawal", "wg", zone.WithdrawalWaitgroup, "address", account.Address)
+	return nil
+}
+
+// GetLeastDelegatedAccount returns the zone delegation account with the smallest delegated amount, to which funds are
+// sent for delegation such that delegations are spread across the zone's delegation accounts. Ties are resolved in
+// favour of the earliest account.
+func (k *Keeper) GetLeastDelegatedAccount(ctx sdk.Context, zone *types.Zone) *types.ICAAccount {
+	delegated := make(map[string]sdkmath.Int)
+	k.IterateAllDelegations(ctx, zone, func(delegation types.Delegation) bool {
+		existing, found := delegated[delegation.DelegationAddress]
+		if !found {
+			existing = sdk.ZeroInt()
+		}
+		delegated[delegation.DelegationAddress] = existing.Add(delegation.Amount.Amount)
+		return false
+	})
+
+	var least *types.ICAAccount
+	leastAmount := sdk.ZeroInt()
+	for _, account := range zone.GetDelegationAccounts() {
+		amount, found := delegated[account.Address]
+		if !found {
+			amount = sdk.ZeroInt()
+		}
+		if least == nil || amount.LT(leastAmount) {
+			least = account
+			leastAmount = amount
+		}
+	}
+	return least
+}
+
+// DetermineDelegatorsForValidator splits amount, to be removed from the zone's delegations to valoper, across the zone's
+// delegation accounts. The largest delegations are drawn from first, such that as few unbonding entries as possible
+// are created on the host chain. Any amount exceeding the recorded delegations is attributed to the largest delegation,
+// or to the zone delegation address where no delegations to valoper exist.
+func (k *Keeper) DetermineDelegatorsForValidator(ctx sdk.Context, zone *types.Zone, valoper string, amount sdkmath.Int) []types.Delegation {
+	delegations := make([]types.Delegation, 0)
+	k.IterateAllDelegations(ctx, zone, func(delegation types.Delegation) bool {
+		if delegation.ValidatorAddress == valoper && delegation.Amount.IsPositive() && zone.IsDelegateAddress(delegation.DelegationAddress) {
+			delegations = append(delegations, delegation)
+		}
+		return false
+	})
+	sort.SliceStable(delegations, func(i, j int) bool {
+		if !delegations[i].Amount.Amount.Equal(delegations[j].Amount.Amount) {
+			return delegations[i].Amount.Amount.GT(delegations[j].Amount.Amount)
+		}
+		return delegations[i].DelegationAddress < delegations[j].DelegationAddress
+	})
+
+	if len(delegations) == 0 {
+		return []types.Delegation{types.NewDelegation(zone.DelegationAddress.Address, valoper, sdk.NewCoin(zone.BaseDenom, amount))}
+	}
 
-	return k.SubmitTx(ctx, msgs, zone.DelegationAddress, "")
+	out := make([]types.Delegation, 0)
+	remaining := amount
+	for _, delegation := range delegations {
+		if !remaining.IsPositive() {
+			break
+		}
+		thisAmount := sdk.MinInt(remaining, delegation.Amount.Amount)
+		out = append(out, types.NewDelegation(delegation.DelegationAddress, valoper, sdk.NewCoin(zone.BaseDenom, thisAmount)))
+		remaining = remaining.Sub(thisAmount)
+	}
+	if remaining.IsPositive() {
+		out[0].Amount = out[0].Amount.AddAmount(remaining)
+	}
+	return out
 }
 
 func (k *Keeper) GetDelegationMap(ctx sdk.Context, zone *types.Zone) (map[string]sdkmath.Int, sdkmath.Int, map[string]bool) {
@@ -583,7 +583,7 @@ func TestDetermineAllocationsForRebalance(t *testing.T) {
 		for _, amount := range val.current {
 			sum = sum.Add(amount)
 		}
-		allocations := types.DetermineAllocationsForRebalancing(val.current, val.locked, sum, val.target, val.redelegations, nil)
+		allocations := types.DetermineAllocationsForRebalancing(val.current, val.locked, sum, val.target, val.redelegations, types.DefaultRebalanceLimits(), nil)
 		require.Equal(t, len(val.expected), len(allocations), fmt.Sprintf("expected %d RebalanceTargets in '%s', got %d", len(val.expected), val.name, len(allocations)))
 		for idx, rebalance := range val.expected {
 			require.Equal(t, rebalance, allocations[idx], fmt.Sprintf("%s, idx %d: Expected %v, got %v", val.name, idx, rebalance, allocations[idx]))
@@ -625,3 +625,79 @@ func (s *KeeperTestSuite) TestStoreGetDeleteDelegation() {
 		s.Require().Len(allDelegations2, 0)
 	})
 }
+
+func (s *KeeperTestSuite) TestDelegationShards() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().NoError(s.setupChannelForICA(ctx, s.chainB.ChainID, s.path.EndpointA.ConnectionID, "delegate.1", zone.AccountPrefix))
+
+	zone, found = app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Len(zone.DelegationShards, 1)
+	s.Require().Equal("icacontroller-"+s.chainB.ChainID+".delegate.1", zone.DelegationShards[0].PortName)
+
+	primary := zone.DelegationAddress
+	shard := zone.DelegationShards[0]
+	s.Require().Equal(zone.ChainId, app.InterchainstakingKeeper.GetZoneForDelegateAccount(ctx, shard.Address).ChainId)
+
+	// funds are sent to the least delegated account, favouring the zone delegation address.
+	s.Require().Equal(primary, app.InterchainstakingKeeper.GetLeastDelegatedAccount(ctx, &zone))
+
+	valoper := zone.Validators[0].ValoperAddress
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, types.NewDelegation(primary.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))))
+	s.Require().Equal(shard, app.InterchainstakingKeeper.GetLeastDelegatedAccount(ctx, &zone))
+
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, types.NewDelegation(shard.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(400))))
+	s.Require().Equal(shard, app.InterchainstakingKeeper.GetLeastDelegatedAccount(ctx, &zone))
+
+	// undelegations draw from the largest delegation first.
+	s.Require().Equal(
+		[]types.Delegation{types.NewDelegation(primary.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(300)))},
+		app.InterchainstakingKeeper.DetermineDelegatorsForValidator(ctx, &zone, valoper, sdk.NewInt(300)),
+	)
+	s.Require().Equal(
+		[]types.Delegation{
+			types.NewDelegation(primary.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))),
+			types.NewDelegation(shard.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(200))),
+		},
+		app.InterchainstakingKeeper.DetermineDelegatorsForValidator(ctx, &zone, valoper, sdk.NewInt(1200)),
+	)
+
+	// validators without delegations are attributed to the zone delegation address.
+	other := zone.Validators[1].ValoperAddress
+	s.Require().Equal(
+		[]types.Delegation{types.NewDelegation(primary.Address, other, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100)))},
+		app.InterchainstakingKeeper.DetermineDelegatorsForValidator(ctx, &zone, other, sdk.NewInt(100)),
+	)
+}
+
+func (s *KeeperTestSuite) TestWithdrawDelegationRewards() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	zone.WithdrawalWaitgroup = 0
+
+	// no recorded delegations, so nothing to withdraw.
+	s.Require().NoError(app.InterchainstakingKeeper.WithdrawDelegationRewards(ctx, &zone, zone.DelegationAddress))
+	s.Require().Equal(uint32(0), zone.WithdrawalWaitgroup)
+
+	// rewards are withdrawn from each positive delegation record.
+	delegator := zone.DelegationAddress.Address
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, types.NewDelegation(delegator, zone.Validators[0].ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))))
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, types.NewDelegation(delegator, zone.Validators[1].ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(500))))
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, types.NewDelegation(delegator, zone.Validators[2].ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt())))
+
+	s.Require().NoError(app.InterchainstakingKeeper.WithdrawDelegationRewards(ctx, &zone, zone.DelegationAddress))
+	s.Require().Equal(uint32(2), zone.WithdrawalWaitgroup)
+}
@@ -0,0 +1,389 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetDepositAssetRate returns the value of one unit of the deposit asset in the zone base denom.
+func (k *Keeper) GetDepositAssetRate(ctx sdk.Context, asset *types.DepositAsset) (sdk.Dec, error) {
+	switch asset.RateSource {
+	case types.DepositRateSourceUnity:
+		return sdk.OneDec(), nil
+	case types.DepositRateSourceFixed:
+		if asset.Rate.IsNil() || !asset.Rate.IsPositive() {
+			return sdk.ZeroDec(), fmt.Errorf("invalid fixed rate for deposit asset %s", asset.Denom)
+		}
+		return asset.Rate, nil
+	case types.DepositRateSourceZone:
+		rateZone, found := k.GetZone(ctx, asset.RateChainId)
+		if !found {
+			return sdk.ZeroDec(), fmt.Errorf("unable to find rate zone %s for deposit asset %s", asset.RateChainId, asset.Denom)
+		}
+		// use the lower of the current and previous redemption rates, as for redemptions.
+		return sdk.MinDec(rateZone.LastRedemptionRate, rateZone.RedemptionRate), nil
+	default:
+		return sdk.ZeroDec(), fmt.Errorf("unknown rate source %d for deposit asset %s", asset.RateSource, asset.Denom)
+	}
+}
+
+// ValueDeposit returns the deposited coins with each deposit asset replaced by its value in the zone base denom.
+func (k *Keeper) ValueDeposit(ctx sdk.Context, zone *types.Zone, assets sdk.Coins) (sdk.Coins, error) {
+	valued := sdk.Coins{}
+	for _, coin := range assets {
+		asset, found := zone.GetDepositAsset(coin.Denom)
+		if !found {
+			valued = valued.Add(coin)
+			continue
+		}
+		rate, err := k.GetDepositAssetRate(ctx, asset)
+		if err != nil {
+			return nil, err
+		}
+		value := sdk.NewDecFromInt(coin.Amount).Mul(rate).TruncateInt()
+		if !value.IsPositive() {
+			return nil, fmt.Errorf("deposit of %s has no value", coin)
+		}
+		valued = valued.Add(sdk.NewCoin(zone.BaseDenom, value))
+	}
+	return valued, nil
+}
+
+// ConvertDepositAssets sends the deposited assets for conversion to the zone base denom, which is returned to the
+// least delegated delegation account, and adds a conversion record with status SENT for each. qAssets are minted to
+// the depositor once the converted asset has been received.
+func (k *Keeper) ConvertDepositAssets(ctx sdk.Context, zone *types.Zone, coins sdk.Coins, hash string, depositor string) error {
+	delegator := k.GetLeastDelegatedAccount(ctx, zone).GetAddress()
+
+	msgs := make([]sdk.Msg, 0, len(coins))
+	for _, coin := range coins {
+		asset, found := zone.GetDepositAsset(coin.Denom)
+		if !found {
+			return fmt.Errorf("%s is not a deposit asset of zone %s", coin.Denom, zone.ChainId)
+		}
+
+		record := types.ConversionRecord{
+			ChainId:   zone.ChainId,
+			Txhash:    hash,
+			Delegator: delegator,
+			Amount:    coin,
+			Status:    ConversionStatusSent,
+			SentTime:  ctx.BlockTime(),
+			Depositor: depositor,
+		}
+		msg, err := k.prepareConversion(ctx, zone, asset, &record)
+		if err != nil {
+			return err
+		}
+		k.SetConversionRecord(ctx, record)
+		msgs = append(msgs, msg)
+	}
+
+	return k.SubmitTx(ctx, msgs, zone.DepositAddress, hash)
+}
+
+// prepareConversion sets the expected output of the conversion record and returns the transfer that sends the asset
+// for conversion.
+func (k *Keeper) prepareConversion(ctx sdk.Context, zone *types.Zone, asset *types.DepositAsset, record *types.ConversionRecord) (sdk.Msg, error) {
+	rate, err := k.GetDepositAssetRate(ctx, asset)
+	if err != nil {
+		return nil, err
+	}
+	value := sdk.NewDecFromInt(record.Amount.Amount).Mul(rate).TruncateInt()
+	record.Expected = sdk.NewCoin(zone.BaseDenom, asset.GetMinOutput(value))
+
+	memo, err := asset.GetConversionMemo(record.Delegator, record.Expected.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := ctx.BlockTime().Add(zone.GetICAPacketLimits().Timeout)
+	return &ibctransfertypes.MsgTransfer{
+		SourcePort:       types.TransferPort,
+		SourceChannel:    asset.ChannelId,
+		Token:            record.Amount,
+		Sender:           zone.DepositAddress.GetAddress(),
+		Receiver:         asset.GetConversionReceiver(),
+		TimeoutHeight:    clienttypes.Height{},
+		TimeoutTimestamp: uint64(timeout.UnixNano()),
+		Memo:             memo,
+	}, nil
+}
+
+// HandleConversionTransfer moves the conversion record for the acknowledged or timed out transfer to CONVERTING, once
+// the transfer has been sent from the deposit account, or to FAILED, such that it is resent next epoch. The
+// acknowledgement only confirms the asset left the deposit account; the record is CONVERTED once the delegator
+// balance shows the converted asset has been received.
+func (k *Keeper) HandleConversionTransfer(ctx sdk.Context, msg *ibctransfertypes.MsgTransfer, memo string, success bool) error {
+	zone := k.GetZoneForDepositAccount(ctx, msg.Sender)
+	if zone == nil {
+		return fmt.Errorf("unable to find zone for deposit address %s", msg.Sender)
+	}
+
+	record, found := k.GetConversionRecord(ctx, zone.ChainId, ConversionStatusSent, memo, msg.Token.Denom)
+	if !found {
+		return fmt.Errorf("unable to find conversion record for hash %s and denom %s", memo, msg.Token.Denom)
+	}
+
+	if !success {
+		k.Logger(ctx).Error("deposit asset conversion failed; will retry", "chain_id", zone.ChainId, "hash", memo, "amount", record.Amount)
+		k.UpdateConversionRecordStatus(ctx, &record, ConversionStatusFailed)
+		return nil
+	}
+
+	k.UpdateConversionRecordStatus(ctx, &record, ConversionStatusConverting)
+	return nil
+}
+
+// HandleConversions is called once per epoch. It resends failed conversions, or refunds them to the depositor once
+// past the ConversionDeadline; queries the balance of each delegator with converting records, to confirm the receipt
+// of the converted assets, abandoning those not received by the deadline; and delegates the expected output of
+// converted records not already being delegated.
+func (k *Keeper) HandleConversions(ctx sdk.Context, zone *types.Zone) error {
+	for _, record := range k.ZoneConversionRecords(ctx, zone.ChainId, ConversionStatusFailed) {
+		record := record
+		if isConversionExpired(ctx, record) {
+			if err := k.RefundConversion(ctx, zone, record); err != nil {
+				return err
+			}
+			continue
+		}
+		asset, found := zone.GetDepositAsset(record.Amount.Denom)
+		if !found {
+			k.Logger(ctx).Error("deposit asset removed; unable to resend conversion", "chain_id", zone.ChainId, "hash", record.Txhash, "amount", record.Amount)
+			continue
+		}
+		k.DeleteConversionRecord(ctx, record.ChainId, record.Status, record.Txhash, record.Amount.Denom)
+		record.Status = ConversionStatusSent
+		msg, err := k.prepareConversion(ctx, zone, asset, &record)
+		if err != nil {
+			return err
+		}
+		k.SetConversionRecord(ctx, record)
+		if err := k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DepositAddress, record.Txhash); err != nil {
+			return err
+		}
+	}
+
+	delegators := make(map[string]bool)
+	for _, record := range k.ZoneConversionRecords(ctx, zone.ChainId, ConversionStatusConverting) {
+		if isConversionExpired(ctx, record) {
+			k.AbandonConversion(ctx, zone, record)
+			continue
+		}
+		delegators[record.Delegator] = true
+	}
+
+	for _, delegator := range utils.Keys(delegators) {
+		if err := k.RequestConversionBalance(ctx, zone, delegator); err != nil {
+			return err
+		}
+	}
+
+	return k.DelegateConversions(ctx, zone)
+}
+
+// RequestConversionBalance queries the zone base denom balance of the delegator, to confirm the receipt of converted
+// assets.
+func (k *Keeper) RequestConversionBalance(ctx sdk.Context, zone *types.Zone, delegator string) error {
+	_, addr, err := bech32.DecodeAndConvert(delegator)
+	if err != nil {
+		return err
+	}
+	data := banktypes.CreateAccountBalancesPrefix(addr)
+
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		types.BankStoreKey,
+		append(data, []byte(zone.BaseDenom)...),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"conversionbalance",
+		0,
+	)
+
+	return nil
+}
+
+// HandleConversionBalance confirms the receipt of the converting records of the delegator, in the order in which they
+// were sent, for as long as its balance covers their expected output in addition to that of the converted records
+// awaiting delegation. qAssets are minted to the depositor of each confirmed record, which is moved to CONVERTED and
+// delegated.
+func (k *Keeper) HandleConversionBalance(ctx sdk.Context, zone *types.Zone, delegator string, balance sdk.Coin) error {
+	available := balance.Amount
+	for _, record := range k.ZoneConversionRecords(ctx, zone.ChainId, ConversionStatusConverted) {
+		if record.Delegator == delegator {
+			available = available.Sub(record.Expected.Amount)
+		}
+	}
+
+	converting := []types.ConversionRecord{}
+	for _, record := range k.ZoneConversionRecords(ctx, zone.ChainId, ConversionStatusConverting) {
+		if record.Delegator == delegator {
+			converting = append(converting, record)
+		}
+	}
+	sort.SliceStable(converting, func(i, j int) bool {
+		return converting[i].SentTime.Before(converting[j].SentTime)
+	})
+
+	for _, record := range converting {
+		record := record
+		if record.Expected.Amount.GT(available) {
+			break
+		}
+		available = available.Sub(record.Expected.Amount)
+
+		_, addressBytes, err := bech32.DecodeAndConvert(record.Depositor)
+		if err != nil {
+			return fmt.Errorf("unable to decode depositor %q of conversion %s: %w", record.Depositor, record.Txhash, err)
+		}
+		if err := k.MintQAsset(ctx, addressBytes, record.Depositor, zone, sdk.NewCoins(record.Expected), types.DepositMemo{}); err != nil {
+			return err
+		}
+		k.UpdateConversionRecordStatus(ctx, &record, ConversionStatusConverted)
+	}
+
+	return k.DelegateConversions(ctx, zone)
+}
+
+// DelegateConversions delegates the expected output of converted records not already being delegated. A delegation
+// that fails is retried the next epoch.
+func (k *Keeper) DelegateConversions(ctx sdk.Context, zone *types.Zone) error {
+	// the assets of a single deposit are all returned to the same delegation account, so are delegated together.
+	hashes := []string{}
+	expected := make(map[string]sdk.Coins)
+	delegators := make(map[string]string)
+	for _, record := range k.ZoneConversionRecords(ctx, zone.ChainId, ConversionStatusConverted) {
+		if _, found := expected[record.Txhash]; !found {
+			hashes = append(hashes, record.Txhash)
+			delegators[record.Txhash] = record.Delegator
+		}
+		expected[record.Txhash] = expected[record.Txhash].Add(record.Expected)
+	}
+
+	for _, hash := range hashes {
+		memo := fmt.Sprintf("%s/%s", types.MsgTypeConversion, hash)
+		if k.hasInFlightICAPackets(ctx, zone.ChainId, memo) {
+			continue
+		}
+		account, found := zone.GetDelegationAccountByAddress(delegators[hash])
+		if !found {
+			return fmt.Errorf("unable to find delegation account %s", delegators[hash])
+		}
+		allocations := k.DeterminePlanForDelegation(ctx, zone, expected[hash])
+		msgs := k.PrepareDelegationMessagesForCoins(zone, allocations, account.Address)
+		if err := k.SubmitTx(ctx, msgs, account, memo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RefundConversion returns the deposit asset of a failed conversion from the deposit account to the depositor, and
+// removes the record. No qAssets have been minted against it.
+func (k *Keeper) RefundConversion(ctx sdk.Context, zone *types.Zone, record types.ConversionRecord) error {
+	k.Logger(ctx).Error("deposit asset conversion not completed by deadline; refunding", "chain_id", zone.ChainId, "hash", record.Txhash, "amount", record.Amount, "depositor", record.Depositor)
+
+	msg := &banktypes.MsgSend{FromAddress: zone.DepositAddress.Address, ToAddress: record.Depositor, Amount: sdk.NewCoins(record.Amount)}
+	if err := k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DepositAddress, fmt.Sprintf("%s/%s", types.MsgTypeConversion, record.Txhash)); err != nil {
+		return err
+	}
+	k.DeleteConversionRecord(ctx, record.ChainId, record.Status, record.Txhash, record.Amount.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundConversion,
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, record.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, record.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyHash, record.Txhash),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+		),
+	)
+
+	k.completeConversionReceipt(ctx, zone, record.Txhash)
+	return nil
+}
+
+// AbandonConversion removes a converting record the output of which has not been received by the delegator by the
+// deadline. No qAssets have been minted against it; the converted asset, if later received, is not delegated on behalf
+// of the depositor and must be recovered manually.
+func (k *Keeper) AbandonConversion(ctx sdk.Context, zone *types.Zone, record types.ConversionRecord) {
+	k.Logger(ctx).Error("converted asset not received by deadline; abandoning conversion", "chain_id", zone.ChainId, "hash", record.Txhash, "amount", record.Amount, "expected", record.Expected, "delegator", record.Delegator)
+	k.DeleteConversionRecord(ctx, record.ChainId, record.Status, record.Txhash, record.Amount.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAbandonConversion,
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, record.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, record.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyHash, record.Txhash),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+		),
+	)
+
+	k.completeConversionReceipt(ctx, zone, record.Txhash)
+}
+
+// HandleConversionDelegated removes the converted records of the deposit returned to the delegator, once delegated,
+// and completes the receipt if no conversions of the deposit remain.
+func (k *Keeper) HandleConversionDelegated(ctx sdk.Context, zone *types.Zone, memo string, delegator string) error {
+	if !strings.HasPrefix(memo, types.MsgTypeConversion+"/") {
+		return errors.New("unexpected conversion memo format")
+	}
+	hash := strings.TrimPrefix(memo, types.MsgTypeConversion+"/")
+
+	delegated := []types.ConversionRecord{}
+	k.IteratePrefixedConversionRecords(ctx, append(types.GetConversionRecordsKey(zone.ChainId, ConversionStatusConverted), []byte(hash+"/")...), func(_ int64, record types.ConversionRecord) (stop bool) {
+		if record.Delegator == delegator {
+			delegated = append(delegated, record)
+		}
+		return false
+	})
+
+	for _, record := range delegated {
+		k.DeleteConversionRecord(ctx, record.ChainId, record.Status, record.Txhash, record.Amount.Denom)
+	}
+
+	k.completeConversionReceipt(ctx, zone, hash)
+	return nil
+}
+
+// completeConversionReceipt completes the receipt of the deposit if no conversions of the deposit remain.
+func (k *Keeper) completeConversionReceipt(ctx sdk.Context, zone *types.Zone, hash string) {
+	for _, status := range []int32{ConversionStatusSent, ConversionStatusConverting, ConversionStatusFailed, ConversionStatusConverted} {
+		remaining := false
+		k.IteratePrefixedConversionRecords(ctx, append(types.GetConversionRecordsKey(zone.ChainId, status), []byte(hash+"/")...), func(_ int64, _ types.ConversionRecord) (stop bool) {
+			remaining = true
+			return true
+		})
+		if remaining {
+			return
+		}
+	}
+
+	if receipt, found := k.GetReceipt(ctx, types.GetReceiptKey(zone.ChainId, hash)); found && receipt.Completed == nil {
+		t := ctx.BlockTime()
+		receipt.Completed = &t
+		k.SetReceipt(ctx, receipt)
+	}
+}
+
+// isConversionExpired returns true if the conversion record was first sent at least ConversionDeadline ago.
+func isConversionExpired(ctx sdk.Context, record types.ConversionRecord) bool {
+	return !ctx.BlockTime().Before(record.SentTime.Add(ConversionDeadline))
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestDepositAssetConversion() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	quicksilver := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	icsKeeper := quicksilver.InterchainstakingKeeper
+
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	zone.SetDepositAsset(icstypes.DepositAsset{
+		Denom:           "uosmo",
+		Conversion:      icstypes.DepositConversionSwap,
+		ChannelId:       "channel-2",
+		SwapContract:    "osmo1contract",
+		SwapOutputDenom: "ibc/atom",
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+		RateSource:      icstypes.DepositRateSourceFixed,
+		Rate:            sdk.NewDecWithPrec(5, 1),
+	})
+	icsKeeper.SetZone(ctx, &zone)
+
+	for _, record := range icsKeeper.AllICAPacketRecords(ctx) {
+		icsKeeper.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+	}
+
+	// the base denom is sent from the depositor's host account, while the deposit asset is received over IBC, so the
+	// sender of its transfer event is the transfer module and the depositor is the sender of the packet.
+	depositor := utils.GenerateAccAddressForTest()
+	senderAddress, err := bech32.ConvertAndEncode(zone.AccountPrefix, depositor)
+	s.Require().NoError(err)
+	packetSender, err := bech32.ConvertAndEncode("osmo", depositor)
+	s.Require().NoError(err)
+	native := sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100))
+	converted := sdk.NewCoin("uosmo", sdk.NewInt(1000))
+	transferEvent := func(sender string, coin sdk.Coin) abcitypes.Event {
+		return abcitypes.Event{
+			Type: icstypes.TransferPort,
+			Attributes: []abcitypes.EventAttribute{
+				{Key: []byte("sender"), Value: []byte(sender)},
+				{Key: []byte("recipient"), Value: []byte(zone.DepositAddress.Address)},
+				{Key: []byte("amount"), Value: []byte(coin.String())},
+			},
+		}
+	}
+	packetEvent := abcitypes.Event{
+		Type: ibctransfertypes.EventTypePacket,
+		Attributes: []abcitypes.EventAttribute{
+			{Key: []byte(sdk.AttributeKeySender), Value: []byte(packetSender)},
+			{Key: []byte(ibctransfertypes.AttributeKeyReceiver), Value: []byte(zone.DepositAddress.Address)},
+			{Key: []byte(ibctransfertypes.AttributeKeyAmount), Value: []byte(converted.Amount.String())},
+			{Key: []byte(ibctransfertypes.AttributeKeyAckSuccess), Value: []byte("true")},
+		},
+	}
+	transferModule := utils.GenerateAccAddressForTestWithPrefix(zone.AccountPrefix)
+	hash := fmt.Sprintf("%X", sha256.Sum256([]byte{0x02}))
+
+	// the packet sender is only attributed to the transfer it credited.
+	txr := &sdk.TxResponse{
+		TxHash: hash,
+		Events: []abcitypes.Event{
+			transferEvent(utils.GenerateAccAddressForTestWithPrefix(zone.AccountPrefix), native),
+			transferEvent(transferModule, converted),
+			packetEvent,
+		},
+	}
+	s.Require().Error(icsKeeper.HandleReceiptForTransaction(ctx, txr, &tx.Tx{Body: &tx.TxBody{}}, &zone))
+
+	txr.Events[0] = transferEvent(senderAddress, native)
+	s.Require().NoError(icsKeeper.HandleReceiptForTransaction(ctx, txr, &tx.Tx{Body: &tx.TxBody{}}, &zone))
+
+	// qAssets are only minted against the native asset until the converted asset has been received.
+	s.Require().Equal(sdk.NewInt(100), quicksilver.BankKeeper.GetBalance(ctx, depositor, zone.LocalDenom).Amount)
+
+	records := icsKeeper.ZoneConversionRecords(ctx, zone.ChainId, keeper.ConversionStatusSent)
+	s.Require().Equal(1, len(records))
+	s.Require().Equal(converted, records[0].Amount)
+	s.Require().Equal(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(495)), records[0].Expected)
+	s.Require().Equal(zone.DelegationAddress.Address, records[0].Delegator)
+	s.Require().Equal(senderAddress, records[0].Depositor)
+
+	packets := icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)
+	s.Require().Equal(2, len(packets))
+	msgTypes := []string{}
+	for _, packet := range packets {
+		s.Require().Equal(hash, packet.Memo)
+		msgTypes = append(msgTypes, packet.MsgTypes...)
+	}
+	s.Require().ElementsMatch([]string{"/cosmos.bank.v1beta1.MsgSend", "/ibc.applications.transfer.v1.MsgTransfer"}, msgTypes)
+
+	// a failed transfer is resent next epoch, retaining the time it was first sent.
+	transfer := &ibctransfertypes.MsgTransfer{Sender: zone.DepositAddress.Address, Token: records[0].Amount}
+	s.Require().NoError(icsKeeper.HandleConversionTransfer(ctx, transfer, hash, false))
+	s.Require().Equal(1, len(icsKeeper.ZoneConversionRecords(ctx, zone.ChainId, keeper.ConversionStatusFailed)))
+
+	s.Require().NoError(icsKeeper.HandleConversions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Second)), &zone))
+	s.Require().Equal(0, len(icsKeeper.ZoneConversionRecords(ctx, zone.ChainId, keeper.ConversionStatusFailed)))
+	s.Require().Equal(3, len(icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)))
+	record, found := icsKeeper.GetConversionRecord(ctx, zone.ChainId, keeper.ConversionStatusSent, hash, converted.Denom)
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockTime(), record.SentTime)
+
+	// the acknowledgement only moves the record to converting; the delegator balance is queried to confirm receipt.
+	s.Require().NoError(icsKeeper.HandleConversionTransfer(ctx, transfer, hash, true))
+	s.Require().Equal(1, len(icsKeeper.ZoneConversionRecords(ctx, zone.ChainId, keeper.ConversionStatusConverting)))
+	for _, record := range icsKeeper.AllICAPacketRecords(ctx) {
+		icsKeeper.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+	}
+
+	s.Require().NoError(icsKeeper.HandleConversions(ctx, &zone))
+	s.Require().Equal(0, len(icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)))
+
+	accAddr, err := sdk.AccAddressFromBech32(zone.DelegationAddress.Address)
+	s.Require().NoError(err)
+	request := append(banktypes.CreateAccountBalancesPrefix(accAddr), []byte(zone.BaseDenom)...)
+	found = false
+	quicksilver.InterchainQueryKeeper.IterateQueries(ctx, func(_ int64, query icqtypes.Query) (stop bool) {
+		if query.CallbackId == "conversionbalance" && bytes.Equal(query.Request, request) {
+			found = true
+		}
+		return found
+	})
+	s.Require().True(found)
+
+	balanceCallback := func(amount int64) {
+		balance, err := sdk.NewInt(amount).Marshal()
+		s.Require().NoError(err)
+		s.Require().NoError(keeper.ConversionBalanceCallback(&quicksilver.InterchainstakingKeeper, ctx, balance, icqtypes.Query{ChainId: zone.ChainId, Request: request}))
+	}
+
+	// a balance short of the expected output does not confirm the conversion.
+	balanceCallback(400)
+	s.Require().Equal(1, len(icsKeeper.ZoneConversionRecords(ctx, zone.ChainId, keeper.ConversionStatusConverting)))
+	s.Require().Equal(sdk.NewInt(100), quicksilver.BankKeeper.GetBalance(ctx, depositor, zone.LocalDenom).Amount)
+
+	// once received, qAssets are minted against the expected output, which is delegated.
+	memo := fmt.Sprintf("%s/%s", icstypes.MsgTypeConversion, hash)
+	balanceCallback(495)
+	s.Require().Equal(1, len(icsKeeper.ZoneConversionRecords(ctx, zone.ChainId, keeper.ConversionStatusConverted)))
+	s.Require().Equal(sdk.NewInt(595), quicksilver.BankKeeper.GetBalance(ctx, depositor, zone.LocalDenom).Amount)
+	packets = icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)
+	s.Require().Equal(1, len(packets))
+	s.Require().Equal(memo, packets[0].Memo)
+	s.Require().Equal("/cosmos.staking.v1beta1.MsgDelegate", packets[0].MsgTypes[0])
+
+	// no further delegations are sent until the previous are acknowledged, and converted records are not confirmed twice.
+	s.Require().NoError(icsKeeper.HandleConversions(ctx, &zone))
+	balanceCallback(495)
+	s.Require().Equal(1, len(icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)))
+	s.Require().Equal(sdk.NewInt(595), quicksilver.BankKeeper.GetBalance(ctx, depositor, zone.LocalDenom).Amount)
+
+	// the delegation acknowledgement removes the record and completes the receipt.
+	delegate := &stakingtypes.MsgDelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: zone.Validators[0].ValoperAddress, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(495))}
+	s.Require().NoError(icsKeeper.HandleDelegate(ctx, delegate, memo))
+	s.Require().Equal(0, len(icsKeeper.AllConversionRecords(ctx)))
+
+	receipt, found := icsKeeper.GetReceipt(ctx, icstypes.GetReceiptKey(zone.ChainId, hash))
+	s.Require().True(found)
+	s.Require().NotNil(receipt.Completed)
+}
+
+func (s *KeeperTestSuite) TestConversionDeadline() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	quicksilver := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	icsKeeper := quicksilver.InterchainstakingKeeper
+
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	for _, record := range icsKeeper.AllICAPacketRecords(ctx) {
+		icsKeeper.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+	}
+
+	depositor := utils.GenerateAccAddressForTestWithPrefix(zone.AccountPrefix)
+	sentTime := ctx.BlockTime().Add(-keeper.ConversionDeadline)
+	failed := icstypes.ConversionRecord{
+		ChainId:   zone.ChainId,
+		Txhash:    "failed",
+		Delegator: zone.DelegationAddress.Address,
+		Amount:    sdk.NewCoin("uosmo", sdk.NewInt(1000)),
+		Expected:  sdk.NewCoin(zone.BaseDenom, sdk.NewInt(495)),
+		Status:    keeper.ConversionStatusFailed,
+		SentTime:  sentTime,
+		Depositor: depositor,
+	}
+	converting := failed
+	converting.Txhash = "converting"
+	converting.Status = keeper.ConversionStatusConverting
+	icsKeeper.SetConversionRecord(ctx, failed)
+	icsKeeper.SetConversionRecord(ctx, converting)
+	for _, hash := range []string{failed.Txhash, converting.Txhash} {
+		icsKeeper.SetReceipt(ctx, *icsKeeper.NewReceipt(ctx, &zone, depositor, hash, sdk.NewCoins(failed.Amount)))
+	}
+
+	s.Require().NoError(icsKeeper.HandleConversions(ctx, &zone))
+	s.Require().Equal(0, len(icsKeeper.AllConversionRecords(ctx)))
+
+	// the failed conversion is refunded to the depositor from the deposit account.
+	packets := icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)
+	s.Require().Equal(1, len(packets))
+	s.Require().Equal(fmt.Sprintf("%s/%s", icstypes.MsgTypeConversion, failed.Txhash), packets[0].Memo)
+	s.Require().Equal([]string{"/cosmos.bank.v1beta1.MsgSend"}, packets[0].MsgTypes)
+
+	// the conversion never received is abandoned; no qAssets were minted against either.
+	events := []string{}
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	s.Require().Contains(events, icstypes.EventTypeRefundConversion)
+	s.Require().Contains(events, icstypes.EventTypeAbandonConversion)
+	s.Require().True(quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom).IsZero())
+
+	for _, hash := range []string{failed.Txhash, converting.Txhash} {
+		receipt, found := icsKeeper.GetReceipt(ctx, icstypes.GetReceiptKey(zone.ChainId, hash))
+		s.Require().True(found)
+		s.Require().NotNil(receipt.Completed)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetDepositAssetRate() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	quicksilver := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	icsKeeper := quicksilver.InterchainstakingKeeper
+
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	zone.LastRedemptionRate = sdk.NewDecWithPrec(11, 1)
+	zone.RedemptionRate = sdk.NewDecWithPrec(12, 1)
+	icsKeeper.SetZone(ctx, &zone)
+
+	rate, err := icsKeeper.GetDepositAssetRate(ctx, &icstypes.DepositAsset{RateSource: icstypes.DepositRateSourceUnity})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	rate, err = icsKeeper.GetDepositAssetRate(ctx, &icstypes.DepositAsset{RateSource: icstypes.DepositRateSourceFixed, Rate: sdk.NewDecWithPrec(5, 1)})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), rate)
+
+	rate, err = icsKeeper.GetDepositAssetRate(ctx, &icstypes.DepositAsset{RateSource: icstypes.DepositRateSourceZone, RateChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(11, 1), rate)
+
+	_, err = icsKeeper.GetDepositAssetRate(ctx, &icstypes.DepositAsset{RateSource: icstypes.DepositRateSourceZone, RateChainId: "unknown"})
+	s.Require().Error(err)
+}
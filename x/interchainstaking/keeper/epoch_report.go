@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetEpochReport returns the epoch report for the given zone and epoch.
+func (k *Keeper) GetEpochReport(ctx sdk.Context, chainID string, epochNumber int64) (types.EpochReport, bool) {
+	report := types.EpochReport{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := store.Get(types.GetEpochReportKey(chainID, epochNumber))
+	if bz == nil {
+		return report, false
+	}
+	k.cdc.MustUnmarshal(bz, &report)
+	return report, true
+}
+
+// SetEpochReport stores the epoch report.
+func (k *Keeper) SetEpochReport(ctx sdk.Context, report types.EpochReport) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&report)
+	store.Set(types.GetEpochReportKey(report.ChainId, report.Epoch), bz)
+}
+
+// DeleteEpochReport deletes the epoch report.
+func (k *Keeper) DeleteEpochReport(ctx sdk.Context, chainID string, epochNumber int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	store.Delete(types.GetEpochReportKey(chainID, epochNumber))
+}
+
+// IterateZoneEpochReports iterates through the epoch reports of the given zone, oldest first.
+func (k *Keeper) IterateZoneEpochReports(ctx sdk.Context, chainID string, fn func(index int64, report types.EpochReport) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochReportsKey(chainID))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		report := types.EpochReport{}
+		k.cdc.MustUnmarshal(iterator.Value(), &report)
+
+		stop := fn(i, report)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// ZoneEpochReports returns every epoch report in the store for the specified zone, oldest first.
+func (k *Keeper) ZoneEpochReports(ctx sdk.Context, chainID string) []types.EpochReport {
+	reports := []types.EpochReport{}
+	k.IterateZoneEpochReports(ctx, chainID, func(_ int64, report types.EpochReport) (stop bool) {
+		reports = append(reports, report)
+		return false
+	})
+	return reports
+}
+
+// AllEpochReports returns every epoch report in the store.
+func (k *Keeper) AllEpochReports(ctx sdk.Context) []types.EpochReport {
+	reports := []types.EpochReport{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochReport)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		report := types.EpochReport{}
+		k.cdc.MustUnmarshal(iterator.Value(), &report)
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// latestEpochReports returns the latest epoch report of the given zone, and the report preceding it, if any.
+func (k *Keeper) latestEpochReports(ctx sdk.Context, chainID string) (latest *types.EpochReport, previous *types.EpochReport) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochReportsKey(chainID))
+
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		report := types.EpochReport{}
+		k.cdc.MustUnmarshal(iterator.Value(), &report)
+		if latest == nil {
+			latest = &report
+			continue
+		}
+		previous = &report
+		break
+	}
+	return latest, previous
+}
+
+// OpenEpochReport adds the epoch report for the given zone and epoch, recording the zone's TVL and redemption rate at
+// the end of the epoch. The report is completed by CompleteEpochReport once the epoch's rewards are distributed.
+func (k *Keeper) OpenEpochReport(ctx sdk.Context, zone *types.Zone, epochNumber int64) {
+	rate := zone.RedemptionRate
+	if rate.IsNil() {
+		rate = sdk.OneDec()
+	}
+
+	k.SetEpochReport(ctx, types.EpochReport{
+		ChainId:              zone.ChainId,
+		Epoch:                epochNumber,
+		Height:               ctx.BlockHeight(),
+		Time:                 ctx.BlockTime(),
+		Tvl:                  rate.MulInt(k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount).TruncateInt(),
+		GrossRewards:         sdk.ZeroInt(),
+		Commission:           sdk.ZeroInt(),
+		Redelegated:          sdk.ZeroInt(),
+		RedemptionRateBefore: rate,
+		RedemptionRateAfter:  rate,
+		RedemptionRateDelta:  sdk.ZeroDec(),
+		AnnualisedYield:      sdk.ZeroDec(),
+	})
+}
+
+// CompleteEpochReport records the distributed rewards, and the resulting change in redemption rate, against the
+// latest epoch report of the zone. The yield is annualised over the time since the preceding report. Where the latest
+// report has already been completed, or none exists, the distribution is not recorded.
+func (k *Keeper) CompleteEpochReport(ctx sdk.Context, zone *types.Zone, gross sdkmath.Int, commission sdkmath.Int, redelegated sdkmath.Int) {
+	report, previous := k.latestEpochReports(ctx, zone.ChainId)
+	if report == nil || report.DistributionHeight != 0 {
+		k.Logger(ctx).Info("no open epoch report; not recording rewards", "chain_id", zone.ChainId, "rewards", gross)
+		return
+	}
+
+	report.GrossRewards = gross
+	report.Commission = commission
+	report.Redelegated = redelegated
+	report.RedemptionRateAfter = zone.RedemptionRate
+	report.RedemptionRateDelta = zone.RedemptionRate.Sub(report.RedemptionRateBefore)
+	if previous != nil {
+		report.AnnualisedYield = types.AnnualisedYield(report.RedemptionRateBefore, report.RedemptionRateAfter, report.Time.Sub(previous.Time))
+	}
+	report.DistributionHeight = ctx.BlockHeight()
+	k.SetEpochReport(ctx, *report)
+}
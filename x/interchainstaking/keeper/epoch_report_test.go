@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestEpochReport() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := qapp.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	for _, report := range qapp.InterchainstakingKeeper.AllEpochReports(ctx) {
+		qapp.InterchainstakingKeeper.DeleteEpochReport(ctx, report.ChainId, report.Epoch)
+	}
+
+	s.Require().NoError(qapp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000000)))))
+	zone.RedemptionRate = sdk.OneDec()
+
+	// rewards distributed without an open report are not recorded.
+	qapp.InterchainstakingKeeper.CompleteEpochReport(ctx, &zone, sdk.NewInt(100), sdk.NewInt(10), sdk.NewInt(90))
+	s.Require().Equal(0, len(qapp.InterchainstakingKeeper.ZoneEpochReports(ctx, zone.ChainId)))
+
+	qapp.InterchainstakingKeeper.OpenEpochReport(ctx, &zone, 1)
+	zone.RedemptionRate = sdk.NewDecWithPrec(101, 2)
+	qapp.InterchainstakingKeeper.CompleteEpochReport(ctx, &zone, sdk.NewInt(100), sdk.NewInt(10), sdk.NewInt(90))
+
+	// the first report has no preceding epoch over which to annualise.
+	report, found := qapp.InterchainstakingKeeper.GetEpochReport(ctx, zone.ChainId, 1)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(1000000), report.Tvl)
+	s.Require().Equal(sdk.NewInt(100), report.GrossRewards)
+	s.Require().Equal(sdk.NewInt(10), report.Commission)
+	s.Require().Equal(sdk.NewInt(90), report.Redelegated)
+	s.Require().Equal(sdk.NewDecWithPrec(1, 2), report.RedemptionRateDelta)
+	s.Require().Equal(sdk.ZeroDec(), report.AnnualisedYield)
+	s.Require().Equal(ctx.BlockHeight(), report.DistributionHeight)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.Year / 100))
+	qapp.InterchainstakingKeeper.OpenEpochReport(ctx, &zone, 2)
+	zone.RedemptionRate = sdk.MustNewDecFromStr("1.0201")
+	qapp.InterchainstakingKeeper.CompleteEpochReport(ctx, &zone, sdk.NewInt(200), sdk.NewInt(20), sdk.NewInt(180))
+
+	// 1% growth over 1/100th of a year.
+	report, found = qapp.InterchainstakingKeeper.GetEpochReport(ctx, zone.ChainId, 2)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDecWithPrec(101, 2), report.RedemptionRateBefore)
+	s.Require().Equal(sdk.NewDec(1), report.AnnualisedYield)
+
+	// a completed report is not overwritten by a later distribution.
+	qapp.InterchainstakingKeeper.CompleteEpochReport(ctx, &zone, sdk.NewInt(300), sdk.NewInt(30), sdk.NewInt(270))
+	report, _ = qapp.InterchainstakingKeeper.GetEpochReport(ctx, zone.ChainId, 2)
+	s.Require().Equal(sdk.NewInt(200), report.GrossRewards)
+
+	reports := qapp.InterchainstakingKeeper.ZoneEpochReports(ctx, zone.ChainId)
+	s.Require().Equal(2, len(reports))
+	s.Require().Equal(int64(1), reports[0].Epoch)
+	s.Require().Equal(0, len(qapp.InterchainstakingKeeper.ZoneEpochReports(ctx, "elgafar-1")))
+
+	resp, err := qapp.InterchainstakingKeeper.EpochReports(sdk.WrapSDKContext(ctx), &types.QueryEpochReportsRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(reports, resp.Reports)
+
+	_, err = qapp.InterchainstakingKeeper.EpochReport(sdk.WrapSDKContext(ctx), &types.QueryEpochReportRequest{ChainId: zone.ChainId, Epoch: 3})
+	s.Require().Error(err)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+	participationrewardstypes "github.com/ingenuity-build/quicksilver/x/participationrewards/types"
+)
+
+// GetZoneCommissionRate returns the share of the zone's rewards retained as protocol fees.
+func (k *Keeper) GetZoneCommissionRate(ctx sdk.Context, zone *types.Zone) sdk.Dec {
+	return zone.GetCommissionRate(k.GetCommissionRate(ctx))
+}
+
+// GetZoneFees returns the cumulative fees collected from the given zone.
+func (k *Keeper) GetZoneFees(ctx sdk.Context, chainID string) (types.ZoneFees, bool) {
+	fees := types.ZoneFees{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := store.Get(types.GetZoneFeesKey(chainID))
+	if bz == nil {
+		return fees, false
+	}
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees, true
+}
+
+// SetZoneFees stores the cumulative fees of a zone.
+func (k *Keeper) SetZoneFees(ctx sdk.Context, fees types.ZoneFees) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&fees)
+	store.Set(types.GetZoneFeesKey(fees.ChainId), bz)
+}
+
+// DeleteZoneFees deletes the cumulative fees of a zone.
+func (k *Keeper) DeleteZoneFees(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	store.Delete(types.GetZoneFeesKey(chainID))
+}
+
+// AllZoneFees returns the cumulative fees of every zone.
+func (k *Keeper) AllZoneFees(ctx sdk.Context) []types.ZoneFees {
+	out := []types.ZoneFees{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZoneFees)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		fees := types.ZoneFees{}
+		k.cdc.MustUnmarshal(iterator.Value(), &fees)
+		out = append(out, fees)
+	}
+	return out
+}
+
+// AddZoneFees adds the given fees to the cumulative fees collected from the zone.
+func (k *Keeper) AddZoneFees(ctx sdk.Context, chainID string, amount sdk.Coins) {
+	fees, found := k.GetZoneFees(ctx, chainID)
+	if !found {
+		fees = types.ZoneFees{ChainId: chainID}
+	}
+	fees.Collected = fees.Collected.Add(amount...)
+	k.SetZoneFees(ctx, fees)
+}
+
+// RouteFees distributes the collected fees held by the module account; each fee route receives its share, and the
+// remainder is sent to the fee collector, to be distributed to stakers.
+func (k *Keeper) RouteFees(ctx sdk.Context, fees sdk.Coins) error {
+	moduleAddress := k.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	remaining := fees
+	for _, route := range k.GetFeeRoutes(ctx) {
+		amount := sdk.Coins{}
+		for _, coin := range fees {
+			amount = amount.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(route.Share).TruncateInt()))
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		var recipient string
+		switch route.Destination {
+		case types.FeeDestinationCommunityPool:
+			if err := k.DistrKeeper.FundCommunityPool(ctx, amount, moduleAddress); err != nil {
+				return err
+			}
+			recipient = k.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+		case types.FeeDestinationParticipationRewards:
+			if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, participationrewardstypes.ModuleName, amount); err != nil {
+				return err
+			}
+			recipient = k.AccountKeeper.GetModuleAddress(participationrewardstypes.ModuleName).String()
+		case types.FeeDestinationTreasury, types.FeeDestinationBuyback:
+			address, err := sdk.AccAddressFromBech32(route.Address)
+			if err != nil {
+				return err
+			}
+			if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, amount); err != nil {
+				return err
+			}
+			recipient = route.Address
+		default:
+			return fmt.Errorf("unknown fee destination %d", route.Destination)
+		}
+
+		remaining = remaining.Sub(amount...)
+		k.emitDistributeFeesEvent(ctx, route.GetDestinationName(), recipient, amount)
+	}
+
+	if remaining.IsZero() {
+		return nil
+	}
+
+	if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, remaining); err != nil {
+		return err
+	}
+	k.emitDistributeFeesEvent(ctx, types.FeeDestinationFeeCollector, k.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String(), remaining)
+	return nil
+}
+
+func (k *Keeper) emitDistributeFeesEvent(ctx sdk.Context, destination string, recipient string, amount sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeFees,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyFeeDestination, destination),
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+	participationrewardstypes "github.com/ingenuity-build/quicksilver/x/participationrewards/types"
+)
+
+func (s *KeeperTestSuite) TestRouteFees() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	icsKeeper := qapp.InterchainstakingKeeper
+
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	treasury := utils.GenerateAccAddressForTest()
+	params := icsKeeper.GetParams(ctx)
+	params.FeeRoutes = []types.FeeRoute{
+		{Destination: types.FeeDestinationCommunityPool, Share: sdk.NewDecWithPrec(1, 1)},
+		{Destination: types.FeeDestinationTreasury, Address: treasury.String(), Share: sdk.NewDecWithPrec(2, 1)},
+		{Destination: types.FeeDestinationParticipationRewards, Share: sdk.NewDecWithPrec(3, 1)},
+	}
+	icsKeeper.SetParams(ctx, params)
+
+	feeCollector := qapp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	participationRewards := qapp.AccountKeeper.GetModuleAddress(participationrewardstypes.ModuleName)
+	communityPool := qapp.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("ufee")
+
+	fees := sdk.NewCoins(sdk.NewCoin("ufee", sdk.NewInt(1000)))
+	s.Require().NoError(qapp.BankKeeper.MintCoins(ctx, types.ModuleName, fees))
+
+	// the acknowledged transfer from the withdrawal account records the fees of the zone, in the local denom.
+	qapp.IBCKeeper.ChannelKeeper.SetChannel(ctx, types.TransferPort, "channel-9", channeltypes.Channel{State: channeltypes.OPEN, Counterparty: channeltypes.NewCounterparty(types.TransferPort, "channel-3"), ConnectionHops: []string{zone.ConnectionId}})
+	transfer := &ibctransfertypes.MsgTransfer{
+		SourcePort:    types.TransferPort,
+		SourceChannel: "channel-3",
+		Sender:        zone.WithdrawalAddress.Address,
+		Receiver:      qapp.AccountKeeper.GetModuleAddress(types.ModuleName).String(),
+		Token:         sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)),
+	}
+	localDenom := ibctransfertypes.ParseDenomTrace("transfer/channel-9/" + zone.BaseDenom).IBCDenom()
+	s.Require().NoError(icsKeeper.HandleMsgTransfer(ctx, transfer))
+	s.Require().NoError(icsKeeper.HandleMsgTransfer(ctx, transfer))
+
+	s.Require().Equal(sdk.NewDec(100), qapp.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("ufee").Sub(communityPool))
+	s.Require().Equal(sdk.NewInt(200), qapp.BankKeeper.GetBalance(ctx, treasury, "ufee").Amount)
+	s.Require().Equal(sdk.NewInt(300), qapp.BankKeeper.GetBalance(ctx, participationRewards, "ufee").Amount)
+	s.Require().Equal(sdk.NewInt(400), qapp.BankKeeper.GetBalance(ctx, feeCollector, "ufee").Amount)
+	s.Require().True(qapp.BankKeeper.GetAllBalances(ctx, qapp.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	res, err := icsKeeper.ZoneFees(sdk.WrapSDKContext(ctx), &types.QueryZoneFeesRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(localDenom, sdk.NewInt(2000))), res.Fees.Collected)
+	s.Require().Equal(icsKeeper.GetCommissionRate(ctx), res.CommissionRate)
+
+	// the zone commission rate overrides the module commission rate.
+	s.Require().NoError(icsKeeper.HandleUpdateZoneProposal(ctx, types.NewUpdateZoneProposal("update", "update zone", zone.ChainId, []*types.UpdateZoneValue{{Key: "commission_rate", Value: "0.1"}})))
+	s.Require().Error(icsKeeper.HandleUpdateZoneProposal(ctx, types.NewUpdateZoneProposal("update", "update zone", zone.ChainId, []*types.UpdateZoneValue{{Key: "commission_rate", Value: "1.1"}})))
+
+	res, err = icsKeeper.ZoneFees(sdk.WrapSDKContext(ctx), &types.QueryZoneFeesRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(1, 1), res.CommissionRate)
+
+	_, err = icsKeeper.ZoneFees(sdk.WrapSDKContext(ctx), &types.QueryZoneFeesRequest{ChainId: "unknown"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestRouteFeesFailure() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	icsKeeper := qapp.InterchainstakingKeeper
+
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	// param changes routing fees to blocked addresses are rejected.
+	blocked := qapp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	routes := []types.FeeRoute{{Destination: types.FeeDestinationTreasury, Address: blocked.String(), Share: sdk.NewDecWithPrec(5, 1)}}
+	subspace := qapp.GetSubspace(types.ModuleName)
+	s.Require().Error(subspace.Validate(ctx, types.KeyFeeRoutes, routes))
+	s.Require().NoError(subspace.Validate(ctx, types.KeyFeeRoutes, []types.FeeRoute{{Destination: types.FeeDestinationTreasury, Address: utils.GenerateAccAddressForTest().String(), Share: sdk.NewDecWithPrec(5, 1)}}))
+
+	// routing failures do not fail the acknowledgement; the fees remain in the module account.
+	params := icsKeeper.GetParams(ctx)
+	params.FeeRoutes = routes
+	icsKeeper.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewCoin("ufee", sdk.NewInt(1000)))
+	s.Require().NoError(qapp.BankKeeper.MintCoins(ctx, types.ModuleName, fees))
+
+	transfer := &ibctransfertypes.MsgTransfer{
+		SourcePort:    types.TransferPort,
+		SourceChannel: "channel-3",
+		Sender:        zone.WithdrawalAddress.Address,
+		Receiver:      qapp.AccountKeeper.GetModuleAddress(types.ModuleName).String(),
+		Token:         sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)),
+	}
+	s.Require().NoError(icsKeeper.HandleMsgTransfer(ctx, transfer))
+	s.Require().True(fees.IsEqual(qapp.BankKeeper.GetAllBalances(ctx, qapp.AccountKeeper.GetModuleAddress(types.ModuleName))))
+	s.Require().True(qapp.BankKeeper.GetAllBalances(ctx, blocked).IsZero())
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -105,7 +107,13 @@ func (k *Keeper) DelegatorIntent(c context.Context, req *types.QueryDelegatorInt
 	// - see comment in GetDelegatorIntent
 	intent, _ := k.GetDelegatorIntent(ctx, &zone, req.DelegatorAddress, false)
 
-	return &types.QueryDelegatorIntentResponse{Intent: &intent}, nil
+	expires := zone.IntentExpiry(intent)
+	remaining := uint64(0)
+	if expires != nil && expires.After(ctx.BlockTime()) {
+		remaining = uint64(expires.Sub(ctx.BlockTime()) / time.Second)
+	}
+
+	return &types.QueryDelegatorIntentResponse{Intent: &intent, Expires: expires, RemainingLifetime: remaining}, nil
 }
 
 func (k *Keeper) Delegations(c context.Context, req *types.QueryDelegationsRequest) (*types.QueryDelegationsResponse, error) {
@@ -217,3 +225,341 @@ func (k *Keeper) RedelegationRecords(c context.Context, req *types.QueryRedelega
 
 	return &types.QueryRedelegationRecordsResponse{Redelegations: redelegations}, nil
 }
+
+// RebalancePlan returns the redelegations that would be issued were the zone rebalanced at the current state.
+func (k *Keeper) RebalancePlan(c context.Context, req *types.QueryRebalancePlanRequest) (*types.QueryRebalancePlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	rebalances, validators, before, after := k.PreviewRebalancing(ctx, &zone)
+
+	return &types.QueryRebalancePlanResponse{
+		Rebalances:     rebalances,
+		Validators:     validators,
+		DistanceBefore: before,
+		DistanceAfter:  after,
+	}, nil
+}
+
+func (k *Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	return &types.QueryRateLimitsResponse{
+		Deposit:    k.GetDepositRateLimit(ctx, &zone),
+		Redemption: k.GetRedemptionRateLimit(ctx, &zone),
+	}, nil
+}
+
+func (k *Keeper) RedemptionRateHistory(c context.Context, req *types.QueryRedemptionRateHistoryRequest) (*types.QueryRedemptionRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	records := make([]types.RedemptionRateRecord, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRedemptionRateRecordsKey(req.ChainId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.RedemptionRateRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionRateHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) RedemptionRateTWAP(c context.Context, req *types.QueryRedemptionRateTWAPRequest) (*types.QueryRedemptionRateTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	records := k.ZoneRedemptionRateRecords(ctx, req.ChainId)
+	if len(records) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no redemption rate history for %s", req.ChainId))
+	}
+
+	if req.Epochs > 0 && req.Epochs < uint64(len(records)) {
+		records = records[uint64(len(records))-req.Epochs:]
+	}
+
+	return &types.QueryRedemptionRateTWAPResponse{
+		Twap:      types.RedemptionRateTWAP(records, ctx.BlockTime()),
+		FromEpoch: records[0].Epoch,
+		ToEpoch:   records[len(records)-1].Epoch,
+		Epochs:    uint64(len(records)),
+	}, nil
+}
+
+func (k *Keeper) SlashRecords(c context.Context, req *types.QuerySlashRecordsRequest) (*types.QuerySlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	prefixBytes := types.GetSlashRecordsKey(req.ChainId)
+	if req.Valoper != "" {
+		prefixBytes = types.GetValidatorSlashRecordsKey(req.ChainId, req.Valoper)
+	}
+
+	slashes := make([]types.SlashRecord, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixBytes)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.SlashRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		slashes = append(slashes, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordsResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) InFlightICAPackets(c context.Context, req *types.QueryICAPacketsRequest) (*types.QueryICAPacketsResponse, error) {
+	return k.icaPackets(c, req, ICAPacketStatusInFlight)
+}
+
+func (k *Keeper) FailedICAPackets(c context.Context, req *types.QueryICAPacketsRequest) (*types.QueryICAPacketsResponse, error) {
+	return k.icaPackets(c, req, ICAPacketStatusFailed)
+}
+
+func (k *Keeper) icaPackets(c context.Context, req *types.QueryICAPacketsRequest, packetStatus int32) (*types.QueryICAPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	packets := make([]types.ICAPacketRecord, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetICAPacketRecordsKey(req.ChainId, packetStatus))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.ICAPacketRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		packets = append(packets, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryICAPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+// OffboardingStatus returns the progress of the zone's offboarding.
+func (k *Keeper) OffboardingStatus(c context.Context, req *types.QueryOffboardingStatusRequest) (*types.QueryOffboardingStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	delegated := sdk.ZeroInt()
+	delegations := uint64(0)
+	for _, delegation := range k.GetAllDelegations(ctx, &zone) {
+		if delegation.Amount.Amount.IsPositive() {
+			delegated = delegated.Add(delegation.Amount.Amount)
+			delegations++
+		}
+	}
+
+	return &types.QueryOffboardingStatusResponse{
+		Status:              zone.OffboardingStatus,
+		Delegated:           sdk.NewCoin(zone.BaseDenom, delegated),
+		Delegations:         delegations,
+		UnbondingCompletion: zone.OffboardingUnbondingCompletion,
+		Rate:                zone.GetOffboardingRate(),
+		Unclaimed:           sdk.NewCoin(zone.BaseDenom, k.GetOffboardingUnclaimed(&zone)),
+		Supply:              k.BankKeeper.GetSupply(ctx, zone.LocalDenom),
+	}, nil
+}
+
+func (k *Keeper) ZoneFees(c context.Context, req *types.QueryZoneFeesRequest) (*types.QueryZoneFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	fees, found := k.GetZoneFees(ctx, zone.ChainId)
+	if !found {
+		fees = types.ZoneFees{ChainId: zone.ChainId}
+	}
+
+	return &types.QueryZoneFeesResponse{
+		Fees:           fees,
+		CommissionRate: k.GetZoneCommissionRate(ctx, &zone),
+	}, nil
+}
+
+func (k *Keeper) EpochReports(c context.Context, req *types.QueryEpochReportsRequest) (*types.QueryEpochReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	reports := make([]types.EpochReport, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochReportsKey(req.ChainId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var report types.EpochReport
+		if err := k.cdc.Unmarshal(value, &report); err != nil {
+			return err
+		}
+		reports = append(reports, report)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochReportsResponse{Reports: reports, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) EpochReport(c context.Context, req *types.QueryEpochReportRequest) (*types.QueryEpochReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	report, found := k.GetEpochReport(ctx, req.ChainId, req.Epoch)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no epoch report found for %s at epoch %d", req.ChainId, req.Epoch))
+	}
+
+	return &types.QueryEpochReportResponse{Report: report}, nil
+}
+
+// DelegationPlan returns the delegations that would be issued were the given amount deposited at the current state.
+func (k *Keeper) DelegationPlan(c context.Context, req *types.QueryDelegationPlanRequest) (*types.QueryDelegationPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	amount, err := parsePlanAmount(&zone, req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	validators, before, after := k.PreviewDelegation(ctx, &zone, sdk.NewCoins(amount))
+
+	return &types.QueryDelegationPlanResponse{Validators: validators, DistanceBefore: before, DistanceAfter: after}, nil
+}
+
+// UndelegationPlan returns the undelegations that would be issued were the given amount redeemed at the current state.
+func (k *Keeper) UndelegationPlan(c context.Context, req *types.QueryUndelegationPlanRequest) (*types.QueryUndelegationPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	amount, err := parsePlanAmount(&zone, req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, totalAvailable := k.GetUnlockedTokensForZone(ctx, &zone); totalAvailable.LT(amount.Amount) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("amount %s exceeds unlocked delegations %s", amount, totalAvailable))
+	}
+
+	validators, before, after := k.PreviewUndelegation(ctx, &zone, sdk.NewCoins(amount))
+
+	return &types.QueryUndelegationPlanResponse{Validators: validators, DistanceBefore: before, DistanceAfter: after}, nil
+}
+
+// parsePlanAmount parses a positive amount of the zone's base denom for plan queries.
+func parsePlanAmount(zone *types.Zone, amount string) (sdk.Coin, error) {
+	coin, err := sdk.ParseCoinNormalized(amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if coin.Denom != zone.BaseDenom {
+		return sdk.Coin{}, fmt.Errorf("expected denom %s, got %s", zone.BaseDenom, coin.Denom)
+	}
+	if !coin.IsPositive() {
+		return sdk.Coin{}, errors.New("amount must be positive")
+	}
+	return coin, nil
+}
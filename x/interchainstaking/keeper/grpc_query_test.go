@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -770,3 +771,597 @@ func (suite *KeeperTestSuite) TestKeeper_RedelegationRecords() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeper_RebalancePlan() {
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	tests := []struct {
+		name         string
+		malleate     func()
+		req          *types.QueryRebalancePlanRequest
+		wantErr      bool
+		expectLength int
+	}{
+		{
+			"RebalancePlan_Nil_Request",
+			func() {},
+			nil,
+			true,
+			0,
+		},
+		{
+			"RebalancePlan_No_Zone",
+			func() {},
+			&types.QueryRebalancePlanRequest{ChainId: "unknown"},
+			true,
+			0,
+		},
+		{
+			"RebalancePlan_No_Delegations",
+			func() {
+				// setup zones
+				suite.setupTestZones()
+			},
+			&types.QueryRebalancePlanRequest{ChainId: suite.chainB.ChainID},
+			false,
+			0,
+		},
+		{
+			"RebalancePlan_Valid_Plan",
+			func() {
+				zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.Require().True(found)
+
+				icsKeeper.SetDelegation(
+					ctx,
+					&zone,
+					types.NewDelegation(zone.DelegationAddress.Address, zone.GetValidatorsAddressesAsSlice()[0], sdk.NewCoin(zone.BaseDenom, sdk.NewInt(10000000))),
+				)
+			},
+			&types.QueryRebalancePlanRequest{ChainId: suite.chainB.ChainID},
+			false,
+			1,
+		},
+		{
+			"RebalancePlan_Raised_Epoch_Limit",
+			func() {
+				zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.Require().True(found)
+
+				zone.MaxRebalancePerEpoch = sdk.NewDecWithPrec(5, 1)
+				icsKeeper.SetZone(ctx, &zone)
+			},
+			&types.QueryRebalancePlanRequest{ChainId: suite.chainB.ChainID},
+			false,
+			2,
+		},
+		{
+			"RebalancePlan_Limited_Iterations",
+			func() {
+				zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.Require().True(found)
+
+				zone.MaxRebalanceIterations = 1
+				icsKeeper.SetZone(ctx, &zone)
+			},
+			&types.QueryRebalancePlanRequest{ChainId: suite.chainB.ChainID},
+			false,
+			1,
+		},
+	}
+
+	// run tests:
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.malleate()
+			resp, err := icsKeeper.RebalancePlan(
+				ctx,
+				tt.req,
+			)
+			if tt.wantErr {
+				suite.T().Logf("Error:\n%v\n", err)
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(resp)
+			suite.Require().Equal(tt.expectLength, len(resp.Rebalances))
+
+			// redelegations must not change the total delegated amount.
+			currentSum, projectedSum := sdk.ZeroInt(), sdk.ZeroInt()
+			for _, plan := range resp.Validators {
+				currentSum = currentSum.Add(plan.Current)
+				projectedSum = projectedSum.Add(plan.Projected)
+			}
+			suite.Require().Equal(currentSum, projectedSum)
+
+			vstr, err := json.MarshalIndent(resp, "", "\t")
+			suite.Require().NoError(err)
+
+			suite.T().Logf("Response:\n%s\n", vstr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DelegationPlan() {
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	tests := []struct {
+		name     string
+		malleate func()
+		req      *types.QueryDelegationPlanRequest
+		wantErr  bool
+	}{
+		{
+			"DelegationPlan_Nil_Request",
+			func() {},
+			nil,
+			true,
+		},
+		{
+			"DelegationPlan_No_Zone",
+			func() {},
+			&types.QueryDelegationPlanRequest{ChainId: "unknown", Amount: "1000uatom"},
+			true,
+		},
+		{
+			"DelegationPlan_Invalid_Denom",
+			func() {
+				// setup zones
+				suite.setupTestZones()
+			},
+			&types.QueryDelegationPlanRequest{ChainId: suite.chainB.ChainID, Amount: "1000uqck"},
+			true,
+		},
+		{
+			"DelegationPlan_Zero_Amount",
+			func() {},
+			&types.QueryDelegationPlanRequest{ChainId: suite.chainB.ChainID, Amount: "0uatom"},
+			true,
+		},
+		{
+			"DelegationPlan_No_Delegations",
+			func() {},
+			&types.QueryDelegationPlanRequest{ChainId: suite.chainB.ChainID, Amount: "1000uatom"},
+			false,
+		},
+		{
+			"DelegationPlan_Valid_Plan",
+			func() {
+				zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.Require().True(found)
+
+				icsKeeper.SetDelegation(
+					ctx,
+					&zone,
+					types.NewDelegation(zone.DelegationAddress.Address, zone.GetValidatorsAddressesAsSlice()[0], sdk.NewCoin(zone.BaseDenom, sdk.NewInt(10000000))),
+				)
+			},
+			&types.QueryDelegationPlanRequest{ChainId: suite.chainB.ChainID, Amount: "3000000uatom"},
+			false,
+		},
+	}
+
+	// run tests:
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.malleate()
+			resp, err := icsKeeper.DelegationPlan(
+				ctx,
+				tt.req,
+			)
+			if tt.wantErr {
+				suite.T().Logf("Error:\n%v\n", err)
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(resp)
+
+			amount, err := sdk.ParseCoinNormalized(tt.req.Amount)
+			suite.Require().NoError(err)
+
+			delegateSum, currentSum, projectedSum := sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+			for _, plan := range resp.Validators {
+				delegateSum = delegateSum.Add(plan.Delegate)
+				currentSum = currentSum.Add(plan.Current)
+				projectedSum = projectedSum.Add(plan.Projected)
+			}
+			suite.Require().Equal(amount.Amount, delegateSum)
+			suite.Require().Equal(currentSum.Add(amount.Amount), projectedSum)
+
+			vstr, err := json.MarshalIndent(resp, "", "\t")
+			suite.Require().NoError(err)
+
+			suite.T().Logf("Response:\n%s\n", vstr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_UndelegationPlan() {
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	tests := []struct {
+		name     string
+		malleate func()
+		req      *types.QueryUndelegationPlanRequest
+		wantErr  bool
+	}{
+		{
+			"UndelegationPlan_Nil_Request",
+			func() {},
+			nil,
+			true,
+		},
+		{
+			"UndelegationPlan_No_Zone",
+			func() {},
+			&types.QueryUndelegationPlanRequest{ChainId: "unknown", Amount: "1000uatom"},
+			true,
+		},
+		{
+			"UndelegationPlan_Invalid_Amount",
+			func() {
+				// setup zones
+				suite.setupTestZones()
+			},
+			&types.QueryUndelegationPlanRequest{ChainId: suite.chainB.ChainID, Amount: "uatom"},
+			true,
+		},
+		{
+			"UndelegationPlan_No_Delegations",
+			func() {},
+			&types.QueryUndelegationPlanRequest{ChainId: suite.chainB.ChainID, Amount: "1000uatom"},
+			true,
+		},
+		{
+			"UndelegationPlan_Valid_Plan",
+			func() {
+				zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.Require().True(found)
+
+				for idx, valoper := range zone.GetValidatorsAddressesAsSlice() {
+					icsKeeper.SetDelegation(
+						ctx,
+						&zone,
+						types.NewDelegation(zone.DelegationAddress.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(int64(idx+1)*1000000))),
+					)
+				}
+			},
+			&types.QueryUndelegationPlanRequest{ChainId: suite.chainB.ChainID, Amount: "2000000uatom"},
+			false,
+		},
+		{
+			"UndelegationPlan_Locked_Delegations",
+			func() {
+				zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.Require().True(found)
+
+				validators := zone.GetValidatorsAddressesAsSlice()
+				icsKeeper.SetRedelegationRecord(ctx, types.RedelegationRecord{
+					ChainId:        zone.ChainId,
+					EpochNumber:    1,
+					Source:         validators[0],
+					Destination:    validators[3],
+					Amount:         4000000,
+					CompletionTime: ctx.BlockTime().Add(time.Hour),
+				})
+			},
+			&types.QueryUndelegationPlanRequest{ChainId: suite.chainB.ChainID, Amount: "6000001uatom"},
+			true,
+		},
+	}
+
+	// run tests:
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.malleate()
+			resp, err := icsKeeper.UndelegationPlan(
+				ctx,
+				tt.req,
+			)
+			if tt.wantErr {
+				suite.T().Logf("Error:\n%v\n", err)
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(resp)
+
+			amount, err := sdk.ParseCoinNormalized(tt.req.Amount)
+			suite.Require().NoError(err)
+
+			undelegateSum, currentSum, projectedSum := sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+			for _, plan := range resp.Validators {
+				undelegateSum = undelegateSum.Add(plan.Undelegate)
+				currentSum = currentSum.Add(plan.Current)
+				projectedSum = projectedSum.Add(plan.Projected)
+			}
+			suite.Require().Equal(amount.Amount, undelegateSum)
+			suite.Require().Equal(currentSum.Sub(amount.Amount), projectedSum)
+
+			vstr, err := json.MarshalIndent(resp, "", "\t")
+			suite.Require().NoError(err)
+
+			suite.T().Logf("Response:\n%s\n", vstr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RateLimits() {
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	tests := []struct {
+		name             string
+		malleate         func()
+		req              *types.QueryRateLimitsRequest
+		wantErr          bool
+		expectDeposit    types.RateLimit
+		expectRedemption types.RateLimit
+	}{
+		{
+			"RateLimits_Nil_Request",
+			func() {},
+			nil,
+			true,
+			types.RateLimit{},
+			types.RateLimit{},
+		},
+		{
+			"RateLimits_No_Zone",
+			func() {},
+			&types.QueryRateLimitsRequest{ChainId: "unknown"},
+			true,
+			types.RateLimit{},
+			types.RateLimit{},
+		},
+		{
+			"RateLimits_Disabled",
+			func() {
+				// setup zones
+				suite.setupTestZones()
+				suite.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(1000000)))))
+			},
+			&types.QueryRateLimitsRequest{ChainId: suite.chainB.ChainID},
+			false,
+			types.RateLimit{Enabled: false, Denom: "uatom", Limit: sdk.ZeroInt(), Used: sdk.ZeroInt(), Remaining: sdk.ZeroInt()},
+			types.RateLimit{Enabled: false, Denom: "uqatom", Limit: sdk.ZeroInt(), Used: sdk.ZeroInt(), Remaining: sdk.ZeroInt()},
+		},
+		{
+			"RateLimits_Enabled",
+			func() {
+				zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.Require().True(found)
+
+				zone.RedemptionRate = sdk.NewDecWithPrec(12, 1)
+				zone.DepositLimitRate = sdk.NewDecWithPrec(1, 1)
+				zone.RedemptionLimitRate = sdk.NewDecWithPrec(5, 2)
+				zone.EpochDeposits = sdk.NewInt(20000)
+				zone.EpochRedemptions = sdk.NewInt(60000)
+				icsKeeper.SetZone(ctx, &zone)
+			},
+			&types.QueryRateLimitsRequest{ChainId: suite.chainB.ChainID},
+			false,
+			types.RateLimit{Enabled: true, Denom: "uatom", Limit: sdk.NewInt(120000), Used: sdk.NewInt(20000), Remaining: sdk.NewInt(100000)},
+			types.RateLimit{Enabled: true, Denom: "uqatom", Limit: sdk.NewInt(50000), Used: sdk.NewInt(60000), Remaining: sdk.ZeroInt()},
+		},
+	}
+
+	// run tests:
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.malleate()
+			resp, err := icsKeeper.RateLimits(
+				ctx,
+				tt.req,
+			)
+			if tt.wantErr {
+				suite.T().Logf("Error:\n%v\n", err)
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(resp)
+			suite.Require().Equal(tt.expectDeposit, resp.Deposit)
+			suite.Require().Equal(tt.expectRedemption, resp.Redemption)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RedemptionRateHistory() {
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	tests := []struct {
+		name          string
+		malleate      func()
+		req           *types.QueryRedemptionRateHistoryRequest
+		wantErr       bool
+		expectEpochs  []int64
+		expectNextKey bool
+	}{
+		{
+			"RedemptionRateHistory_Nil_Request",
+			func() {},
+			nil,
+			true,
+			nil,
+			false,
+		},
+		{
+			"RedemptionRateHistory_No_Zone",
+			func() {},
+			&types.QueryRedemptionRateHistoryRequest{ChainId: "unknown"},
+			true,
+			nil,
+			false,
+		},
+		{
+			"RedemptionRateHistory_No_Records",
+			func() {
+				// setup zones
+				suite.setupTestZones()
+				// clear any records taken at epoch boundaries during setup.
+				for _, record := range icsKeeper.ZoneRedemptionRateRecords(ctx, suite.chainB.ChainID) {
+					icsKeeper.DeleteRedemptionRateRecord(ctx, record.ChainId, record.Epoch)
+				}
+			},
+			&types.QueryRedemptionRateHistoryRequest{ChainId: suite.chainB.ChainID},
+			false,
+			[]int64{},
+			false,
+		},
+		{
+			"RedemptionRateHistory_Valid",
+			func() {
+				zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.Require().True(found)
+				for epoch := int64(1); epoch <= 3; epoch++ {
+					icsKeeper.RecordRedemptionRate(ctx, &zone, epoch)
+				}
+			},
+			&types.QueryRedemptionRateHistoryRequest{ChainId: suite.chainB.ChainID},
+			false,
+			[]int64{1, 2, 3},
+			false,
+		},
+		{
+			"RedemptionRateHistory_Paginated",
+			func() {},
+			&types.QueryRedemptionRateHistoryRequest{ChainId: suite.chainB.ChainID, Pagination: &query.PageRequest{Limit: 2}},
+			false,
+			[]int64{1, 2},
+			true,
+		},
+	}
+
+	// run tests:
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.malleate()
+			resp, err := icsKeeper.RedemptionRateHistory(
+				ctx,
+				tt.req,
+			)
+			if tt.wantErr {
+				suite.T().Logf("Error:\n%v\n", err)
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(resp)
+
+			epochs := make([]int64, 0)
+			for _, record := range resp.Records {
+				epochs = append(epochs, record.Epoch)
+			}
+			suite.Require().Equal(tt.expectEpochs, epochs)
+			suite.Require().Equal(tt.expectNextKey, resp.Pagination.NextKey != nil)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RedemptionRateTWAP() {
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	record := func(epoch int64, offset time.Duration, rate sdk.Dec) types.RedemptionRateRecord {
+		return types.RedemptionRateRecord{ChainId: suite.chainB.ChainID, Epoch: epoch, Time: ctx.BlockTime().Add(offset), Rate: rate, Tvl: sdk.ZeroInt()}
+	}
+
+	tests := []struct {
+		name       string
+		malleate   func()
+		req        *types.QueryRedemptionRateTWAPRequest
+		wantErr    bool
+		expectTWAP sdk.Dec
+		expectFrom int64
+		expectTo   int64
+	}{
+		{
+			"RedemptionRateTWAP_Nil_Request",
+			func() {},
+			nil,
+			true,
+			sdk.Dec{},
+			0,
+			0,
+		},
+		{
+			"RedemptionRateTWAP_No_Zone",
+			func() {},
+			&types.QueryRedemptionRateTWAPRequest{ChainId: "unknown"},
+			true,
+			sdk.Dec{},
+			0,
+			0,
+		},
+		{
+			"RedemptionRateTWAP_No_Records",
+			func() {
+				// setup zones
+				suite.setupTestZones()
+				// clear any records taken at epoch boundaries during setup.
+				for _, record := range icsKeeper.ZoneRedemptionRateRecords(ctx, suite.chainB.ChainID) {
+					icsKeeper.DeleteRedemptionRateRecord(ctx, record.ChainId, record.Epoch)
+				}
+			},
+			&types.QueryRedemptionRateTWAPRequest{ChainId: suite.chainB.ChainID},
+			true,
+			sdk.Dec{},
+			0,
+			0,
+		},
+		{
+			"RedemptionRateTWAP_All_Epochs",
+			func() {
+				icsKeeper.SetRedemptionRateRecord(ctx, record(1, -3*time.Hour, sdk.NewDec(1)))
+				icsKeeper.SetRedemptionRateRecord(ctx, record(2, -2*time.Hour, sdk.NewDecWithPrec(11, 1)))
+				icsKeeper.SetRedemptionRateRecord(ctx, record(3, -time.Hour, sdk.NewDecWithPrec(12, 1)))
+			},
+			&types.QueryRedemptionRateTWAPRequest{ChainId: suite.chainB.ChainID},
+			false,
+			sdk.NewDecWithPrec(11, 1),
+			1,
+			3,
+		},
+		{
+			"RedemptionRateTWAP_Recent_Epochs",
+			func() {},
+			&types.QueryRedemptionRateTWAPRequest{ChainId: suite.chainB.ChainID, Epochs: 2},
+			false,
+			sdk.NewDecWithPrec(115, 2),
+			2,
+			3,
+		},
+	}
+
+	// run tests:
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.malleate()
+			resp, err := icsKeeper.RedemptionRateTWAP(
+				ctx,
+				tt.req,
+			)
+			if tt.wantErr {
+				suite.T().Logf("Error:\n%v\n", err)
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(resp)
+			suite.Require().Equal(tt.expectTWAP, resp.Twap)
+			suite.Require().Equal(tt.expectFrom, resp.FromEpoch)
+			suite.Require().Equal(tt.expectTo, resp.ToEpoch)
+			suite.Require().Equal(uint64(tt.expectTo-tt.expectFrom+1), resp.Epochs)
+		})
+	}
+}
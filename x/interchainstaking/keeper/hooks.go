@@ -3,7 +3,6 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	epochstypes "github.com/ingenuity-build/quicksilver/x/epochs/types"
@@ -19,9 +18,16 @@ func (k *Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
 //
 //	k.AggregateDelegatorIntents
 //	k.HandleQueuedUnbondings
+//	k.RetryTimedOutDelegations
 //	k.Rebalance
+//	k.ReleaseInstantRedemptionBufferExcess
+//	k.HandleConversions
+//	k.HandleOffboarding (offboarding zones, in place of the above three)
+//	k.ResetRateLimits
+//	k.RecordRedemptionRate
+//	k.OpenEpochReport
 //
-// and re-queries icq for new zone info.
+// and re-queries icq for new zone info, before removing offboarded zones.
 func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	// every epoch
 	if epochIdentifier == types.EpochIdentifier {
@@ -68,14 +74,13 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 				)
 			}
 
-			err = k.Rebalance(ctx, zone, epochNumber)
-			if err != nil {
+			if err := k.RetryTimedOutDelegations(ctx, zone); err != nil {
 				// we can and need not panic here; logging the error is sufficient.
 				// an error here is not expected, but also not terminal.
 				// we don't return on failure here as we still want to attempt
 				// the unrelated tasks below.
 				k.Logger(ctx).Error(
-					"encountered a problem rebalancing",
+					"encountered a problem resending timed out delegations",
 					"error", err.Error(),
 					"chain_id", zone.ChainId,
 					"epoch_identifier", epochIdentifier,
@@ -83,6 +88,70 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 				)
 			}
 
+			if zone.IsOffboarding() {
+				if err := k.HandleOffboarding(ctx, zone, epochNumber); err != nil {
+					k.Logger(ctx).Error(
+						"encountered a problem offboarding zone",
+						"error", err.Error(),
+						"chain_id", zone.ChainId,
+						"epoch_identifier", epochIdentifier,
+						"epoch_number", epochNumber,
+					)
+				}
+			} else {
+				err = k.Rebalance(ctx, zone, epochNumber)
+				if err != nil {
+					// we can and need not panic here; logging the error is sufficient.
+					// an error here is not expected, but also not terminal.
+					// we don't return on failure here as we still want to attempt
+					// the unrelated tasks below.
+					k.Logger(ctx).Error(
+						"encountered a problem rebalancing",
+						"error", err.Error(),
+						"chain_id", zone.ChainId,
+						"epoch_identifier", epochIdentifier,
+						"epoch_number", epochNumber,
+					)
+				}
+
+				if err := k.ReleaseInstantRedemptionBufferExcess(ctx, zone); err != nil {
+					// we can and need not panic here; logging the error is sufficient.
+					// an error here is not expected, but also not terminal.
+					// we don't return on failure here as we still want to attempt
+					// the unrelated tasks below.
+					k.Logger(ctx).Error(
+						"encountered a problem releasing excess instant redemption buffer",
+						"error", err.Error(),
+						"chain_id", zone.ChainId,
+						"epoch_identifier", epochIdentifier,
+						"epoch_number", epochNumber,
+					)
+				}
+
+				if err := k.HandleConversions(ctx, zone); err != nil {
+					// we can and need not panic here; logging the error is sufficient.
+					// an error here is not expected, but also not terminal.
+					// we don't return on failure here as we still want to attempt
+					// the unrelated tasks below.
+					k.Logger(ctx).Error(
+						"encountered a problem handling deposit asset conversions",
+						"error", err.Error(),
+						"chain_id", zone.ChainId,
+						"epoch_identifier", epochIdentifier,
+						"epoch_number", epochNumber,
+					)
+				}
+			}
+
+			// deposit and redemption limits apply per epoch.
+			k.ResetRateLimits(ctx, zone)
+
+			// snapshot the redemption rate in effect at the end of this epoch.
+			k.RecordRedemptionRate(ctx, zone, epochNumber)
+
+			// open the reward report for this epoch; completed once rewards are distributed.
+			k.OpenEpochReport(ctx, zone, epochNumber)
+
 			if zone.WithdrawalWaitgroup > 0 {
 				k.Logger(ctx).Error(
 					"epoch waitgroup was unexpected > 0; this means we did not process the previous epoch!",
@@ -101,55 +170,62 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 				"epoch_number", epochNumber,
 			)
 
-			delegationQuery := stakingtypes.QueryDelegatorDelegationsRequest{
-				DelegatorAddr: zone.DelegationAddress.Address,
-				Pagination: &query.PageRequest{
-					Limit: uint64(len(zone.Validators)),
-				},
+			if zone.OffboardingStatus >= types.OffboardingStatusSettling {
+				// no delegations remain; the delegation account balances are settled.
+				k.SetZone(ctx, zone)
+				return false
 			}
-			bz := k.cdc.MustMarshal(&delegationQuery)
-
-			k.ICQKeeper.MakeRequest(
-				ctx,
-				zone.ConnectionId,
-				zone.ChainId,
-				"cosmos.staking.v1beta1.Query/DelegatorDelegations",
-				bz,
-				sdk.NewInt(-1),
-				types.ModuleName,
-				"delegations",
-				0,
-			)
 
-			rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: zone.DelegationAddress.Address}
-			bz = k.cdc.MustMarshal(&rewardsQuery)
-
-			k.ICQKeeper.MakeRequest(
-				ctx,
-				zone.ConnectionId,
-				zone.ChainId,
-				"cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
-				bz,
-				sdk.NewInt(-1),
-				types.ModuleName,
-				"rewards",
-				0,
-			)
+			for _, account := range zone.GetDelegationAccounts() {
+				delegationQuery := stakingtypes.QueryDelegatorDelegationsRequest{
+					DelegatorAddr: account.Address,
+					Pagination: &query.PageRequest{
+						Limit: uint64(len(zone.Validators)),
+					},
+				}
+				bz := k.cdc.MustMarshal(&delegationQuery)
+
+				k.ICQKeeper.MakeRequest(
+					ctx,
+					zone.ConnectionId,
+					zone.ChainId,
+					"cosmos.staking.v1beta1.Query/DelegatorDelegations",
+					bz,
+					sdk.NewInt(-1),
+					types.ModuleName,
+					"delegations",
+					0,
+				)
 
-			// increment the WithdrawalWaitgroup
-			// this allows us to track the response for every protocol delegator
-			// WithdrawalWaitgroup is decremented in RewardsCallback
-			zone.WithdrawalWaitgroup++
-			k.Logger(ctx).Info("Incrementing waitgroup for delegation",
-				"value", zone.WithdrawalWaitgroup,
-				"chain_id", zone.ChainId,
-				"epoch_identifier", epochIdentifier,
-				"epoch_number", epochNumber,
-			)
+				// rewards are withdrawn from each delegation recorded for the account. Delegation records are
+				// only updated by proven delegation callbacks; outstanding rewards cannot be proven, so are
+				// not queried.
+				if err := k.WithdrawDelegationRewards(ctx, zone, account); err != nil {
+					k.Logger(ctx).Error(
+						"unable to withdraw delegation rewards",
+						"delegator", account.Address,
+						"error", err.Error(),
+						"chain_id", zone.ChainId,
+						"epoch_identifier", epochIdentifier,
+						"epoch_number", epochNumber,
+					)
+				}
+			}
 			k.SetZone(ctx, zone)
 
 			return false
 		})
+
+		offboarded := make([]*types.Zone, 0)
+		k.IterateZones(ctx, func(_ int64, zone *types.Zone) (stop bool) {
+			if k.IsOffboardingComplete(ctx, zone) {
+				offboarded = append(offboarded, zone)
+			}
+			return false
+		})
+		for _, zone := range offboarded {
+			k.RemoveOffboardedZone(ctx, zone)
+		}
 	}
 	return nil
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -88,6 +89,19 @@ func (k *Keeper) HandleChannelOpenAck(ctx sdk.Context, portID string, connection
 			}
 		}
 
+	// delegation shards
+	case len(portParts) == 3 && portParts[1] == types.ICASuffixDelegate:
+		if _, err := strconv.ParseUint(portParts[2], 10, 32); err != nil {
+			return fmt.Errorf("unexpected delegation shard on portID: %s", portID)
+		}
+		if _, found := zone.GetDelegationAccountByPort(portID); !found {
+			shard, err := types.NewICAAccount(address, portID)
+			if err != nil {
+				return err
+			}
+			zone.DelegationShards = append(zone.DelegationShards, shard)
+		}
+
 	// performance address
 	case len(portParts) == 2 && portParts[1] == types.ICASuffixPerformance:
 		if zone.PerformanceAddress == nil {
@@ -13,7 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -62,6 +62,7 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 	err := json.Unmarshal(acknowledgement, &ack)
 	txMsgData := &sdk.TxMsgData{}
 	var success bool
+	var ackErrMsg string
 	if err != nil {
 		k.Logger(ctx).Error("unable to unmarshal acknowledgement", "error", err, "data", acknowledgement)
 		return err
@@ -77,6 +78,7 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 		k.Logger(ctx).Error("received an acknowledgement error", "error", err, "remote_err", ackErr, "data", acknowledgement)
 		defer telemetry.IncrCounter(1, types.ModuleName, "ica_acknowledgement_errors")
 		success = false
+		ackErrMsg = ackErr.Error
 	} else {
 		defer telemetry.IncrCounter(1, types.ModuleName, "ica_acknowledgement_success")
 
@@ -88,6 +90,8 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 		success = true
 	}
 
+	k.UpdateICAPacketRecord(ctx, packet, success, false, ackErrMsg)
+
 	var packetData icatypes.InterchainAccountPacketData
 	err = icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData)
 	if err != nil {
@@ -260,8 +264,15 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 
 		case "/cosmos.bank.v1beta1.MsgSend":
 			if !success {
-				// TODO: handle this.
-				return nil
+				// a failed send that cannot be reconciled is logged rather than returned, as failing the
+				// acknowledgement would leave the ordered channel unable to progress.
+				cacheCtx, write := ctx.CacheContext()
+				if err := k.HandleFailedBankSend(cacheCtx, src, packetData.Memo); err != nil {
+					k.Logger(ctx).Error("unable to handle failed MsgSend", "memo", packetData.Memo, "error", err)
+					continue
+				}
+				write()
+				continue
 			}
 			response := banktypes.MsgSendResponse{}
 			if msgResponseType != "" {
@@ -306,6 +317,13 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 				return err
 			}
 		case "/ibc.applications.transfer.v1.MsgTransfer":
+			// transfers from the deposit account send deposit assets for conversion.
+			if tMsg, ok := src.(*ibctransfertypes.MsgTransfer); ok && k.GetZoneForDepositAccount(ctx, tMsg.Sender) != nil {
+				if err := k.HandleConversionTransfer(ctx, tMsg, packetData.Memo, success); err != nil {
+					return err
+				}
+				continue
+			}
 			// this should be okay to fail; we'll pick it up next time around.
 			if !success {
 				return nil
@@ -336,7 +354,141 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 	return nil
 }
 
-func (k *Keeper) HandleTimeout(_ sdk.Context, _ channeltypes.Packet) error {
+// HandleTimeout reverts or requeues the local bookkeeping for each message in a timed out ICA packet. A timeout
+// closes the ordered ICA channel; the channel is subsequently reopened by ReopenClosedICAs in the BeginBlocker,
+// or immediately if it was already closed (i.e. MsgTimeoutOnClose).
+func (k *Keeper) HandleTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	defer telemetry.IncrCounter(1, types.ModuleName, "ica_timeouts")
+
+	k.UpdateICAPacketRecord(ctx, packet, false, true, "")
+
+	var packetData icatypes.InterchainAccountPacketData
+	err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData)
+	if err != nil {
+		k.Logger(ctx).Error("unable to unmarshal timeout packet data", "error", err, "data", packetData)
+		return err
+	}
+	if reflect.DeepEqual(packetData, icatypes.InterchainAccountPacketData{}) {
+		return errors.New("unable to unmarshal packet data; got empty JSON object")
+	}
+
+	msgs, err := DeserializeCosmosTxTyped(k.cdc, packetData.Data)
+	if err != nil {
+		k.Logger(ctx).Error("unable to decode messages", "err", err)
+		return err
+	}
+
+	k.Logger(ctx).Error("received an ica packet timeout", "port", packet.SourcePort, "channel", packet.SourceChannel, "sequence", packet.Sequence, "memo", packetData.Memo)
+
+	// errors reverting local state are logged rather than returned; a failed MsgTimeout cannot be relayed
+	// again, so returning an error would leave the ordered channel unable to progress.
+	for _, msg := range msgs {
+		src := msg.Msg
+
+		// discard the state changes of a message whose handler fails.
+		cacheCtx, write := ctx.CacheContext()
+		var err error
+		switch msg.Type {
+		case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
+			// rewards remain unclaimed on the host and will be withdrawn next epoch, but we must still
+			// decrement the waitgroup so that the rewards withdrawn by other messages are distributed.
+			err = k.HandleWithdrawRewards(cacheCtx, src)
+		case "/cosmos.staking.v1beta1.MsgBeginRedelegate":
+			err = k.HandleFailedBeginRedelegate(cacheCtx, src, packetData.Memo)
+		case "/cosmos.staking.v1beta1.MsgUndelegate":
+			err = k.HandleFailedUndelegate(cacheCtx, src, packetData.Memo)
+		case "/cosmos.bank.v1beta1.MsgSend":
+			err = k.HandleFailedBankSend(cacheCtx, src, packetData.Memo)
+		case "/cosmos.staking.v1beta1.MsgDelegate":
+			// the channel is closed once the timeout is handled, so the delegation is resent next epoch.
+			err = k.HandleTimedOutDelegate(cacheCtx, src, packetData.Memo)
+		case "/ibc.applications.transfer.v1.MsgTransfer":
+			// conversions of deposit assets are resent next epoch; other transfers are retried in due course.
+			if tMsg, ok := src.(*ibctransfertypes.MsgTransfer); ok && k.GetZoneForDepositAccount(ctx, tMsg.Sender) != nil {
+				err = k.HandleConversionTransfer(cacheCtx, tMsg, packetData.Memo, false)
+			}
+		default:
+			// MsgSetWithdrawAddress is retried in due course, and LSM messages are not yet handled.
+			k.Logger(ctx).Error("unhandled timeout packet", "type", msg.Type)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("unable to handle timeout", "type", msg.Type, "memo", packetData.Memo, "error", err)
+			continue
+		}
+		write()
+	}
+
+	zone, account := k.GetZoneAndAccountForPort(ctx, packet.SourcePort)
+	if zone == nil {
+		k.Logger(ctx).Error("unable to find zone for timed out packet", "port", packet.SourcePort)
+		return nil
+	}
+
+	if err := k.EnsureICAActive(ctx, zone, account); err != nil {
+		k.Logger(ctx).Error("unable to reopen ica channel", "port", packet.SourcePort, "error", err)
+	}
+	return nil
+}
+
+// HandleFailedBankSend reverts local state for a MsgSend that was not executed on the host chain.
+func (k *Keeper) HandleFailedBankSend(ctx sdk.Context, msg sdk.Msg, memo string) error {
+	sMsg, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		err := errors.New("unable to cast source message to MsgSend")
+		k.Logger(ctx).Error(err.Error())
+		return err
+	}
+
+	zone, err := k.GetZoneFromContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("5: %w", err)
+		k.Logger(ctx).Error(err.Error())
+		return err
+	}
+
+	switch {
+	case zone.IsDelegateAddress(sMsg.FromAddress):
+		// return the withdrawal record to the unbond state, so that HandleMaturedUnbondings resends the funds.
+		withdrawalRecord, found := k.GetWithdrawalRecord(ctx, zone.ChainId, memo, WithdrawStatusSend)
+		if !found {
+			return errors.New("no matching withdrawal record found")
+		}
+		if withdrawalRecord.Instant {
+			// the instant redemption buffer was never drawn down; queue the full amount for unbonding, refunding the fee.
+			k.Logger(ctx).Info("queueing withdrawal record for failed instant redemption", "hash", memo)
+			if withdrawalRecord.InstantFee != nil {
+				withdrawalRecord.Amount = withdrawalRecord.Amount.Add(*withdrawalRecord.InstantFee)
+			}
+			withdrawalRecord.InstantFee = nil
+			withdrawalRecord.Instant = false
+			k.UpdateWithdrawalRecordStatus(ctx, &withdrawalRecord, WithdrawStatusQueued)
+			return nil
+		}
+		if len(withdrawalRecord.Distribution) > 0 {
+			// distributions may be sent from several delegation accounts, some of which may already have been paid
+			// out; only the distributions of the failed send are returned to the unbond state.
+			k.requeueFailedWithdrawalSend(ctx, zone, withdrawalRecord, sMsg)
+			return nil
+		}
+		k.Logger(ctx).Info("reverting withdrawal record to unbond status for failed send", "hash", memo)
+		k.UpdateWithdrawalRecordStatus(ctx, &withdrawalRecord, WithdrawStatusUnbond)
+	case zone.WithdrawalAddress != nil && sMsg.FromAddress == zone.WithdrawalAddress.GetAddress():
+		// rewards remain in the withdrawal account and will be distributed with next epoch's rewards.
+		k.Logger(ctx).Error("rewards distribution failed; funds remain in withdrawal account", "amount", sMsg.Amount)
+	case zone.DepositAddress != nil && sMsg.FromAddress == zone.DepositAddress.GetAddress() && strings.HasPrefix(memo, types.MsgTypeConversion+"/"):
+		// the conversion record has been removed, so the deposit asset must be returned manually.
+		k.Logger(ctx).Error("conversion refund failed; funds remain in deposit account", "memo", memo, "amount", sMsg.Amount)
+	case zone.DepositAddress != nil && sMsg.FromAddress == zone.DepositAddress.GetAddress() && !zone.IsDelegateAddress(sMsg.ToAddress):
+		// the refunded receipt remains incomplete, so the deposit must be returned manually.
+		k.Logger(ctx).Error("deposit refund failed; funds remain in deposit account", "receipt", memo, "amount", sMsg.Amount)
+	case zone.DepositAddress != nil && sMsg.FromAddress == zone.DepositAddress.GetAddress():
+		// qAssets have already been minted for this receipt, so the funds must be delegated manually.
+		k.Logger(ctx).Error("transfer to delegate account failed; funds remain in deposit account", "receipt", memo, "amount", sMsg.Amount)
+	default:
+		err = errors.New("unexpected failed send")
+		k.Logger(ctx).Error(err.Error())
+		return err
+	}
 	return nil
 }
 
@@ -357,14 +509,58 @@ func (k *Keeper) HandleMsgTransfer(ctx sdk.Context, msg sdk.Msg) error {
 		return errors.New("unexpected recipient")
 	}
 
-	return k.HandleDistributeFeesFromModuleAccount(ctx)
+	if zone := k.GetZoneForWithdrawalAccount(ctx, sMsg.Sender); zone != nil {
+		denom, err := k.receivedDenom(ctx, zone, sMsg)
+		if err != nil {
+			k.Logger(ctx).Error("unable to determine the local denom of collected fees", "chain_id", zone.ChainId, "denom", sMsg.Token.Denom, "error", err)
+		} else {
+			k.AddZoneFees(ctx, zone.ChainId, sdk.NewCoins(sdk.NewCoin(denom, sMsg.Token.Amount)))
+		}
+	}
+
+	// fee routing errors are logged rather than returned; the transfer has completed, and failing the
+	// acknowledgement would not return the fees. Fees not routed remain in the module account, and are routed
+	// with the next fees collected.
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.HandleDistributeFeesFromModuleAccount(cacheCtx); err != nil {
+		k.Logger(ctx).Error("unable to route collected fees", "error", err)
+		return nil
+	}
+	write()
+	return nil
+}
+
+// receivedDenom returns the local denom of the token of a transfer from the zone to Quicksilver.
+func (k *Keeper) receivedDenom(ctx sdk.Context, zone *types.Zone, msg *ibctransfertypes.MsgTransfer) (string, error) {
+	if ibctransfertypes.ReceiverChainIsSource(msg.SourcePort, msg.SourceChannel, msg.Token.Denom) {
+		// the token originated on Quicksilver, and is received unwound.
+		unprefixed := msg.Token.Denom[len(ibctransfertypes.GetDenomPrefix(msg.SourcePort, msg.SourceChannel)):]
+		return ibctransfertypes.ParseDenomTrace(unprefixed).IBCDenom(), nil
+	}
+
+	var localPort string
+	var localChannel string
+	k.IBCKeeper.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if channel.ConnectionHops[0] == zone.ConnectionId && channel.Counterparty.PortId == msg.SourcePort && channel.Counterparty.ChannelId == msg.SourceChannel {
+			localPort = channel.PortId
+			localChannel = channel.ChannelId
+			return true
+		}
+		return false
+	})
+
+	if localChannel == "" {
+		return "", errors.New("unable to find local transfer channel")
+	}
+
+	return ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(localPort, localChannel, msg.Token.Denom)).IBCDenom(), nil
 }
 
 func (k *Keeper) HandleDistributeFeesFromModuleAccount(ctx sdk.Context) error {
 	// what do we have in the account?
 	balance := k.BankKeeper.GetAllBalances(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName))
-	k.Logger(ctx).Info("distributing collected fees to stakers", "amount", balance)
-	return k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, balance) // Fee collector name needs to be passed in to keeper constructor.
+	k.Logger(ctx).Info("distributing collected fees", "amount", balance)
+	return k.RouteFees(ctx, balance)
 }
 
 func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string) error {
@@ -395,6 +591,13 @@ func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string) e
 		return k.HandleWithdrawForUser(ctx, zone, sMsg, memo)
 	case zone.IsDelegateAddress(sMsg.ToAddress) && zone.DepositAddress.Address == sMsg.FromAddress:
 		return k.handleSendToDelegate(ctx, zone, sMsg, memo)
+	case zone.DepositAddress.Address == sMsg.FromAddress && strings.HasPrefix(memo, types.MsgTypeConversion+"/"):
+		// deposit assets that could not be converted are returned to the depositor; the record is already removed.
+		k.Logger(ctx).Info("deposit asset conversion refunded", "memo", memo, "amount", sMsg.Amount)
+		return nil
+	case zone.DepositAddress.Address == sMsg.FromAddress:
+		// deposits exceeding the zone deposit limit are returned to the sender.
+		return k.handleRefundDeposit(ctx, zone, memo)
 	default:
 		err = errors.New("unexpected completed send")
 		k.Logger(ctx).Error(err.Error())
@@ -402,24 +605,62 @@ func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string) e
 	}
 }
 
+func (k *Keeper) handleRefundDeposit(ctx sdk.Context, zone *types.Zone, memo string) error {
+	receipt, found := k.GetReceipt(ctx, types.GetReceiptKey(zone.ChainId, memo))
+	if !found || !receipt.Refunded {
+		return fmt.Errorf("unable to find refunded receipt for %s", memo)
+	}
+	t := ctx.BlockTime()
+	receipt.Completed = &t
+	k.SetReceipt(ctx, receipt)
+	return nil
+}
+
 func (k *Keeper) handleRewardsDelegation(ctx sdk.Context, zone types.Zone, msg *banktypes.MsgSend) error {
 	return k.handleSendToDelegate(ctx, &zone, msg, "rewards")
 }
 
 func (k *Keeper) handleSendToDelegate(ctx sdk.Context, zone *types.Zone, msg *banktypes.MsgSend, memo string) error {
+	account, found := zone.GetDelegationAccountByAddress(msg.ToAddress)
+	if !found {
+		return fmt.Errorf("unable to find delegation account %s", msg.ToAddress)
+	}
+
 	var msgs []sdk.Msg
 	for _, coin := range msg.Amount {
+		if zone.IsOffboarding() {
+			// funds received by an offboarding zone are retained, and distributed to qAsset holders once claimable.
+			break
+		}
 		if coin.Denom == zone.BaseDenom {
-			allocations := k.DeterminePlanForDelegation(ctx, zone, msg.Amount)
-			msgs = append(msgs, k.PrepareDelegationMessagesForCoins(zone, allocations)...)
+			// retain any shortfall in the instant redemption buffer before delegating; the buffer is held only by the
+			// zone delegation address.
+			if account == zone.DelegationAddress {
+				coin = k.TopUpInstantRedemptionBuffer(ctx, zone, coin)
+			}
+			if coin.IsZero() {
+				continue
+			}
+			allocations := k.DeterminePlanForDelegation(ctx, zone, sdk.NewCoins(coin))
+			msgs = append(msgs, k.PrepareDelegationMessagesForCoins(zone, allocations, account.Address)...)
 		} else {
-			msgs = append(msgs, k.PrepareDelegationMessagesForShares(zone, msg.Amount)...)
+			msgs = append(msgs, k.PrepareDelegationMessagesForShares(zone, msg.Amount, account.Address)...)
 		}
 	}
 
 	k.Logger(ctx).Info("messages to send", "messages", msgs)
 
-	return k.SubmitTx(ctx, msgs, zone.DelegationAddress, memo)
+	if len(msgs) == 0 && memo != "rewards" {
+		// the deposit was retained in full by the instant redemption buffer, or by an offboarding zone, so no delegation
+		// will complete the receipt.
+		if receipt, found := k.GetReceipt(ctx, types.GetReceiptKey(zone.ChainId, memo)); found {
+			t := ctx.BlockTime()
+			receipt.Completed = &t
+			k.SetReceipt(ctx, receipt)
+		}
+	}
+
+	return k.SubmitTx(ctx, msgs, account, memo)
 }
 
 // withdraw for user will check that the msgSend we have successfully executed matches an existing withdrawal record.
@@ -447,6 +688,11 @@ func (k *Keeper) HandleWithdrawForUser(ctx sdk.Context, zone *types.Zone, msg *b
 		}
 		k.SetWithdrawalRecord(ctx, withdrawalRecord)
 		k.Logger(ctx).Info("burned coins post-withdrawal", "coins", withdrawalRecord.BurnAmount)
+		if withdrawalRecord.Instant {
+			// instant redemptions are paid from the buffer; draw it down in step with the burn.
+			zone.InstantRedemptionBuffer = zone.GetInstantRedemptionBuffer().Sub(withdrawalRecord.Amount.AmountOf(zone.BaseDenom))
+			k.SetZone(ctx, zone)
+		}
 	} else {
 
 		// case 2: per validator amounts - LSM unbonding
@@ -512,13 +758,10 @@ func (k *Keeper) HandleMaturedUnbondings(ctx sdk.Context, zone *types.Zone) erro
 	k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, WithdrawStatusUnbond, func(idx int64, withdrawal types.WithdrawalRecord) bool {
 		if ctx.BlockTime().After(withdrawal.CompletionTime) && !withdrawal.CompletionTime.Equal(time.Time{}) { // completion date has passed.
 			k.Logger(ctx).Info("found completed unbonding")
-			sendMsg := &banktypes.MsgSend{FromAddress: zone.DelegationAddress.GetAddress(), ToAddress: withdrawal.Recipient, Amount: sdk.Coins{withdrawal.Amount[0]}}
-			err = k.SubmitTx(ctx, []sdk.Msg{sendMsg}, zone.DelegationAddress, withdrawal.Txhash)
-			if err != nil {
+			if err = k.sendWithdrawal(ctx, zone, withdrawal); err != nil {
 				k.Logger(ctx).Error("error", err)
 				return true
 			}
-			k.Logger(ctx).Info("sending funds", "for", withdrawal.Delegator, "delegate_account", zone.DelegationAddress.GetAddress(), "to", withdrawal.Recipient, "amount", withdrawal.Amount)
 			k.UpdateWithdrawalRecordStatus(ctx, &withdrawal, WithdrawStatusSend)
 		}
 		return false
@@ -526,6 +769,141 @@ func (k *Keeper) HandleMaturedUnbondings(ctx sdk.Context, zone *types.Zone) erro
 	return err
 }
 
+// sendWithdrawal sends the withdrawn funds to the recipient from the delegation accounts holding them.
+func (k *Keeper) sendWithdrawal(ctx sdk.Context, zone *types.Zone, withdrawal types.WithdrawalRecord) error {
+	delegators := withdrawalDelegators(zone, withdrawal)
+	if len(delegators) <= 1 {
+		account := zone.DelegationAddress
+		if len(delegators) == 1 {
+			account, _ = zone.GetDelegationAccountByAddress(delegators[0])
+		}
+		sendMsg := &banktypes.MsgSend{FromAddress: account.GetAddress(), ToAddress: withdrawal.Recipient, Amount: sdk.Coins{withdrawal.Amount[0]}}
+		if err := k.SubmitTx(ctx, []sdk.Msg{sendMsg}, account, withdrawal.Txhash); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("sending funds", "for", withdrawal.Delegator, "delegate_account", account.GetAddress(), "to", withdrawal.Recipient, "amount", withdrawal.Amount)
+		return nil
+	}
+
+	// funds were unbonded from multiple delegation accounts; return each distribution from its account. The
+	// record is completed once every distribution has been acknowledged.
+	for _, dist := range withdrawal.Distribution {
+		account, found := zone.GetDelegationAccountByAddress(dist.Delegator)
+		if !found {
+			account = zone.DelegationAddress
+		}
+		sendMsg := &banktypes.MsgSend{FromAddress: account.GetAddress(), ToAddress: withdrawal.Recipient, Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewIntFromUint64(dist.Amount)))}
+		if err := k.SubmitTx(ctx, []sdk.Msg{sendMsg}, account, withdrawal.Txhash); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("sending funds", "for", withdrawal.Delegator, "delegate_account", account.GetAddress(), "to", withdrawal.Recipient, "amount", dist.Amount)
+	}
+	return nil
+}
+
+// requeueFailedWithdrawalSend returns the distributions sent by a failed MsgSend to the unbond state, such that
+// HandleMaturedUnbondings resends them. Distributions paid out by other sends are removed from the record as they are
+// acknowledged, so if the failed send accounts for every remaining distribution the record itself is reverted;
+// otherwise the failed distributions, and their share of the burn amount, are split into a new record.
+func (k *Keeper) requeueFailedWithdrawalSend(ctx sdk.Context, zone *types.Zone, withdrawal types.WithdrawalRecord, msg *banktypes.MsgSend) {
+	sent := msg.Amount.AmountOf(zone.BaseDenom)
+	pending := sdk.ZeroInt()
+	candidates := make([]int, 0)
+	for i, dist := range withdrawal.Distribution {
+		pending = pending.Add(sdk.NewIntFromUint64(dist.Amount))
+		delegator := dist.Delegator
+		if !zone.IsDelegateAddress(delegator) {
+			delegator = zone.DelegationAddress.GetAddress()
+		}
+		if delegator == msg.FromAddress {
+			candidates = append(candidates, i)
+		}
+	}
+
+	// distributions from multiple delegation accounts are sent individually; those from a single account together.
+	failed := make(map[int]bool)
+	failedAmount := sdk.ZeroInt()
+	for _, i := range candidates {
+		amount := sdk.NewIntFromUint64(withdrawal.Distribution[i].Amount)
+		if amount.Equal(sent) {
+			failed = map[int]bool{i: true}
+			failedAmount = amount
+			break
+		}
+		failed[i] = true
+		failedAmount = failedAmount.Add(amount)
+	}
+
+	if !sent.IsPositive() || !failedAmount.Equal(sent) {
+		if len(withdrawalDelegators(zone, withdrawal)) > 1 {
+			k.Logger(ctx).Error("unable to match failed send to withdrawal distributions; funds remain in delegate account", "hash", withdrawal.Txhash, "from", msg.FromAddress, "amount", msg.Amount)
+			return
+		}
+		// the record was sent in full from a single account.
+		k.Logger(ctx).Info("reverting withdrawal record to unbond status for failed send", "hash", withdrawal.Txhash)
+		k.UpdateWithdrawalRecordStatus(ctx, &withdrawal, WithdrawStatusUnbond)
+		return
+	}
+
+	if failedAmount.Equal(pending) {
+		k.Logger(ctx).Info("reverting withdrawal record to unbond status for failed send", "hash", withdrawal.Txhash)
+		withdrawal.Amount = sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, failedAmount))
+		k.UpdateWithdrawalRecordStatus(ctx, &withdrawal, WithdrawStatusUnbond)
+		return
+	}
+
+	requeuedDistribution := make([]*types.Distribution, 0, len(failed))
+	remainingDistribution := make([]*types.Distribution, 0, len(withdrawal.Distribution)-len(failed))
+	for i, dist := range withdrawal.Distribution {
+		if failed[i] {
+			requeuedDistribution = append(requeuedDistribution, dist)
+		} else {
+			remainingDistribution = append(remainingDistribution, dist)
+		}
+	}
+
+	rr := sdk.NewDecFromInt(withdrawal.BurnAmount.Amount).Quo(sdk.NewDecFromInt(pending))
+	relatedQAsset := sdk.NewDecFromInt(failedAmount).Mul(rr).TruncateInt()
+	withdrawal.Distribution = remainingDistribution
+	withdrawal.Amount = sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, pending.Sub(failedAmount)))
+	withdrawal.BurnAmount = withdrawal.BurnAmount.SubAmount(relatedQAsset)
+	k.SetWithdrawalRecord(ctx, withdrawal)
+
+	// create a new record with the failed amount
+	newWdr := types.WithdrawalRecord{
+		ChainId:        zone.ChainId,
+		Delegator:      withdrawal.Delegator,
+		Recipient:      withdrawal.Recipient,
+		Distribution:   requeuedDistribution,
+		Amount:         sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, failedAmount)),
+		BurnAmount:     sdk.NewCoin(withdrawal.BurnAmount.Denom, relatedQAsset),
+		Txhash:         fmt.Sprintf("%064d", k.GetNextWithdrawalRecordSequence(ctx)),
+		Status:         WithdrawStatusUnbond,
+		CompletionTime: withdrawal.CompletionTime,
+		Requeued:       true,
+	}
+	k.Logger(ctx).Info("requeueing failed distributions of withdrawal record", "hash", withdrawal.Txhash, "new_hash", newWdr.Txhash, "from", msg.FromAddress, "amount", failedAmount)
+	k.SetWithdrawalRecord(ctx, newWdr)
+}
+
+// withdrawalDelegators returns the distinct delegation accounts from which the withdrawal was unbonded. Distributions
+// not attributed to a known delegation account are attributed to the zone delegation address.
+func withdrawalDelegators(zone *types.Zone, withdrawal types.WithdrawalRecord) []string {
+	delegators := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, dist := range withdrawal.Distribution {
+		delegator := dist.Delegator
+		if !zone.IsDelegateAddress(delegator) {
+			delegator = zone.DelegationAddress.GetAddress()
+		}
+		if !seen[delegator] {
+			seen[delegator] = true
+			delegators = append(delegators, delegator)
+		}
+	}
+	return delegators
+}
+
 func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, sharesAmount sdk.Coin, memo string) error {
 	var err error
 	k.Logger(ctx).Info("received MsgTokenizeShares acknowledgement")
@@ -553,8 +931,9 @@ func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, sharesAmoun
 				k.Logger(ctx).Info("Found matching withdrawal; marking for send")
 				k.DeleteWithdrawalRecord(ctx, zone.ChainId, memo, WithdrawStatusTokenize)
 				withdrawalRecord.Status = WithdrawStatusSend
-				sendMsg := &banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: withdrawalRecord.Recipient, Amount: withdrawalRecord.Amount}
-				err = k.SubmitTx(ctx, []sdk.Msg{sendMsg}, zone.DelegationAddress, memo)
+				account, _ := zone.GetDelegationAccountByAddress(tsMsg.DelegatorAddress)
+				sendMsg := &banktypes.MsgSend{FromAddress: account.Address, ToAddress: withdrawalRecord.Recipient, Amount: withdrawalRecord.Amount}
+				err = k.SubmitTx(ctx, []sdk.Msg{sendMsg}, account, memo)
 				if err != nil {
 					return err
 				}
@@ -617,8 +996,20 @@ func (k *Keeper) HandleFailedBeginRedelegate(ctx sdk.Context, msg sdk.Msg, memo
 		return errors.New("unable to unmarshal MsgBeginRedelegate")
 	}
 	zone := k.GetZoneForDelegateAccount(ctx, redelegateMsg.DelegatorAddress)
-	k.DeleteRedelegationRecord(ctx, zone.ChainId, redelegateMsg.ValidatorSrcAddress, redelegateMsg.ValidatorDstAddress, epochNumber)
-	k.Logger(ctx).Error("Cleaning up redelegation record")
+	record, found := k.GetRedelegationRecord(ctx, zone.ChainId, redelegateMsg.ValidatorSrcAddress, redelegateMsg.ValidatorDstAddress, epochNumber)
+	if !found {
+		k.Logger(ctx).Error("unable to find redelegation record", "chain", zone.ChainId, "source", redelegateMsg.ValidatorSrcAddress, "dst", redelegateMsg.ValidatorDstAddress, "epoch_number", epochNumber)
+		return nil
+	}
+	// a redelegation may be split across delegation accounts; only the amount of the failed message is removed.
+	record.Amount -= redelegateMsg.Amount.Amount.Int64()
+	if record.Amount <= 0 {
+		k.Logger(ctx).Error("Cleaning up redelegation record")
+		k.DeleteRedelegationRecord(ctx, zone.ChainId, redelegateMsg.ValidatorSrcAddress, redelegateMsg.ValidatorDstAddress, epochNumber)
+		return nil
+	}
+	k.Logger(ctx).Error("Reducing redelegation record by failed amount", "amount", redelegateMsg.Amount.Amount, "remaining", record.Amount)
+	k.SetRedelegationRecord(ctx, record)
 	return nil
 }
 
@@ -635,30 +1026,38 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 		return errors.New("unable to cast source message to MsgUndelegate")
 	}
 
-	epochNumber, err := types.ParseMsgMemo(memo, types.MsgTypeWithdrawal)
-	if err != nil {
-		return err
-	}
-
 	zone := k.GetZoneForDelegateAccount(ctx, undelegateMsg.DelegatorAddress)
 
-	ubr, found := k.GetUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
-	if !found {
-		return fmt.Errorf("unbonding record for %s not found for epoch %d", undelegateMsg.ValidatorAddress, epochNumber)
-	}
-
-	for _, hash := range ubr.RelatedTxhash {
-		k.Logger(ctx).Info("MsgUndelegate", "del", undelegateMsg.DelegatorAddress, "val", undelegateMsg.ValidatorAddress, "hash", hash, "chain", zone.ChainId)
+	if _, err := types.ParseMsgMemo(memo, types.MsgTypeOffboard); err == nil {
+		// undelegations of an offboarding zone have no withdrawal records; the zone is settled once the last matures.
+		if zone.OffboardingUnbondingCompletion == nil || completion.After(*zone.OffboardingUnbondingCompletion) {
+			zone.OffboardingUnbondingCompletion = &completion
+			k.SetZone(ctx, zone)
+		}
+	} else {
+		epochNumber, err := types.ParseMsgMemo(memo, types.MsgTypeWithdrawal)
+		if err != nil {
+			return err
+		}
 
-		record, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, WithdrawStatusUnbond)
+		ubr, found := k.GetUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
 		if !found {
-			return fmt.Errorf("unable to lookup withdrawal record; chain: %s, hash: %s", zone.ChainId, hash)
+			return fmt.Errorf("unbonding record for %s not found for epoch %d", undelegateMsg.ValidatorAddress, epochNumber)
 		}
-		if completion.After(record.CompletionTime) {
-			record.CompletionTime = completion
+
+		for _, hash := range ubr.RelatedTxhash {
+			k.Logger(ctx).Info("MsgUndelegate", "del", undelegateMsg.DelegatorAddress, "val", undelegateMsg.ValidatorAddress, "hash", hash, "chain", zone.ChainId)
+
+			record, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, WithdrawStatusUnbond)
+			if !found {
+				return fmt.Errorf("unable to lookup withdrawal record; chain: %s, hash: %s", zone.ChainId, hash)
+			}
+			if completion.After(record.CompletionTime) {
+				record.CompletionTime = completion
+			}
+			k.Logger(ctx).Info("withdrawal record to save", "rcd", record)
+			k.UpdateWithdrawalRecordStatus(ctx, &record, WithdrawStatusUnbond)
 		}
-		k.Logger(ctx).Info("withdrawal record to save", "rcd", record)
-		k.UpdateWithdrawalRecordStatus(ctx, &record, WithdrawStatusUnbond)
 	}
 
 	delAddr, err := utils.AccAddressFromBech32(undelegateMsg.DelegatorAddress, "")
@@ -689,6 +1088,12 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 }
 
 func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo string) error {
+	if _, err := types.ParseMsgMemo(memo, types.MsgTypeOffboard); err == nil {
+		// the delegation remains, and is undelegated again at the end of the next epoch.
+		k.Logger(ctx).Error("Received offboarding MsgUndelegate acknowledgement error; retrying next epoch")
+		return nil
+	}
+
 	epochNumber, err := types.ParseMsgMemo(memo, types.MsgTypeWithdrawal)
 	if err != nil {
 		return err
@@ -707,6 +1112,13 @@ func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo strin
 		return fmt.Errorf("cannot find unbonding record for %s/%s/%d", zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
 	}
 
+	// only distributions undelegated by the failed message's delegator are requeued; those undelegated from the same
+	// validator by other delegation accounts remain unbonding.
+	failed := func(dist *types.Distribution) bool {
+		return dist.Valoper == ubr.Validator && (dist.Delegator == "" || dist.Delegator == undelegateMsg.DelegatorAddress)
+	}
+	unbonding := make([]string, 0)
+
 	for _, hash := range ubr.RelatedTxhash {
 		wdr, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, WithdrawStatusUnbond)
 		if !found {
@@ -717,6 +1129,10 @@ func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo strin
 			if wdr.Distribution[0].Valoper != ubr.Validator {
 				return fmt.Errorf("unable to requeue withdrawal record for failed unbonding; expected %s, got %s", ubr.Validator, wdr.Distribution[0].Valoper)
 			}
+			if !failed(wdr.Distribution[0]) {
+				unbonding = append(unbonding, hash)
+				continue
+			}
 			wdr.Distribution = nil
 			wdr.Requeued = true
 			k.UpdateWithdrawalRecordStatus(ctx, &wdr, WithdrawStatusQueued)
@@ -725,12 +1141,18 @@ func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo strin
 			newDistribution := make([]*types.Distribution, 0)
 			relatedAmount := uint64(0)
 			for _, dist := range wdr.Distribution {
-				if dist.Valoper != ubr.Validator {
+				if !failed(dist) {
 					newDistribution = append(newDistribution, dist)
+					if dist.Valoper == ubr.Validator && (len(unbonding) == 0 || unbonding[len(unbonding)-1] != hash) {
+						unbonding = append(unbonding, hash)
+					}
 				} else {
-					relatedAmount = dist.Amount
+					relatedAmount += dist.Amount
 				}
 			}
+			if relatedAmount == 0 {
+				continue
+			}
 			wdr.Distribution = newDistribution
 			amount := wdr.Amount.AmountOf(zone.BaseDenom)
 			wdr.Amount = wdr.Amount.Sub(sdk.NewCoin(zone.BaseDenom, sdk.NewIntFromUint64(relatedAmount)))
@@ -754,6 +1176,12 @@ func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo strin
 		}
 	}
 
+	if len(unbonding) > 0 {
+		ubr.RelatedTxhash = unbonding
+		k.SetUnbondingRecord(ctx, ubr)
+		return nil
+	}
+
 	k.DeleteUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
 	k.Logger(ctx).Error("Cleaning up redelegation record")
 	return nil
@@ -793,7 +1221,11 @@ func (k *Keeper) HandleDelegate(ctx sdk.Context, msg sdk.Msg, memo string) error
 		return fmt.Errorf("unable to find zone for address %s", delegateMsg.DelegatorAddress)
 	}
 
-	if memo != "rewards" {
+	if strings.HasPrefix(memo, types.MsgTypeConversion+"/") {
+		if err := k.HandleConversionDelegated(ctx, zone, memo, delegateMsg.DelegatorAddress); err != nil {
+			return err
+		}
+	} else if memo != "rewards" && memo != InstantRedemptionBufferMemo {
 		receipt, found := k.GetReceipt(ctx, types.GetReceiptKey(zone.ChainId, memo))
 		if !found {
 			return fmt.Errorf("unable to find receipt for hash %s", memo)
@@ -829,7 +1261,8 @@ func (k *Keeper) HandleUpdatedWithdrawAddress(ctx sdk.Context, msg sdk.Msg) erro
 			return err
 		}
 	} else {
-		if err := zone.DelegationAddress.SetWithdrawalAddress(original.WithdrawAddress); err != nil {
+		account, _ := zone.GetDelegationAccountByAddress(original.DelegatorAddress)
+		if err := account.SetWithdrawalAddress(original.WithdrawAddress); err != nil {
 			return err
 		}
 	}
@@ -983,59 +1416,91 @@ func (k *Keeper) HandleWithdrawRewards(ctx sdk.Context, msg sdk.Msg) error {
 	k.Logger(ctx).Info("Received MsgWithdrawDelegatorReward acknowledgement", "wg", zone.WithdrawalWaitgroup, "delegator", withdrawalMsg.DelegatorAddress)
 	switch zone.WithdrawalWaitgroup {
 	case 0:
-		// interface assertion
-		balanceQuery := banktypes.QueryAllBalancesRequest{Address: zone.WithdrawalAddress.Address}
-		bz, err := k.cdc.Marshal(&balanceQuery)
-		if err != nil {
-			return err
-		}
-		k.Logger(ctx).Info("Distributing rewards")
-		// total rewards balance withdrawn
-		k.ICQKeeper.MakeRequest(
-			ctx,
-			zone.ConnectionId,
-			zone.ChainId,
-			"cosmos.bank.v1beta1.Query/AllBalances",
-			bz,
-			sdk.NewInt(int64(-1)),
-			types.ModuleName,
-			"distributerewards",
-			0,
-		)
-		return nil
+		return k.RequestRewardsDistribution(ctx, zone)
 	default:
 		return nil
 	}
 }
 
+// RequestRewardsDistribution queries the withdrawal account balance of the zone's base denom, with proof, in order
+// to distribute the total rewards withdrawn for the zone.
+func (k *Keeper) RequestRewardsDistribution(ctx sdk.Context, zone *types.Zone) error {
+	_, addr, err := bech32.DecodeAndConvert(zone.WithdrawalAddress.Address)
+	if err != nil {
+		return err
+	}
+	data := banktypes.CreateAccountBalancesPrefix(addr)
+
+	k.Logger(ctx).Info("Distributing rewards")
+	// total rewards balance withdrawn
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		types.BankStoreKey,
+		append(data, []byte(zone.BaseDenom)...),
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		"distributerewards",
+		0,
+	)
+	return nil
+}
+
 func DistributeRewardsFromWithdrawAccount(k *Keeper, ctx sdk.Context, args []byte, query queryTypes.Query) error {
 	zone, found := k.GetZone(ctx, query.ChainId)
 	if !found {
 		return fmt.Errorf("unable to find zone for %s", query.ChainId)
 	}
 
-	// query all balances as chains can accumulate fees in different denoms.
-	withdrawBalance := banktypes.QueryAllBalancesResponse{}
+	if len(query.Request) < 2 {
+		return errors.New("account balance icq request must always have a length of at least 2 bytes")
+	}
+	accAddr, denom, err := banktypes.AddressAndDenomFromBalancesStore(query.Request[1:])
+	if err != nil {
+		return err
+	}
+	address, err := bech32.ConvertAndEncode(zone.AccountPrefix, accAddr)
+	if err != nil {
+		return err
+	}
+	if address != zone.WithdrawalAddress.Address || denom != zone.BaseDenom {
+		return fmt.Errorf("unexpected rewards balance query for %s %s", address, denom)
+	}
 
-	err := k.cdc.Unmarshal(args, &withdrawBalance)
+	// the proven base denom balance of the withdrawal account.
+	withdrawBalance, err := bankkeeper.UnmarshalBalanceCompat(k.cdc, args, zone.BaseDenom)
 	if err != nil {
 		return err
 	}
-	baseDenomAmount := withdrawBalance.Balances.AmountOf(zone.BaseDenom)
+	if withdrawBalance.Denom != zone.BaseDenom {
+		return fmt.Errorf("received coin denom %s does not match requested denom %s", withdrawBalance.Denom, zone.BaseDenom)
+	}
+	if err := withdrawBalance.Validate(); err != nil {
+		return err
+	}
+
+	baseDenomAmount := withdrawBalance.Amount
 	// calculate fee (fee = amount * rate)
 
 	baseDenomFee := sdk.NewDecFromInt(baseDenomAmount).
-		Mul(k.GetCommissionRate(ctx)).
+		Mul(k.GetZoneCommissionRate(ctx, &zone)).
 		TruncateInt()
 
 	// prepare rewards distribution
 	rewards := sdk.NewCoin(zone.BaseDenom, baseDenomAmount.Sub(baseDenomFee))
 
 	var msgs []sdk.Msg
-	msgs = append(msgs, k.prepareRewardsDistributionMsgs(zone, rewards.Amount))
-
-	// multiDenomFee is the balance of withdrawal account minus the redelegated rewards.
-	multiDenomFee := withdrawBalance.Balances.Sub(sdk.Coins{rewards}...)
+	msgs = append(msgs, k.prepareRewardsDistributionMsgs(ctx, zone, rewards.Amount))
+
+	// chains can accumulate fees in different denoms; multiDenomFee is the base denom fee and the proven balances of
+	// the withdrawal account in other denoms.
+	multiDenomFee := sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, baseDenomFee))
+	for _, coin := range zone.WithdrawalAddress.Balance {
+		if coin.Denom != zone.BaseDenom {
+			multiDenomFee = multiDenomFee.Add(coin)
+		}
+	}
 
 	var remotePort string
 	var remoteChannel string
@@ -1067,17 +1532,24 @@ func DistributeRewardsFromWithdrawAccount(k *Keeper, ctx sdk.Context, args []byt
 		)
 	}
 
+	// the withdrawal account is emptied by the distribution; its balance is re-proven when next queried.
+	zone.WithdrawalAddress.Balance = sdk.NewCoins()
+	k.SetZone(ctx, &zone)
+
 	// update redemption rate
 	k.UpdateRedemptionRate(ctx, &zone, rewards.Amount)
 
+	// record the distribution against the epoch report
+	k.CompleteEpochReport(ctx, &zone, baseDenomAmount, baseDenomFee, rewards.Amount)
+
 	// send tx
 	return k.SubmitTx(ctx, msgs, zone.WithdrawalAddress, "")
 }
 
-func (k *Keeper) prepareRewardsDistributionMsgs(zone types.Zone, rewards sdkmath.Int) sdk.Msg {
+func (k *Keeper) prepareRewardsDistributionMsgs(ctx sdk.Context, zone types.Zone, rewards sdkmath.Int) sdk.Msg {
 	return &banktypes.MsgSend{
 		FromAddress: zone.WithdrawalAddress.GetAddress(),
-		ToAddress:   zone.DelegationAddress.GetAddress(),
+		ToAddress:   k.GetLeastDelegatedAccount(ctx, &zone).GetAddress(),
 		Amount:      sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, rewards)),
 	}
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"crypto/sha256"
 	"fmt"
 	"math/rand"
@@ -11,6 +12,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -19,6 +21,7 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/ingenuity-build/quicksilver/app"
 	"github.com/ingenuity-build/quicksilver/utils"
@@ -772,6 +775,658 @@ func (s *KeeperTestSuite) TestReceiveAckErrForBeginRedelegate() {
 	s.Require().False(found)
 }
 
+func (s *KeeperTestSuite) TestReceiveAckErrForShardedBeginRedelegate() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+
+	// the redelegation was split across two delegation accounts.
+	record := icstypes.RedelegationRecord{
+		ChainId:     s.chainB.ChainID,
+		EpochNumber: 1,
+		Source:      zone.Validators[0].ValoperAddress,
+		Destination: zone.Validators[1].ValoperAddress,
+		Amount:      1000,
+	}
+	app.InterchainstakingKeeper.SetRedelegationRecord(ctx, record)
+
+	ackBytes := []byte("{\"error\":\"ABCI code: 32: error handling packet on host chain: see events for details\"}")
+	for _, amount := range []int64{600, 400} {
+		redelegate := &stakingtypes.MsgBeginRedelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorSrcAddress: zone.Validators[0].ValoperAddress, ValidatorDstAddress: zone.Validators[1].ValoperAddress, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(amount))}
+		data, err := icatypes.SerializeCosmosTx(app.InterchainstakingKeeper.GetCodec(), []sdk.Msg{redelegate})
+		s.Require().NoError(err)
+
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+			Memo: fmt.Sprintf("rebalance/%d", 1),
+		}
+		packet := channeltypes.Packet{Data: app.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
+
+		s.Require().NoError(app.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet, ackBytes))
+
+		// only the failed amount is removed from the record, which is deleted once nothing remains.
+		record, found = app.InterchainstakingKeeper.GetRedelegationRecord(ctx, zone.ChainId, zone.Validators[0].ValoperAddress, zone.Validators[1].ValoperAddress, 1)
+		if amount == 600 {
+			s.Require().True(found)
+			s.Require().Equal(int64(400), record.Amount)
+		} else {
+			s.Require().False(found)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestReceiveAckErrForUnknownSend() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+
+	send := &banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: mustGetTestBech32Address(zone.AccountPrefix), Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)))}
+	data, err := icatypes.SerializeCosmosTx(app.InterchainstakingKeeper.GetCodec(), []sdk.Msg{send})
+	s.Require().NoError(err)
+
+	for _, memo := range []string{fmt.Sprintf("%x", sha256.Sum256([]byte{0x09})), "unrecognised"} {
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+			Memo: memo,
+		}
+
+		packet := channeltypes.Packet{Data: app.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
+		ackBytes := []byte("{\"error\":\"ABCI code: 32: error handling packet on host chain: see events for details\"}")
+
+		// a failed send with no matching withdrawal record must not fail the acknowledgement.
+		s.Require().NoError(app.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet, ackBytes))
+	}
+}
+
+func (s *KeeperTestSuite) TestHandleTimeoutForBeginRedelegate() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+
+	record := icstypes.RedelegationRecord{
+		ChainId:     s.chainB.ChainID,
+		EpochNumber: 1,
+		Source:      zone.Validators[0].ValoperAddress,
+		Destination: zone.Validators[1].ValoperAddress,
+		Amount:      1000,
+	}
+
+	app.InterchainstakingKeeper.SetRedelegationRecord(ctx, record)
+
+	redelegate := &stakingtypes.MsgBeginRedelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorSrcAddress: zone.Validators[0].ValoperAddress, ValidatorDstAddress: zone.Validators[1].ValoperAddress, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))}
+	data, err := icatypes.SerializeCosmosTx(app.InterchainstakingKeeper.GetCodec(), []sdk.Msg{redelegate})
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: fmt.Sprintf("rebalance/%d", 1),
+	}
+
+	packet := channeltypes.Packet{SourcePort: zone.DelegationAddress.GetPortName(), Data: app.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
+
+	err = app.InterchainstakingKeeper.HandleTimeout(ctx, packet)
+	s.Require().NoError(err)
+
+	_, found = app.InterchainstakingKeeper.GetRedelegationRecord(ctx, zone.ChainId, zone.Validators[0].ValoperAddress, zone.Validators[1].ValoperAddress, 1)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestHandleTimeoutForDelegate() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte{0x01}))
+	receipt := app.InterchainstakingKeeper.NewReceipt(ctx, &zone, utils.GenerateAccAddressForTest().String(), hash, sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(3000))))
+	app.InterchainstakingKeeper.SetReceipt(ctx, *receipt)
+
+	timeout := func(memo string, msgs ...sdk.Msg) {
+		data, err := icatypes.SerializeCosmosTx(app.InterchainstakingKeeper.GetCodec(), msgs)
+		s.Require().NoError(err)
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: memo}
+		packet := channeltypes.Packet{SourcePort: zone.DelegationAddress.GetPortName(), Data: app.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
+		s.Require().NoError(app.InterchainstakingKeeper.HandleTimeout(ctx, packet))
+	}
+
+	delegateA := &stakingtypes.MsgDelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: zone.Validators[0].ValoperAddress, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))}
+	delegateB := &stakingtypes.MsgDelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: zone.Validators[1].ValoperAddress, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))}
+
+	// two chunks of the same deposit, both delegating to the first validator.
+	timeout(hash, delegateA, delegateB)
+	timeout(hash, delegateA)
+	// delegations of converted deposit assets are resent with the conversion.
+	timeout(fmt.Sprintf("%s/%s", icstypes.MsgTypeConversion, hash), delegateA)
+
+	records := app.InterchainstakingKeeper.ZoneTimedOutDelegations(ctx, zone.ChainId)
+	s.Require().Equal(2, len(records))
+	record, found := app.InterchainstakingKeeper.GetTimedOutDelegation(ctx, zone.ChainId, hash, zone.DelegationAddress.Address, zone.Validators[0].ValoperAddress)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(2000)), record.Amount)
+
+	inFlight := len(app.InterchainstakingKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, icskeeper.ICAPacketStatusInFlight))
+	s.Require().NoError(app.InterchainstakingKeeper.RetryTimedOutDelegations(ctx, &zone))
+	s.Require().Equal(0, len(app.InterchainstakingKeeper.ZoneTimedOutDelegations(ctx, zone.ChainId)))
+
+	packets := app.InterchainstakingKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, icskeeper.ICAPacketStatusInFlight)
+	s.Require().Equal(inFlight+1, len(packets))
+	s.Require().Equal(hash, packets[len(packets)-1].Memo)
+
+	// the acknowledgement of the resent delegation completes the receipt.
+	s.Require().NoError(app.InterchainstakingKeeper.HandleDelegate(ctx, &stakingtypes.MsgDelegate{DelegatorAddress: record.DelegatorAddress, ValidatorAddress: record.ValidatorAddress, Amount: record.Amount}, hash))
+	completed, found := app.InterchainstakingKeeper.GetReceipt(ctx, icstypes.GetReceiptKey(zone.ChainId, hash))
+	s.Require().True(found)
+	s.Require().NotNil(completed.Completed)
+}
+
+func (s *KeeperTestSuite) TestHandleTimeoutForWithdrawalSend() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte{0x01}))
+	record := icstypes.WithdrawalRecord{
+		ChainId:        zone.ChainId,
+		Delegator:      utils.GenerateAccAddressForTest().String(),
+		Recipient:      mustGetTestBech32Address(zone.AccountPrefix),
+		Amount:         sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))),
+		BurnAmount:     sdk.NewCoin(zone.LocalDenom, sdk.NewInt(900)),
+		Txhash:         hash,
+		Status:         icskeeper.WithdrawStatusSend,
+		CompletionTime: ctx.BlockTime().Add(-time.Hour),
+	}
+	app.InterchainstakingKeeper.SetWithdrawalRecord(ctx, record)
+
+	send := &banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: record.Recipient, Amount: record.Amount}
+	data, err := icatypes.SerializeCosmosTx(app.InterchainstakingKeeper.GetCodec(), []sdk.Msg{send})
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: hash,
+	}
+
+	packet := channeltypes.Packet{SourcePort: zone.DelegationAddress.GetPortName(), Data: app.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
+
+	err = app.InterchainstakingKeeper.HandleTimeout(ctx, packet)
+	s.Require().NoError(err)
+
+	_, found = app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusSend)
+	s.Require().False(found)
+	_, found = app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusUnbond)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestHandleTimeoutForShardedWithdrawalSend() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+	shard := &icstypes.ICAAccount{Address: mustGetTestBech32Address(zone.AccountPrefix), PortName: zone.ChainId + ".delegate.1"}
+	zone.DelegationShards = []*icstypes.ICAAccount{shard}
+	app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte{0x01}))
+	record := icstypes.WithdrawalRecord{
+		ChainId:   zone.ChainId,
+		Delegator: utils.GenerateAccAddressForTest().String(),
+		Distribution: []*icstypes.Distribution{
+			{Valoper: zone.Validators[0].ValoperAddress, Amount: 600, Delegator: zone.DelegationAddress.Address},
+			{Valoper: zone.Validators[1].ValoperAddress, Amount: 300, Delegator: shard.Address},
+			{Valoper: zone.Validators[2].ValoperAddress, Amount: 100, Delegator: shard.Address},
+		},
+		Recipient:      mustGetTestBech32Address(zone.AccountPrefix),
+		Amount:         sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))),
+		BurnAmount:     sdk.NewCoin(zone.LocalDenom, sdk.NewInt(900)),
+		Txhash:         hash,
+		Status:         icskeeper.WithdrawStatusSend,
+		CompletionTime: ctx.BlockTime().Add(-time.Hour),
+	}
+	app.InterchainstakingKeeper.SetWithdrawalRecord(ctx, record)
+
+	timeout := func(from string, amount int64) {
+		send := &banktypes.MsgSend{FromAddress: from, ToAddress: record.Recipient, Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(amount)))}
+		data, err := icatypes.SerializeCosmosTx(app.InterchainstakingKeeper.GetCodec(), []sdk.Msg{send})
+		s.Require().NoError(err)
+
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+			Memo: hash,
+		}
+		packet := channeltypes.Packet{SourcePort: zone.DelegationAddress.GetPortName(), Data: app.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
+		s.Require().NoError(app.InterchainstakingKeeper.HandleTimeout(ctx, packet))
+	}
+
+	// the send from the shard failed while the send from the delegation address is in flight; only the failed
+	// distribution is returned to the unbond state.
+	timeout(shard.Address, 300)
+
+	sending, found := app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusSend)
+	s.Require().True(found)
+	s.Require().Len(sending.Distribution, 2)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(700))), sending.Amount)
+	s.Require().Equal(sdk.NewInt(630), sending.BurnAmount.Amount)
+
+	requeued := app.InterchainstakingKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId)
+	unbonding := make([]icstypes.WithdrawalRecord, 0)
+	for _, wdr := range requeued {
+		if wdr.Status == icskeeper.WithdrawStatusUnbond {
+			unbonding = append(unbonding, wdr)
+		}
+	}
+	s.Require().Len(unbonding, 1)
+	s.Require().NotEqual(hash, unbonding[0].Txhash)
+	s.Require().Equal(record.Recipient, unbonding[0].Recipient)
+	s.Require().Equal([]*icstypes.Distribution{record.Distribution[1]}, unbonding[0].Distribution)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(300))), unbonding[0].Amount)
+	s.Require().Equal(sdk.NewInt(270), unbonding[0].BurnAmount.Amount)
+
+	// the send from the delegation address is paid out; the last remaining distribution fails, reverting the record
+	// for the unpaid amount only.
+	send := banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: record.Recipient, Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(600)))}
+	s.Require().NoError(app.InterchainstakingKeeper.HandleWithdrawForUser(ctx, &zone, &send, hash))
+	timeout(shard.Address, 100)
+
+	_, found = app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusSend)
+	s.Require().False(found)
+	reverted, found := app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusUnbond)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100))), reverted.Amount)
+	s.Require().Equal(sdk.NewInt(630), reverted.BurnAmount.Amount)
+}
+
+func (s *KeeperTestSuite) TestHandleWithdrawForUserInstant() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+	zone.InstantRedemptionBuffer = sdk.NewInt(5000)
+	app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte{0x01}))
+	fee := sdk.NewCoin(zone.BaseDenom, sdk.NewInt(5))
+	record := icstypes.WithdrawalRecord{
+		ChainId:        zone.ChainId,
+		Delegator:      utils.GenerateAccAddressForTest().String(),
+		Recipient:      mustGetTestBech32Address(zone.AccountPrefix),
+		Amount:         sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(995))),
+		BurnAmount:     sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000)),
+		Txhash:         hash,
+		Status:         icskeeper.WithdrawStatusSend,
+		CompletionTime: ctx.BlockTime(),
+		Instant:        true,
+		InstantFee:     &fee,
+	}
+	app.InterchainstakingKeeper.SetWithdrawalRecord(ctx, record)
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(record.BurnAmount)))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, icstypes.ModuleName, icstypes.EscrowModuleAccount, sdk.NewCoins(record.BurnAmount)))
+
+	s.Require().Equal(sdk.NewInt(4005), app.InterchainstakingKeeper.GetAvailableInstantRedemptionBuffer(ctx, &zone))
+
+	send := banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: record.Recipient, Amount: record.Amount}
+	err := app.InterchainstakingKeeper.HandleWithdrawForUser(ctx, &zone, &send, hash)
+	s.Require().NoError(err)
+
+	_, found = app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusCompleted)
+	s.Require().True(found)
+
+	zone, found = app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(4005), zone.InstantRedemptionBuffer)
+	s.Require().Equal(sdk.NewInt(4005), app.InterchainstakingKeeper.GetAvailableInstantRedemptionBuffer(ctx, &zone))
+}
+
+func (s *KeeperTestSuite) TestHandleTimeoutForInstantRedemptionSend() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+	zone.InstantRedemptionBuffer = sdk.NewInt(5000)
+	app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte{0x01}))
+	fee := sdk.NewCoin(zone.BaseDenom, sdk.NewInt(5))
+	record := icstypes.WithdrawalRecord{
+		ChainId:        zone.ChainId,
+		Delegator:      utils.GenerateAccAddressForTest().String(),
+		Recipient:      mustGetTestBech32Address(zone.AccountPrefix),
+		Amount:         sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(995))),
+		BurnAmount:     sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000)),
+		Txhash:         hash,
+		Status:         icskeeper.WithdrawStatusSend,
+		CompletionTime: ctx.BlockTime(),
+		Instant:        true,
+		InstantFee:     &fee,
+	}
+	app.InterchainstakingKeeper.SetWithdrawalRecord(ctx, record)
+
+	send := &banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: record.Recipient, Amount: record.Amount}
+	data, err := icatypes.SerializeCosmosTx(app.InterchainstakingKeeper.GetCodec(), []sdk.Msg{send})
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: hash,
+	}
+
+	packet := channeltypes.Packet{SourcePort: zone.DelegationAddress.GetPortName(), Data: app.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
+
+	err = app.InterchainstakingKeeper.HandleTimeout(ctx, packet)
+	s.Require().NoError(err)
+
+	// the failed instant redemption is queued for unbonding, with the fee refunded.
+	_, found = app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusSend)
+	s.Require().False(found)
+	queued, found := app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusQueued)
+	s.Require().True(found)
+	s.Require().False(queued.Instant)
+	s.Require().Equal(sdk.NewInt(1000), queued.Amount.AmountOf(zone.BaseDenom))
+
+	zone, found = app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(5000), zone.InstantRedemptionBuffer)
+}
+
+func (s *KeeperTestSuite) TestHandleSendToDelegateTopsUpBuffer() {
+	tests := []struct {
+		name           string
+		deposit        int64
+		expectBuffer   int64
+		expectComplete bool
+	}{
+		{
+			name:           "deposit exceeds shortfall",
+			deposit:        2000000,
+			expectBuffer:   1200000,
+			expectComplete: false,
+		},
+		{
+			name:           "deposit retained in full",
+			deposit:        1000000,
+			expectBuffer:   1000000,
+			expectComplete: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			s.setupTestZones()
+
+			app := s.GetQuicksilverApp(s.chainA)
+			ctx := s.chainA.GetContext()
+			ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+			zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+			if !found {
+				s.Fail("unable to retrieve zone for test")
+			}
+			zone.LiquidityModule = false
+			zone.InstantRedemptionBufferRate = sdk.NewDecWithPrec(1, 1)
+			app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+			for _, valoper := range zone.GetValidatorsAddressesAsSlice() {
+				app.InterchainstakingKeeper.SetDelegation(ctx, &zone, icstypes.NewDelegation(zone.DelegationAddress.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(3000000))))
+			}
+
+			hash := fmt.Sprintf("%x", sha256.Sum256([]byte{0x01}))
+			amount := sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(tt.deposit)))
+			app.InterchainstakingKeeper.SetReceipt(ctx, icstypes.Receipt{ChainId: zone.ChainId, Sender: utils.GenerateAccAddressForTest().String(), Txhash: hash, Amount: amount})
+
+			send := &banktypes.MsgSend{FromAddress: zone.DepositAddress.Address, ToAddress: zone.DelegationAddress.Address, Amount: amount}
+			err := app.InterchainstakingKeeper.HandleCompleteSend(ctx, send, hash)
+			s.Require().NoError(err)
+
+			zone, found = app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+			s.Require().True(found)
+			s.Require().Equal(sdk.NewInt(tt.expectBuffer), zone.InstantRedemptionBuffer)
+
+			receipt, found := app.InterchainstakingKeeper.GetReceipt(ctx, icstypes.GetReceiptKey(zone.ChainId, hash))
+			s.Require().True(found)
+			s.Require().Equal(tt.expectComplete, receipt.Completed != nil)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestHandleReceiptForTransactionDepositLimit() {
+	tests := []struct {
+		name         string
+		deposit      int64
+		expectRefund bool
+	}{
+		{
+			name:         "within limit",
+			deposit:      1000000,
+			expectRefund: false,
+		},
+		{
+			name:         "exceeds limit",
+			deposit:      1000001,
+			expectRefund: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			s.setupTestZones()
+
+			quicksilver := s.GetQuicksilverApp(s.chainA)
+			ctx := s.chainA.GetContext()
+			ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+			zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+			s.Require().True(found)
+			zone.DepositLimitRate = sdk.NewDecWithPrec(1, 1)
+			quicksilver.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+			// 10,000,000 uqatom at a redemption rate of 1.0 gives a limit of 1,000,000 uatom.
+			s.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(10000000)))))
+
+			sender := utils.GenerateAccAddressForTestWithPrefix(zone.AccountPrefix)
+			amount := sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(tt.deposit)))
+			hash := fmt.Sprintf("%X", sha256.Sum256([]byte{0x01}))
+			txr := &sdk.TxResponse{
+				TxHash: hash,
+				Events: []abcitypes.Event{
+					{
+						Type: icstypes.TransferPort,
+						Attributes: []abcitypes.EventAttribute{
+							{Key: []byte("sender"), Value: []byte(sender)},
+							{Key: []byte("recipient"), Value: []byte(zone.DepositAddress.Address)},
+							{Key: []byte("amount"), Value: []byte(amount.String())},
+						},
+					},
+				},
+			}
+
+			err := quicksilver.InterchainstakingKeeper.HandleReceiptForTransaction(ctx, txr, &tx.Tx{Body: &tx.TxBody{}}, &zone)
+			s.Require().NoError(err)
+
+			receipt, found := quicksilver.InterchainstakingKeeper.GetReceipt(ctx, icstypes.GetReceiptKey(zone.ChainId, hash))
+			s.Require().True(found)
+			s.Require().Equal(tt.expectRefund, receipt.Refunded)
+
+			zone, found = quicksilver.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+			s.Require().True(found)
+
+			if !tt.expectRefund {
+				s.Require().Equal(sdk.NewInt(tt.deposit), zone.EpochDeposits)
+				s.Require().Equal(sdk.NewInt(10000000+tt.deposit), quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount)
+				return
+			}
+
+			// nothing minted, nor counted toward the limit.
+			s.Require().True(zone.GetEpochDeposits().IsZero())
+			s.Require().Equal(sdk.NewInt(10000000), quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount)
+
+			// the refund acknowledgement completes the receipt.
+			refund := &banktypes.MsgSend{FromAddress: zone.DepositAddress.Address, ToAddress: sender, Amount: amount}
+			s.Require().NoError(quicksilver.InterchainstakingKeeper.HandleCompleteSend(ctx, refund, hash))
+
+			receipt, found = quicksilver.InterchainstakingKeeper.GetReceipt(ctx, icstypes.GetReceiptKey(zone.ChainId, hash))
+			s.Require().True(found)
+			s.Require().NotNil(receipt.Completed)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestHandleReceiptForTransactionIntentSignal() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	quicksilver := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	vals := zone.GetValidatorsAddressesAsSlice()
+
+	// weights of 150 and 50, of 200, to the first two validators.
+	intent := []byte{}
+	for idx, weight := range []byte{150, 50} {
+		_, valBytes, err := bech32.DecodeAndConvert(vals[idx])
+		s.Require().NoError(err)
+		intent = append(append(intent, weight), valBytes...)
+	}
+	memo := icstypes.DepositMemo{Intent: intent, SignalOnly: true}.Encode()
+
+	sender := utils.GenerateAccAddressForTestWithPrefix(zone.AccountPrefix)
+	_, senderBytes, err := bech32.DecodeAndConvert(sender)
+	s.Require().NoError(err)
+	amount := sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1)))
+	hash := fmt.Sprintf("%X", sha256.Sum256([]byte{0x03}))
+	txr := &sdk.TxResponse{
+		TxHash: hash,
+		Events: []abcitypes.Event{
+			{
+				Type: icstypes.TransferPort,
+				Attributes: []abcitypes.EventAttribute{
+					{Key: []byte("sender"), Value: []byte(sender)},
+					{Key: []byte("recipient"), Value: []byte(zone.DepositAddress.Address)},
+					{Key: []byte("amount"), Value: []byte(amount.String())},
+				},
+			},
+		},
+	}
+
+	supply := quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+	s.Require().NoError(quicksilver.InterchainstakingKeeper.HandleReceiptForTransaction(ctx, txr, &tx.Tx{Body: &tx.TxBody{Memo: memo}}, &zone))
+
+	// the intent replaces any existing intent, without regard to the sender's claims.
+	delIntent, _ := quicksilver.InterchainstakingKeeper.GetDelegatorIntent(ctx, &zone, sdk.AccAddress(senderBytes).String(), false)
+	s.Require().Len(delIntent.Intents, 2)
+	s.Require().Equal(sdk.NewDecWithPrec(75, 2), delIntent.Intents.MustGetForValoper(vals[0]).Weight)
+	s.Require().Equal(sdk.NewDecWithPrec(25, 2), delIntent.Intents.MustGetForValoper(vals[1]).Weight)
+	s.Require().NotNil(delIntent.Updated)
+
+	// nothing is minted, and the transfer is refunded.
+	s.Require().Equal(supply, quicksilver.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount)
+	receipt, found := quicksilver.InterchainstakingKeeper.GetReceipt(ctx, icstypes.GetReceiptKey(zone.ChainId, hash))
+	s.Require().True(found)
+	s.Require().True(receipt.Refunded)
+}
+
+func (s *KeeperTestSuite) TestHandleTimeoutForUnknownSend() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	if !found {
+		s.Fail("unable to retrieve zone for test")
+	}
+
+	// a send with no matching withdrawal record must not fail the timeout.
+	send := &banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: mustGetTestBech32Address(zone.AccountPrefix), Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000)))}
+	data, err := icatypes.SerializeCosmosTx(app.InterchainstakingKeeper.GetCodec(), []sdk.Msg{send})
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: fmt.Sprintf("%x", sha256.Sum256([]byte{0x09})),
+	}
+
+	packet := channeltypes.Packet{SourcePort: zone.DelegationAddress.GetPortName(), Data: app.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
+	s.Require().NoError(app.InterchainstakingKeeper.HandleTimeout(ctx, packet))
+
+	// undecodable packet data is still an error.
+	s.Require().Error(app.InterchainstakingKeeper.HandleTimeout(ctx, channeltypes.Packet{SourcePort: zone.DelegationAddress.GetPortName(), Data: []byte("{}")}))
+}
+
 func (s *KeeperTestSuite) TestReceiveAckErrForBeginUndelegate() {
 	hash1 := fmt.Sprintf("%x", sha256.Sum256([]byte{0x01}))
 	hash2 := fmt.Sprintf("%x", sha256.Sum256([]byte{0x02}))
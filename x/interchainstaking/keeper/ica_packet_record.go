@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	// ICAPacketStatusUnspecified is the zero value, which is omitted when (un)marshalling; records are never stored
+	// with it.
+	ICAPacketStatusUnspecified int32 = iota
+	ICAPacketStatusInFlight
+	ICAPacketStatusAcknowledged
+	ICAPacketStatusFailed
+)
+
+// GetICAPacketRecord returns the ICA packet record for the given zone, status, channel and sequence.
+func (k *Keeper) GetICAPacketRecord(ctx sdk.Context, chainID string, status int32, channelID string, sequence uint64) (types.ICAPacketRecord, bool) {
+	record := types.ICAPacketRecord{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := store.Get(types.GetICAPacketRecordKey(chainID, status, channelID, sequence))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetICAPacketRecord stores the ICA packet record.
+func (k *Keeper) SetICAPacketRecord(ctx sdk.Context, record types.ICAPacketRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetICAPacketRecordKey(record.ChainId, record.Status, record.ChannelId, record.Sequence), bz)
+}
+
+// DeleteICAPacketRecord deletes the ICA packet record.
+func (k *Keeper) DeleteICAPacketRecord(ctx sdk.Context, chainID string, status int32, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	store.Delete(types.GetICAPacketRecordKey(chainID, status, channelID, sequence))
+}
+
+// IteratePrefixedICAPacketRecords iterates through all ICA packet records with the given prefix.
+func (k *Keeper) IteratePrefixedICAPacketRecords(ctx sdk.Context, prefixBytes []byte, fn func(index int64, record types.ICAPacketRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixBytes)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ICAPacketRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		stop := fn(i, record)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllICAPacketRecords returns every ICA packet record in the store.
+func (k *Keeper) AllICAPacketRecords(ctx sdk.Context) []types.ICAPacketRecord {
+	records := []types.ICAPacketRecord{}
+	k.IteratePrefixedICAPacketRecords(ctx, types.KeyPrefixICAPacketRecord, func(_ int64, record types.ICAPacketRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// ZoneICAPacketRecords returns every ICA packet record in the store for the specified zone and status.
+func (k *Keeper) ZoneICAPacketRecords(ctx sdk.Context, chainID string, status int32) []types.ICAPacketRecord {
+	records := []types.ICAPacketRecord{}
+	k.IteratePrefixedICAPacketRecords(ctx, types.GetICAPacketRecordsKey(chainID, status), func(_ int64, record types.ICAPacketRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// RecordICAPacketSent adds an in-flight record for an outbound ICA packet.
+func (k *Keeper) RecordICAPacketSent(ctx sdk.Context, chainID string, portID string, channelID string, sequence uint64, msgs []sdk.Msg, memo string, timeoutTimestamp uint64) {
+	msgTypes := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		msgTypes = append(msgTypes, sdk.MsgTypeURL(msg))
+	}
+
+	k.SetICAPacketRecord(ctx, types.ICAPacketRecord{
+		ChainId:          chainID,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		AccountRole:      types.GetICAAccountRole(portID),
+		MsgTypes:         msgTypes,
+		Memo:             memo,
+		SendHeight:       ctx.BlockHeight(),
+		SendTime:         ctx.BlockTime(),
+		TimeoutTimestamp: timeoutTimestamp,
+		Status:           ICAPacketStatusInFlight,
+	})
+}
+
+// UpdateICAPacketRecord moves the in-flight record for the given packet to the acknowledged or failed status.
+// errMsg is the error returned by an error acknowledgement. Packets with no in-flight record (e.g. those sent
+// before the ledger existed) are ignored.
+func (k *Keeper) UpdateICAPacketRecord(ctx sdk.Context, packet channeltypes.Packet, success bool, timedOut bool, errMsg string) {
+	zone, _ := k.GetZoneAndAccountForPort(ctx, packet.SourcePort)
+	if zone == nil {
+		return
+	}
+
+	record, found := k.GetICAPacketRecord(ctx, zone.ChainId, ICAPacketStatusInFlight, packet.SourceChannel, packet.Sequence)
+	if !found {
+		k.Logger(ctx).Debug("no in-flight record for ica packet", "port", packet.SourcePort, "channel", packet.SourceChannel, "sequence", packet.Sequence)
+		return
+	}
+
+	k.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+
+	t := ctx.BlockTime()
+	record.CompletionHeight = ctx.BlockHeight()
+	record.CompletionTime = &t
+	record.TimedOut = timedOut
+	record.Error = errMsg
+	record.Status = ICAPacketStatusFailed
+	if success {
+		record.Status = ICAPacketStatusAcknowledged
+	}
+	k.SetICAPacketRecord(ctx, record)
+}
+
+// GCAcknowledgedICAPackets removes the records of packets acknowledged more than 24 hours ago. Failed packets are
+// retained for inspection.
+func (k *Keeper) GCAcknowledgedICAPackets(ctx sdk.Context, zone *types.Zone) {
+	for _, record := range k.ZoneICAPacketRecords(ctx, zone.ChainId, ICAPacketStatusAcknowledged) {
+		if record.CompletionTime != nil && ctx.BlockTime().After(record.CompletionTime.Add(24*time.Hour)) {
+			k.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestICAPacketLedger() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	// statuses are part of the record keys, so their values must not change.
+	s.Require().Equal([]int32{0, 1, 2, 3}, []int32{keeper.ICAPacketStatusUnspecified, keeper.ICAPacketStatusInFlight, keeper.ICAPacketStatusAcknowledged, keeper.ICAPacketStatusFailed})
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := qapp.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	// clear the packets sent by zone setup.
+	for _, record := range qapp.InterchainstakingKeeper.AllICAPacketRecords(ctx) {
+		qapp.InterchainstakingKeeper.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+	}
+
+	valoper := zone.Validators[0].ValoperAddress
+	delegator := zone.DelegationAddress.Address
+
+	// submitTx sends the messages and returns the in-flight record and the packet that carried them.
+	submitTx := func(msgs []sdk.Msg, memo string) (types.ICAPacketRecord, channeltypes.Packet) {
+		before := len(qapp.InterchainstakingKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight))
+		s.Require().NoError(qapp.InterchainstakingKeeper.SubmitTx(ctx, msgs, zone.DelegationAddress, memo))
+
+		records := qapp.InterchainstakingKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)
+		s.Require().Equal(before+1, len(records))
+		record := records[len(records)-1]
+
+		data, err := icatypes.SerializeCosmosTx(qapp.InterchainstakingKeeper.GetCodec(), msgs)
+		s.Require().NoError(err)
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: memo}
+		packet := channeltypes.Packet{
+			Sequence:      record.Sequence,
+			SourcePort:    record.PortId,
+			SourceChannel: record.ChannelId,
+			Data:          qapp.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData),
+		}
+		return record, packet
+	}
+
+	// sent packets are recorded as in-flight.
+	rewardsMsgs := []sdk.Msg{
+		&distrtypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: valoper},
+		&distrtypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: zone.Validators[1].ValoperAddress},
+	}
+	record, packet := submitTx(rewardsMsgs, "rewards")
+	s.Require().Equal(zone.ChainId, record.ChainId)
+	s.Require().Equal(zone.DelegationAddress.PortName, record.PortId)
+	s.Require().Equal(types.ICASuffixDelegate, record.AccountRole)
+	s.Require().Equal([]string{"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward", "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward"}, record.MsgTypes)
+	s.Require().Equal("rewards", record.Memo)
+	s.Require().Equal(ctx.BlockHeight(), record.SendHeight)
+	s.Require().Equal(uint64(ctx.BlockTime().Add(24*time.Hour).UnixNano()), record.TimeoutTimestamp)
+
+	resp, err := qapp.InterchainstakingKeeper.InFlightICAPackets(ctx, &types.QueryICAPacketsRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ICAPacketRecord{record}, resp.Packets)
+
+	// an error acknowledgement marks the packet as failed.
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("rewards withdrawal failed"))
+	s.Require().NoError(qapp.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet, ack.Acknowledgement()))
+
+	_, found = qapp.InterchainstakingKeeper.GetICAPacketRecord(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight, record.ChannelId, record.Sequence)
+	s.Require().False(found)
+	failed, found := qapp.InterchainstakingKeeper.GetICAPacketRecord(ctx, zone.ChainId, keeper.ICAPacketStatusFailed, record.ChannelId, record.Sequence)
+	s.Require().True(found)
+	s.Require().Equal(ack.GetError(), failed.Error)
+	s.Require().False(failed.TimedOut)
+	s.Require().Equal(ctx.BlockHeight(), failed.CompletionHeight)
+
+	// a timeout marks the packet as failed.
+	delegateMsgs := []sdk.Msg{&stakingtypes.MsgDelegate{DelegatorAddress: delegator, ValidatorAddress: valoper, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100))}}
+	record, packet = submitTx(delegateMsgs, "")
+	s.Require().NoError(qapp.InterchainstakingKeeper.HandleTimeout(ctx, packet))
+
+	timedOut, found := qapp.InterchainstakingKeeper.GetICAPacketRecord(ctx, zone.ChainId, keeper.ICAPacketStatusFailed, record.ChannelId, record.Sequence)
+	s.Require().True(found)
+	s.Require().True(timedOut.TimedOut)
+
+	resp, err = qapp.InterchainstakingKeeper.FailedICAPackets(ctx, &types.QueryICAPacketsRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ICAPacketRecord{failed, timedOut}, resp.Packets)
+
+	// a successful acknowledgement marks the packet as acknowledged.
+	record, packet = submitTx(delegateMsgs, "")
+	qapp.InterchainstakingKeeper.UpdateICAPacketRecord(ctx, packet, true, false, "")
+
+	acknowledged, found := qapp.InterchainstakingKeeper.GetICAPacketRecord(ctx, zone.ChainId, keeper.ICAPacketStatusAcknowledged, record.ChannelId, record.Sequence)
+	s.Require().True(found)
+	s.Require().Empty(acknowledged.Error)
+
+	resp, err = qapp.InterchainstakingKeeper.InFlightICAPackets(ctx, &types.QueryICAPacketsRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(0, len(resp.Packets))
+
+	// acknowledged packets are garbage collected after 24 hours; failed packets are retained.
+	qapp.InterchainstakingKeeper.GCAcknowledgedICAPackets(ctx, &zone)
+	s.Require().Equal(1, len(qapp.InterchainstakingKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusAcknowledged)))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	qapp.InterchainstakingKeeper.GCAcknowledgedICAPackets(ctx, &zone)
+	s.Require().Equal(0, len(qapp.InterchainstakingKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusAcknowledged)))
+	s.Require().Equal(2, len(qapp.InterchainstakingKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusFailed)))
+
+	_, err = qapp.InterchainstakingKeeper.FailedICAPackets(ctx, &types.QueryICAPacketsRequest{ChainId: "unknown"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestSubmitTxZonePacketLimits() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := qapp.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	for _, record := range qapp.InterchainstakingKeeper.AllICAPacketRecords(ctx) {
+		qapp.InterchainstakingKeeper.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+	}
+
+	// timeouts beyond MaxICATimeout are rejected.
+	update := func(value string) error {
+		return qapp.InterchainstakingKeeper.HandleUpdateZoneProposal(ctx, types.NewUpdateZoneProposal("update", "update zone", zone.ChainId, []*types.UpdateZoneValue{{Key: "ica_timeout", Value: value}}))
+	}
+	s.Require().Error(update("2419201"))
+	s.Require().Error(update("18446744073709551615"))
+	s.Require().NoError(update("2419200"))
+
+	zone.IcaTimeout = 3600
+	zone.IcaChunkSize = 3
+	zone.IcaGasBudget = 500000
+	qapp.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	delegator := zone.DelegationAddress.Address
+	valoper := zone.Validators[0].ValoperAddress
+	amount := sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100))
+	msgs := []sdk.Msg{
+		&stakingtypes.MsgDelegate{DelegatorAddress: delegator, ValidatorAddress: valoper, Amount: amount},
+		&stakingtypes.MsgDelegate{DelegatorAddress: delegator, ValidatorAddress: valoper, Amount: amount},
+		&stakingtypes.MsgDelegate{DelegatorAddress: delegator, ValidatorAddress: valoper, Amount: amount},
+		&stakingtypes.MsgUndelegate{DelegatorAddress: delegator, ValidatorAddress: valoper, Amount: amount},
+	}
+	s.Require().NoError(qapp.InterchainstakingKeeper.SubmitTx(ctx, msgs, zone.DelegationAddress, ""))
+
+	// the gas budget admits two delegations per packet.
+	records := qapp.InterchainstakingKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)
+	s.Require().Equal(2, len(records))
+	s.Require().Equal(2, len(records[0].MsgTypes))
+	s.Require().Equal(2, len(records[1].MsgTypes))
+	for _, record := range records {
+		s.Require().Equal(uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), record.TimeoutTimestamp)
+	}
+}
@@ -93,9 +93,28 @@ func (k *Keeper) AggregateDelegatorIntents(ctx sdk.Context, zone *types.Zone) er
 	snapshot := false
 	aggregate := make(types.ValidatorIntents, 0)
 	ordinalizedIntentSum := sdk.ZeroDec()
+	expired := make([]string, 0)
+	unstamped := make([]types.DelegatorIntent, 0)
 	// reduce intents
 
 	k.IterateDelegatorIntents(ctx, zone, snapshot, func(_ int64, delIntent types.DelegatorIntent) (stop bool) {
+		// intents signalled before the zone applied a lifetime are treated as signalled now.
+		if zone.IntentLifetime > 0 && delIntent.Updated == nil {
+			updated := ctx.BlockTime()
+			delIntent.Updated = &updated
+			unstamped = append(unstamped, delIntent)
+		}
+
+		// expired intents decay toward the default aggregate intent, and are dropped once fully decayed.
+		factor := zone.IntentDecayFactor(delIntent, ctx.BlockTime())
+		if factor.IsZero() {
+			expired = append(expired, delIntent.Delegator)
+			return false
+		}
+		if factor.LT(sdk.OneDec()) {
+			delIntent = zone.DecayIntent(delIntent, factor)
+		}
+
 		// addr, localErr := sdk.AccAddressFromBech32(intent.Delegator)
 		// if localErr != nil {
 		// 	err = localErr
@@ -141,6 +160,15 @@ func (k *Keeper) AggregateDelegatorIntents(ctx sdk.Context, zone *types.Zone) er
 		return false
 	})
 
+	for _, delIntent := range unstamped {
+		k.SetDelegatorIntent(ctx, zone, delIntent, snapshot)
+	}
+
+	for _, delegator := range expired {
+		k.Logger(ctx).Info("intent expired", "user", delegator, "chain", zone.ChainId)
+		k.DeleteDelegatorIntent(ctx, zone, delegator, snapshot)
+	}
+
 	if len(aggregate) > 0 && ordinalizedIntentSum.IsZero() {
 		return errors.New("ordinalized intent sum is zero, this may happen if no claims are recorded")
 	}
@@ -154,6 +182,9 @@ func (k *Keeper) AggregateDelegatorIntents(ctx sdk.Context, zone *types.Zone) er
 		}
 	}
 
+	// redistribute weight away from validators that are ineligible for delegation.
+	newAggregate = zone.ApplyEligibility(newAggregate)
+
 	k.Logger(ctx).Info(
 		"aggregates",
 		"agg", newAggregate,
@@ -212,10 +243,47 @@ func (k *Keeper) UpdateDelegatorIntent(ctx sdk.Context, delegator sdk.AccAddress
 		return nil
 	}
 
+	updated := ctx.BlockTime()
+	delIntent.Updated = &updated
 	k.SetDelegatorIntent(ctx, zone, delIntent, snapshot)
 	return nil
 }
 
+// SignalIntentFromMemo replaces the intent of the delegator with the validator intents of the given memo, as signalled
+// from the host chain by a signal only transfer to the deposit address.
+func (k *Keeper) SignalIntentFromMemo(ctx sdk.Context, zone *types.Zone, delegator sdk.AccAddress, memo string) error {
+	// the memo intents are weighted by the transferred amount; as the intent is replaced, any positive amount will do.
+	delIntent, err := zone.UpdateIntentWithMemo(types.DelegatorIntent{Delegator: delegator.String()}, memo, sdk.OneDec(), sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.OneInt())))
+	if err != nil {
+		return err
+	}
+
+	weightSum := sdk.ZeroDec()
+	for _, valIntent := range delIntent.Intents {
+		if _, found := zone.GetValidatorByValoper(valIntent.ValoperAddress); !found {
+			return fmt.Errorf("unable to find valoper %s", valIntent.ValoperAddress)
+		}
+		weightSum = weightSum.Add(valIntent.Weight)
+	}
+	if !weightSum.IsPositive() {
+		return errors.New("signalled intent has no weight")
+	}
+
+	updated := ctx.BlockTime()
+	delIntent.Updated = &updated
+	k.SetDelegatorIntent(ctx, zone, delIntent, false)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetIntent,
+			sdk.NewAttribute(types.AttributeKeyUser, delIntent.Delegator),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+		),
+	)
+
+	return nil
+}
+
 func (k msgServer) validateValidatorIntents(zone types.Zone, intents []*types.ValidatorIntent) error {
 	errMap := make(map[string]error)
 
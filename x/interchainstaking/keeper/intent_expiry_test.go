@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	cmtypes "github.com/ingenuity-build/quicksilver/x/claimsmanager/types"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestIntentExpiry() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	icsKeeper := qapp.InterchainstakingKeeper
+
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	zone.IntentLifetime = 100
+	zone.IntentDecay = 100
+	icsKeeper.SetZone(ctx, &zone)
+	vals := zone.GetValidatorsAddressesAsSlice()
+
+	active := utils.GenerateAccAddressForTest().String()
+	decaying := utils.GenerateAccAddressForTest().String()
+	dropped := utils.GenerateAccAddressForTest().String()
+	legacy := utils.GenerateAccAddressForTest().String()
+
+	setIntent := func(delegator string, valoper string, age time.Duration, stamped bool) {
+		intent := icstypes.DelegatorIntent{
+			Delegator: delegator,
+			Intents:   icstypes.ValidatorIntents{{ValoperAddress: valoper, Weight: sdk.OneDec()}},
+		}
+		if stamped {
+			updated := ctx.BlockTime().Add(-age)
+			intent.Updated = &updated
+		}
+		icsKeeper.SetDelegatorIntent(ctx, &zone, intent, false)
+		claim := qapp.ClaimsManagerKeeper.NewClaim(delegator, zone.ChainId, cmtypes.ClaimTypeLiquidToken, zone.ChainId, 1000)
+		qapp.ClaimsManagerKeeper.SetLastEpochClaim(ctx, &claim)
+	}
+	setIntent(active, vals[0], 50*time.Second, true)
+	setIntent(decaying, vals[1], 150*time.Second, true)
+	setIntent(dropped, vals[2], 250*time.Second, true)
+	setIntent(legacy, vals[3], 0, false)
+
+	// the remaining lifetime is exposed by the intent query.
+	res, err := icsKeeper.DelegatorIntent(sdk.WrapSDKContext(ctx), &icstypes.QueryDelegatorIntentRequest{ChainId: zone.ChainId, DelegatorAddress: active})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.Expires)
+	s.Require().Equal(uint64(50), res.RemainingLifetime)
+
+	s.Require().NoError(icsKeeper.AggregateDelegatorIntents(ctx, &zone))
+
+	// fully decayed intents are dropped, and intents signalled before the lifetime applied are stamped.
+	intent, _ := icsKeeper.GetDelegatorIntent(ctx, &zone, dropped, false)
+	s.Require().Len(intent.Intents, 0)
+	intent, _ = icsKeeper.GetDelegatorIntent(ctx, &zone, legacy, false)
+	s.Require().NotNil(intent.Updated)
+	s.Require().Equal(ctx.BlockTime(), *intent.Updated)
+
+	// the decaying intent carries half its weight, the remainder spread across the default aggregate intent, such
+	// that the dropped intent validator remains in the aggregate.
+	aggregate, found := zone.AggregateIntent.GetForValoper(vals[0])
+	s.Require().True(found)
+	decayed, found := zone.AggregateIntent.GetForValoper(vals[1])
+	s.Require().True(found)
+	s.Require().True(decayed.Weight.LT(aggregate.Weight))
+	_, found = zone.AggregateIntent.GetForValoper(vals[2])
+	s.Require().True(found)
+
+	// confirming an intent refreshes it; an intent must first be signalled.
+	msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	_, err = msgSrv.ConfirmIntent(sdk.WrapSDKContext(ctx), icstypes.NewMsgConfirmIntent(zone.ChainId, sdk.MustAccAddressFromBech32(active)))
+	s.Require().NoError(err)
+	intent, _ = icsKeeper.GetDelegatorIntent(ctx, &zone, active, false)
+	s.Require().Equal(ctx.BlockTime(), *intent.Updated)
+
+	_, err = msgSrv.ConfirmIntent(sdk.WrapSDKContext(ctx), icstypes.NewMsgConfirmIntent(zone.ChainId, sdk.MustAccAddressFromBech32(dropped)))
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestUpdateZoneIntentExpiryBounds() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	update := func(key string, value string) error {
+		return qapp.InterchainstakingKeeper.HandleUpdateZoneProposal(ctx, icstypes.NewUpdateZoneProposal("update", "update zone", s.chainB.ChainID, []*icstypes.UpdateZoneValue{{Key: key, Value: value}}))
+	}
+
+	// lifetimes and decay periods beyond the maximum are rejected.
+	s.Require().Error(update("intent_lifetime", "157680001"))
+	s.Require().Error(update("intent_lifetime", "18446744073709551615"))
+	s.Require().NoError(update("intent_lifetime", "157680000"))
+	s.Require().Error(update("intent_decay", "31536001"))
+	s.Require().Error(update("intent_decay", "18446744073709551615"))
+	s.Require().NoError(update("intent_decay", "31536000"))
+
+	zone, found := qapp.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.MaxIntentLifetime, zone.IntentLifetimeDuration())
+	s.Require().Equal(icstypes.MaxIntentDecay, zone.IntentDecayDuration())
+}
@@ -42,6 +42,7 @@ type Keeper struct {
 	ICQKeeper           interchainquerykeeper.Keeper
 	AccountKeeper       types.AccountKeeper
 	BankKeeper          types.BankKeeper
+	DistrKeeper         types.DistributionKeeper
 	IBCKeeper           ibckeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
 	ClaimsManagerKeeper claimsmanagerkeeper.Keeper
@@ -55,6 +56,7 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 	icacontrollerkeeper icacontrollerkeeper.Keeper,
 	scopedKeeper *capabilitykeeper.ScopedKeeper,
 	icqKeeper interchainquerykeeper.Keeper,
@@ -72,7 +74,7 @@ func NewKeeper(
 	}
 
 	if !ps.HasKeyTable() {
-		ps = ps.WithKeyTable(types.ParamKeyTable())
+		ps = ps.WithKeyTable(types.ParamKeyTable(bankKeeper.BlockedAddr))
 	}
 
 	return Keeper{
@@ -82,6 +84,7 @@ func NewKeeper(
 		ICAControllerKeeper: icacontrollerkeeper,
 		ICQKeeper:           icqKeeper,
 		BankKeeper:          bankKeeper,
+		DistrKeeper:         distrKeeper,
 		AccountKeeper:       accountKeeper,
 		IBCKeeper:           ibcKeeper,
 		TransferKeeper:      transferKeeper,
@@ -258,14 +261,6 @@ func (k *Keeper) SetValidatorForZone(ctx sdk.Context, zone *types.Zone, data []b
 			if !validator.Tokens.IsPositive() {
 				return errors.New("incoming voting power must be greater than zero")
 			}
-			// determine difference between previous vp/shares ratio and new ratio.
-			prevRatio := val.DelegatorShares.Quo(sdk.NewDecFromInt(val.VotingPower))
-			newRatio := validator.DelegatorShares.Quo(sdk.NewDecFromInt(validator.Tokens))
-			delta := newRatio.Quo(prevRatio)
-			err = k.UpdateWithdrawalRecordsForSlash(ctx, zone, val.ValoperAddress, delta)
-			if err != nil {
-				return err
-			}
 		} else if val.Jailed && !validator.IsJailed() {
 			k.Logger(ctx).Info("Transitioning validator to unjailed state", "valoper", validator.OperatorAddress)
 
@@ -273,6 +268,23 @@ func (k *Keeper) SetValidatorForZone(ctx sdk.Context, zone *types.Zone, data []b
 			val.JailedSince = time.Time{}
 		}
 
+		// a drop in tokens per share indicates the validator was slashed; validators without tokens or shares, which
+		// have no ratio to compare, are only an error on jailing.
+		if val.VotingPower.IsPositive() && val.DelegatorShares.IsPositive() && validator.Tokens.IsPositive() && validator.DelegatorShares.IsPositive() {
+			// determine difference between previous vp/shares ratio and new ratio.
+			prevRatio := val.DelegatorShares.Quo(sdk.NewDecFromInt(val.VotingPower))
+			newRatio := validator.DelegatorShares.Quo(sdk.NewDecFromInt(validator.Tokens))
+			fraction := sdk.OneDec().Sub(prevRatio.Quo(newRatio))
+			if fraction.GTE(types.MinSlashFraction) {
+				delta := newRatio.Quo(prevRatio)
+				err = k.UpdateWithdrawalRecordsForSlash(ctx, zone, val.ValoperAddress, delta)
+				if err != nil {
+					return err
+				}
+				k.HandleValidatorSlash(ctx, zone, val.ValoperAddress, fraction)
+			}
+		}
+
 		if !val.CommissionRate.Equal(validator.GetCommission()) {
 			k.Logger(ctx).Debug("Validator commission rate change; updating...", "valoper", validator.OperatorAddress, "oldRate", val.CommissionRate, "newRate", validator.GetCommission())
 			val.CommissionRate = validator.GetCommission()
@@ -360,6 +372,13 @@ func (k *Keeper) GetCommissionRate(ctx sdk.Context) sdk.Dec {
 	return out
 }
 
+// GetFeeRoutes returns the routes of collected protocol fees, or none if unset.
+func (k *Keeper) GetFeeRoutes(ctx sdk.Context) []types.FeeRoute {
+	out := []types.FeeRoute{}
+	k.paramStore.GetIfExists(ctx, types.KeyFeeRoutes, &out)
+	return out
+}
+
 // MigrateParams fetches params, adds ClaimsEnabled field and re-sets params.
 func (k *Keeper) MigrateParams(ctx sdk.Context) {
 	params := types.Params{}
@@ -367,12 +386,14 @@ func (k *Keeper) MigrateParams(ctx sdk.Context) {
 	params.CommissionRate = k.GetCommissionRate(ctx)
 	params.ValidatorsetInterval = k.GetParam(ctx, types.KeyValidatorSetInterval)
 	params.UnbondingEnabled = false
+	params.FeeRoutes = k.GetFeeRoutes(ctx)
 
 	k.paramStore.SetParamSet(ctx, &params)
 }
 
 func (k *Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
-	k.paramStore.GetParamSet(clientCtx, &params)
+	// params added since genesis, such as FeeRoutes, are unset until first updated.
+	k.paramStore.GetParamSetIfExists(clientCtx, &params)
 	return params
 }
 
@@ -496,13 +517,22 @@ func (k *Keeper) EmitDepositIntervalQuery(ctx sdk.Context, zone *types.Zone) {
 func (k *Keeper) UpdateRedemptionRate(ctx sdk.Context, zone *types.Zone, epochRewards sdkmath.Int) {
 	delegationsInProcess := sdkmath.ZeroInt()
 	k.IterateZoneReceipts(ctx, zone, func(_ int64, receipt types.Receipt) (stop bool) {
-		if receipt.Completed == nil {
+		// refunded deposits are returned to the sender, and never delegated.
+		if receipt.Completed == nil && !receipt.Refunded {
 			for _, coin := range receipt.Amount {
+				// deposit assets are not valued in the zone base denom until converted; see below.
+				if _, found := zone.GetDepositAsset(coin.Denom); found {
+					continue
+				}
 				delegationsInProcess = delegationsInProcess.Add(coin.Amount) // we cannot simply choose
 			}
 		}
 		return false
 	})
+	// the converted assets of deposits are in process once minted against, until delegated.
+	for _, record := range k.ZoneConversionRecords(ctx, zone.ChainId, ConversionStatusConverted) {
+		delegationsInProcess = delegationsInProcess.Add(record.Expected.Amount)
+	}
 	ratio, isZero := k.GetRatio(ctx, zone, epochRewards.Add(delegationsInProcess))
 	k.Logger(ctx).Info("Epochly rewards", "coins", epochRewards)
 	k.Logger(ctx).Info("Last redemption rate", "rate", zone.LastRedemptionRate)
@@ -535,8 +565,8 @@ func (k *Keeper) OverrideRedemptionRateNoCap(ctx sdk.Context, zone *types.Zone)
 }
 
 func (k *Keeper) GetRatio(ctx sdk.Context, zone *types.Zone, epochRewards sdkmath.Int) (sdk.Dec, bool) {
-	// native asset amount
-	nativeAssetAmount := k.GetDelegatedAmount(ctx, zone).Amount
+	// native asset amount, including that held undelegated for instant redemptions
+	nativeAssetAmount := k.GetDelegatedAmount(ctx, zone).Amount.Add(zone.GetInstantRedemptionBuffer())
 	nativeAssetUnbondingAmount := k.GetUnbondingAmount(ctx, zone).Amount
 
 	// qAsset amount
@@ -552,13 +582,46 @@ func (k *Keeper) GetRatio(ctx sdk.Context, zone *types.Zone, epochRewards sdkmat
 	return sdk.NewDecFromInt(nativeAssetAmount.Add(epochRewards).Add(nativeAssetUnbondingAmount)).Quo(sdk.NewDecFromInt(qAssetAmount)), false
 }
 
-func (k *Keeper) Rebalance(ctx sdk.Context, zone *types.Zone, epochNumber int64) error {
+// DeterminePlanForRebalancing returns the redelegations required to move the zone toward its aggregate intent, within the
+// zone's rebalancing limits.
+func (k *Keeper) DeterminePlanForRebalancing(ctx sdk.Context, zone *types.Zone) []types.RebalanceTarget {
 	currentAllocations, currentSum, currentLocked := k.GetDelegationMap(ctx, zone)
 	targetAllocations := zone.GetAggregateIntentOrDefault()
-	rebalances := types.DetermineAllocationsForRebalancing(currentAllocations, currentLocked, currentSum, targetAllocations, k.ZoneRedelegationRecords(ctx, zone.ChainId), k.Logger(ctx))
-	msgs := make([]sdk.Msg, 0)
+	return types.DetermineAllocationsForRebalancing(currentAllocations, currentLocked, currentSum, targetAllocations, k.ZoneRedelegationRecords(ctx, zone.ChainId), zone.GetRebalanceLimits(), k.Logger(ctx))
+}
+
+func (k *Keeper) Rebalance(ctx sdk.Context, zone *types.Zone, epochNumber int64) error {
+	rebalances := k.DeterminePlanForRebalancing(ctx, zone)
+
+	// redelegate from each source validator across the delegation accounts holding its delegations.
+	sourceTotals := make(map[string]sdkmath.Int)
 	for _, rebalance := range rebalances {
-		msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorSrcAddress: rebalance.Source, ValidatorDstAddress: rebalance.Target, Amount: sdk.NewCoin(zone.BaseDenom, rebalance.Amount)})
+		existing, found := sourceTotals[rebalance.Source]
+		if !found {
+			existing = sdk.ZeroInt()
+		}
+		sourceTotals[rebalance.Source] = existing.Add(rebalance.Amount)
+	}
+	sources := make(map[string][]types.Delegation, len(sourceTotals))
+	for _, valoper := range utils.Keys(sourceTotals) {
+		sources[valoper] = k.DetermineDelegatorsForValidator(ctx, zone, valoper, sourceTotals[valoper])
+	}
+
+	msgs := make(map[string][]sdk.Msg)
+	count := 0
+	for _, rebalance := range rebalances {
+		remaining := rebalance.Amount
+		for remaining.IsPositive() && len(sources[rebalance.Source]) > 0 {
+			source := &sources[rebalance.Source][0]
+			amount := sdk.MinInt(remaining, source.Amount.Amount)
+			msgs[source.DelegationAddress] = append(msgs[source.DelegationAddress], &stakingtypes.MsgBeginRedelegate{DelegatorAddress: source.DelegationAddress, ValidatorSrcAddress: rebalance.Source, ValidatorDstAddress: rebalance.Target, Amount: sdk.NewCoin(zone.BaseDenom, amount)})
+			count++
+			remaining = remaining.Sub(amount)
+			source.Amount = source.Amount.SubAmount(amount)
+			if source.Amount.IsZero() {
+				sources[rebalance.Source] = sources[rebalance.Source][1:]
+			}
+		}
 		k.SetRedelegationRecord(ctx, types.RedelegationRecord{
 			ChainId:     zone.ChainId,
 			EpochNumber: epochNumber,
@@ -567,12 +630,17 @@ func (k *Keeper) Rebalance(ctx sdk.Context, zone *types.Zone, epochNumber int64)
 			Amount:      rebalance.Amount.Int64(),
 		})
 	}
-	if len(msgs) == 0 {
+	if count == 0 {
 		k.Logger(ctx).Info("No rebalancing required")
 		return nil
 	}
-	k.Logger(ctx).Debug("Send rebalancing messages", "msgs", msgs)
-	return k.SubmitTx(ctx, msgs, zone.DelegationAddress, fmt.Sprintf("rebalance/%d", epochNumber))
+	for _, account := range zone.GetDelegationAccounts() {
+		k.Logger(ctx).Debug("Send rebalancing messages", "delegator", account.Address, "msgs", msgs[account.Address])
+		if err := k.SubmitTx(ctx, msgs[account.Address], account, fmt.Sprintf("rebalance/%d", epochNumber)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // UnmarshalValidatorResponse attempts to umarshal  a byte slice into a QueryValidatorsResponse.
@@ -574,6 +574,58 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRate() {
 	s.Require().Equal(sdk.NewDecWithPrec(9982638, 7), zone.RedemptionRate)
 }
 
+func (s *KeeperTestSuite) TestUpdateRedemptionRateInProcessReceipts() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	icsKeeper := app.InterchainstakingKeeper
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	vals := s.GetQuicksilverApp(s.chainB).StakingKeeper.GetAllValidators(s.chainB.GetContext())
+	icsKeeper.SetDelegation(ctx, &zone, icstypes.Delegation{DelegationAddress: zone.DelegationAddress.Address, ValidatorAddress: vals[0].OperatorAddress, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(3000))})
+	app.MintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(3000))))
+
+	// refunded deposits are not in process, even if the refund is not yet complete.
+	refunded := icsKeeper.NewReceipt(ctx, &zone, "cosmos1sender", "refunded", sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(30))))
+	refunded.Refunded = true
+	icsKeeper.SetReceipt(ctx, *refunded)
+
+	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.ZeroInt())
+	zone, found = icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(sdk.OneDec(), zone.RedemptionRate)
+
+	// pending deposits are in process.
+	icsKeeper.SetReceipt(ctx, *icsKeeper.NewReceipt(ctx, &zone, "cosmos1sender", "pending", sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(30)))))
+
+	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.ZeroInt())
+	zone, found = icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDecWithPrec(101, 2), zone.RedemptionRate)
+
+	// deposit assets are not in process until converted and minted against.
+	zone.SetDepositAsset(icstypes.DepositAsset{Denom: "uosmo", Conversion: icstypes.DepositConversionSwap, ChannelId: "channel-2", SwapContract: "osmo1contract", SwapOutputDenom: zone.BaseDenom, MaxSlippage: sdk.NewDecWithPrec(1, 2), RateSource: icstypes.DepositRateSourceFixed, Rate: sdk.NewDecWithPrec(5, 1)})
+	icsKeeper.SetZone(ctx, &zone)
+	icsKeeper.SetReceipt(ctx, *icsKeeper.NewReceipt(ctx, &zone, "cosmos1sender", "converting", sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(1000)))))
+	record := icstypes.ConversionRecord{ChainId: zone.ChainId, Txhash: "converting", Delegator: zone.DelegationAddress.Address, Amount: sdk.NewCoin("uosmo", sdk.NewInt(1000)), Expected: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(30)), Status: icskeeper.ConversionStatusConverting, SentTime: ctx.BlockTime(), Depositor: "cosmos1sender"}
+	icsKeeper.SetConversionRecord(ctx, record)
+
+	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.ZeroInt())
+	zone, found = icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDecWithPrec(101, 2), zone.RedemptionRate)
+
+	icsKeeper.UpdateConversionRecordStatus(ctx, &record, icskeeper.ConversionStatusConverted)
+
+	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.ZeroInt())
+	zone, found = icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDecWithPrec(102, 2), zone.RedemptionRate)
+}
+
 func (s *KeeperTestSuite) TestOverrideRedemptionRateNoCap() {
 	s.SetupTest()
 	s.setupTestZones()
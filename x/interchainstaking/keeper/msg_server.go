@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,10 +33,6 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgRequestRedemption) (*types.MsgRequestRedemptionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !k.Keeper.GetUnbondingEnabled(ctx) {
-		return nil, fmt.Errorf("unbonding is currently disabled")
-	}
-
 	var zone *types.Zone
 	k.IterateZones(ctx, func(_ int64, thisZone *types.Zone) bool {
 		if thisZone.LocalDenom == msg.Value.GetDenom() {
@@ -50,7 +47,17 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 		return nil, fmt.Errorf("unable to find matching zone for denom %s", msg.Value.GetDenom())
 	}
 
-	if !zone.UnbondingEnabled {
+	// offboarded zones are claimable regardless of whether unbonding is enabled.
+	claimable := zone.OffboardingStatus == types.OffboardingStatusClaimable
+	if zone.IsOffboarding() && !claimable {
+		return nil, fmt.Errorf("zone %s is offboarding; redemptions are claimable once its delegations are unbonded", zone.ChainId)
+	}
+
+	if !claimable && !k.Keeper.GetUnbondingEnabled(ctx) {
+		return nil, fmt.Errorf("unbonding is currently disabled")
+	}
+
+	if !claimable && !zone.UnbondingEnabled {
 		return nil, fmt.Errorf("unbonding currently disabled for zone %s", zone.ChainId)
 	}
 
@@ -59,6 +66,11 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 		return nil, fmt.Errorf("destination address %s does not match expected prefix %s [%w]", msg.DestinationAddress, zone.AccountPrefix, err)
 	}
 
+	// would the redemption exceed the zone redemption limit for this epoch?
+	if rateLimit := k.GetRedemptionRateLimit(ctx, zone); !claimable && !rateLimit.Allows(msg.Value.Amount) {
+		return nil, fmt.Errorf("redemption exceeds zone redemption limit; %s remaining this epoch", sdk.NewCoin(zone.LocalDenom, rateLimit.Remaining))
+	}
+
 	sender, _ := sdk.AccAddressFromBech32(msg.FromAddress) // already validated
 
 	// does the user have sufficient assets to burn
@@ -68,6 +80,9 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 
 	// get min of LastRedemptionRate (N-1) and RedemptionRate (N)
 	rate := sdk.MinDec(zone.LastRedemptionRate, zone.RedemptionRate)
+	if claimable {
+		rate = zone.GetOffboardingRate()
+	}
 	nativeTokens := sdk.NewDecFromInt(msg.Value.Amount).Mul(rate).TruncateInt()
 	outTokens := sdk.NewCoin(zone.BaseDenom, nativeTokens)
 	k.Logger(ctx).Info("tokens to distribute", "amount", outTokens)
@@ -81,16 +96,33 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 		return nil, fmt.Errorf("unable to send coins to escrow account: %w", err)
 	}
 
-	if zone.LiquidityModule {
+	redemptionPath := types.AttributeValueRedemptionPathQueued
+	fee := sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt())
+	switch {
+	case claimable:
+		redemptionPath = types.AttributeValueRedemptionPathOffboard
+		if err := k.processOffboardingRedemption(ctx, zone, sender, msg.DestinationAddress, nativeTokens, msg.Value, hashString); err != nil {
+			return nil, fmt.Errorf("unable to process offboarding redemption: %w", err)
+		}
+	case zone.LiquidityModule:
+		redemptionPath = types.AttributeValueRedemptionPathLsm
 		if err := k.processRedemptionForLsm(ctx, zone, sender, msg.DestinationAddress, nativeTokens, msg.Value, hashString); err != nil {
 			return nil, fmt.Errorf("unable to process redemption for LSM: %w", err)
 		}
-	} else {
+	case k.CanRedeemInstantly(ctx, zone, nativeTokens):
+		redemptionPath = types.AttributeValueRedemptionPathInstant
+		fee.Amount = zone.GetInstantRedemptionFeeAmount(nativeTokens)
+		if err := k.processInstantRedemption(ctx, zone, sender, msg.DestinationAddress, nativeTokens, msg.Value, hashString); err != nil {
+			return nil, fmt.Errorf("unable to process instant redemption: %w", err)
+		}
+	default:
 		if err := k.queueRedemption(ctx, zone, sender, msg.DestinationAddress, nativeTokens, msg.Value, hashString); err != nil {
 			return nil, fmt.Errorf("unable to queue redemption: %w", err)
 		}
 	}
 
+	k.RecordRedemption(ctx, zone, msg.Value.Amount)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -103,10 +135,107 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 			sdk.NewAttribute(types.AttributeKeyRecipientAddress, msg.DestinationAddress),
 			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
 			sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyRedemptionPath, redemptionPath),
+			sdk.NewAttribute(types.AttributeKeyFeeAmount, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyHash, hashString),
+		),
+	})
+
+	return &types.MsgRequestRedemptionResponse{Hash: hashString}, nil
+}
+
+// CancelRedemption handles MsgCancelRedemption by deleting a queued withdrawal record and returning the escrowed qAssets to the sender.
+// Only records that have not yet been picked up for unbonding may be cancelled.
+func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.getQueuedWithdrawalRecordForDelegator(ctx, msg.ChainId, msg.Hash, msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, _ := sdk.AccAddressFromBech32(msg.FromAddress) // already validated
+
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowModuleAccount, sender, sdk.NewCoins(record.BurnAmount)); err != nil {
+		return nil, fmt.Errorf("unable to return escrowed coins: %w", err)
+	}
+
+	k.DeleteWithdrawalRecord(ctx, record.ChainId, record.Txhash, WithdrawStatusQueued)
+
+	// the cancelled redemption no longer counts toward the zone redemption limit.
+	if zone, found := k.GetZone(ctx, record.ChainId); found {
+		k.ReverseRedemption(ctx, &zone, record)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeRedemptionCancel,
+			sdk.NewAttribute(types.AttributeKeyUser, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyBurnAmount, record.BurnAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyHash, record.Txhash),
+			sdk.NewAttribute(types.AttributeKeyChainID, record.ChainId),
+		),
+	})
+
+	return &types.MsgCancelRedemptionResponse{Returned: record.BurnAmount}, nil
+}
+
+// UpdateRedemptionDestination handles MsgUpdateRedemptionDestination by updating the recipient of a queued withdrawal record.
+// Only records that have not yet been picked up for unbonding may be updated.
+func (k msgServer) UpdateRedemptionDestination(goCtx context.Context, msg *types.MsgUpdateRedemptionDestination) (*types.MsgUpdateRedemptionDestinationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.getQueuedWithdrawalRecordForDelegator(ctx, msg.ChainId, msg.Hash, msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	zone, found := k.GetZone(ctx, record.ChainId)
+	if !found {
+		return nil, fmt.Errorf("invalid chain id \"%s\"", record.ChainId)
+	}
+
+	// does destination address match the prefix registered against the zone?
+	if _, err := utils.AccAddressFromBech32(msg.DestinationAddress, zone.AccountPrefix); err != nil {
+		return nil, fmt.Errorf("destination address %s does not match expected prefix %s [%w]", msg.DestinationAddress, zone.AccountPrefix, err)
+	}
+
+	record.Recipient = msg.DestinationAddress
+	k.SetWithdrawalRecord(ctx, record)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeRedemptionDestinationUpdated,
+			sdk.NewAttribute(types.AttributeKeyUser, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, msg.DestinationAddress),
+			sdk.NewAttribute(types.AttributeKeyHash, record.Txhash),
+			sdk.NewAttribute(types.AttributeKeyChainID, record.ChainId),
 		),
 	})
 
-	return &types.MsgRequestRedemptionResponse{}, nil
+	return &types.MsgUpdateRedemptionDestinationResponse{}, nil
+}
+
+// getQueuedWithdrawalRecordForDelegator returns the queued withdrawal record for the given hash, ensuring it belongs to delegator.
+func (k msgServer) getQueuedWithdrawalRecordForDelegator(ctx sdk.Context, chainID string, hash string, delegator string) (types.WithdrawalRecord, error) {
+	record, found := k.GetWithdrawalRecord(ctx, chainID, hash, WithdrawStatusQueued)
+	if !found {
+		return types.WithdrawalRecord{}, fmt.Errorf("no queued redemption found for %s/%s", chainID, hash)
+	}
+
+	if record.Delegator != delegator {
+		return types.WithdrawalRecord{}, fmt.Errorf("redemption %s does not belong to %s", hash, delegator)
+	}
+
+	return record, nil
 }
 
 func (k msgServer) SignalIntent(goCtx context.Context, msg *types.MsgSignalIntent) (*types.MsgSignalIntentResponse, error) {
@@ -128,9 +257,11 @@ func (k msgServer) SignalIntent(goCtx context.Context, msg *types.MsgSignalInten
 		return nil, err
 	}
 
+	updated := ctx.BlockTime()
 	intent := types.DelegatorIntent{
 		Delegator: msg.FromAddress,
 		Intents:   intents,
+		Updated:   &updated,
 	}
 
 	k.SetDelegatorIntent(ctx, &zone, intent, false)
@@ -150,6 +281,39 @@ func (k msgServer) SignalIntent(goCtx context.Context, msg *types.MsgSignalInten
 	return &types.MsgSignalIntentResponse{}, nil
 }
 
+// ConfirmIntent refreshes the signalled intent of the sender, such that it does not expire.
+func (k msgServer) ConfirmIntent(goCtx context.Context, msg *types.MsgConfirmIntent) (*types.MsgConfirmIntentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	zone, ok := k.GetZone(ctx, msg.ChainId)
+	if !ok {
+		return nil, fmt.Errorf("invalid chain id \"%s\"", msg.ChainId)
+	}
+
+	intent, _ := k.GetDelegatorIntent(ctx, &zone, msg.FromAddress, false)
+	if len(intent.Intents) == 0 {
+		return nil, fmt.Errorf("no intent signalled by %s for %s", msg.FromAddress, msg.ChainId)
+	}
+
+	updated := ctx.BlockTime()
+	intent.Updated = &updated
+	k.SetDelegatorIntent(ctx, &zone, intent, false)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeConfirmIntent,
+			sdk.NewAttribute(types.AttributeKeyUser, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyChainID, msg.ChainId),
+		),
+	})
+
+	return &types.MsgConfirmIntentResponse{}, nil
+}
+
 // GovReopenChannel reopens an ICA channel.
 func (k msgServer) GovReopenChannel(goCtx context.Context, msg *types.MsgGovReopenChannel) (*types.MsgGovReopenChannelResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -163,9 +327,9 @@ func (k msgServer) GovReopenChannel(goCtx context.Context, msg *types.MsgGovReop
 	// 		)
 	// }
 
-	// validate the zone exists, and the format is valid (e.g. quickgaia-1.delegate)
+	// validate the zone exists, and the format is valid (e.g. quickgaia-1.delegate or quickgaia-1.delegate.1)
 	parts := strings.Split(msg.PortId, ".")
-	if len(parts) != 2 {
+	if len(parts) != 2 && len(parts) != 3 {
 		return &types.MsgGovReopenChannelResponse{}, errors.New("invalid port format")
 	}
 
@@ -173,7 +337,14 @@ func (k msgServer) GovReopenChannel(goCtx context.Context, msg *types.MsgGovReop
 		return &types.MsgGovReopenChannelResponse{}, errors.New("invalid port format; zone not found")
 	}
 
-	if parts[1] != "delegate" && parts[1] != "deposit" && parts[1] != "performance" && parts[1] != "withdrawal" {
+	if len(parts) == 3 {
+		if parts[1] != "delegate" {
+			return &types.MsgGovReopenChannelResponse{}, errors.New("invalid port format; unexpected account")
+		}
+		if _, err := strconv.ParseUint(parts[2], 10, 32); err != nil {
+			return &types.MsgGovReopenChannelResponse{}, errors.New("invalid port format; unexpected delegation shard")
+		}
+	} else if parts[1] != "delegate" && parts[1] != "deposit" && parts[1] != "performance" && parts[1] != "withdrawal" {
 		return &types.MsgGovReopenChannelResponse{}, errors.New("invalid port format; unexpected account")
 	}
 
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 
+	"github.com/ingenuity-build/quicksilver/app"
 	"github.com/ingenuity-build/quicksilver/utils"
 	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
 	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -250,6 +251,43 @@ func (s *KeeperTestSuite) TestRequestRedemption() {
 			"",
 			"unable to satisfy unbond request; delegations may be locked",
 		},
+		{
+			"invalid - exceeds redemption limit",
+			func() {
+				addr, err := bech32.ConvertAndEncode("cosmos", utils.GenerateAccAddressForTest())
+				s.Require().NoError(err)
+				msg = icstypes.MsgRequestRedemption{
+					Value:              sdk.NewCoin("uqatom", sdk.NewInt(1000001)),
+					DestinationAddress: addr,
+					FromAddress:        testAddress,
+				}
+
+				// 10% of the 10,000,000 uqatom supply.
+				zone, _ := s.GetQuicksilverApp(s.chainA).InterchainstakingKeeper.GetZone(s.chainA.GetContext(), s.chainB.ChainID)
+				zone.RedemptionLimitRate = sdk.NewDecWithPrec(1, 1)
+				s.GetQuicksilverApp(s.chainA).InterchainstakingKeeper.SetZone(s.chainA.GetContext(), &zone)
+			},
+			"redemption exceeds zone redemption limit",
+			"redemption exceeds zone redemption limit",
+		},
+		{
+			"valid - within redemption limit",
+			func() {
+				addr, err := bech32.ConvertAndEncode("cosmos", utils.GenerateAccAddressForTest())
+				s.Require().NoError(err)
+				msg = icstypes.MsgRequestRedemption{
+					Value:              sdk.NewCoin("uqatom", sdk.NewInt(1000000)),
+					DestinationAddress: addr,
+					FromAddress:        testAddress,
+				}
+
+				zone, _ := s.GetQuicksilverApp(s.chainA).InterchainstakingKeeper.GetZone(s.chainA.GetContext(), s.chainB.ChainID)
+				zone.RedemptionLimitRate = sdk.NewDecWithPrec(1, 1)
+				s.GetQuicksilverApp(s.chainA).InterchainstakingKeeper.SetZone(s.chainA.GetContext(), &zone)
+			},
+			"",
+			"",
+		},
 	}
 
 	for _, tt := range tests {
@@ -342,6 +380,302 @@ func (s *KeeperTestSuite) TestRequestRedemption() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRequestRedemptionInstant() {
+	testAccount, err := utils.AccAddressFromBech32(testAddress, "")
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name         string
+		bufferRate   sdk.Dec
+		fee          sdk.Dec
+		buffer       math.Int
+		lsm          bool
+		expectPath   string
+		expectStatus int32
+		expectAmount math.Int
+	}{
+		{
+			name:         "buffer disabled",
+			bufferRate:   sdk.ZeroDec(),
+			fee:          sdk.ZeroDec(),
+			buffer:       sdk.NewInt(20000000),
+			expectPath:   icstypes.AttributeValueRedemptionPathQueued,
+			expectStatus: icskeeper.WithdrawStatusQueued,
+			expectAmount: sdk.NewInt(10000000),
+		},
+		{
+			name:         "insufficient buffer",
+			bufferRate:   sdk.NewDecWithPrec(1, 1),
+			fee:          sdk.ZeroDec(),
+			buffer:       sdk.NewInt(9999999),
+			expectPath:   icstypes.AttributeValueRedemptionPathQueued,
+			expectStatus: icskeeper.WithdrawStatusQueued,
+			expectAmount: sdk.NewInt(10000000),
+		},
+		{
+			name:         "instant, no fee",
+			bufferRate:   sdk.NewDecWithPrec(1, 1),
+			fee:          sdk.ZeroDec(),
+			buffer:       sdk.NewInt(10000000),
+			expectPath:   icstypes.AttributeValueRedemptionPathInstant,
+			expectStatus: icskeeper.WithdrawStatusSend,
+			expectAmount: sdk.NewInt(10000000),
+		},
+		{
+			name:         "instant, with fee",
+			bufferRate:   sdk.NewDecWithPrec(1, 1),
+			fee:          sdk.NewDecWithPrec(5, 3),
+			buffer:       sdk.NewInt(9950000),
+			expectPath:   icstypes.AttributeValueRedemptionPathInstant,
+			expectStatus: icskeeper.WithdrawStatusSend,
+			expectAmount: sdk.NewInt(9950000),
+		},
+		{
+			name:         "lsm zone ignores buffer",
+			bufferRate:   sdk.NewDecWithPrec(1, 1),
+			fee:          sdk.ZeroDec(),
+			buffer:       sdk.NewInt(20000000),
+			lsm:          true,
+			expectPath:   icstypes.AttributeValueRedemptionPathLsm,
+			expectStatus: icskeeper.WithdrawStatusTokenize,
+			expectAmount: sdk.ZeroInt(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			s.setupTestZones()
+
+			app := s.GetQuicksilverApp(s.chainA)
+			ctx := s.chainA.GetContext()
+
+			params := app.InterchainstakingKeeper.GetParams(ctx)
+			params.UnbondingEnabled = true
+			app.InterchainstakingKeeper.SetParams(ctx, params)
+
+			s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(sdk.NewCoin("uqatom", math.NewInt(10000000)))))
+			s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, testAccount, sdk.NewCoins(sdk.NewCoin("uqatom", math.NewInt(10000000)))))
+
+			zone, found := app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+			s.Require().True(found)
+			zone.LiquidityModule = tt.lsm
+			zone.UnbondingEnabled = true
+			zone.InstantRedemptionBufferRate = tt.bufferRate
+			zone.InstantRedemptionFee = tt.fee
+			zone.InstantRedemptionBuffer = tt.buffer
+			app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+			for _, valoper := range zone.GetValidatorsAddressesAsSlice() {
+				app.InterchainstakingKeeper.SetDelegation(ctx, &zone, icstypes.NewDelegation(zone.DelegationAddress.Address, valoper, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(3000000))))
+			}
+
+			addr, err := bech32.ConvertAndEncode("cosmos", utils.GenerateAccAddressForTest())
+			s.Require().NoError(err)
+			msg := icstypes.MsgRequestRedemption{
+				Value:              sdk.NewCoin("uqatom", sdk.NewInt(10000000)),
+				DestinationAddress: addr,
+				FromAddress:        testAddress,
+			}
+
+			msgSrv := icskeeper.NewMsgServerImpl(app.InterchainstakingKeeper)
+			res, err := msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), &msg)
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			path := ""
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != icstypes.EventTypeRedemptionRequest {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == icstypes.AttributeKeyRedemptionPath {
+						path = string(attr.Value)
+					}
+				}
+			}
+			s.Require().Equal(tt.expectPath, path)
+
+			records := app.InterchainstakingKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId)
+			s.Require().Len(records, 1)
+			s.Require().Equal(tt.expectStatus, records[0].Status)
+			s.Require().Equal(tt.expectAmount, records[0].Amount.AmountOf(zone.BaseDenom))
+			s.Require().Equal(tt.expectPath == icstypes.AttributeValueRedemptionPathInstant, records[0].Instant)
+
+			// the buffer is drawn down on acknowledgement, not on request.
+			zone, found = app.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+			s.Require().True(found)
+			s.Require().Equal(tt.buffer, zone.InstantRedemptionBuffer)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelAndUpdateRedemption() {
+	testAccount, err := utils.AccAddressFromBech32(testAddress, "")
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name      string
+		malleate  func(app *app.Quicksilver, ctx sdk.Context, hash string) (string, string)
+		expectErr string
+	}{
+		{
+			name: "valid",
+			malleate: func(_ *app.Quicksilver, _ sdk.Context, hash string) (string, string) {
+				return hash, testAddress
+			},
+		},
+		{
+			name: "unknown hash",
+			malleate: func(_ *app.Quicksilver, _ sdk.Context, _ string) (string, string) {
+				return fmt.Sprintf("%064d", 1), testAddress
+			},
+			expectErr: "no queued redemption found",
+		},
+		{
+			name: "wrong sender",
+			malleate: func(_ *app.Quicksilver, _ sdk.Context, hash string) (string, string) {
+				return hash, utils.GenerateAccAddressForTest().String()
+			},
+			expectErr: "does not belong to",
+		},
+		{
+			name: "already unbonding",
+			malleate: func(app *app.Quicksilver, ctx sdk.Context, hash string) (string, string) {
+				record, found := app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, s.chainB.ChainID, hash, icskeeper.WithdrawStatusQueued)
+				s.Require().True(found)
+				app.InterchainstakingKeeper.UpdateWithdrawalRecordStatus(ctx, &record, icskeeper.WithdrawStatusUnbond)
+				return hash, testAddress
+			},
+			expectErr: "no queued redemption found",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		for _, cancel := range []bool{true, false} {
+			cancel := cancel
+
+			s.Run(fmt.Sprintf("%s (cancel: %v)", tt.name, cancel), func() {
+				s.SetupTest()
+				s.setupTestZones()
+
+				quicksilver := s.GetQuicksilverApp(s.chainA)
+				ctx := s.chainA.GetContext()
+
+				params := quicksilver.InterchainstakingKeeper.GetParams(ctx)
+				params.UnbondingEnabled = true
+				quicksilver.InterchainstakingKeeper.SetParams(ctx, params)
+
+				zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+				s.Require().True(found)
+				zone.UnbondingEnabled = true
+				zone.LiquidityModule = false
+				quicksilver.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+				burnAmount := sdk.NewCoin("uqatom", math.NewInt(10000000))
+				s.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(burnAmount)))
+				s.Require().NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, testAccount, sdk.NewCoins(burnAmount)))
+
+				addr, err := bech32.ConvertAndEncode("cosmos", utils.GenerateAccAddressForTest())
+				s.Require().NoError(err)
+
+				msgSrv := icskeeper.NewMsgServerImpl(quicksilver.InterchainstakingKeeper)
+				res, err := msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), icstypes.NewMsgRequestRedemption(burnAmount, addr, testAccount))
+				s.Require().NoError(err)
+				s.Require().Len(res.Hash, 64)
+				s.Require().True(quicksilver.BankKeeper.GetBalance(ctx, testAccount, "uqatom").IsZero())
+
+				hash, sender := tt.malleate(quicksilver, ctx, res.Hash)
+				senderAddr, err := sdk.AccAddressFromBech32(sender)
+				s.Require().NoError(err)
+
+				if cancel {
+					cancelRes, err := msgSrv.CancelRedemption(sdk.WrapSDKContext(ctx), icstypes.NewMsgCancelRedemption(zone.ChainId, hash, senderAddr))
+					if tt.expectErr != "" {
+						s.Require().ErrorContains(err, tt.expectErr)
+						s.Require().True(quicksilver.BankKeeper.GetBalance(ctx, testAccount, "uqatom").IsZero())
+						return
+					}
+					s.Require().NoError(err)
+					s.Require().Equal(burnAmount, cancelRes.Returned)
+					s.Require().Equal(burnAmount, quicksilver.BankKeeper.GetBalance(ctx, testAccount, "uqatom"))
+					s.Require().Empty(quicksilver.InterchainstakingKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId))
+
+					// the cancelled redemption no longer counts toward the zone redemption limit.
+					zone, found = quicksilver.InterchainstakingKeeper.GetZone(ctx, zone.ChainId)
+					s.Require().True(found)
+					s.Require().True(zone.EpochRedemptions.IsZero())
+					return
+				}
+
+				newAddr, err := bech32.ConvertAndEncode("cosmos", utils.GenerateAccAddressForTest())
+				s.Require().NoError(err)
+
+				// destination must match the zone account prefix.
+				_, err = msgSrv.UpdateRedemptionDestination(sdk.WrapSDKContext(ctx), icstypes.NewMsgUpdateRedemptionDestination(zone.ChainId, hash, utils.GenerateAccAddressForTestWithPrefix("quick"), senderAddr))
+				s.Require().Error(err)
+
+				_, err = msgSrv.UpdateRedemptionDestination(sdk.WrapSDKContext(ctx), icstypes.NewMsgUpdateRedemptionDestination(zone.ChainId, hash, newAddr, senderAddr))
+				if tt.expectErr != "" {
+					s.Require().ErrorContains(err, tt.expectErr)
+					return
+				}
+				s.Require().NoError(err)
+
+				record, found := quicksilver.InterchainstakingKeeper.GetWithdrawalRecord(ctx, zone.ChainId, hash, icskeeper.WithdrawStatusQueued)
+				s.Require().True(found)
+				s.Require().Equal(newAddr, record.Recipient)
+			})
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelRedemptionPreviousEpoch() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	quicksilver := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	icsKeeper := quicksilver.InterchainstakingKeeper
+
+	params := icsKeeper.GetParams(ctx)
+	params.UnbondingEnabled = true
+	icsKeeper.SetParams(ctx, params)
+
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	zone.UnbondingEnabled = true
+	zone.LiquidityModule = false
+	icsKeeper.SetZone(ctx, &zone)
+
+	testAccount := utils.GenerateAccAddressForTest()
+	burnAmount := sdk.NewCoin("uqatom", math.NewInt(1000))
+	s.Require().NoError(quicksilver.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(burnAmount)))
+	s.Require().NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, testAccount, sdk.NewCoins(burnAmount)))
+
+	msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+	res, err := msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), icstypes.NewMsgRequestRedemption(burnAmount, utils.GenerateAccAddressForTestWithPrefix("cosmos"), testAccount))
+	s.Require().NoError(err)
+
+	// the limits are reset at the end of the epoch, and redemptions made in the next epoch.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	zone, found = icsKeeper.GetZone(ctx, zone.ChainId)
+	s.Require().True(found)
+	icsKeeper.ResetRateLimits(ctx, &zone)
+	icsKeeper.RecordRedemption(ctx, &zone, math.NewInt(500))
+
+	// a redemption requested in a previous epoch does not reduce the usage of the current epoch.
+	_, err = msgSrv.CancelRedemption(sdk.WrapSDKContext(ctx), icstypes.NewMsgCancelRedemption(zone.ChainId, res.Hash, testAccount))
+	s.Require().NoError(err)
+	zone, found = icsKeeper.GetZone(ctx, zone.ChainId)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(500), zone.EpochRedemptions)
+}
+
 func (s *KeeperTestSuite) TestSignalIntent() {
 	tests := []struct {
 		name             string
@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	interchainquerykeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+const allBalancesQueryType = "cosmos.bank.v1beta1.Query/AllBalances"
+
+// HandleOffboarding advances the offboarding of the zone. It is called once per epoch, after queued unbondings have been
+// handled. The caller is responsible for persisting the zone.
+func (k *Keeper) HandleOffboarding(ctx sdk.Context, zone *types.Zone, epochNumber int64) error {
+	switch zone.OffboardingStatus {
+	case types.OffboardingStatusUnbonding:
+		return k.unbondOffboardingZone(ctx, zone, epochNumber)
+	case types.OffboardingStatusSettling:
+		k.settleOffboardingZone(ctx, zone)
+	}
+	return nil
+}
+
+// unbondOffboardingZone undelegates, in full, up to types.MaxOffboardingUndelegationsPerEpoch of the zone's
+// delegations. Once no delegations remain and the resulting unbondings have matured, the balances of the delegation
+// accounts are queried and the zone moves to the settling stage.
+func (k *Keeper) unbondOffboardingZone(ctx sdk.Context, zone *types.Zone, epochNumber int64) error {
+	offboardMemo := fmt.Sprintf("%s/%d", types.MsgTypeOffboard, epochNumber)
+
+	if k.hasInFlightICAPackets(ctx, zone.ChainId, types.MsgTypeOffboard+"/") {
+		// delegation records are updated once the previous undelegations have been acknowledged.
+		k.Logger(ctx).Info("awaiting acknowledgement of offboarding undelegations", "chain_id", zone.ChainId)
+		return nil
+	}
+
+	msgs := make(map[string][]sdk.Msg)
+	remaining := 0
+	count := 0
+	for _, delegation := range k.GetAllDelegations(ctx, zone) {
+		if !delegation.Amount.Amount.IsPositive() {
+			continue
+		}
+		remaining++
+		if count >= types.MaxOffboardingUndelegationsPerEpoch {
+			continue
+		}
+		if _, found := k.GetUnbondingRecord(ctx, zone.ChainId, delegation.ValidatorAddress, epochNumber); found {
+			// the validator is being unbonded from for queued redemptions this epoch.
+			continue
+		}
+		msgs[delegation.DelegationAddress] = append(msgs[delegation.DelegationAddress], &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delegation.DelegationAddress,
+			ValidatorAddress: delegation.ValidatorAddress,
+			Amount:           delegation.Amount,
+		})
+		count++
+	}
+
+	if remaining == 0 {
+		k.beginOffboardingSettlement(ctx, zone)
+		return nil
+	}
+
+	k.Logger(ctx).Info("undelegating for offboarding", "chain_id", zone.ChainId, "undelegations", count, "delegations", remaining)
+	for _, account := range zone.GetDelegationAccounts() {
+		if err := k.SubmitTx(ctx, msgs[account.Address], account, offboardMemo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// beginOffboardingSettlement queries the balances of the zone's delegation accounts, once every unbonding has matured
+// and no withdrawals or packets remain outstanding.
+func (k *Keeper) beginOffboardingSettlement(ctx sdk.Context, zone *types.Zone) {
+	if zone.OffboardingUnbondingCompletion != nil && !ctx.BlockTime().After(*zone.OffboardingUnbondingCompletion) {
+		k.Logger(ctx).Info("awaiting maturity of offboarding unbondings", "chain_id", zone.ChainId, "completion", zone.OffboardingUnbondingCompletion)
+		return
+	}
+
+	if k.hasPendingWithdrawals(ctx, zone.ChainId) || k.hasInFlightICAPackets(ctx, zone.ChainId, "") {
+		k.Logger(ctx).Info("awaiting completion of outstanding withdrawals", "chain_id", zone.ChainId)
+		return
+	}
+
+	for _, account := range zone.GetDelegationAccounts() {
+		k.ICQKeeper.MakeRequest(
+			ctx,
+			zone.ConnectionId,
+			zone.ChainId,
+			allBalancesQueryType,
+			k.cdc.MustMarshal(&banktypes.QueryAllBalancesRequest{Address: account.Address}),
+			sdk.NewInt(-1),
+			types.ModuleName,
+			"allbalances",
+			0,
+		)
+	}
+
+	zone.OffboardingStatus = types.OffboardingStatusSettling
+	k.emitOffboardingEvent(ctx, zone)
+}
+
+// settleOffboardingZone determines the final rate, at which qAssets may be redeemed for the native assets held by the
+// zone's delegation accounts, once the balances of the accounts have been received.
+func (k *Keeper) settleOffboardingZone(ctx sdk.Context, zone *types.Zone) {
+	for _, account := range zone.GetDelegationAccounts() {
+		request := k.cdc.MustMarshal(&banktypes.QueryAllBalancesRequest{Address: account.Address})
+		id := interchainquerykeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, allBalancesQueryType, request, types.ModuleName)
+		if _, found := k.ICQKeeper.GetQuery(ctx, id); found || account.BalanceWaitgroup > 0 {
+			k.Logger(ctx).Info("awaiting delegation account balances", "chain_id", zone.ChainId, "account", account.Address)
+			return
+		}
+	}
+
+	unclaimed := k.GetOffboardingUnclaimed(zone)
+	supply := k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+
+	rate := sdk.ZeroDec()
+	if supply.IsPositive() {
+		rate = sdk.NewDecFromInt(unclaimed).QuoInt(supply)
+	}
+
+	zone.OffboardingRate = rate
+	// the instant redemption buffer forms part of the unclaimed balance.
+	zone.InstantRedemptionBuffer = sdk.ZeroInt()
+	zone.OffboardingStatus = types.OffboardingStatusClaimable
+
+	k.Logger(ctx).Info("offboarding zone claimable", "chain_id", zone.ChainId, "unclaimed", unclaimed, "supply", supply, "rate", rate)
+	k.emitOffboardingEvent(ctx, zone)
+}
+
+// GetOffboardingUnclaimed returns the native assets held by the zone's delegation accounts.
+func (k *Keeper) GetOffboardingUnclaimed(zone *types.Zone) math.Int {
+	unclaimed := sdk.ZeroInt()
+	for _, account := range zone.GetDelegationAccounts() {
+		unclaimed = unclaimed.Add(account.Balance.AmountOf(zone.BaseDenom))
+	}
+	return unclaimed
+}
+
+// IsOffboardingComplete returns true if every qAsset of the offboarded zone has been redeemed, and every redemption
+// paid, such that the zone may be removed.
+func (k *Keeper) IsOffboardingComplete(ctx sdk.Context, zone *types.Zone) bool {
+	return zone.OffboardingStatus == types.OffboardingStatusClaimable &&
+		k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount.IsZero() &&
+		!k.hasPendingWithdrawals(ctx, zone.ChainId)
+}
+
+// RemoveOffboardedZone removes the zone, its records and its interchain queries.
+func (k *Keeper) RemoveOffboardedZone(ctx sdk.Context, zone *types.Zone) {
+	k.Logger(ctx).Info("removing offboarded zone", "chain_id", zone.ChainId, "remaining", k.GetOffboardingUnclaimed(zone))
+	k.RemoveZoneAndAssociatedRecords(ctx, zone.ChainId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeZoneOffboarded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+		),
+	)
+}
+
+// processOffboardingRedemption pays the redemption from the unclaimed balances of the offboarded zone's delegation
+// accounts, largest first, and adds a withdrawal record with status SEND. The qAssets are burned on acknowledgement.
+func (k *Keeper) processOffboardingRedemption(ctx sdk.Context, zone *types.Zone, sender sdk.AccAddress, destination string, nativeTokens math.Int, burnAmount sdk.Coin, hash string) error {
+	if !nativeTokens.IsPositive() {
+		return fmt.Errorf("redemption of %s is too small to be paid at the offboarding rate", burnAmount)
+	}
+
+	accounts := zone.GetDelegationAccounts()
+	sort.SliceStable(accounts, func(i, j int) bool {
+		return accounts[i].Balance.AmountOf(zone.BaseDenom).GT(accounts[j].Balance.AmountOf(zone.BaseDenom))
+	})
+
+	distributions := make([]*types.Distribution, 0)
+	remaining := nativeTokens
+	for _, account := range accounts {
+		amount := sdk.MinInt(remaining, account.Balance.AmountOf(zone.BaseDenom))
+		if !amount.IsPositive() {
+			continue
+		}
+		distributions = append(distributions, &types.Distribution{Delegator: account.Address, Amount: amount.Uint64()})
+		remaining = remaining.Sub(amount)
+		if remaining.IsZero() {
+			break
+		}
+	}
+
+	if remaining.IsPositive() {
+		return fmt.Errorf("insufficient unclaimed balance to pay %s", sdk.NewCoin(zone.BaseDenom, nativeTokens))
+	}
+
+	// the balances are no longer queried once claimable, so are drawn down as redemptions are paid.
+	for _, dist := range distributions {
+		account, _ := zone.GetDelegationAccountByAddress(dist.Delegator)
+		if err := account.SetBalance(account.Balance.Sub(sdk.NewCoin(zone.BaseDenom, sdk.NewIntFromUint64(dist.Amount)))); err != nil {
+			return err
+		}
+	}
+	k.SetZone(ctx, zone)
+
+	record := types.WithdrawalRecord{
+		ChainId:        zone.ChainId,
+		Delegator:      sender.String(),
+		Distribution:   distributions,
+		Recipient:      destination,
+		Amount:         sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, nativeTokens)),
+		BurnAmount:     burnAmount,
+		Txhash:         hash,
+		Status:         WithdrawStatusSend,
+		CompletionTime: ctx.BlockTime(),
+		RequestHeight:  ctx.BlockHeight(),
+	}
+	k.SetWithdrawalRecord(ctx, record)
+
+	return k.sendWithdrawal(ctx, zone, record)
+}
+
+// hasPendingWithdrawals returns true if the zone has withdrawal records yet to be completed.
+func (k *Keeper) hasPendingWithdrawals(ctx sdk.Context, chainID string) bool {
+	pending := false
+	for _, status := range []int32{WithdrawStatusTokenize, WithdrawStatusQueued, WithdrawStatusUnbond, WithdrawStatusSend} {
+		k.IterateZoneStatusWithdrawalRecords(ctx, chainID, status, func(_ int64, _ types.WithdrawalRecord) (stop bool) {
+			pending = true
+			return true
+		})
+	}
+	return pending
+}
+
+// hasInFlightICAPackets returns true if the zone has unacknowledged ICA packets with the given memo prefix.
+func (k *Keeper) hasInFlightICAPackets(ctx sdk.Context, chainID string, memoPrefix string) bool {
+	inFlight := false
+	k.IteratePrefixedICAPacketRecords(ctx, types.GetICAPacketRecordsKey(chainID, ICAPacketStatusInFlight), func(_ int64, record types.ICAPacketRecord) (stop bool) {
+		inFlight = strings.HasPrefix(record.Memo, memoPrefix)
+		return inFlight
+	})
+	return inFlight
+}
+
+func (k *Keeper) emitOffboardingEvent(ctx sdk.Context, zone *types.Zone) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOffboardZone,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyOffboardingStage, fmt.Sprintf("%d", zone.OffboardingStatus)),
+		),
+	)
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	interchainquerykeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestOffboardZone() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	icsKeeper := qapp.InterchainstakingKeeper
+
+	zone, found := icsKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	for _, record := range icsKeeper.AllICAPacketRecords(ctx) {
+		icsKeeper.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+	}
+	for _, delegation := range icsKeeper.GetAllDelegations(ctx, &zone) {
+		s.Require().NoError(icsKeeper.RemoveDelegation(ctx, &zone, delegation))
+	}
+
+	delegator := zone.DelegationAddress.Address
+	for _, val := range zone.Validators[:2] {
+		icsKeeper.SetDelegation(ctx, &zone, types.NewDelegation(delegator, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))))
+	}
+
+	testAccount, err := utils.AccAddressFromBech32(testAddress, "")
+	s.Require().NoError(err)
+	supply := sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000)))
+	s.Require().NoError(qapp.BankKeeper.MintCoins(ctx, types.ModuleName, supply))
+	s.Require().NoError(qapp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, testAccount, supply))
+
+	// the proposal disables deposits and begins unbonding.
+	s.Require().NoError(icsKeeper.HandleOffboardZoneProposal(ctx, types.NewOffboardZoneProposal("offboard", "offboard zone", zone.ChainId)))
+	s.Require().Error(icsKeeper.HandleOffboardZoneProposal(ctx, types.NewOffboardZoneProposal("offboard", "offboard zone", zone.ChainId)))
+	s.Require().Error(icsKeeper.HandleOffboardZoneProposal(ctx, types.NewOffboardZoneProposal("offboard", "offboard zone", "unknown")))
+
+	zone, _ = icsKeeper.GetZone(ctx, zone.ChainId)
+	s.Require().Equal(types.OffboardingStatusUnbonding, zone.OffboardingStatus)
+	s.Require().False(zone.DepositsEnabled)
+	s.Require().Error(icsKeeper.HandleUpdateZoneProposal(ctx, types.NewUpdateZoneProposal("update", "update zone", zone.ChainId, []*types.UpdateZoneValue{{Key: "deposits_enabled", Value: "true"}})))
+
+	// redemptions are rejected until the zone is claimable.
+	recipient, err := bech32.ConvertAndEncode(zone.AccountPrefix, utils.GenerateAccAddressForTest())
+	s.Require().NoError(err)
+	redeem := &types.MsgRequestRedemption{Value: sdk.NewCoin(zone.LocalDenom, sdk.NewInt(400)), DestinationAddress: recipient, FromAddress: testAddress}
+	msgSrv := keeper.NewMsgServerImpl(icsKeeper)
+	_, err = msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), redeem)
+	s.Require().ErrorContains(err, "offboarding")
+
+	// every delegation is undelegated in full.
+	s.Require().NoError(icsKeeper.HandleOffboarding(ctx, &zone, 1))
+	records := icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)
+	s.Require().Equal(1, len(records))
+	s.Require().Equal("offboard/1", records[0].Memo)
+	s.Require().Equal([]string{"/cosmos.staking.v1beta1.MsgUndelegate", "/cosmos.staking.v1beta1.MsgUndelegate"}, records[0].MsgTypes)
+
+	// no further undelegations are sent until the previous are acknowledged.
+	s.Require().NoError(icsKeeper.HandleOffboarding(ctx, &zone, 2))
+	s.Require().Equal(1, len(icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)))
+	icsKeeper.SetZone(ctx, &zone)
+
+	completion := ctx.BlockTime().Add(time.Hour)
+	for _, val := range zone.Validators[:2] {
+		msg := &stakingtypes.MsgUndelegate{DelegatorAddress: delegator, ValidatorAddress: val.ValoperAddress, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))}
+		s.Require().NoError(icsKeeper.HandleUndelegate(ctx, msg, completion, "offboard/1"))
+		icsKeeper.SetDelegation(ctx, &zone, types.NewDelegation(delegator, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt())))
+	}
+	for _, record := range icsKeeper.AllICAPacketRecords(ctx) {
+		icsKeeper.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+	}
+
+	zone, _ = icsKeeper.GetZone(ctx, zone.ChainId)
+	s.Require().Equal(completion, *zone.OffboardingUnbondingCompletion)
+
+	status, err := icsKeeper.OffboardingStatus(ctx, &types.QueryOffboardingStatusRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(types.OffboardingStatusUnbonding, status.Status)
+	s.Require().Equal(uint64(0), status.Delegations)
+	s.Require().Equal(supply[0], status.Supply)
+
+	// the zone settles once the unbondings have matured.
+	s.Require().NoError(icsKeeper.HandleOffboarding(ctx, &zone, 3))
+	s.Require().Equal(types.OffboardingStatusUnbonding, zone.OffboardingStatus)
+
+	s.Require().NoError(icsKeeper.HandleOffboarding(ctx.WithBlockTime(completion.Add(time.Minute)), &zone, 24))
+	s.Require().Equal(types.OffboardingStatusSettling, zone.OffboardingStatus)
+
+	request := qapp.AppCodec().MustMarshal(&banktypes.QueryAllBalancesRequest{Address: delegator})
+	queryID := interchainquerykeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "cosmos.bank.v1beta1.Query/AllBalances", request, types.ModuleName)
+	_, found = qapp.InterchainQueryKeeper.GetQuery(ctx, queryID)
+	s.Require().True(found)
+
+	// the rate is not determined until the balances have been received.
+	s.Require().NoError(icsKeeper.HandleOffboarding(ctx, &zone, 25))
+	s.Require().Equal(types.OffboardingStatusSettling, zone.OffboardingStatus)
+
+	qapp.InterchainQueryKeeper.DeleteQuery(ctx, queryID)
+	s.Require().NoError(zone.DelegationAddress.SetBalance(sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(2000)))))
+	s.Require().NoError(icsKeeper.HandleOffboarding(ctx, &zone, 25))
+	s.Require().Equal(types.OffboardingStatusClaimable, zone.OffboardingStatus)
+	s.Require().Equal(sdk.NewDec(2), zone.OffboardingRate)
+	icsKeeper.SetZone(ctx, &zone)
+
+	// redemptions are paid at the offboarding rate from the delegation account.
+	_, err = msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), redeem)
+	s.Require().NoError(err)
+
+	zone, _ = icsKeeper.GetZone(ctx, zone.ChainId)
+	s.Require().Equal(sdk.NewInt(1200), zone.DelegationAddress.Balance.AmountOf(zone.BaseDenom))
+
+	withdrawals := icsKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId)
+	s.Require().Equal(1, len(withdrawals))
+	s.Require().Equal(keeper.WithdrawStatusSend, withdrawals[0].Status)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(800))), withdrawals[0].Amount)
+	s.Require().Equal([]string{"/cosmos.bank.v1beta1.MsgSend"}, icsKeeper.ZoneICAPacketRecords(ctx, zone.ChainId, keeper.ICAPacketStatusInFlight)[0].MsgTypes)
+
+	s.Require().False(icsKeeper.IsOffboardingComplete(ctx, &zone))
+
+	// the zone is removed once every qAsset has been redeemed.
+	icsKeeper.DeleteWithdrawalRecord(ctx, zone.ChainId, withdrawals[0].Txhash, keeper.WithdrawStatusSend)
+	s.Require().NoError(qapp.BankKeeper.SendCoinsFromAccountToModule(ctx, testAccount, types.EscrowModuleAccount, sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(600)))))
+	escrowed := qapp.BankKeeper.GetAllBalances(ctx, qapp.AccountKeeper.GetModuleAddress(types.EscrowModuleAccount))
+	s.Require().NoError(qapp.BankKeeper.BurnCoins(ctx, types.EscrowModuleAccount, escrowed))
+
+	s.Require().True(icsKeeper.IsOffboardingComplete(ctx, &zone))
+	icsKeeper.RemoveOffboardedZone(ctx, &zone)
+	_, found = icsKeeper.GetZone(ctx, zone.ChainId)
+	s.Require().False(found)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// PreviewRebalancing returns the redelegations that would be issued were the zone rebalanced now,
+// along with the resulting per-validator plan and the distance to target before and after.
+func (k *Keeper) PreviewRebalancing(ctx sdk.Context, zone *types.Zone) ([]types.RebalanceTarget, []types.ValidatorPlan, string, string) {
+	plans := k.GetValidatorPlans(ctx, zone)
+	rebalances := k.DeterminePlanForRebalancing(ctx, zone)
+	plans.ApplyRebalances(rebalances)
+	before, after := distancesForPlans(zone, plans)
+	return rebalances, plans.Sorted(), before, after
+}
+
+// PreviewDelegation returns the per-validator plan were amount delegated now, along with the
+// distance to target before and after.
+func (k *Keeper) PreviewDelegation(ctx sdk.Context, zone *types.Zone, amount sdk.Coins) ([]types.ValidatorPlan, string, string) {
+	plans := k.GetValidatorPlans(ctx, zone)
+	plans.ApplyDelegations(k.DeterminePlanForDelegation(ctx, zone, amount))
+	before, after := distancesForPlans(zone, plans)
+	return plans.Sorted(), before, after
+}
+
+// PreviewUndelegation returns the per-validator plan were amount undelegated now, along with the
+// distance to target before and after.
+func (k *Keeper) PreviewUndelegation(ctx sdk.Context, zone *types.Zone, amount sdk.Coins) ([]types.ValidatorPlan, string, string) {
+	plans := k.GetValidatorPlans(ctx, zone)
+	plans.ApplyUndelegations(k.DeterminePlanForUndelegation(ctx, zone, amount))
+	before, after := distancesForPlans(zone, plans)
+	return plans.Sorted(), before, after
+}
+
+// GetValidatorPlans returns a ValidatorPlan for each validator of the zone, populated with the current
+// delegated amount and the amount locked by in-flight redelegations.
+func (k *Keeper) GetValidatorPlans(ctx sdk.Context, zone *types.Zone) types.ValidatorPlans {
+	currentAllocations, _, _ := k.GetDelegationMap(ctx, zone)
+	availablePerValidator, _ := k.GetUnlockedTokensForZone(ctx, zone)
+
+	plans := make(types.ValidatorPlans, len(zone.Validators))
+	for _, valoper := range zone.GetValidatorsAddressesAsSlice() {
+		plans[valoper] = types.NewValidatorPlan(valoper, sdk.ZeroInt(), sdk.ZeroInt())
+	}
+	for valoper, current := range currentAllocations {
+		locked := sdk.ZeroInt()
+		if available, found := availablePerValidator[valoper]; found {
+			locked = current.Sub(available)
+		}
+		plans[valoper] = types.NewValidatorPlan(valoper, current, locked)
+	}
+	return plans
+}
+
+func distancesForPlans(zone *types.Zone, plans types.ValidatorPlans) (string, string) {
+	target := zone.GetAggregateIntentOrDefault()
+	valopers := zone.GetValidatorsAddressesAsSlice()
+	before := types.DistanceToTarget(types.AllocationsAsIntent(plans.Current()), target, valopers)
+	after := types.DistanceToTarget(types.AllocationsAsIntent(plans.Projected()), target, valopers)
+	return fmt.Sprintf("%f", before), fmt.Sprintf("%f", after)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +14,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
 	tmclienttypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
 
+	"github.com/ingenuity-build/quicksilver/utils"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
@@ -130,6 +132,10 @@ func (k *Keeper) HandleUpdateZoneProposal(ctx sdk.Context, p *types.UpdateZonePr
 		return err
 	}
 
+	if zone.IsOffboarding() {
+		return fmt.Errorf("zone %s is offboarding and cannot be updated", zone.ChainId)
+	}
+
 	for _, change := range p.Changes {
 		switch change.Key {
 		case "base_denom":
@@ -178,6 +184,211 @@ func (k *Keeper) HandleUpdateZoneProposal(ctx sdk.Context, p *types.UpdateZonePr
 			}
 			zone.ReturnToSender = boolValue
 
+		case "max_rebalance_total":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("max_rebalance_total must be between 0 and 1")
+			}
+			zone.MaxRebalanceTotal = decValue
+
+		case "max_rebalance_per_epoch":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("max_rebalance_per_epoch must be between 0 and 1")
+			}
+			zone.MaxRebalancePerEpoch = decValue
+
+		case "max_rebalance_iterations":
+			intValue, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			zone.MaxRebalanceIterations = intValue
+
+		case "instant_redemption_buffer_rate":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("instant_redemption_buffer_rate must be between 0 and 1")
+			}
+			zone.InstantRedemptionBufferRate = decValue
+
+		case "instant_redemption_fee":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("instant_redemption_fee must be between 0 and 1")
+			}
+			zone.InstantRedemptionFee = decValue
+
+		case "commission_rate":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("commission_rate must be between 0 and 1")
+			}
+			zone.CommissionRate = &decValue
+
+		case "deposit_limit_rate":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("deposit_limit_rate must be between 0 and 1")
+			}
+			zone.DepositLimitRate = decValue
+
+		case "redemption_limit_rate":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("redemption_limit_rate must be between 0 and 1")
+			}
+			zone.RedemptionLimitRate = decValue
+
+		case "max_commission_rate":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("max_commission_rate must be between 0 and 1")
+			}
+			zone.MaxCommissionRate = decValue
+
+		case "min_uptime":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("min_uptime must be between 0 and 1")
+			}
+			zone.MinUptime = decValue
+
+		case "max_validator_share":
+			decValue, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decValue.IsNegative() || decValue.GT(sdk.OneDec()) {
+				return errors.New("max_validator_share must be between 0 and 1")
+			}
+			zone.MaxValidatorShare = decValue
+
+		case "validator_denylist":
+			denylist := make([]string, 0)
+			for _, valoper := range strings.Split(change.Value, ",") {
+				valoper = strings.TrimSpace(valoper)
+				if valoper == "" {
+					continue
+				}
+				if _, err := utils.ValAddressFromBech32(valoper, zone.AccountPrefix+"valoper"); err != nil {
+					return err
+				}
+				denylist = append(denylist, valoper)
+			}
+			zone.ValidatorDenylist = denylist
+
+		case "delegation_shards":
+			shards, err := strconv.ParseUint(change.Value, 10, 32)
+			if err != nil {
+				return err
+			}
+			if shards == 0 || shards > types.MaxDelegationShards {
+				return fmt.Errorf("delegation_shards must be between 1 and %d", types.MaxDelegationShards)
+			}
+			if int(shards) < len(zone.DelegationShards)+1 {
+				return errors.New("delegation_shards cannot be reduced")
+			}
+			for index := 1; index < int(shards); index++ {
+				portOwner := types.GetDelegationPortOwner(zone.ChainId, index)
+				portID, _ := icatypes.NewControllerPortID(portOwner)
+				if _, found := zone.GetDelegationAccountByPort(portID); found {
+					continue
+				}
+				if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
+					return err
+				}
+			}
+
+		case "ica_timeout":
+			intValue, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			if intValue > uint64(types.MaxICATimeout/time.Second) {
+				return fmt.Errorf("ica_timeout must not exceed %d seconds", uint64(types.MaxICATimeout/time.Second))
+			}
+			zone.IcaTimeout = intValue
+
+		case "ica_chunk_size":
+			intValue, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			zone.IcaChunkSize = intValue
+
+		case "ica_gas_budget":
+			intValue, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			zone.IcaGasBudget = intValue
+
+		case "intent_lifetime":
+			intValue, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			if intValue > uint64(types.MaxIntentLifetime/time.Second) {
+				return fmt.Errorf("intent_lifetime must not exceed %d seconds", uint64(types.MaxIntentLifetime/time.Second))
+			}
+			zone.IntentLifetime = intValue
+
+		case "intent_decay":
+			intValue, err := strconv.ParseUint(change.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			if intValue > uint64(types.MaxIntentDecay/time.Second) {
+				return fmt.Errorf("intent_decay must not exceed %d seconds", uint64(types.MaxIntentDecay/time.Second))
+			}
+			zone.IntentDecay = intValue
+
+		case "add_deposit_asset":
+			asset := types.DepositAsset{}
+			if err := k.cdc.UnmarshalJSON([]byte(change.Value), &asset); err != nil {
+				return err
+			}
+			if err := asset.Validate(); err != nil {
+				return err
+			}
+			if asset.Denom == zone.BaseDenom {
+				return errors.New("deposit asset must not be the zone base denom")
+			}
+			zone.SetDepositAsset(asset)
+
+		case "remove_deposit_asset":
+			if !zone.RemoveDepositAsset(change.Value) {
+				return fmt.Errorf("unable to find deposit asset %s", change.Value)
+			}
+
 		case "connection_id":
 			if !strings.HasPrefix(change.Value, "connection-") {
 				return errors.New("unexpected connection format")
@@ -253,3 +464,25 @@ func (k *Keeper) HandleUpdateZoneProposal(ctx sdk.Context, p *types.UpdateZonePr
 
 	return nil
 }
+
+// HandleOffboardZoneProposal is a handler for executing a passed zone offboarding proposal. The zone stops accepting
+// deposits and begins unbonding its delegations at the end of the next epoch.
+func (k *Keeper) HandleOffboardZoneProposal(ctx sdk.Context, p *types.OffboardZoneProposal) error {
+	zone, found := k.GetZone(ctx, p.ChainId)
+	if !found {
+		return fmt.Errorf("unable to get registered zone for chain id: %s", p.ChainId)
+	}
+
+	if zone.IsOffboarding() {
+		return fmt.Errorf("zone %s is already offboarding", zone.ChainId)
+	}
+
+	zone.OffboardingStatus = types.OffboardingStatusUnbonding
+	zone.DepositsEnabled = false
+	k.SetZone(ctx, &zone)
+
+	k.Logger(ctx).Info("offboarding zone", "chain_id", zone.ChainId)
+	k.emitOffboardingEvent(ctx, &zone)
+
+	return nil
+}
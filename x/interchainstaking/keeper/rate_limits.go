@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetDepositRateLimit returns the zone's deposit limit for the current epoch, in native assets, measured against the
+// zone's TVL.
+func (k *Keeper) GetDepositRateLimit(ctx sdk.Context, zone *types.Zone) types.RateLimit {
+	tvl := sdk.ZeroInt()
+	if !zone.RedemptionRate.IsNil() {
+		tvl = zone.RedemptionRate.MulInt(k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount).TruncateInt()
+	}
+	return types.NewRateLimit(zone.BaseDenom, zone.GetDepositLimitRate(), tvl, zone.GetEpochDeposits())
+}
+
+// GetRedemptionRateLimit returns the zone's redemption limit for the current epoch, in qAssets, measured against the
+// zone's qAsset supply.
+func (k *Keeper) GetRedemptionRateLimit(ctx sdk.Context, zone *types.Zone) types.RateLimit {
+	supply := k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+	return types.NewRateLimit(zone.LocalDenom, zone.GetRedemptionLimitRate(), supply, zone.GetEpochRedemptions())
+}
+
+// RecordDeposit adds amount to the zone's deposits for the current epoch.
+func (k *Keeper) RecordDeposit(ctx sdk.Context, zone *types.Zone, amount sdkmath.Int) {
+	zone.EpochDeposits = zone.GetEpochDeposits().Add(amount)
+	k.SetZone(ctx, zone)
+}
+
+// RecordRedemption adds amount to the zone's redemptions for the current epoch.
+func (k *Keeper) RecordRedemption(ctx sdk.Context, zone *types.Zone, amount sdkmath.Int) {
+	zone.EpochRedemptions = zone.GetEpochRedemptions().Add(amount)
+	k.SetZone(ctx, zone)
+}
+
+// ReverseRedemption removes the qAssets of a cancelled redemption from the zone's redemptions for the current epoch.
+// Redemptions requested before the usage was last reset are no longer counted, and are not reversed.
+func (k *Keeper) ReverseRedemption(ctx sdk.Context, zone *types.Zone, record types.WithdrawalRecord) {
+	if record.RequestHeight == 0 || record.RequestHeight < zone.EpochLimitsHeight {
+		return
+	}
+	used := zone.GetEpochRedemptions()
+	zone.EpochRedemptions = used.Sub(sdkmath.MinInt(used, record.BurnAmount.Amount))
+	k.SetZone(ctx, zone)
+}
+
+// ResetRateLimits clears the zone's deposit and redemption usage at the end of an epoch. The caller is responsible
+// for persisting the zone.
+func (k *Keeper) ResetRateLimits(ctx sdk.Context, zone *types.Zone) {
+	zone.EpochDeposits = sdk.ZeroInt()
+	zone.EpochRedemptions = sdk.ZeroInt()
+	zone.EpochLimitsHeight = ctx.BlockHeight()
+}
+
+// RefundDeposit returns a deposit that exceeded the zone deposit limit to the sender, from the deposit account, and
+// records a refunded receipt so the deposit is not processed again.
+func (k *Keeper) RefundDeposit(ctx sdk.Context, zone *types.Zone, senderAddress string, hash string, assets sdk.Coins) error {
+	msg := &bankTypes.MsgSend{FromAddress: zone.DepositAddress.GetAddress(), ToAddress: senderAddress, Amount: assets}
+	if err := k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DepositAddress, hash); err != nil {
+		return err
+	}
+
+	receipt := k.NewReceipt(ctx, zone, senderAddress, hash, assets)
+	receipt.Refunded = true
+	k.SetReceipt(ctx, *receipt)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundDeposit,
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, senderAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, assets.String()),
+			sdk.NewAttribute(types.AttributeKeyHash, hash),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+		),
+	)
+
+	return nil
+}
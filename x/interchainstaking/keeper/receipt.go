@@ -12,16 +12,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
 const (
 	Unset           = "unset"
-	ICAMsgChunkSize = 5
+	ICAMsgChunkSize = types.DefaultICAMsgChunkSize
 )
 
 func (k *Keeper) HandleReceiptForTransaction(ctx sdk.Context, txr *sdk.TxResponse, txn *tx.Tx, zone *types.Zone) error {
@@ -32,10 +34,24 @@ func (k *Keeper) HandleReceiptForTransaction(ctx sdk.Context, txr *sdk.TxRespons
 	senderAddress := Unset
 	assets := sdk.Coins{}
 
-	for _, event := range txr.Events {
+	// deposits received over IBC are transferred to the deposit address by the transfer module, so the depositor is
+	// the sender of the received packet.
+	packetSenders, packetMemo, err := k.GetDepositPacketSenders(zone, txr.Events)
+	if err != nil {
+		k.Logger(ctx).Error("unable to determine packet sender. Ignoring.", "err", err)
+		return fmt.Errorf("unable to determine packet sender. Ignoring. err: %w", err)
+	}
+	if len(packetSenders) > 0 && packetMemo != "" {
+		memo = packetMemo
+	}
+
+	for i, event := range txr.Events {
 		if event.Type == types.TransferPort {
 			attrs := types.AttributesToMap(event.Attributes)
 			sender := attrs["sender"]
+			if packetSender, found := packetSenders[i]; found {
+				sender = packetSender
+			}
 			amount := attrs["amount"]
 			if attrs["recipient"] == zone.DepositAddress.GetAddress() { // negate case where sender sends to multiple addresses in one tx
 				if senderAddress == Unset {
@@ -70,6 +86,11 @@ func (k *Keeper) HandleReceiptForTransaction(ctx sdk.Context, txr *sdk.TxRespons
 	}
 	var senderAccAddress sdk.AccAddress = addressBytes
 
+	// a signal only transfer updates the intent of the sender; the transferred assets are refunded and no qAssets minted.
+	if depositMemo, err := types.ParseDepositMemo(memo); len(memo) > 0 && err == nil && depositMemo.SignalOnly {
+		return k.HandleIntentSignal(ctx, zone, senderAccAddress, senderAddress, hash, memo, assets)
+	}
+
 	if err := zone.ValidateCoinsForZone(assets); err != nil {
 		// we expect this to trigger if the validatorset has changed recently (i.e. we haven't seen the validator before.
 		// That is okay, we'll catch it next round!)
@@ -79,21 +100,61 @@ func (k *Keeper) HandleReceiptForTransaction(ctx sdk.Context, txr *sdk.TxRespons
 
 	k.Logger(ctx).Info("found new deposit tx", "deposit_address", zone.DepositAddress.GetAddress(), "senderAddress", senderAddress, "local", senderAccAddress.String(), "chain id", zone.ChainId, "assets", assets, "hash", hash)
 
+	// deposit assets are valued in the zone base denom; intent and rate limits are applied against the value.
+	valued, err := k.ValueDeposit(ctx, zone, assets)
+	if err != nil {
+		k.Logger(ctx).Error("unable to value deposit. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+		return fmt.Errorf("unable to value deposit. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+	}
+
+	depositAmount := zone.GetDepositValue(valued)
+
+	if !k.GetDepositRateLimit(ctx, zone).Allows(depositAmount) {
+		k.Logger(ctx).Info("deposit exceeds zone deposit limit; refunding.", "senderAddress", senderAddress, "zone", zone.ChainId, "assets", assets)
+		if err := k.RefundDeposit(ctx, zone, senderAddress, hash, assets); err != nil {
+			k.Logger(ctx).Error("unable to refund deposit. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+			return fmt.Errorf("unable to refund deposit. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+		}
+		return nil
+	}
+
 	// update state
-	if err := k.UpdateDelegatorIntent(ctx, senderAccAddress, zone, assets, memo); err != nil {
+	if err := k.UpdateDelegatorIntent(ctx, senderAccAddress, zone, valued, memo); err != nil {
 		k.Logger(ctx).Error("unable to update intent. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err.Error())
 		return fmt.Errorf("unable to update intent. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
 	}
-	if err := k.MintQAsset(ctx, senderAccAddress, senderAddress, zone, assets); err != nil {
-		k.Logger(ctx).Error("unable to mint QAsset. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
-		return fmt.Errorf("unable to mint QAsset. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+
+	// a memo that cannot be parsed is not fatal to the deposit; qAssets are simply not forwarded.
+	depositMemo := types.DepositMemo{}
+	if len(memo) > 0 {
+		if depositMemo, err = types.ParseDepositMemo(memo); err != nil {
+			k.Logger(ctx).Info("unable to parse deposit memo; qAssets will not be forwarded.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+		}
+	}
+
+	// qAssets are minted against deposit assets only once the converted asset has been received.
+	native, converted := zone.SplitDepositAssets(assets)
+	if !native.Empty() {
+		if err := k.MintQAsset(ctx, senderAccAddress, senderAddress, zone, native, depositMemo); err != nil {
+			k.Logger(ctx).Error("unable to mint QAsset. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+			return fmt.Errorf("unable to mint QAsset. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+		}
+
+		if err := k.TransferToDelegate(ctx, zone, native, hash); err != nil {
+			k.Logger(ctx).Error("unable to transfer to delegate. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+			return fmt.Errorf("unable to transfer to delegate. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+		}
 	}
 
-	if err := k.TransferToDelegate(ctx, zone, assets, hash); err != nil {
-		k.Logger(ctx).Error("unable to transfer to delegate. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
-		return fmt.Errorf("unable to transfer to delegate. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+	if !converted.Empty() {
+		if err := k.ConvertDepositAssets(ctx, zone, converted, hash, senderAddress); err != nil {
+			k.Logger(ctx).Error("unable to convert deposit assets. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+			return fmt.Errorf("unable to convert deposit assets. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+		}
 	}
 
+	k.RecordDeposit(ctx, zone, depositAmount)
+
 	// create receipt
 	receipt := k.NewReceipt(ctx, zone, senderAddress, hash, assets)
 	k.SetReceipt(ctx, *receipt)
@@ -101,8 +162,79 @@ func (k *Keeper) HandleReceiptForTransaction(ctx sdk.Context, txr *sdk.TxRespons
 	return nil
 }
 
-// MintQAsset mints qAssets based on the native asset redemption rate.  Tokens are then transferred to the given user.
-func (k *Keeper) MintQAsset(ctx sdk.Context, sender sdk.AccAddress, senderAddress string, zone *types.Zone, assets sdk.Coins) error {
+// HandleIntentSignal applies the intent signalled by a signal only transfer to the deposit address, and refunds the
+// transferred assets. The assets are refunded even if the intent cannot be applied.
+func (k *Keeper) HandleIntentSignal(ctx sdk.Context, zone *types.Zone, sender sdk.AccAddress, senderAddress string, hash string, memo string, assets sdk.Coins) error {
+	k.Logger(ctx).Info("found new intent signal tx", "deposit_address", zone.DepositAddress.GetAddress(), "senderAddress", senderAddress, "local", sender.String(), "chain id", zone.ChainId, "hash", hash)
+
+	if err := k.SignalIntentFromMemo(ctx, zone, sender, memo); err != nil {
+		k.Logger(ctx).Error("unable to signal intent from memo. Refunding.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+	}
+
+	if err := k.RefundDeposit(ctx, zone, senderAddress, hash, assets); err != nil {
+		k.Logger(ctx).Error("unable to refund intent signal. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+		return fmt.Errorf("unable to refund intent signal. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+	}
+
+	return nil
+}
+
+// GetDepositPacketSenders returns the senders of the ICS-20 packets received by the zone deposit address in the given
+// events, keyed by the index of the transfer event that credited the deposit address, and the packet memo. The
+// transfer module credits the receiver before emitting the packet event, so each packet is matched to the closest
+// preceding unmatched transfer of its amount to the deposit address.
+func (k *Keeper) GetDepositPacketSenders(zone *types.Zone, events []abcitypes.Event) (senders map[int]string, memo string, err error) {
+	senders = make(map[int]string)
+	for i, event := range events {
+		if event.Type != ibctransfertypes.EventTypePacket {
+			continue
+		}
+		attrs := types.AttributesToMap(event.Attributes)
+		if attrs[ibctransfertypes.AttributeKeyReceiver] != zone.DepositAddress.GetAddress() || attrs[ibctransfertypes.AttributeKeyAckSuccess] != "true" {
+			continue
+		}
+
+		_, addressBytes, err := bech32.DecodeAndConvert(attrs[sdk.AttributeKeySender])
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to decode packet sender %q: %w", attrs[sdk.AttributeKeySender], err)
+		}
+		sender, err := bech32.ConvertAndEncode(zone.AccountPrefix, addressBytes)
+		if err != nil {
+			return nil, "", err
+		}
+
+		matched := -1
+		for j := i - 1; j >= 0 && matched < 0; j-- {
+			if _, found := senders[j]; found || events[j].Type != types.TransferPort {
+				continue
+			}
+			transfer := types.AttributesToMap(events[j].Attributes)
+			if transfer["recipient"] != zone.DepositAddress.GetAddress() {
+				continue
+			}
+			if amount := attrs[ibctransfertypes.AttributeKeyAmount]; amount != "" {
+				coins, err := sdk.ParseCoinsNormalized(transfer["amount"])
+				if err != nil || len(coins) != 1 || coins[0].Amount.String() != amount {
+					continue
+				}
+			}
+			matched = j
+		}
+		if matched < 0 {
+			return nil, "", fmt.Errorf("unable to find transfer to deposit address for packet from %q", sender)
+		}
+
+		senders[matched] = sender
+		if attrs[ibctransfertypes.AttributeKeyMemo] != "" {
+			memo = attrs[ibctransfertypes.AttributeKeyMemo]
+		}
+	}
+	return senders, memo, nil
+}
+
+// MintQAsset mints qAssets based on the native asset redemption rate.  Tokens are then transferred to the given user,
+// or forwarded over IBC where requested by the deposit memo.
+func (k *Keeper) MintQAsset(ctx sdk.Context, sender sdk.AccAddress, senderAddress string, zone *types.Zone, assets sdk.Coins, depositMemo types.DepositMemo) error {
 	if zone.RedemptionRate.IsZero() {
 		return errors.New("zero redemption rate")
 	}
@@ -119,7 +251,13 @@ func (k *Keeper) MintQAsset(ctx sdk.Context, sender sdk.AccAddress, senderAddres
 		return err
 	}
 
-	if zone.ReturnToSender {
+	switch {
+	case depositMemo.HasForward():
+		// qAssets are forwarded from the user's local account, so that a failed or timed out transfer is refunded there.
+		if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, qAssets); err == nil {
+			k.ForwardQAssets(ctx, zone, sender, qAssets, depositMemo)
+		}
+	case zone.ReturnToSender:
 		var srcPort string
 		var srcChannel string
 
@@ -148,7 +286,7 @@ func (k *Keeper) MintQAsset(ctx sdk.Context, sender sdk.AccAddress, senderAddres
 			},
 			uint64(ctx.BlockTime().UnixNano()+5*time.Minute.Nanoseconds()),
 		)
-	} else {
+	default:
 		err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, qAssets)
 	}
 
@@ -160,9 +298,54 @@ func (k *Keeper) MintQAsset(ctx sdk.Context, sender sdk.AccAddress, senderAddres
 	return nil
 }
 
-// TransferToDelegate transfers tokens from the zone deposit account address to the zone delegate account address.
+// ForwardQAssets transfers qAssets from the user's local account over the channel given in the deposit memo. If the
+// transfer cannot be initiated the qAssets remain in the local account; if it later fails or times out, the transfer
+// module refunds the local account.
+func (k *Keeper) ForwardQAssets(ctx sdk.Context, zone *types.Zone, sender sdk.AccAddress, qAssets sdk.Coins, depositMemo types.DepositMemo) {
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, types.TransferPort, depositMemo.ForwardChannel)
+	if !found || channel.State != channeltypes.OPEN {
+		k.Logger(ctx).Error("unable to forward qAssets; channel not open", "channel", depositMemo.ForwardChannel, "sender", sender)
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	for _, qAsset := range qAssets {
+		if err := k.TransferKeeper.SendTransfer(
+			cacheCtx,
+			types.TransferPort,
+			depositMemo.ForwardChannel,
+			qAsset,
+			sender,
+			depositMemo.ForwardReceiver,
+			clienttypes.Height{
+				RevisionNumber: 0,
+				RevisionHeight: 0,
+			},
+			uint64(ctx.BlockTime().UnixNano()+5*time.Minute.Nanoseconds()),
+		); err != nil {
+			k.Logger(ctx).Error("unable to forward qAssets", "channel", depositMemo.ForwardChannel, "sender", sender, "err", err)
+			return
+		}
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardQAssets,
+			sdk.NewAttribute(types.AttributeKeyUser, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, depositMemo.ForwardReceiver),
+			sdk.NewAttribute(types.AttributeKeyChannelID, depositMemo.ForwardChannel),
+			sdk.NewAttribute(types.AttributeKeyAmount, qAssets.String()),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+		),
+	)
+	k.Logger(ctx).Info("Forwarded qAssets", "assets", qAssets, "sender", sender, "channel", depositMemo.ForwardChannel, "receiver", depositMemo.ForwardReceiver)
+}
+
+// TransferToDelegate transfers tokens from the zone deposit account address to the least delegated of the zone's
+// delegate account addresses.
 func (k *Keeper) TransferToDelegate(ctx sdk.Context, zone *types.Zone, coins sdk.Coins, memo string) error {
-	msg := &bankTypes.MsgSend{FromAddress: zone.DepositAddress.GetAddress(), ToAddress: zone.DelegationAddress.GetAddress(), Amount: coins}
+	msg := &bankTypes.MsgSend{FromAddress: zone.DepositAddress.GetAddress(), ToAddress: k.GetLeastDelegatedAccount(ctx, zone).GetAddress(), Amount: coins}
 	return k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DepositAddress, memo)
 }
 
@@ -188,21 +371,18 @@ func (k *Keeper) SubmitTx(ctx sdk.Context, msgs []sdk.Msg, account *types.ICAAcc
 		return sdkioerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	chunkSize := ICAMsgChunkSize
-	timeoutTimestamp := uint64(ctx.BlockTime().Add(24 * time.Hour).UnixNano())
-
-	for {
-		// if no messages, no chunks!
-		if len(msgs) == 0 {
-			break
-		}
+	chainID, err := k.GetChainID(ctx, connectionID)
+	if err != nil {
+		return err
+	}
 
-		// if the last chunk, make chunksize the number of messages
-		if len(msgs) < chunkSize {
-			chunkSize = len(msgs)
-		}
-		msgsChunk := msgs[0:chunkSize]
+	limits := types.DefaultICAPacketLimits()
+	if zone, found := k.GetZone(ctx, chainID); found {
+		limits = zone.GetICAPacketLimits()
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(limits.Timeout).UnixNano())
 
+	for _, msgsChunk := range types.ChunkMsgs(msgs, limits) {
 		// build and submit message for this chunk
 		data, err := icatypes.SerializeCosmosTx(k.cdc, msgsChunk)
 		if err != nil {
@@ -216,13 +396,12 @@ func (k *Keeper) SubmitTx(ctx sdk.Context, msgs []sdk.Msg, account *types.ICAAcc
 			Memo: memo,
 		}
 
-		_, err = k.ICAControllerKeeper.SendTx(ctx, chanCap, connectionID, portID, packetData, timeoutTimestamp)
+		sequence, err := k.ICAControllerKeeper.SendTx(ctx, chanCap, connectionID, portID, packetData, timeoutTimestamp)
 		if err != nil {
 			return err
 		}
 
-		// remove chunk from original msg slice
-		msgs = msgs[chunkSize:]
+		k.RecordICAPacketSent(ctx, chainID, portID, channelID, sequence, msgsChunk, memo, timeoutTimestamp)
 	}
 
 	return nil
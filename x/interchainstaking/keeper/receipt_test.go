@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestMintQAssetForwarding() {
+	tests := []struct {
+		name          string
+		memo          func(channelID string) icstypes.DepositMemo
+		expectForward bool
+	}{
+		{
+			name: "no forward",
+			memo: func(_ string) icstypes.DepositMemo {
+				return icstypes.DepositMemo{}
+			},
+			expectForward: false,
+		},
+		{
+			name: "forward over open channel",
+			memo: func(channelID string) icstypes.DepositMemo {
+				return icstypes.DepositMemo{ForwardChannel: channelID, ForwardReceiver: utils.GenerateAccAddressForTestWithPrefix("osmo")}
+			},
+			expectForward: true,
+		},
+		{
+			name: "unknown channel falls back to local account",
+			memo: func(_ string) icstypes.DepositMemo {
+				return icstypes.DepositMemo{ForwardChannel: "channel-99", ForwardReceiver: utils.GenerateAccAddressForTestWithPrefix("osmo")}
+			},
+			expectForward: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			// open a transfer channel over the zone connection.
+			s.path.EndpointA.ChannelConfig.Version = ibctransfertypes.Version
+			s.path.EndpointB.ChannelConfig.Version = ibctransfertypes.Version
+			s.coordinator.CreateChannels(s.path)
+			s.setupTestZones()
+
+			quicksilver := s.GetQuicksilverApp(s.chainA)
+			ctx := s.chainA.GetContext()
+
+			zone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+			s.Require().True(found)
+
+			sender := utils.GenerateAccAddressForTest()
+			assets := sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000000)))
+			memo := tt.memo(s.path.EndpointA.ChannelID)
+
+			err := quicksilver.InterchainstakingKeeper.MintQAsset(ctx, sender, sender.String(), &zone, assets, memo)
+			s.Require().NoError(err)
+
+			escrow := ibctransfertypes.GetEscrowAddress(icstypes.TransferPort, s.path.EndpointA.ChannelID)
+			if tt.expectForward {
+				s.Require().True(quicksilver.BankKeeper.GetBalance(ctx, sender, zone.LocalDenom).IsZero())
+				s.Require().Equal(sdk.NewInt(1000000), quicksilver.BankKeeper.GetBalance(ctx, escrow, zone.LocalDenom).Amount)
+				return
+			}
+
+			s.Require().Equal(sdk.NewInt(1000000), quicksilver.BankKeeper.GetBalance(ctx, sender, zone.LocalDenom).Amount)
+			s.Require().True(quicksilver.BankKeeper.GetBalance(ctx, escrow, zone.LocalDenom).IsZero())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetRedemptionRateRecord returns the redemption rate record for the given zone and epoch.
+func (k *Keeper) GetRedemptionRateRecord(ctx sdk.Context, chainID string, epochNumber int64) (types.RedemptionRateRecord, bool) {
+	record := types.RedemptionRateRecord{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := store.Get(types.GetRedemptionRateRecordKey(chainID, epochNumber))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetRedemptionRateRecord stores the redemption rate record.
+func (k *Keeper) SetRedemptionRateRecord(ctx sdk.Context, record types.RedemptionRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetRedemptionRateRecordKey(record.ChainId, record.Epoch), bz)
+}
+
+// DeleteRedemptionRateRecord deletes the redemption rate record.
+func (k *Keeper) DeleteRedemptionRateRecord(ctx sdk.Context, chainID string, epochNumber int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	store.Delete(types.GetRedemptionRateRecordKey(chainID, epochNumber))
+}
+
+// IterateZoneRedemptionRateRecords iterates through the redemption rate records of the given zone, oldest first.
+func (k *Keeper) IterateZoneRedemptionRateRecords(ctx sdk.Context, chainID string, fn func(index int64, record types.RedemptionRateRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRedemptionRateRecordsKey(chainID))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.RedemptionRateRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		stop := fn(i, record)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// ZoneRedemptionRateRecords returns every redemption rate record in the store for the specified zone, oldest first.
+func (k *Keeper) ZoneRedemptionRateRecords(ctx sdk.Context, chainID string) []types.RedemptionRateRecord {
+	records := []types.RedemptionRateRecord{}
+	k.IterateZoneRedemptionRateRecords(ctx, chainID, func(_ int64, record types.RedemptionRateRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// AllRedemptionRateRecords returns every redemption rate record in the store.
+func (k *Keeper) AllRedemptionRateRecords(ctx sdk.Context) []types.RedemptionRateRecord {
+	records := []types.RedemptionRateRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemptionRateRecord)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.RedemptionRateRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// RecordRedemptionRate snapshots the zone's current redemption rate and TVL for the given epoch, pruning the oldest
+// records such that at most types.RedemptionRateHistoryLength are retained.
+func (k *Keeper) RecordRedemptionRate(ctx sdk.Context, zone *types.Zone, epochNumber int64) {
+	rate := zone.RedemptionRate
+	if rate.IsNil() {
+		rate = sdk.OneDec()
+	}
+
+	k.SetRedemptionRateRecord(ctx, types.RedemptionRateRecord{
+		ChainId: zone.ChainId,
+		Epoch:   epochNumber,
+		Height:  ctx.BlockHeight(),
+		Time:    ctx.BlockTime(),
+		Rate:    rate,
+		Tvl:     rate.MulInt(k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount).TruncateInt(),
+	})
+
+	records := k.ZoneRedemptionRateRecords(ctx, zone.ChainId)
+	for len(records) > types.RedemptionRateHistoryLength {
+		k.DeleteRedemptionRateRecord(ctx, zone.ChainId, records[0].Epoch)
+		records = records[1:]
+	}
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestRecordRedemptionRate() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := qapp.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	s.Require().NoError(qapp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000000)))))
+	zone.RedemptionRate = sdk.NewDecWithPrec(11, 1)
+
+	qapp.InterchainstakingKeeper.RecordRedemptionRate(ctx, &zone, 1)
+
+	record, found := qapp.InterchainstakingKeeper.GetRedemptionRateRecord(ctx, zone.ChainId, 1)
+	s.Require().True(found)
+	s.Require().Equal(int64(1), record.Epoch)
+	s.Require().Equal(ctx.BlockHeight(), record.Height)
+	s.Require().Equal(sdk.NewDecWithPrec(11, 1), record.Rate)
+	s.Require().Equal(sdk.NewInt(1100000), record.Tvl)
+
+	// other zones are unaffected.
+	s.Require().Equal(0, len(qapp.InterchainstakingKeeper.ZoneRedemptionRateRecords(ctx, "elgafar-1")))
+
+	// fill the history; the oldest record is pruned once the limit is exceeded.
+	for epoch := int64(2); epoch <= types.RedemptionRateHistoryLength+1; epoch++ {
+		qapp.InterchainstakingKeeper.RecordRedemptionRate(ctx, &zone, epoch)
+	}
+
+	records := qapp.InterchainstakingKeeper.ZoneRedemptionRateRecords(ctx, zone.ChainId)
+	s.Require().Equal(types.RedemptionRateHistoryLength, len(records))
+	s.Require().Equal(int64(2), records[0].Epoch)
+	s.Require().Equal(int64(types.RedemptionRateHistoryLength+1), records[len(records)-1].Epoch)
+
+	_, found = qapp.InterchainstakingKeeper.GetRedemptionRateRecord(ctx, zone.ChainId, 1)
+	s.Require().False(found)
+}
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	lsmstakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 
@@ -15,6 +16,9 @@ import (
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
+// InstantRedemptionBufferMemo is the memo used when delegating the excess of a zone's instant redemption buffer.
+const InstantRedemptionBufferMemo = "buffer"
+
 // processRedemptionForLsm will determine based on user intent, the tokens to return to the user, generate Redeem message and send them.
 func (k *Keeper) processRedemptionForLsm(ctx sdk.Context, zone *types.Zone, sender sdk.AccAddress, destination string, nativeTokens math.Int, burnAmount sdk.Coin, hash string) error {
 	intent, found := k.GetDelegatorIntent(ctx, zone, sender.String(), false)
@@ -42,9 +46,15 @@ func (k *Keeper) processRedemptionForLsm(ctx sdk.Context, zone *types.Zone, send
 
 	distribution[intents[0].ValoperAddress] += outstanding.Uint64()
 
+	// tokenized shares are sent to the user in a single message, so must all be tokenized from the same account.
+	account, err := k.getDelegationAccountForDistribution(ctx, zone, distribution)
+	if err != nil {
+		return err
+	}
+
 	for _, valoper := range utils.Keys(distribution) {
 		msgs = append(msgs, &lsmstakingtypes.MsgTokenizeShares{
-			DelegatorAddress:    zone.DelegationAddress.Address,
+			DelegatorAddress:    account.Address,
 			ValidatorAddress:    valoper,
 			Amount:              sdk.NewCoin(zone.BaseDenom, sdk.NewIntFromUint64(distribution[valoper])),
 			TokenizedShareOwner: destination,
@@ -58,7 +68,27 @@ func (k *Keeper) processRedemptionForLsm(ctx sdk.Context, zone *types.Zone, send
 	}
 	k.AddWithdrawalRecord(ctx, zone.ChainId, sender.String(), []*types.Distribution{}, destination, sdk.Coins{}, burnAmount, hash, WithdrawStatusTokenize, time.Unix(0, 0))
 
-	return k.SubmitTx(ctx, sdkMsgs, zone.DelegationAddress, hash)
+	return k.SubmitTx(ctx, sdkMsgs, account, hash)
+}
+
+// getDelegationAccountForDistribution returns the first of the zone's delegation accounts with delegations sufficient to
+// satisfy the given per validator distribution.
+func (k *Keeper) getDelegationAccountForDistribution(ctx sdk.Context, zone *types.Zone, distribution map[string]uint64) (*types.ICAAccount, error) {
+	accounts := zone.GetDelegationAccounts()
+	if len(accounts) == 1 {
+		return accounts[0], nil
+	}
+ACCOUNTS:
+	for _, account := range accounts {
+		for _, valoper := range utils.Keys(distribution) {
+			delegation, found := k.GetDelegation(ctx, zone, account.Address, valoper)
+			if !found || delegation.Amount.Amount.LT(sdk.NewIntFromUint64(distribution[valoper])) {
+				continue ACCOUNTS
+			}
+		}
+		return account, nil
+	}
+	return nil, errors.New("unable to satisfy unbond request from a single delegation account")
 }
 
 // queueRedemption will determine based on zone intent, the tokens to unbond, and add a withdrawal record with status QUEUED.
@@ -90,6 +120,98 @@ func (k *Keeper) queueRedemption(
 	return nil
 }
 
+// processInstantRedemption will pay the redemption, less the instant redemption fee, from the zone's instant redemption
+// buffer and add a withdrawal record with status SEND. The buffer is drawn down, and the qAssets burned, on acknowledgement.
+func (k *Keeper) processInstantRedemption(ctx sdk.Context, zone *types.Zone, sender sdk.AccAddress, destination string, nativeTokens math.Int, burnAmount sdk.Coin, hash string) error {
+	fee := sdk.NewCoin(zone.BaseDenom, zone.GetInstantRedemptionFeeAmount(nativeTokens))
+	amount := sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, nativeTokens.Sub(fee.Amount)))
+
+	k.SetWithdrawalRecord(ctx, types.WithdrawalRecord{
+		ChainId:        zone.ChainId,
+		Delegator:      sender.String(),
+		Distribution:   []*types.Distribution{},
+		Recipient:      destination,
+		Amount:         amount,
+		BurnAmount:     burnAmount,
+		Txhash:         hash,
+		Status:         WithdrawStatusSend,
+		CompletionTime: ctx.BlockTime(),
+		Instant:        true,
+		InstantFee:     &fee,
+		RequestHeight:  ctx.BlockHeight(),
+	})
+
+	msg := &banktypes.MsgSend{FromAddress: zone.DelegationAddress.Address, ToAddress: destination, Amount: amount}
+	return k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DelegationAddress, hash)
+}
+
+// CanRedeemInstantly returns true if a redemption of nativeTokens can be paid from the zone's instant redemption buffer.
+func (k *Keeper) CanRedeemInstantly(ctx sdk.Context, zone *types.Zone, nativeTokens math.Int) bool {
+	if !zone.SupportInstantRedemption() {
+		return false
+	}
+	fee := zone.GetInstantRedemptionFeeAmount(nativeTokens)
+	payout := nativeTokens.Sub(fee)
+	return payout.IsPositive() && payout.LTE(k.GetAvailableInstantRedemptionBuffer(ctx, zone))
+}
+
+// GetAvailableInstantRedemptionBuffer returns the zone's instant redemption buffer, less instant redemptions that have
+// been sent but not yet acknowledged.
+func (k *Keeper) GetAvailableInstantRedemptionBuffer(ctx sdk.Context, zone *types.Zone) math.Int {
+	available := zone.GetInstantRedemptionBuffer()
+	k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, WithdrawStatusSend, func(_ int64, record types.WithdrawalRecord) bool {
+		if record.Instant {
+			available = available.Sub(record.Amount.AmountOf(zone.BaseDenom))
+		}
+		return false
+	})
+	if available.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return available
+}
+
+// GetInstantRedemptionBufferTarget returns the amount of native assets the zone aims to hold for instant redemptions.
+func (k *Keeper) GetInstantRedemptionBufferTarget(ctx sdk.Context, zone *types.Zone) math.Int {
+	if !zone.SupportInstantRedemption() {
+		return sdk.ZeroInt()
+	}
+	total := k.GetDelegatedAmount(ctx, zone).Amount.Add(zone.GetInstantRedemptionBuffer())
+	return zone.GetInstantRedemptionBufferRate().MulInt(total).TruncateInt()
+}
+
+// TopUpInstantRedemptionBuffer retains up to the shortfall in the zone's instant redemption buffer from coin, which has
+// been received by the delegation account, returning the remainder to be delegated.
+func (k *Keeper) TopUpInstantRedemptionBuffer(ctx sdk.Context, zone *types.Zone, coin sdk.Coin) sdk.Coin {
+	if coin.Denom != zone.BaseDenom {
+		return coin
+	}
+	shortfall := k.GetInstantRedemptionBufferTarget(ctx, zone).Sub(zone.GetInstantRedemptionBuffer())
+	if !shortfall.IsPositive() {
+		return coin
+	}
+	retained := sdk.MinInt(shortfall, coin.Amount)
+	zone.InstantRedemptionBuffer = zone.GetInstantRedemptionBuffer().Add(retained)
+	k.SetZone(ctx, zone)
+	k.Logger(ctx).Info("retained funds for instant redemption buffer", "chain_id", zone.ChainId, "amount", retained, "buffer", zone.InstantRedemptionBuffer)
+	return coin.SubAmount(retained)
+}
+
+// ReleaseInstantRedemptionBufferExcess delegates any of the zone's instant redemption buffer in excess of its target,
+// such as when the buffer rate is lowered by governance. It is called once per epoch.
+func (k *Keeper) ReleaseInstantRedemptionBufferExcess(ctx sdk.Context, zone *types.Zone) error {
+	excess := k.GetAvailableInstantRedemptionBuffer(ctx, zone).Sub(k.GetInstantRedemptionBufferTarget(ctx, zone))
+	if !excess.IsPositive() {
+		return nil
+	}
+	zone.InstantRedemptionBuffer = zone.GetInstantRedemptionBuffer().Sub(excess)
+	k.SetZone(ctx, zone)
+	k.Logger(ctx).Info("delegating excess instant redemption buffer", "chain_id", zone.ChainId, "amount", excess, "buffer", zone.InstantRedemptionBuffer)
+
+	allocations := k.DeterminePlanForDelegation(ctx, zone, sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, excess)))
+	return k.SubmitTx(ctx, k.PrepareDelegationMessagesForCoins(zone, allocations, zone.DelegationAddress.Address), zone.DelegationAddress, InstantRedemptionBufferMemo)
+}
+
 // GetUnlockedTokensForZone will iterate over all delegation records for a zone, and then remove the
 // locked tokens (those actively being redelegated), returning a slice of int64 staking tokens that
 // are unlocked and free to redelegate or unbond.
@@ -208,6 +330,22 @@ WITHDRAWAL:
 		}
 	}
 
+	// split each validator's unbonding across the delegation accounts holding its delegations, and attribute the
+	// distributions of each withdrawal record to those accounts, such that the unbonded funds can be returned from them.
+	undelegations := make(map[string][]types.Delegation, len(valOutCoinsMap))
+	msgsByDelegator := make(map[string][]sdk.Msg)
+	for _, valoper := range utils.Keys(valOutCoinsMap) {
+		if !valOutCoinsMap[valoper].Amount.IsZero() {
+			undelegations[valoper] = k.DetermineDelegatorsForValidator(ctx, zone, valoper, valOutCoinsMap[valoper].Amount)
+			for _, undelegation := range undelegations[valoper] {
+				msgsByDelegator[undelegation.DelegationAddress] = append(msgsByDelegator[undelegation.DelegationAddress], &stakingtypes.MsgUndelegate{DelegatorAddress: undelegation.DelegationAddress, ValidatorAddress: valoper, Amount: undelegation.Amount})
+			}
+		}
+	}
+	for _, hash := range utils.Keys(txDistrsMap) {
+		txDistrsMap[hash] = attributeDistributions(txDistrsMap[hash], undelegations)
+	}
+
 	for _, hash := range utils.Keys(txDistrsMap) {
 		record, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, WithdrawStatusQueued)
 		if !found {
@@ -222,18 +360,47 @@ WITHDRAWAL:
 		return nil
 	}
 
-	var msgs []sdk.Msg
 	for _, valoper := range utils.Keys(valOutCoinsMap) {
 		if !valOutCoinsMap[valoper].Amount.IsZero() {
 			sort.Strings(txHashes[valoper])
 			k.SetUnbondingRecord(ctx, types.UnbondingRecord{ChainId: zone.ChainId, EpochNumber: epoch, Validator: valoper, RelatedTxhash: txHashes[valoper]})
-			msgs = append(msgs, &stakingtypes.MsgUndelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorAddress: valoper, Amount: valOutCoinsMap[valoper]})
 		}
 	}
 
-	k.Logger(ctx).Info("unbonding messages to send", "msg", msgs)
+	for _, account := range zone.GetDelegationAccounts() {
+		msgs := msgsByDelegator[account.Address]
+		k.Logger(ctx).Info("unbonding messages to send", "delegator", account.Address, "msg", msgs)
+		if err := k.SubmitTx(ctx, msgs, account, fmt.Sprintf("withdrawal/%d", epoch)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	return k.SubmitTx(ctx, msgs, zone.DelegationAddress, fmt.Sprintf("withdrawal/%d", epoch))
+// attributeDistributions splits each of distributions across the delegation accounts from which its validator is
+// undelegated, consuming the undelegated amounts in order.
+func attributeDistributions(distributions []*types.Distribution, undelegations map[string][]types.Delegation) []*types.Distribution {
+	out := make([]*types.Distribution, 0, len(distributions))
+	for _, dist := range distributions {
+		remaining := dist.Amount
+		for remaining > 0 && len(undelegations[dist.Valoper]) > 0 {
+			undelegation := &undelegations[dist.Valoper][0]
+			amount := remaining
+			if available := undelegation.Amount.Amount.Uint64(); available < amount {
+				amount = available
+			}
+			out = append(out, &types.Distribution{Valoper: dist.Valoper, Amount: amount, Delegator: undelegation.DelegationAddress})
+			remaining -= amount
+			undelegation.Amount = undelegation.Amount.SubAmount(sdk.NewIntFromUint64(amount))
+			if undelegation.Amount.IsZero() {
+				undelegations[dist.Valoper] = undelegations[dist.Valoper][1:]
+			}
+		}
+		if remaining > 0 || dist.Amount == 0 {
+			out = append(out, &types.Distribution{Valoper: dist.Valoper, Amount: remaining})
+		}
+	}
+	return out
 }
 
 func (k *Keeper) GCCompletedUnbondings(ctx sdk.Context, zone *types.Zone) error {
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetSlashRecord returns the slash record for the given zone, validator and height.
+func (k *Keeper) GetSlashRecord(ctx sdk.Context, chainID string, valoper string, height int64) (types.SlashRecord, bool) {
+	record := types.SlashRecord{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := store.Get(types.GetSlashRecordKey(chainID, valoper, height))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetSlashRecord stores the slash record.
+func (k *Keeper) SetSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetSlashRecordKey(record.ChainId, record.Valoper, record.Height), bz)
+}
+
+// DeleteSlashRecord deletes the slash record.
+func (k *Keeper) DeleteSlashRecord(ctx sdk.Context, chainID string, valoper string, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	store.Delete(types.GetSlashRecordKey(chainID, valoper, height))
+}
+
+// IteratePrefixedSlashRecords iterates through all slash records with the given prefix.
+func (k *Keeper) IteratePrefixedSlashRecords(ctx sdk.Context, prefixBytes []byte, fn func(index int64, record types.SlashRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixBytes)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.SlashRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		stop := fn(i, record)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllSlashRecords returns every slash record in the store.
+func (k *Keeper) AllSlashRecords(ctx sdk.Context) []types.SlashRecord {
+	records := []types.SlashRecord{}
+	k.IteratePrefixedSlashRecords(ctx, types.KeyPrefixSlashRecord, func(_ int64, record types.SlashRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// ZoneSlashRecords returns every slash record in the store for the specified zone.
+func (k *Keeper) ZoneSlashRecords(ctx sdk.Context, chainID string) []types.SlashRecord {
+	records := []types.SlashRecord{}
+	k.IteratePrefixedSlashRecords(ctx, types.GetSlashRecordsKey(chainID), func(_ int64, record types.SlashRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// HandleValidatorSlash records a slash of fraction of the given validator's tokens, and immediately reduces the zone
+// redemption rate by the estimated loss, bounded by types.MaxSlashAdjustment, such that redemptions in the remainder of
+// the epoch cannot get ahead of the loss. The caller is responsible for persisting the zone.
+func (k *Keeper) HandleValidatorSlash(ctx sdk.Context, zone *types.Zone, valoper string, fraction sdk.Dec) {
+	delegations := []types.Delegation{}
+	delegated := sdk.ZeroInt()
+	k.IterateAllDelegations(ctx, zone, func(delegation types.Delegation) (stop bool) {
+		if delegation.ValidatorAddress == valoper {
+			delegations = append(delegations, delegation)
+			delegated = delegated.Add(delegation.Amount.Amount)
+		}
+		return false
+	})
+	loss := fraction.MulInt(delegated).TruncateInt()
+
+	rateBefore := zone.RedemptionRate
+	rateAfter := types.SlashAdjustedRedemptionRate(rateBefore, loss, k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount)
+	zone.RedemptionRate = rateAfter
+
+	k.Logger(ctx).Info("Validator slashed", "chain_id", zone.ChainId, "valoper", valoper, "fraction", fraction, "loss", loss, "rate_before", rateBefore, "rate_after", rateAfter)
+
+	k.SetSlashRecord(ctx, types.SlashRecord{
+		ChainId:              zone.ChainId,
+		Valoper:              valoper,
+		Height:               ctx.BlockHeight(),
+		Time:                 ctx.BlockTime(),
+		Fraction:             fraction,
+		Delegations:          delegations,
+		Amount:               loss,
+		RedemptionRateBefore: rateBefore,
+		RedemptionRateAfter:  rateAfter,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSlash,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, valoper),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(zone.BaseDenom, loss).String()),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, rateAfter.String()),
+		),
+	)
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/app"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestHandleValidatorSlash() {
+	s.SetupTest()
+	s.setupTestZones()
+
+	qapp := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone, found := qapp.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+
+	s.Require().NoError(qapp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(1000000)))))
+	zone.RedemptionRate = sdk.OneDec()
+	qapp.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+	validators := s.GetQuicksilverApp(s.chainB).StakingKeeper.GetBondedValidatorsByPower(s.chainB.GetContext())
+	validator := validators[0]
+	delegation := types.Delegation{
+		DelegationAddress: zone.DelegationAddress.Address,
+		ValidatorAddress:  validator.OperatorAddress,
+		Amount:            sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100000)),
+	}
+	qapp.InterchainstakingKeeper.SetDelegation(ctx, &zone, delegation)
+
+	// an unchanged validator is not slashed.
+	s.Require().NoError(qapp.InterchainstakingKeeper.SetValidatorForZone(ctx, &zone, app.DefaultConfig().Codec.MustMarshal(&validator)))
+	s.Require().Equal(0, len(qapp.InterchainstakingKeeper.ZoneSlashRecords(ctx, zone.ChainId)))
+
+	// slash 5% of the validator's tokens, leaving shares unchanged.
+	validator.Tokens = sdk.NewDecWithPrec(95, 2).MulInt(validator.Tokens).TruncateInt()
+	s.Require().NoError(qapp.InterchainstakingKeeper.SetValidatorForZone(ctx, &zone, app.DefaultConfig().Codec.MustMarshal(&validator)))
+
+	record, found := qapp.InterchainstakingKeeper.GetSlashRecord(ctx, zone.ChainId, validator.OperatorAddress, ctx.BlockHeight())
+	s.Require().True(found)
+	s.Require().True(record.Fraction.Sub(sdk.NewDecWithPrec(5, 2)).Abs().LT(sdk.NewDecWithPrec(1, 9)))
+	s.Require().Equal([]types.Delegation{delegation}, record.Delegations)
+	s.Require().Equal(record.Fraction.MulInt(sdk.NewInt(100000)).TruncateInt(), record.Amount)
+	s.Require().Equal(sdk.OneDec(), record.RedemptionRateBefore)
+	s.Require().Equal(sdk.OneDec().Sub(sdk.NewDecFromInt(record.Amount).QuoInt64(1000000)), record.RedemptionRateAfter)
+
+	// the redemption rate is adjusted immediately.
+	zone, found = qapp.InterchainstakingKeeper.GetZone(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(record.RedemptionRateAfter, zone.RedemptionRate)
+
+	// the slash is recorded once.
+	s.Require().NoError(qapp.InterchainstakingKeeper.SetValidatorForZone(ctx, &zone, app.DefaultConfig().Codec.MustMarshal(&validator)))
+	s.Require().Equal(1, len(qapp.InterchainstakingKeeper.ZoneSlashRecords(ctx, zone.ChainId)))
+
+	resp, err := qapp.InterchainstakingKeeper.SlashRecords(ctx, &types.QuerySlashRecordsRequest{ChainId: zone.ChainId, Valoper: validator.OperatorAddress})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.SlashRecord{record}, resp.Slashes)
+
+	resp, err = qapp.InterchainstakingKeeper.SlashRecords(ctx, &types.QuerySlashRecordsRequest{ChainId: zone.ChainId, Valoper: validators[1].OperatorAddress})
+	s.Require().NoError(err)
+	s.Require().Equal(0, len(resp.Slashes))
+
+	_, err = qapp.InterchainstakingKeeper.SlashRecords(ctx, &types.QuerySlashRecordsRequest{ChainId: "unknown"})
+	s.Require().Error(err)
+
+	// a validator jailed without voting power cannot be assessed for a slash.
+	jailed := validators[1]
+	jailed.Jailed = true
+	jailed.Tokens = sdk.ZeroInt()
+	s.Require().ErrorContains(qapp.InterchainstakingKeeper.SetValidatorForZone(ctx, &zone, app.DefaultConfig().Codec.MustMarshal(&jailed)), "incoming voting power must be greater than zero")
+}
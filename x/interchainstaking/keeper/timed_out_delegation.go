@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetTimedOutDelegation returns the timed out delegation for the given zone, memo, delegator and validator.
+func (k *Keeper) GetTimedOutDelegation(ctx sdk.Context, chainID string, memo string, delegator string, validator string) (types.TimedOutDelegation, bool) {
+	record := types.TimedOutDelegation{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := store.Get(types.GetTimedOutDelegationKey(chainID, memo, delegator, validator))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetTimedOutDelegation stores the timed out delegation.
+func (k *Keeper) SetTimedOutDelegation(ctx sdk.Context, record types.TimedOutDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetTimedOutDelegationKey(record.ChainId, record.Memo, record.DelegatorAddress, record.ValidatorAddress), bz)
+}
+
+// DeleteTimedOutDelegation deletes the timed out delegation.
+func (k *Keeper) DeleteTimedOutDelegation(ctx sdk.Context, chainID string, memo string, delegator string, validator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	store.Delete(types.GetTimedOutDelegationKey(chainID, memo, delegator, validator))
+}
+
+// IteratePrefixedTimedOutDelegations iterates through all timed out delegations with the given prefix.
+func (k *Keeper) IteratePrefixedTimedOutDelegations(ctx sdk.Context, prefixBytes []byte, fn func(index int64, record types.TimedOutDelegation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixBytes)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.TimedOutDelegation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		stop := fn(i, record)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllTimedOutDelegations returns every timed out delegation in the store.
+func (k *Keeper) AllTimedOutDelegations(ctx sdk.Context) []types.TimedOutDelegation {
+	records := []types.TimedOutDelegation{}
+	k.IteratePrefixedTimedOutDelegations(ctx, types.KeyPrefixTimedOutDelegation, func(_ int64, record types.TimedOutDelegation) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// ZoneTimedOutDelegations returns every timed out delegation in the store for the specified zone.
+func (k *Keeper) ZoneTimedOutDelegations(ctx sdk.Context, chainID string) []types.TimedOutDelegation {
+	records := []types.TimedOutDelegation{}
+	k.IteratePrefixedTimedOutDelegations(ctx, types.GetTimedOutDelegationsKey(chainID), func(_ int64, record types.TimedOutDelegation) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// HandleTimedOutDelegate records a MsgDelegate the packet of which timed out, to be resent by
+// RetryTimedOutDelegations. Delegation records are only updated upon acknowledgement, so there is nothing to revert.
+// Delegations of converted deposit assets are resent each epoch until acknowledged, so are not recorded.
+func (k *Keeper) HandleTimedOutDelegate(ctx sdk.Context, msg sdk.Msg, memo string) error {
+	delegateMsg, ok := msg.(*stakingtypes.MsgDelegate)
+	if !ok {
+		k.Logger(ctx).Error("unable to cast source message to MsgDelegate")
+		return errors.New("unable to cast source message to MsgDelegate")
+	}
+	zone := k.GetZoneForDelegateAccount(ctx, delegateMsg.DelegatorAddress)
+	if zone == nil {
+		// performance account delegations are not retried.
+		if zone := k.GetZoneForPerformanceAccount(ctx, delegateMsg.DelegatorAddress); zone != nil {
+			return nil
+		}
+		return fmt.Errorf("unable to find zone for address %s", delegateMsg.DelegatorAddress)
+	}
+
+	if strings.HasPrefix(memo, types.MsgTypeConversion+"/") {
+		return nil
+	}
+
+	k.Logger(ctx).Error("MsgDelegate timed out; will resend", "delegator", delegateMsg.DelegatorAddress, "validator", delegateMsg.ValidatorAddress, "amount", delegateMsg.Amount, "memo", memo)
+
+	// a timed out chunk may repeat a delegation of another timed out chunk with the same memo.
+	record, found := k.GetTimedOutDelegation(ctx, zone.ChainId, memo, delegateMsg.DelegatorAddress, delegateMsg.ValidatorAddress)
+	if !found {
+		record = types.TimedOutDelegation{
+			ChainId:          zone.ChainId,
+			Memo:             memo,
+			DelegatorAddress: delegateMsg.DelegatorAddress,
+			ValidatorAddress: delegateMsg.ValidatorAddress,
+			Amount:           sdk.NewCoin(delegateMsg.Amount.Denom, sdk.ZeroInt()),
+		}
+	}
+	record.Amount = record.Amount.Add(delegateMsg.Amount)
+	k.SetTimedOutDelegation(ctx, record)
+	return nil
+}
+
+// RetryTimedOutDelegations is called once per epoch. It resends the timed out delegations of the zone with their
+// original memo, such that their acknowledgement completes the receipt of the deposit. Delegations that cannot be
+// resent, e.g. as the delegation account channel is yet to be reopened, are retried next epoch. The funds of an
+// offboarding zone are instead retained, as for deposits received by an offboarding zone, and the receipt completed.
+func (k *Keeper) RetryTimedOutDelegations(ctx sdk.Context, zone *types.Zone) error {
+	// the delegations of a single packet share a memo and delegator, so are resent together.
+	keys := []string{}
+	records := make(map[string][]types.TimedOutDelegation)
+	for _, record := range k.ZoneTimedOutDelegations(ctx, zone.ChainId) {
+		key := record.Memo + "/" + record.DelegatorAddress
+		if _, found := records[key]; !found {
+			keys = append(keys, key)
+		}
+		records[key] = append(records[key], record)
+	}
+
+	for _, key := range keys {
+		memo := records[key][0].Memo
+		delegator := records[key][0].DelegatorAddress
+		account, found := zone.GetDelegationAccountByAddress(delegator)
+		if !found {
+			return fmt.Errorf("unable to find delegation account %s", delegator)
+		}
+
+		// discard the records of delegations that fail to be resent.
+		cacheCtx, write := ctx.CacheContext()
+		for _, record := range records[key] {
+			k.DeleteTimedOutDelegation(cacheCtx, record.ChainId, record.Memo, record.DelegatorAddress, record.ValidatorAddress)
+		}
+
+		if zone.IsOffboarding() {
+			if receipt, found := k.GetReceipt(cacheCtx, types.GetReceiptKey(zone.ChainId, memo)); found && receipt.Completed == nil {
+				t := ctx.BlockTime()
+				receipt.Completed = &t
+				k.SetReceipt(cacheCtx, receipt)
+			}
+			write()
+			continue
+		}
+
+		msgs := make([]sdk.Msg, 0, len(records[key]))
+		for _, record := range records[key] {
+			msgs = append(msgs, &stakingtypes.MsgDelegate{DelegatorAddress: record.DelegatorAddress, ValidatorAddress: record.ValidatorAddress, Amount: record.Amount})
+		}
+		if err := k.SubmitTx(cacheCtx, msgs, account, memo); err != nil {
+			k.Logger(ctx).Error("unable to resend timed out delegations; will retry", "chain_id", zone.ChainId, "delegator", delegator, "memo", memo, "error", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
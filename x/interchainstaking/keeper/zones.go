@@ -3,7 +3,6 @@ package keeper
 import (
 	"errors"
 	"fmt"
-	"math"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -14,6 +13,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 
 	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -114,7 +114,7 @@ func (k *Keeper) GetZoneFromContext(ctx sdk.Context) (*types.Zone, error) {
 func (k *Keeper) GetZoneForDelegateAccount(ctx sdk.Context, address string) *types.Zone {
 	var zone *types.Zone
 	k.IterateZones(ctx, func(_ int64, zoneInfo *types.Zone) (stop bool) {
-		if zoneInfo.DelegationAddress != nil && zoneInfo.DelegationAddress.Address == address {
+		if zoneInfo.IsDelegateAddress(address) {
 			zone = zoneInfo
 			return true
 		}
@@ -147,13 +147,27 @@ func (k *Keeper) GetZoneForDepositAccount(ctx sdk.Context, address string) *type
 	return zone
 }
 
+func (k *Keeper) GetZoneForWithdrawalAccount(ctx sdk.Context, address string) *types.Zone {
+	var zone *types.Zone
+	k.IterateZones(ctx, func(_ int64, zoneInfo *types.Zone) (stop bool) {
+		if zoneInfo.WithdrawalAddress != nil && zoneInfo.WithdrawalAddress.Address == address {
+			zone = zoneInfo
+			return true
+		}
+		return false
+	})
+	return zone
+}
+
 func (k *Keeper) EnsureICAsActive(ctx sdk.Context, zone *types.Zone) error {
 	k.Logger(ctx).Info("Ensuring ICAs for zone", "zone", zone.ChainId)
 	if err := k.EnsureICAActive(ctx, zone, zone.DepositAddress); err != nil {
 		return err
 	}
-	if err := k.EnsureICAActive(ctx, zone, zone.DelegationAddress); err != nil {
-		return err
+	for _, account := range zone.GetDelegationAccounts() {
+		if err := k.EnsureICAActive(ctx, zone, account); err != nil {
+			return err
+		}
 	}
 	if err := k.EnsureICAActive(ctx, zone, zone.PerformanceAddress); err != nil {
 		return err
@@ -179,6 +193,60 @@ func (k *Keeper) EnsureICAActive(ctx sdk.Context, zone *types.Zone, account *typ
 	return k.ICAControllerKeeper.RegisterInterchainAccount(ctx, zone.ConnectionId, strings.TrimPrefix(account.GetPortName(), icatypes.PortPrefix), "")
 }
 
+// GetZoneAndAccountForPort determines the zone and ICA account for a given controller port.
+func (k *Keeper) GetZoneAndAccountForPort(ctx sdk.Context, portID string) (*types.Zone, *types.ICAAccount) {
+	var zone *types.Zone
+	var account *types.ICAAccount
+	k.IterateZones(ctx, func(_ int64, zoneInfo *types.Zone) (stop bool) {
+		for _, ica := range append([]*types.ICAAccount{zoneInfo.DepositAddress, zoneInfo.PerformanceAddress, zoneInfo.WithdrawalAddress}, zoneInfo.GetDelegationAccounts()...) {
+			if ica != nil && ica.GetPortName() == portID {
+				zone = zoneInfo
+				account = ica
+				return true
+			}
+		}
+		return false
+	})
+	return zone, account
+}
+
+// ReopenClosedICAs reopens, via EnsureICAActive, any of the zone's ICA channels that have been closed; e.g. as
+// the result of a packet timeout on the ordered channel. Ports with a channel handshake in progress are skipped.
+func (k *Keeper) ReopenClosedICAs(ctx sdk.Context, zone *types.Zone) error {
+	for _, account := range append([]*types.ICAAccount{zone.DepositAddress, zone.PerformanceAddress, zone.WithdrawalAddress}, zone.GetDelegationAccounts()...) {
+		if account == nil {
+			continue
+		}
+		portID := account.GetPortName()
+		channelID, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, zone.ConnectionId, portID)
+		if !found {
+			// initial handshake has not yet completed.
+			continue
+		}
+		channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+		if !found || channel.State != channeltypes.CLOSED {
+			continue
+		}
+
+		pending := false
+		k.IBCKeeper.ChannelKeeper.IterateChannels(ctx, func(ic channeltypes.IdentifiedChannel) bool {
+			if ic.PortId == portID && (ic.State == channeltypes.INIT || ic.State == channeltypes.TRYOPEN) {
+				pending = true
+				return true
+			}
+			return false
+		})
+		if pending {
+			continue
+		}
+
+		if err := k.EnsureICAActive(ctx, zone, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k *Keeper) EnsureWithdrawalAddresses(ctx sdk.Context, zone *types.Zone) error {
 	if zone.WithdrawalAddress == nil {
 		k.Logger(ctx).Info("Withdrawal address not set")
@@ -205,11 +273,13 @@ func (k *Keeper) EnsureWithdrawalAddresses(ctx sdk.Context, zone *types.Zone) er
 		}
 	}
 
-	if zone.DelegationAddress.WithdrawalAddress != withdrawalAddress {
-		msg := distrTypes.MsgSetWithdrawAddress{DelegatorAddress: zone.DelegationAddress.Address, WithdrawAddress: withdrawalAddress}
-		err := k.SubmitTx(ctx, []sdk.Msg{&msg}, zone.DelegationAddress, "")
-		if err != nil {
-			return err
+	for _, account := range zone.GetDelegationAccounts() {
+		if account.WithdrawalAddress != withdrawalAddress {
+			msg := distrTypes.MsgSetWithdrawAddress{DelegatorAddress: account.Address, WithdrawAddress: withdrawalAddress}
+			err := k.SubmitTx(ctx, []sdk.Msg{&msg}, account, "")
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -256,6 +326,18 @@ func (k *Keeper) SetAccountBalanceForDenom(ctx sdk.Context, zone *types.Zone, ad
 			return err
 		}
 		k.Logger(ctx).Info("Matched withdrawal address", "address", address, "wg", zone.WithdrawalAddress.BalanceWaitgroup, "balance", zone.WithdrawalAddress.Balance)
+	case zone.IsDelegateAddress(address):
+		account, _ := zone.GetDelegationAccountByAddress(address)
+		existing := account.Balance.AmountOf(coin.Denom)
+		err = account.SetBalance(account.Balance.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, existing))...).Add(coin)) // reset this denom
+		if err != nil {
+			return err
+		}
+		err = account.DecrementBalanceWaitgroup()
+		if err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("Matched delegation address", "address", address, "wg", account.BalanceWaitgroup, "balance", account.Balance)
 	case zone.PerformanceAddress != nil && address == zone.PerformanceAddress.Address:
 		k.Logger(ctx).Info("Matched performance address")
 	default:
@@ -286,8 +368,8 @@ func (k *Keeper) SetAccountBalance(ctx sdk.Context, zone types.Zone, address str
 		icaAccount = zone.DepositAddress
 	case zone.WithdrawalAddress != nil && address == zone.WithdrawalAddress.Address:
 		icaAccount = zone.WithdrawalAddress
-	case zone.DelegationAddress != nil && address == zone.DelegationAddress.Address:
-		icaAccount = zone.DelegationAddress
+	case zone.IsDelegateAddress(address):
+		icaAccount, _ = zone.GetDelegationAccountByAddress(address)
 	case zone.PerformanceAddress != nil && address == zone.PerformanceAddress.Address:
 		icaAccount = zone.PerformanceAddress
 	default:
@@ -424,6 +506,43 @@ func (k *Keeper) RemoveZoneAndAssociatedRecords(ctx sdk.Context, chainID string)
 		return false
 	})
 
+	// clear redemption rate history
+	for _, record := range k.ZoneRedemptionRateRecords(ctx, chainID) {
+		k.DeleteRedemptionRateRecord(ctx, record.ChainId, record.Epoch)
+	}
+
+	// clear epoch reports
+	for _, report := range k.ZoneEpochReports(ctx, chainID) {
+		k.DeleteEpochReport(ctx, report.ChainId, report.Epoch)
+	}
+
+	// clear slash records
+	for _, record := range k.ZoneSlashRecords(ctx, chainID) {
+		k.DeleteSlashRecord(ctx, record.ChainId, record.Valoper, record.Height)
+	}
+
+	// clear ica packet records
+	for _, status := range []int32{ICAPacketStatusInFlight, ICAPacketStatusAcknowledged, ICAPacketStatusFailed} {
+		for _, record := range k.ZoneICAPacketRecords(ctx, chainID, status) {
+			k.DeleteICAPacketRecord(ctx, record.ChainId, record.Status, record.ChannelId, record.Sequence)
+		}
+	}
+
+	// clear conversion records
+	for _, status := range []int32{ConversionStatusSent, ConversionStatusConverting, ConversionStatusFailed} {
+		for _, record := range k.ZoneConversionRecords(ctx, chainID, status) {
+			k.DeleteConversionRecord(ctx, record.ChainId, record.Status, record.Txhash, record.Amount.Denom)
+		}
+	}
+
+	// clear timed out delegations
+	for _, record := range k.ZoneTimedOutDelegations(ctx, chainID) {
+		k.DeleteTimedOutDelegation(ctx, record.ChainId, record.Memo, record.DelegatorAddress, record.ValidatorAddress)
+	}
+
+	// clear fees
+	k.DeleteZoneFees(ctx, chainID)
+
 	// remove zone and related records
 	k.IterateZones(ctx, func(index int64, zone *types.Zone) (stop bool) {
 		if zone.ChainId == chainID {
@@ -482,20 +601,5 @@ func (k *Keeper) CurrentDelegationsAsIntent(ctx sdk.Context, zone *types.Zone) t
 }
 
 func (k *Keeper) DistanceToTarget(ctx sdk.Context, zone *types.Zone) float64 {
-	current := k.CurrentDelegationsAsIntent(ctx, zone)
-	target := zone.GetAggregateIntentOrDefault()
-	preSqRt := sdk.ZeroDec()
-
-	for _, valoper := range zone.Validators {
-		c := current.MustGetForValoper(valoper.ValoperAddress)
-		t := target.MustGetForValoper(valoper.ValoperAddress)
-		v := c.Weight.SubMut(t.Weight)
-		preSqRt = preSqRt.AddMut(v.Mul(v))
-	}
-
-	psqrtf, err := preSqRt.Float64()
-	if err != nil {
-		panic("this value should never be greater than 64-bit dec!")
-	}
-	return math.Sqrt(psqrtf)
+	return types.DistanceToTarget(k.CurrentDelegationsAsIntent(ctx, zone), zone.GetAggregateIntentOrDefault(), zone.GetValidatorsAddressesAsSlice())
 }
@@ -17,9 +17,13 @@ var (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSignalIntent{}, "quicksilver/MsgSignalIntent", nil)
+	cdc.RegisterConcrete(&MsgConfirmIntent{}, "quicksilver/MsgConfirmIntent", nil)
 	cdc.RegisterConcrete(&MsgRequestRedemption{}, "quicksilver/MsgRequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgCancelRedemption{}, "quicksilver/MsgCancelRedemption", nil)
+	cdc.RegisterConcrete(&MsgUpdateRedemptionDestination{}, "quicksilver/MsgUpdateRedemptionDestination", nil)
 	cdc.RegisterConcrete(&RegisterZoneProposal{}, "quicksilver/RegisterZoneProposal", nil)
 	cdc.RegisterConcrete(&UpdateZoneProposal{}, "quicksilver/UpdateZoneProposal", nil)
+	cdc.RegisterConcrete(&OffboardZoneProposal{}, "quicksilver/OffboardZoneProposal", nil)
 	// cdc.RegisterConcrete(&MsgGovCloseChannel{}, "quicksilver/MsgGovCloseChannel", nil)
 	// cdc.RegisterConcrete(&MsgGovCloseChannel{}, "quicksilver/MsgGovReopenChannel", nil)
 	lsmstakingtypes.RegisterLegacyAminoCodec(cdc)
@@ -30,7 +34,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgSignalIntent{},
+		&MsgConfirmIntent{},
 		&MsgRequestRedemption{},
+		&MsgCancelRedemption{},
+		&MsgUpdateRedemptionDestination{},
 		&MsgGovCloseChannel{},
 		&MsgGovReopenChannel{},
 	)
@@ -39,6 +46,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*govv1beta1.Content)(nil),
 		&UpdateZoneProposal{},
 		&RegisterZoneProposal{},
+		&OffboardZoneProposal{},
 	)
 
 	lsmstakingtypes.RegisterInterfaces(registry)
@@ -51,5 +59,6 @@ func init() {
 	cryptocodec.RegisterCrypto(amino)
 	govv1beta1.RegisterProposalType(ProposalTypeRegisterZone)
 	govv1beta1.RegisterProposalType(ProposalTypeUpdateZone)
+	govv1beta1.RegisterProposalType(ProposalTypeOffboardZone)
 	sdk.RegisterLegacyAminoCodec(amino)
 }
@@ -117,6 +117,60 @@ func (vi ValidatorIntents) Normalize() ValidatorIntents {
 	return out.Sort()
 }
 
+// Cap limits the weight of each intent to max, redistributing the excess across the remaining intents in proportion to
+// their weights. Intents must be normalized. Where max cannot be satisfied by every intent, weights are made equal.
+func (vi ValidatorIntents) Cap(max sdk.Dec) ValidatorIntents {
+	out := make(ValidatorIntents, 0, len(vi))
+	for _, i := range vi {
+		out = append(out, &ValidatorIntent{ValoperAddress: i.ValoperAddress, Weight: i.Weight})
+	}
+	if len(out) == 0 {
+		return out
+	}
+
+	if max.MulInt64(int64(len(out))).LT(sdk.OneDec()) {
+		for _, i := range out {
+			i.Weight = sdk.OneDec().QuoInt64(int64(len(out)))
+		}
+		return out.Sort()
+	}
+
+	capped := make(map[string]bool)
+	for {
+		exceeded := false
+		for _, i := range out {
+			if !capped[i.ValoperAddress] && i.Weight.GT(max) {
+				capped[i.ValoperAddress] = true
+				exceeded = true
+			}
+		}
+		if !exceeded {
+			break
+		}
+
+		// redistribute the weight not held by capped intents across those below the cap.
+		remaining := sdk.OneDec().Sub(max.MulInt64(int64(len(capped))))
+		uncappedTotal := sdk.ZeroDec()
+		for _, i := range out {
+			if !capped[i.ValoperAddress] {
+				uncappedTotal = uncappedTotal.Add(i.Weight)
+			}
+		}
+		for _, i := range out {
+			switch {
+			case capped[i.ValoperAddress]:
+				i.Weight = max
+			case uncappedTotal.IsPositive():
+				i.Weight = i.Weight.Mul(remaining).Quo(uncappedTotal)
+			default:
+				i.Weight = remaining.QuoInt64(int64(len(out) - len(capped)))
+			}
+		}
+	}
+
+	return out.Sort()
+}
+
 func DetermineAllocationsForDelegation(currentAllocations map[string]sdkmath.Int, currentSum sdkmath.Int, targetAllocations ValidatorIntents, amount sdk.Coins) map[string]sdkmath.Int {
 	input := amount[0].Amount
 	deltas := CalculateDeltas(currentAllocations, currentSum, targetAllocations)
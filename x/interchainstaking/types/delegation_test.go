@@ -63,6 +63,66 @@ func TestSetForValoper(t *testing.T) {
 	require.Equal(t, sdk.ZeroDec(), actual.Weight)
 }
 
+func TestValidatorIntentsCap(t *testing.T) {
+	intents := func(weights ...string) types.ValidatorIntents {
+		out := types.ValidatorIntents{}
+		for idx, weight := range weights {
+			out = append(out, &types.ValidatorIntent{ValoperAddress: fmt.Sprintf("val%d", idx+1), Weight: sdk.MustNewDecFromStr(weight)})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		intents  types.ValidatorIntents
+		max      string
+		expected types.ValidatorIntents
+	}{
+		{
+			name:     "empty",
+			intents:  intents(),
+			max:      "0.4",
+			expected: intents(),
+		},
+		{
+			name:     "below cap",
+			intents:  intents("0.4", "0.3", "0.3"),
+			max:      "0.4",
+			expected: intents("0.4", "0.3", "0.3"),
+		},
+		{
+			name:     "single capped",
+			intents:  intents("0.5", "0.3", "0.2"),
+			max:      "0.4",
+			expected: intents("0.4", "0.36", "0.24"),
+		},
+		{
+			name:     "redistribution exceeds cap",
+			intents:  intents("0.6", "0.3", "0.1"),
+			max:      "0.35",
+			expected: intents("0.35", "0.35", "0.3"),
+		},
+		{
+			name:     "cap unsatisfiable",
+			intents:  intents("0.7", "0.3"),
+			max:      "0.4",
+			expected: intents("0.5", "0.5"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := intents()
+			for _, i := range tt.intents {
+				input = append(input, &types.ValidatorIntent{ValoperAddress: i.ValoperAddress, Weight: i.Weight})
+			}
+			require.Equal(t, tt.expected, tt.intents.Cap(sdk.MustNewDecFromStr(tt.max)))
+			// input is not modified.
+			require.Equal(t, input, tt.intents)
+		})
+	}
+}
+
 func TestNormalizeValidatorIntentsDeterminism(t *testing.T) {
 	v1 := utils.GenerateValAddressForTest().String()
 	v2 := utils.GenerateValAddressForTest().String()
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+const (
+	// DepositConversionUnwind converts an asset that is the zone base denom, received over IBC via a third chain, by
+	// returning it over the channel it was received on; the counterparty chain forwards the unwound asset back to the
+	// host chain.
+	DepositConversionUnwind int32 = iota + 1
+	// DepositConversionSwap converts an asset by sending it to a swap contract on the counterparty chain, which
+	// returns the swap output to the host chain.
+	DepositConversionSwap
+)
+
+const (
+	// DepositRateSourceUnity values one unit of the asset at one unit of the zone base denom.
+	DepositRateSourceUnity int32 = iota + 1
+	// DepositRateSourceFixed values the asset at the rate set by governance.
+	DepositRateSourceFixed
+	// DepositRateSourceZone values the asset at the redemption rate of another registered zone.
+	DepositRateSourceZone
+)
+
+// PacketForwardReceiver is the intermediate receiver of packets forwarded by the packet forward middleware; the
+// receiver is ignored, as the forward memo determines the final receiver.
+const PacketForwardReceiver = "pfm"
+
+// Validate validates the deposit asset.
+func (a DepositAsset) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(a.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel for deposit asset %s: %w", a.Denom, err)
+	}
+
+	switch a.Conversion {
+	case DepositConversionUnwind:
+		if err := host.ChannelIdentifierValidator(a.ReturnChannelId); err != nil {
+			return fmt.Errorf("invalid return channel for deposit asset %s: %w", a.Denom, err)
+		}
+	case DepositConversionSwap:
+		if a.SwapContract == "" {
+			return fmt.Errorf("swap contract must be set for deposit asset %s", a.Denom)
+		}
+		if err := sdk.ValidateDenom(a.SwapOutputDenom); err != nil {
+			return fmt.Errorf("invalid swap output denom for deposit asset %s: %w", a.Denom, err)
+		}
+		if !a.MaxSlippage.IsNil() && (a.MaxSlippage.IsNegative() || a.MaxSlippage.GTE(sdk.OneDec())) {
+			return fmt.Errorf("max slippage for deposit asset %s must be in the range [0, 1)", a.Denom)
+		}
+	default:
+		return fmt.Errorf("unknown conversion %d for deposit asset %s", a.Conversion, a.Denom)
+	}
+
+	switch a.RateSource {
+	case DepositRateSourceUnity:
+	case DepositRateSourceFixed:
+		if a.Rate.IsNil() || !a.Rate.IsPositive() {
+			return fmt.Errorf("fixed rate for deposit asset %s must be positive", a.Denom)
+		}
+	case DepositRateSourceZone:
+		if a.RateChainId == "" {
+			return fmt.Errorf("rate chain id must be set for deposit asset %s", a.Denom)
+		}
+	default:
+		return fmt.Errorf("unknown rate source %d for deposit asset %s", a.RateSource, a.Denom)
+	}
+
+	return nil
+}
+
+// GetMinOutput returns the minimum amount of the zone base denom returned by converting an asset of the given value.
+func (a DepositAsset) GetMinOutput(value math.Int) math.Int {
+	if a.Conversion != DepositConversionSwap || a.MaxSlippage.IsNil() {
+		return value
+	}
+	return sdk.NewDecFromInt(value).Mul(sdk.OneDec().Sub(a.MaxSlippage)).TruncateInt()
+}
+
+// GetConversionReceiver returns the receiver, on the counterparty chain, of the asset sent for conversion.
+func (a DepositAsset) GetConversionReceiver() string {
+	if a.Conversion == DepositConversionSwap {
+		return a.SwapContract
+	}
+	return PacketForwardReceiver
+}
+
+// GetConversionMemo returns the memo of the transfer that sends the asset for conversion, such that at least
+// minOutput of the zone base denom is returned to receiver on the host chain.
+func (a DepositAsset) GetConversionMemo(receiver string, minOutput math.Int) (string, error) {
+	var memo interface{}
+	switch a.Conversion {
+	case DepositConversionUnwind:
+		memo = map[string]interface{}{
+			"forward": map[string]interface{}{
+				"receiver": receiver,
+				"port":     TransferPort,
+				"channel":  a.ReturnChannelId,
+			},
+		}
+	case DepositConversionSwap:
+		memo = map[string]interface{}{
+			"wasm": map[string]interface{}{
+				"contract": a.SwapContract,
+				"msg": map[string]interface{}{
+					"osmosis_swap": map[string]interface{}{
+						"output_denom":       a.SwapOutputDenom,
+						"slippage":           map[string]interface{}{"min_output_amount": minOutput.String()},
+						"receiver":           receiver,
+						"on_failed_delivery": "do_nothing",
+					},
+				},
+			},
+		}
+	default:
+		return "", errors.New("unknown conversion")
+	}
+
+	bz, err := json.Marshal(memo)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// GetDepositAsset returns the deposit asset of the zone with the given denom.
+func (z Zone) GetDepositAsset(denom string) (*DepositAsset, bool) {
+	for _, asset := range z.DepositAssets {
+		if asset != nil && asset.Denom == denom {
+			return asset, true
+		}
+	}
+	return nil, false
+}
+
+// SetDepositAsset adds the deposit asset to the zone, replacing any existing deposit asset with the same denom.
+func (z *Zone) SetDepositAsset(asset DepositAsset) {
+	for i, existing := range z.DepositAssets {
+		if existing != nil && existing.Denom == asset.Denom {
+			z.DepositAssets[i] = &asset
+			return
+		}
+	}
+	z.DepositAssets = append(z.DepositAssets, &asset)
+}
+
+// RemoveDepositAsset removes the deposit asset with the given denom from the zone, returning false if not found.
+func (z *Zone) RemoveDepositAsset(denom string) bool {
+	for i, asset := range z.DepositAssets {
+		if asset != nil && asset.Denom == denom {
+			z.DepositAssets = append(z.DepositAssets[:i], z.DepositAssets[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// SplitDepositAssets splits deposited coins into those delegated directly, being the base denom and tokenized shares,
+// and the deposit assets that must first be converted.
+func (z Zone) SplitDepositAssets(coins sdk.Coins) (native sdk.Coins, converted sdk.Coins) {
+	for _, coin := range coins {
+		if _, found := z.GetDepositAsset(coin.Denom); found {
+			converted = converted.Add(coin)
+			continue
+		}
+		native = native.Add(coin)
+	}
+	return native, converted
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+const ibcAtom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func TestDepositAssetValidate(t *testing.T) {
+	unwind := types.DepositAsset{
+		Denom:           ibcAtom,
+		Conversion:      types.DepositConversionUnwind,
+		ChannelId:       "channel-1",
+		ReturnChannelId: "channel-0",
+		RateSource:      types.DepositRateSourceUnity,
+	}
+	require.NoError(t, unwind.Validate())
+
+	swap := types.DepositAsset{
+		Denom:           "uosmo",
+		Conversion:      types.DepositConversionSwap,
+		ChannelId:       "channel-2",
+		SwapContract:    "osmo1contract",
+		SwapOutputDenom: ibcAtom,
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+		RateSource:      types.DepositRateSourceFixed,
+		Rate:            sdk.NewDecWithPrec(5, 2),
+	}
+	require.NoError(t, swap.Validate())
+
+	tests := []struct {
+		name   string
+		asset  types.DepositAsset
+		mutate func(asset *types.DepositAsset)
+	}{
+		{"invalid denom", unwind, func(asset *types.DepositAsset) { asset.Denom = "" }},
+		{"invalid channel", unwind, func(asset *types.DepositAsset) { asset.ChannelId = "" }},
+		{"invalid return channel", unwind, func(asset *types.DepositAsset) { asset.ReturnChannelId = "" }},
+		{"unknown conversion", unwind, func(asset *types.DepositAsset) { asset.Conversion = 3 }},
+		{"missing swap contract", swap, func(asset *types.DepositAsset) { asset.SwapContract = "" }},
+		{"invalid swap output denom", swap, func(asset *types.DepositAsset) { asset.SwapOutputDenom = "" }},
+		{"max slippage of one", swap, func(asset *types.DepositAsset) { asset.MaxSlippage = sdk.OneDec() }},
+		{"zero fixed rate", swap, func(asset *types.DepositAsset) { asset.Rate = sdk.ZeroDec() }},
+		{"missing rate chain id", swap, func(asset *types.DepositAsset) { asset.RateSource = types.DepositRateSourceZone }},
+		{"unknown rate source", swap, func(asset *types.DepositAsset) { asset.RateSource = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asset := tt.asset
+			tt.mutate(&asset)
+			require.Error(t, asset.Validate())
+		})
+	}
+}
+
+func TestDepositAssetConversion(t *testing.T) {
+	unwind := types.DepositAsset{Denom: ibcAtom, Conversion: types.DepositConversionUnwind, ChannelId: "channel-1", ReturnChannelId: "channel-0", RateSource: types.DepositRateSourceUnity}
+	require.Equal(t, sdk.NewInt(1000), unwind.GetMinOutput(sdk.NewInt(1000)))
+	require.Equal(t, types.PacketForwardReceiver, unwind.GetConversionReceiver())
+
+	memo, err := unwind.GetConversionMemo("cosmos1receiver", sdk.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, `{"forward":{"channel":"channel-0","port":"transfer","receiver":"cosmos1receiver"}}`, memo)
+
+	swap := types.DepositAsset{Denom: "uosmo", Conversion: types.DepositConversionSwap, ChannelId: "channel-2", SwapContract: "osmo1contract", SwapOutputDenom: ibcAtom, MaxSlippage: sdk.NewDecWithPrec(1, 2)}
+	require.Equal(t, sdk.NewInt(990), swap.GetMinOutput(sdk.NewInt(1000)))
+	require.Equal(t, "osmo1contract", swap.GetConversionReceiver())
+
+	memo, err = swap.GetConversionMemo("cosmos1receiver", sdk.NewInt(990))
+	require.NoError(t, err)
+	require.Equal(t, `{"wasm":{"contract":"osmo1contract","msg":{"osmosis_swap":{"on_failed_delivery":"do_nothing","output_denom":"`+ibcAtom+`","receiver":"cosmos1receiver","slippage":{"min_output_amount":"990"}}}}}`, memo)
+}
+
+func TestZoneDepositAssets(t *testing.T) {
+	zone := types.Zone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	asset := types.DepositAsset{Denom: ibcAtom, Conversion: types.DepositConversionUnwind, ChannelId: "channel-1", ReturnChannelId: "channel-0", RateSource: types.DepositRateSourceUnity}
+
+	deposit := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)), sdk.NewCoin(ibcAtom, sdk.NewInt(200)))
+	require.Error(t, zone.ValidateCoinsForZone(deposit))
+
+	zone.SetDepositAsset(asset)
+	require.NoError(t, zone.ValidateCoinsForZone(deposit))
+
+	native, converted := zone.SplitDepositAssets(deposit)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), native)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(ibcAtom, sdk.NewInt(200))), converted)
+
+	// setting an existing deposit asset replaces it.
+	asset.ChannelId = "channel-3"
+	zone.SetDepositAsset(asset)
+	require.Equal(t, 1, len(zone.DepositAssets))
+	existing, found := zone.GetDepositAsset(ibcAtom)
+	require.True(t, found)
+	require.Equal(t, "channel-3", existing.ChannelId)
+
+	require.True(t, zone.RemoveDepositAsset(ibcAtom))
+	require.False(t, zone.RemoveDepositAsset(ibcAtom))
+	require.Error(t, zone.ValidateCoinsForZone(deposit))
+}
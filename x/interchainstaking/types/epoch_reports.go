@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Year is the period over which epoch yields are annualised.
+const Year = 365 * 24 * time.Hour
+
+// EpochReportCSVHeader is the header row of an epoch report CSV export, matching EpochReport.CSVRecord.
+var EpochReportCSVHeader = []string{
+	"chain_id",
+	"epoch",
+	"height",
+	"time",
+	"tvl",
+	"gross_rewards",
+	"commission",
+	"redelegated",
+	"redemption_rate_before",
+	"redemption_rate_after",
+	"redemption_rate_delta",
+	"annualised_yield",
+	"distribution_height",
+}
+
+// AnnualisedYield returns the growth from before to after over elapsed, annualised linearly. Where before
+// is not positive or no time has elapsed, zero is returned.
+func AnnualisedYield(before sdk.Dec, after sdk.Dec, elapsed time.Duration) sdk.Dec {
+	if before.IsNil() || after.IsNil() || !before.IsPositive() || elapsed <= 0 {
+		return sdk.ZeroDec()
+	}
+	growth := after.Quo(before).Sub(sdk.OneDec())
+	return growth.MulInt64(int64(Year)).QuoInt64(int64(elapsed))
+}
+
+// CSVRecord returns the report as a CSV row, ordered as EpochReportCSVHeader.
+func (r EpochReport) CSVRecord() []string {
+	return []string{
+		r.ChainId,
+		strconv.FormatInt(r.Epoch, 10),
+		strconv.FormatInt(r.Height, 10),
+		r.Time.UTC().Format(time.RFC3339),
+		r.Tvl.String(),
+		r.GrossRewards.String(),
+		r.Commission.String(),
+		r.Redelegated.String(),
+		r.RedemptionRateBefore.String(),
+		r.RedemptionRateAfter.String(),
+		r.RedemptionRateDelta.String(),
+		r.AnnualisedYield.String(),
+		strconv.FormatInt(r.DistributionHeight, 10),
+	}
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestAnnualisedYield(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   sdk.Dec
+		after    sdk.Dec
+		elapsed  time.Duration
+		expected sdk.Dec
+	}{
+		{"one percent over a hundredth of a year", sdk.OneDec(), sdk.NewDecWithPrec(101, 2), types.Year / 100, sdk.NewDec(1)},
+		{"no change", sdk.OneDec(), sdk.OneDec(), types.Year, sdk.ZeroDec()},
+		{"negative growth", sdk.OneDec(), sdk.NewDecWithPrec(99, 2), types.Year / 2, sdk.NewDecWithPrec(-2, 2)},
+		{"no elapsed time", sdk.OneDec(), sdk.NewDecWithPrec(101, 2), 0, sdk.ZeroDec()},
+		{"zero before", sdk.ZeroDec(), sdk.OneDec(), types.Year, sdk.ZeroDec()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, types.AnnualisedYield(tt.before, tt.after, tt.elapsed))
+		})
+	}
+}
+
+func TestEpochReportCSVRecord(t *testing.T) {
+	report := types.EpochReport{
+		ChainId:              "cosmoshub-4",
+		Epoch:                2,
+		Height:               100,
+		Time:                 time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Tvl:                  sdk.NewInt(1000000),
+		GrossRewards:         sdk.NewInt(100),
+		Commission:           sdk.NewInt(10),
+		Redelegated:          sdk.NewInt(90),
+		RedemptionRateBefore: sdk.OneDec(),
+		RedemptionRateAfter:  sdk.NewDecWithPrec(101, 2),
+		RedemptionRateDelta:  sdk.NewDecWithPrec(1, 2),
+		AnnualisedYield:      sdk.NewDec(1),
+		DistributionHeight:   105,
+	}
+
+	record := report.CSVRecord()
+	require.Equal(t, len(types.EpochReportCSVHeader), len(record))
+	require.Equal(t, []string{
+		"cosmoshub-4", "2", "100", "2023-01-01T00:00:00Z", "1000000", "100", "10", "90",
+		"1.000000000000000000", "1.010000000000000000", "0.010000000000000000", "1.000000000000000000", "105",
+	}, record)
+}
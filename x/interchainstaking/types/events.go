@@ -1,11 +1,22 @@
 package types
 
 const (
-	EventTypeRegisterZone      = "register_zone"
-	EventTypeRedemptionRequest = "request_redemption"
-	EventTypeSetIntent         = "set_intent"
-	EventTypeCloseICA          = "close_ica_channel"
-	EventTypeReopenICA         = "reopen_ica_channel"
+	EventTypeRegisterZone                 = "register_zone"
+	EventTypeRedemptionRequest            = "request_redemption"
+	EventTypeRedemptionCancel             = "cancel_redemption"
+	EventTypeRedemptionDestinationUpdated = "update_redemption_destination"
+	EventTypeRefundDeposit                = "refund_deposit"
+	EventTypeForwardQAssets               = "forward_qassets"
+	EventTypeValidatorSlash               = "validator_slash"
+	EventTypeSetIntent                    = "set_intent"
+	EventTypeConfirmIntent                = "confirm_intent"
+	EventTypeCloseICA                     = "close_ica_channel"
+	EventTypeReopenICA                    = "reopen_ica_channel"
+	EventTypeOffboardZone                 = "offboard_zone"
+	EventTypeZoneOffboarded               = "zone_offboarded"
+	EventTypeDistributeFees               = "distribute_fees"
+	EventTypeRefundConversion             = "refund_conversion"
+	EventTypeAbandonConversion            = "abandon_conversion"
 
 	AttributeKeyConnectionID     = "connection_id"
 	AttributeKeyChainID          = "chain_id"
@@ -16,6 +27,20 @@ const (
 	AttributeKeyChannelID        = "channel_id"
 	AttributeKeyPortID           = "port_name"
 	AttributeKeyUser             = "user_address"
+	AttributeKeyRedemptionPath   = "redemption_path"
+	AttributeKeyFeeAmount        = "fee_amount"
+	AttributeKeyHash             = "hash"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyValidator        = "validator"
+	AttributeKeyFraction         = "fraction"
+	AttributeKeyRedemptionRate   = "redemption_rate"
+	AttributeKeyOffboardingStage = "offboarding_stage"
+	AttributeKeyFeeDestination   = "destination"
+
+	AttributeValueRedemptionPathLsm      = "lsm"
+	AttributeValueRedemptionPathQueued   = "queued"
+	AttributeValueRedemptionPathInstant  = "instant"
+	AttributeValueRedemptionPathOffboard = "offboard"
 
 	AttributeValueCategory = ModuleName
 )
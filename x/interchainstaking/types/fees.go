@@ -0,0 +1,88 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FeeDestinationCommunityPool routes fees to the community pool.
+	FeeDestinationCommunityPool int32 = iota + 1
+	// FeeDestinationTreasury routes fees to the treasury address.
+	FeeDestinationTreasury
+	// FeeDestinationParticipationRewards routes fees to the participationrewards module account.
+	FeeDestinationParticipationRewards
+	// FeeDestinationBuyback routes fees to the buy-back address.
+	FeeDestinationBuyback
+)
+
+// FeeDestinationFeeCollector is the name of the destination of fees not otherwise routed.
+const FeeDestinationFeeCollector = "fee_collector"
+
+var feeDestinationNames = map[int32]string{
+	FeeDestinationCommunityPool:        "community_pool",
+	FeeDestinationTreasury:             "treasury",
+	FeeDestinationParticipationRewards: "participation_rewards",
+	FeeDestinationBuyback:              "buyback",
+}
+
+// GetDestinationName returns the name of the route destination, as used in events.
+func (r FeeRoute) GetDestinationName() string {
+	return feeDestinationNames[r.Destination]
+}
+
+// RequiresAddress returns true if fees are routed to the route address.
+func (r FeeRoute) RequiresAddress() bool {
+	return r.Destination == FeeDestinationTreasury || r.Destination == FeeDestinationBuyback
+}
+
+// Validate validates the fee route.
+func (r FeeRoute) Validate() error {
+	if _, found := feeDestinationNames[r.Destination]; !found {
+		return fmt.Errorf("unknown fee destination %d", r.Destination)
+	}
+
+	if r.RequiresAddress() {
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid %s address: %w", r.GetDestinationName(), err)
+		}
+	} else if r.Address != "" {
+		return fmt.Errorf("address must not be set for %s fee route", r.GetDestinationName())
+	}
+
+	if r.Share.IsNil() || !r.Share.IsPositive() || r.Share.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s fee share must be in the range (0, 1]", r.GetDestinationName())
+	}
+
+	return nil
+}
+
+// ValidateFeeRoutes validates each fee route, and that the routes together share no more than the collected fees.
+// Routes to addresses for which blockedAddr returns true are rejected; blockedAddr may be nil where the blocked
+// addresses are not known, e.g. in stateless genesis validation.
+func ValidateFeeRoutes(routes []FeeRoute, blockedAddr func(sdk.AccAddress) bool) error {
+	total := sdk.ZeroDec()
+	for _, route := range routes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		if route.RequiresAddress() && blockedAddr != nil && blockedAddr(sdk.MustAccAddressFromBech32(route.Address)) {
+			return fmt.Errorf("%s address %s is not allowed to receive funds", route.GetDestinationName(), route.Address)
+		}
+		total = total.Add(route.Share)
+	}
+	if total.GT(sdk.OneDec()) {
+		return errors.New("fee route shares must not exceed one")
+	}
+	return nil
+}
+
+// GetCommissionRate returns the zone commission rate, or the given module commission rate if unset.
+func (z Zone) GetCommissionRate(moduleRate sdk.Dec) sdk.Dec {
+	if z.CommissionRate == nil || z.CommissionRate.IsNil() {
+		return moduleRate
+	}
+	return *z.CommissionRate
+}
@@ -84,6 +84,9 @@ type Params struct {
 	ValidatorsetInterval uint64                                 `protobuf:"varint,2,opt,name=validatorset_interval,json=validatorsetInterval,proto3" json:"validatorset_interval,omitempty"`
 	CommissionRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
 	UnbondingEnabled     bool                                   `protobuf:"varint,4,opt,name=unbonding_enabled,json=unbondingEnabled,proto3" json:"unbonding_enabled,omitempty"`
+	// fee_routes route shares of collected protocol fees; the remainder is sent
+	// to the fee collector.
+	FeeRoutes []FeeRoute `protobuf:"bytes,5,rep,name=fee_routes,json=feeRoutes,proto3" json:"fee_routes"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -139,6 +142,70 @@ func (m *Params) GetUnbondingEnabled() bool {
 	return false
 }
 
+func (m *Params) GetFeeRoutes() []FeeRoute {
+	if m != nil {
+		return m.FeeRoutes
+	}
+	return nil
+}
+
+// FeeRoute routes a share of collected protocol fees to a destination.
+type FeeRoute struct {
+	// destination is 1 (community pool), 2 (treasury), 3 (participation
+	// rewards) or 4 (buy-back).
+	Destination int32 `protobuf:"varint,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// address is the recipient of treasury and buy-back fees.
+	Address string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Share   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+}
+
+func (m *FeeRoute) Reset()         { *m = FeeRoute{} }
+func (m *FeeRoute) String() string { return proto.CompactTextString(m) }
+func (*FeeRoute) ProtoMessage()    {}
+func (*FeeRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{2}
+}
+func (m *FeeRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRoute.Merge(m, src)
+}
+func (m *FeeRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRoute proto.InternalMessageInfo
+
+func (m *FeeRoute) GetDestination() int32 {
+	if m != nil {
+		return m.Destination
+	}
+	return 0
+}
+
+func (m *FeeRoute) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type DelegationsForZone struct {
 	ChainId     string        `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Delegations []*Delegation `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
@@ -148,7 +215,7 @@ func (m *DelegationsForZone) Reset()         { *m = DelegationsForZone{} }
 func (m *DelegationsForZone) String() string { return proto.CompactTextString(m) }
 func (*DelegationsForZone) ProtoMessage()    {}
 func (*DelegationsForZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{2}
+	return fileDescriptor_196cdf77e041fc72, []int{3}
 }
 func (m *DelegationsForZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +268,7 @@ func (m *DelegatorIntentsForZone) Reset()         { *m = DelegatorIntentsForZone
 func (m *DelegatorIntentsForZone) String() string { return proto.CompactTextString(m) }
 func (*DelegatorIntentsForZone) ProtoMessage()    {}
 func (*DelegatorIntentsForZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{3}
+	return fileDescriptor_196cdf77e041fc72, []int{4}
 }
 func (m *DelegatorIntentsForZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,13 +328,20 @@ type GenesisState struct {
 	DelegatorIntents       []DelegatorIntentsForZone `protobuf:"bytes,6,rep,name=delegator_intents,json=delegatorIntents,proto3" json:"delegator_intents"`
 	PortConnections        []PortConnectionTuple     `protobuf:"bytes,7,rep,name=port_connections,json=portConnections,proto3" json:"port_connections"`
 	WithdrawalRecords      []WithdrawalRecord        `protobuf:"bytes,8,rep,name=withdrawal_records,json=withdrawalRecords,proto3" json:"withdrawal_records"`
+	RedemptionRateRecords  []RedemptionRateRecord    `protobuf:"bytes,9,rep,name=redemption_rate_records,json=redemptionRateRecords,proto3" json:"redemption_rate_records"`
+	SlashRecords           []SlashRecord             `protobuf:"bytes,10,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	IcaPacketRecords       []ICAPacketRecord         `protobuf:"bytes,11,rep,name=ica_packet_records,json=icaPacketRecords,proto3" json:"ica_packet_records"`
+	ConversionRecords      []ConversionRecord        `protobuf:"bytes,12,rep,name=conversion_records,json=conversionRecords,proto3" json:"conversion_records"`
+	ZoneFees               []ZoneFees                `protobuf:"bytes,13,rep,name=zone_fees,json=zoneFees,proto3" json:"zone_fees"`
+	EpochReports           []EpochReport             `protobuf:"bytes,14,rep,name=epoch_reports,json=epochReports,proto3" json:"epoch_reports"`
+	TimedOutDelegations    []TimedOutDelegation      `protobuf:"bytes,15,rep,name=timed_out_delegations,json=timedOutDelegations,proto3" json:"timed_out_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{4}
+	return fileDescriptor_196cdf77e041fc72, []int{5}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,9 +426,59 @@ func (m *GenesisState) GetWithdrawalRecords() []WithdrawalRecord {
 	return nil
 }
 
+func (m *GenesisState) GetRedemptionRateRecords() []RedemptionRateRecord {
+	if m != nil {
+		return m.RedemptionRateRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaPacketRecords() []ICAPacketRecord {
+	if m != nil {
+		return m.IcaPacketRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConversionRecords() []ConversionRecord {
+	if m != nil {
+		return m.ConversionRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetZoneFees() []ZoneFees {
+	if m != nil {
+		return m.ZoneFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochReports() []EpochReport {
+	if m != nil {
+		return m.EpochReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimedOutDelegations() []TimedOutDelegation {
+	if m != nil {
+		return m.TimedOutDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsV1)(nil), "quicksilver.interchainstaking.v1.Params_v1")
 	proto.RegisterType((*Params)(nil), "quicksilver.interchainstaking.v1.Params")
+	proto.RegisterType((*FeeRoute)(nil), "quicksilver.interchainstaking.v1.FeeRoute")
 	proto.RegisterType((*DelegationsForZone)(nil), "quicksilver.interchainstaking.v1.DelegationsForZone")
 	proto.RegisterType((*DelegatorIntentsForZone)(nil), "quicksilver.interchainstaking.v1.DelegatorIntentsForZone")
 	proto.RegisterType((*GenesisState)(nil), "quicksilver.interchainstaking.v1.GenesisState")
@@ -365,53 +489,70 @@ func init() {
 }
 
 var fileDescriptor_196cdf77e041fc72 = []byte{
-	// 736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x42, 0x29, 0x65, 0x30, 0x52, 0x26, 0x28, 0x85, 0x43, 0xdb, 0xf4, 0x40, 0x4a, 0x94,
-	0xdd, 0x14, 0x34, 0x51, 0xe3, 0xc1, 0x54, 0xc4, 0x10, 0x13, 0x63, 0x56, 0x13, 0x13, 0x62, 0xdc,
-	0xcc, 0xee, 0x0e, 0xdb, 0x09, 0xbb, 0x33, 0xeb, 0xcc, 0x74, 0x11, 0x2f, 0xde, 0x3c, 0x7b, 0xf4,
-	0xc8, 0x47, 0xf0, 0xe0, 0x87, 0xe0, 0x48, 0x3c, 0x19, 0x63, 0x88, 0x81, 0x8b, 0x5f, 0xc1, 0x9b,
-	0xe9, 0xec, 0x74, 0xbb, 0x80, 0x49, 0x21, 0xde, 0x3c, 0xb5, 0xef, 0xcf, 0xef, 0xf7, 0x7b, 0xf3,
-	0xde, 0xdb, 0x19, 0x60, 0xbe, 0xe9, 0x11, 0x6f, 0x47, 0x90, 0x30, 0xc1, 0xdc, 0x22, 0x54, 0x62,
-	0xee, 0x75, 0x11, 0xa1, 0x42, 0xa2, 0x1d, 0x42, 0x03, 0x2b, 0x69, 0x5b, 0x01, 0xa6, 0x58, 0x10,
-	0x61, 0xc6, 0x9c, 0x49, 0x06, 0x1b, 0xb9, 0x7c, 0xf3, 0x5c, 0xbe, 0x99, 0xb4, 0x17, 0xe7, 0x02,
-	0x16, 0x30, 0x95, 0x6c, 0xf5, 0xff, 0xa5, 0xb8, 0xc5, 0x05, 0x8f, 0x89, 0x88, 0x09, 0x27, 0x0d,
-	0xa4, 0x86, 0x0e, 0xd5, 0x52, 0xcb, 0x72, 0x91, 0xc0, 0x56, 0xd2, 0x76, 0xb1, 0x44, 0x6d, 0xcb,
-	0x63, 0x84, 0xea, 0x78, 0x3d, 0x60, 0x2c, 0x08, 0xb1, 0xa5, 0x2c, 0xb7, 0xb7, 0x6d, 0x49, 0x12,
-	0x61, 0x21, 0x51, 0x14, 0xeb, 0x84, 0x3b, 0x23, 0xcf, 0x70, 0xbe, 0x50, 0x85, 0x6c, 0xfe, 0x30,
-	0xc0, 0xd4, 0x33, 0xc4, 0x51, 0x24, 0x9c, 0xa4, 0x0d, 0x97, 0x41, 0xc5, 0xc7, 0x31, 0x13, 0x44,
-	0x3a, 0x0a, 0x90, 0xa0, 0xb0, 0x6a, 0x34, 0x8c, 0x56, 0xd1, 0x9e, 0xd1, 0xfe, 0x4d, 0xed, 0x86,
-	0x6b, 0xe0, 0x5a, 0x82, 0x42, 0xe2, 0x23, 0xc9, 0xb8, 0xc0, 0xb9, 0xfc, 0x31, 0x95, 0x3f, 0x97,
-	0x0f, 0x66, 0x20, 0x0c, 0x66, 0x3c, 0x16, 0x45, 0x44, 0x08, 0xc2, 0xa8, 0xc3, 0x91, 0xc4, 0xd5,
-	0xf1, 0x86, 0xd1, 0x9a, 0xea, 0xdc, 0x3f, 0x38, 0xaa, 0x17, 0xbe, 0x1f, 0xd5, 0x97, 0x02, 0x22,
-	0xbb, 0x3d, 0xd7, 0xf4, 0x58, 0xa4, 0x5b, 0xa4, 0x7f, 0x56, 0x84, 0xbf, 0x63, 0xc9, 0xbd, 0x18,
-	0x0b, 0x73, 0x1d, 0x7b, 0x5f, 0xbf, 0xac, 0x00, 0xdd, 0xc1, 0x75, 0xec, 0xd9, 0x57, 0x87, 0xa4,
-	0x36, 0x92, 0xf8, 0x5e, 0xf9, 0xd3, 0x7e, 0xbd, 0xf0, 0x6b, 0xbf, 0x6e, 0x34, 0x3f, 0x8c, 0x81,
-	0x52, 0x7a, 0xbc, 0xff, 0xe4, 0x6c, 0xf0, 0x06, 0x98, 0xed, 0x51, 0x97, 0x51, 0x9f, 0xd0, 0xc0,
-	0xc1, 0x14, 0xb9, 0x21, 0xf6, 0xab, 0xc5, 0x86, 0xd1, 0x2a, 0xdb, 0x95, 0x2c, 0xf0, 0x28, 0xf5,
-	0xe7, 0x1a, 0xf1, 0x1e, 0xc0, 0x75, 0x1c, 0xe2, 0x00, 0x49, 0xc2, 0xa8, 0xd8, 0x60, 0x7c, 0x8b,
-	0x51, 0x0c, 0x17, 0x40, 0x59, 0xed, 0x84, 0x43, 0x7c, 0xd5, 0x8b, 0x29, 0x7b, 0x52, 0xd9, 0x9b,
-	0x3e, 0x7c, 0x0a, 0xa6, 0xfd, 0x21, 0xa0, 0x3a, 0xd6, 0x18, 0x6f, 0x4d, 0xaf, 0xde, 0x34, 0x47,
-	0x2d, 0xbf, 0x39, 0x54, 0xb1, 0xf3, 0x04, 0xcd, 0xcf, 0x06, 0x98, 0xd7, 0x31, 0xc6, 0xfb, 0x4d,
-	0xa3, 0xf2, 0x22, 0x65, 0xbc, 0x06, 0xb3, 0x43, 0x16, 0x35, 0x08, 0x2a, 0x75, 0x31, 0xed, 0x0b,
-	0x17, 0x33, 0x10, 0xb4, 0x2b, 0x43, 0xae, 0xd4, 0x03, 0x17, 0x41, 0x59, 0x50, 0x14, 0x8b, 0x2e,
-	0x93, 0x6a, 0x5c, 0x65, 0x3b, 0xb3, 0x9b, 0xbf, 0x27, 0xc0, 0x95, 0xc7, 0xe9, 0xb7, 0xff, 0x5c,
-	0xf6, 0x7b, 0xbf, 0x01, 0x4a, 0xb1, 0x5a, 0x26, 0x55, 0xe5, 0xf4, 0x6a, 0x6b, 0x74, 0x05, 0xe9,
-	0xf2, 0x75, 0x8a, 0xfd, 0x1d, 0xb0, 0x35, 0x1a, 0x76, 0xc0, 0xc4, 0x3b, 0x46, 0xf1, 0xa0, 0xab,
-	0x4b, 0xa3, 0x69, 0xfa, 0x6d, 0xd2, 0x24, 0x29, 0x14, 0x3e, 0x01, 0x65, 0x8e, 0x3d, 0x4c, 0x62,
-	0x29, 0xaa, 0xe3, 0x8a, 0x66, 0x79, 0x34, 0x8d, 0x9d, 0x22, 0x34, 0x53, 0x46, 0x00, 0x5f, 0x9d,
-	0x1e, 0x76, 0x51, 0xf1, 0xdd, 0xba, 0xcc, 0xb0, 0x07, 0xb3, 0xd4, 0xd4, 0x79, 0x3a, 0x28, 0xc0,
-	0x7c, 0x8c, 0xf9, 0x36, 0xe3, 0x11, 0xa2, 0x1e, 0x76, 0xf2, 0x4a, 0x13, 0xff, 0xac, 0x74, 0x3d,
-	0x47, 0x9d, 0x4b, 0x82, 0x61, 0xb6, 0x38, 0x8c, 0xeb, 0xbd, 0x11, 0xd5, 0x92, 0x92, 0xbb, 0x7b,
-	0xe9, 0xc5, 0x39, 0xa3, 0x59, 0xf1, 0xcf, 0x84, 0xe1, 0x36, 0xa8, 0xc4, 0x8c, 0x4b, 0xc7, 0x63,
-	0x94, 0x62, 0x2f, 0x3d, 0xdb, 0xa4, 0x12, 0xbb, 0x7d, 0x81, 0x1d, 0x61, 0x5c, 0x3e, 0xcc, 0x80,
-	0x2f, 0x7a, 0x71, 0x38, 0x10, 0x9a, 0x89, 0x4f, 0x85, 0x04, 0x0c, 0x00, 0xdc, 0x25, 0xb2, 0xeb,
-	0x73, 0xb4, 0x8b, 0x42, 0x87, 0x63, 0x8f, 0x71, 0x5f, 0x54, 0xcb, 0x4a, 0x69, 0x75, 0xb4, 0xd2,
-	0xcb, 0x0c, 0x6b, 0x2b, 0xa8, 0x96, 0x99, 0xdd, 0x3d, 0xe3, 0x17, 0x9d, 0xad, 0x83, 0xe3, 0x9a,
-	0x71, 0x78, 0x5c, 0x33, 0x7e, 0x1e, 0xd7, 0x8c, 0x8f, 0x27, 0xb5, 0xc2, 0xe1, 0x49, 0xad, 0xf0,
-	0xed, 0xa4, 0x56, 0xd8, 0x7a, 0x90, 0xbb, 0xc6, 0x08, 0x0d, 0x30, 0xed, 0x11, 0xb9, 0xb7, 0xe2,
-	0xf6, 0x48, 0xe8, 0x5b, 0xf9, 0x67, 0xe8, 0xed, 0x5f, 0x1e, 0x22, 0x75, 0xc9, 0xb9, 0x25, 0xf5,
-	0xf4, 0xac, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x24, 0x0d, 0xe8, 0xd3, 0x7a, 0x07, 0x00, 0x00,
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xe6, 0xaf, 0x3d, 0x49, 0x1b, 0x67, 0x68, 0xc8, 0x36, 0x07, 0xc7, 0xca, 0xa1, 0x4a,
+	0x81, 0x78, 0xe5, 0x14, 0x10, 0x54, 0x1c, 0x20, 0x49, 0x83, 0x22, 0x04, 0xad, 0xb6, 0x95, 0x40,
+	0x11, 0x62, 0x35, 0xde, 0x7d, 0x5e, 0x8f, 0xb2, 0x9e, 0x59, 0xe6, 0x8d, 0x1d, 0xda, 0x0b, 0x5f,
+	0x81, 0x23, 0xc7, 0x7c, 0x04, 0x84, 0xf8, 0x10, 0x3d, 0x56, 0x5c, 0x40, 0x08, 0x55, 0x28, 0xb9,
+	0xf0, 0x31, 0xd0, 0xce, 0x8e, 0xd7, 0xeb, 0xa4, 0x92, 0x5d, 0xc1, 0x89, 0x93, 0xfd, 0xfe, 0xfc,
+	0x7e, 0xbf, 0x37, 0x6f, 0xde, 0xcc, 0x0e, 0x69, 0x7e, 0xdb, 0xe7, 0xe1, 0x29, 0xf2, 0x64, 0x00,
+	0xca, 0xe3, 0x42, 0x83, 0x0a, 0xbb, 0x8c, 0x0b, 0xd4, 0xec, 0x94, 0x8b, 0xd8, 0x1b, 0xb4, 0xbc,
+	0x18, 0x04, 0x20, 0xc7, 0x66, 0xaa, 0xa4, 0x96, 0xb4, 0x51, 0xca, 0x6f, 0x5e, 0xcb, 0x6f, 0x0e,
+	0x5a, 0x9b, 0xb7, 0x62, 0x19, 0x4b, 0x93, 0xec, 0x65, 0xff, 0x72, 0xdc, 0xe6, 0xed, 0x50, 0x62,
+	0x4f, 0x62, 0x90, 0x07, 0x72, 0xc3, 0x86, 0xea, 0xb9, 0xe5, 0xb5, 0x19, 0x82, 0x37, 0x68, 0xb5,
+	0x41, 0xb3, 0x96, 0x17, 0x4a, 0x2e, 0x6c, 0x7c, 0x2b, 0x96, 0x32, 0x4e, 0xc0, 0x33, 0x56, 0xbb,
+	0xdf, 0xf1, 0x34, 0xef, 0x01, 0x6a, 0xd6, 0x4b, 0x6d, 0xc2, 0x07, 0x13, 0xd7, 0x70, 0xbd, 0x50,
+	0x83, 0xdc, 0xfe, 0xd3, 0x21, 0xd5, 0x47, 0x4c, 0xb1, 0x1e, 0x06, 0x83, 0x16, 0xbd, 0x4b, 0x6a,
+	0x11, 0xa4, 0x12, 0xb9, 0x0e, 0x0c, 0x60, 0xc0, 0x12, 0xd7, 0x69, 0x38, 0x3b, 0xf3, 0xfe, 0xaa,
+	0xf5, 0x1f, 0x5b, 0x37, 0xbd, 0x47, 0xd6, 0x07, 0x2c, 0xe1, 0x11, 0xd3, 0x52, 0x21, 0x94, 0xf2,
+	0x67, 0x4d, 0xfe, 0xad, 0x72, 0xb0, 0x00, 0x01, 0x59, 0x0d, 0x65, 0xaf, 0xc7, 0x11, 0xb9, 0x14,
+	0x81, 0x62, 0x1a, 0xdc, 0xb9, 0x86, 0xb3, 0x53, 0xdd, 0xff, 0xe8, 0xf9, 0xcb, 0xad, 0x99, 0x3f,
+	0x5e, 0x6e, 0xdd, 0x89, 0xb9, 0xee, 0xf6, 0xdb, 0xcd, 0x50, 0xf6, 0x6c, 0x8b, 0xec, 0xcf, 0x2e,
+	0x46, 0xa7, 0x9e, 0x7e, 0x9a, 0x02, 0x36, 0x0f, 0x21, 0xfc, 0xf5, 0x97, 0x5d, 0x62, 0x3b, 0x78,
+	0x08, 0xa1, 0x7f, 0x73, 0x44, 0xea, 0x33, 0x0d, 0xf7, 0x2b, 0x3f, 0x9e, 0x6f, 0xcd, 0xfc, 0x7d,
+	0xbe, 0xe5, 0x6c, 0xff, 0x36, 0x4b, 0x16, 0xf3, 0xe5, 0xfd, 0x4f, 0xd6, 0x46, 0xdf, 0x26, 0x6b,
+	0x7d, 0xd1, 0x96, 0x22, 0xe2, 0x22, 0x0e, 0x40, 0xb0, 0x76, 0x02, 0x91, 0x3b, 0xdf, 0x70, 0x76,
+	0x2a, 0x7e, 0xad, 0x08, 0x3c, 0xc8, 0xfd, 0xf4, 0x21, 0x21, 0x1d, 0x80, 0x40, 0xc9, 0xbe, 0x06,
+	0x74, 0x17, 0x1a, 0x73, 0x3b, 0xcb, 0x7b, 0x6f, 0x35, 0x27, 0x0d, 0x70, 0xf3, 0x08, 0xc0, 0xcf,
+	0x20, 0xfb, 0xf3, 0x59, 0xe9, 0x7e, 0xb5, 0x63, 0x6d, 0x2c, 0x75, 0xf6, 0xdc, 0x21, 0x95, 0x61,
+	0x1e, 0x6d, 0x90, 0xe5, 0x08, 0x50, 0x73, 0xc1, 0x34, 0x97, 0xc2, 0xb4, 0x75, 0xc1, 0x2f, 0xbb,
+	0xa8, 0x4b, 0x96, 0x58, 0x14, 0x29, 0x40, 0x34, 0x4d, 0xac, 0xfa, 0x43, 0x93, 0xfa, 0x64, 0x01,
+	0xbb, 0x4c, 0xfd, 0x37, 0xdd, 0xca, 0xa9, 0xee, 0xcf, 0x9b, 0x12, 0xbf, 0x27, 0xf4, 0x10, 0x12,
+	0x88, 0x4d, 0x05, 0x78, 0x24, 0xd5, 0x89, 0x14, 0x40, 0x6f, 0x93, 0x8a, 0x59, 0x6f, 0xc0, 0x23,
+	0x53, 0x68, 0xd5, 0x5f, 0x32, 0xf6, 0x71, 0x44, 0xbf, 0xc8, 0x96, 0x51, 0x00, 0xdc, 0x59, 0xd3,
+	0xaf, 0x77, 0x26, 0xf7, 0x6b, 0xa4, 0xe2, 0x97, 0x09, 0xb6, 0x7f, 0x72, 0xc8, 0x86, 0x8d, 0x49,
+	0x95, 0x0d, 0x8a, 0xd0, 0xd3, 0x94, 0xf1, 0x0d, 0x59, 0x1b, 0xb1, 0x98, 0xe1, 0x13, 0xda, 0x16,
+	0xd3, 0x9a, 0xba, 0x98, 0xa1, 0xa0, 0x5f, 0x1b, 0x71, 0xe5, 0x1e, 0xba, 0x49, 0x2a, 0x28, 0x58,
+	0x8a, 0x5d, 0xa9, 0x4d, 0xd3, 0x2b, 0x7e, 0x61, 0x6f, 0xff, 0xbc, 0x4c, 0x56, 0x3e, 0xcd, 0xef,
+	0xbb, 0xc7, 0x3a, 0x9b, 0xb7, 0x23, 0xb2, 0x98, 0x9a, 0x03, 0x64, 0xaa, 0x5c, 0xde, 0xdb, 0x99,
+	0x5c, 0x41, 0x7e, 0xe0, 0xec, 0xf0, 0x58, 0x34, 0xdd, 0x27, 0x0b, 0xcf, 0xa4, 0x80, 0x61, 0x57,
+	0xef, 0x4c, 0xa6, 0xc9, 0xda, 0x64, 0x49, 0x72, 0x28, 0xfd, 0x8c, 0x54, 0x14, 0x84, 0xc0, 0x53,
+	0x8d, 0xee, 0x9c, 0xa1, 0xb9, 0x3b, 0x99, 0xc6, 0xcf, 0x11, 0x96, 0xa9, 0x20, 0xa0, 0x5f, 0x8f,
+	0x6f, 0xf6, 0xbc, 0xe1, 0x7b, 0xf7, 0x75, 0x36, 0x7b, 0xb8, 0x97, 0x96, 0xba, 0x4c, 0x47, 0x91,
+	0x6c, 0xa4, 0xa0, 0x3a, 0x52, 0xf5, 0x98, 0x08, 0x21, 0x28, 0x2b, 0x2d, 0xfc, 0x6b, 0xa5, 0x37,
+	0x4b, 0xd4, 0xa5, 0x24, 0x9a, 0x14, 0x83, 0x23, 0x95, 0x9d, 0x1b, 0x74, 0x17, 0x8d, 0xdc, 0x87,
+	0xaf, 0x3d, 0x38, 0x57, 0x34, 0x6b, 0xd1, 0x95, 0x30, 0xed, 0x90, 0x5a, 0x2a, 0x95, 0x0e, 0x42,
+	0x29, 0x04, 0x84, 0xf9, 0xda, 0x96, 0x8c, 0xd8, 0x7b, 0x53, 0xcc, 0x88, 0x54, 0xfa, 0xa0, 0x00,
+	0x3e, 0xe9, 0xa7, 0xc9, 0x50, 0x68, 0x35, 0x1d, 0x0b, 0x21, 0x8d, 0x09, 0x3d, 0xe3, 0xba, 0x1b,
+	0x29, 0x76, 0xc6, 0x92, 0x40, 0x41, 0x28, 0x55, 0x84, 0x6e, 0xc5, 0x28, 0xed, 0x4d, 0x56, 0xfa,
+	0xb2, 0xc0, 0xfa, 0x06, 0x6a, 0x65, 0xd6, 0xce, 0xae, 0xf8, 0x91, 0x6a, 0xb2, 0xa1, 0x20, 0x82,
+	0x5e, 0xaa, 0x87, 0x37, 0x78, 0xa1, 0x56, 0x35, 0x6a, 0xef, 0x4f, 0x33, 0x6d, 0x43, 0x82, 0xec,
+	0xb6, 0x1e, 0x53, 0x5c, 0x57, 0xaf, 0x88, 0x21, 0xfd, 0x8a, 0xdc, 0xc0, 0x84, 0x61, 0xb7, 0xd0,
+	0x22, 0x46, 0x6b, 0x77, 0xb2, 0xd6, 0xe3, 0x0c, 0x36, 0x26, 0xb1, 0x82, 0x23, 0x17, 0x52, 0x20,
+	0x94, 0x87, 0x2c, 0x48, 0x59, 0x78, 0x0a, 0xba, 0xa0, 0x5f, 0x9e, 0xf6, 0x22, 0x39, 0x3e, 0xf8,
+	0xe4, 0x91, 0x81, 0x8e, 0x49, 0xd4, 0x78, 0xc8, 0xca, 0x6e, 0xb3, 0x3f, 0xa1, 0x14, 0x03, 0x50,
+	0xf9, 0x87, 0xcf, 0xca, 0xac, 0x4c, 0xbb, 0x3f, 0x07, 0x05, 0x76, 0x7c, 0x7f, 0xc2, 0x2b, 0x7e,
+	0xa4, 0x9f, 0x93, 0x6a, 0x76, 0x0f, 0x04, 0x1d, 0x00, 0x74, 0x6f, 0x4c, 0xfb, 0x31, 0xcb, 0x66,
+	0xf8, 0x08, 0x60, 0x78, 0x1f, 0x55, 0x9e, 0x59, 0x3b, 0x6b, 0x3c, 0xa4, 0x32, 0xcc, 0x1a, 0x9f,
+	0x8d, 0x1c, 0xba, 0x37, 0xa7, 0x6d, 0xfc, 0x83, 0x0c, 0xe6, 0x1b, 0xd4, 0xb0, 0xf1, 0x30, 0x72,
+	0x21, 0x15, 0x64, 0x3d, 0x7b, 0xa1, 0x45, 0x81, 0xec, 0xeb, 0xb1, 0xa3, 0xbf, 0x3a, 0xed, 0xd1,
+	0x7f, 0x92, 0xc1, 0x1f, 0xf6, 0xf5, 0xe8, 0x74, 0x5b, 0xa1, 0x37, 0xf4, 0xb5, 0x08, 0xee, 0x9f,
+	0x3c, 0xbf, 0xa8, 0x3b, 0x2f, 0x2e, 0xea, 0xce, 0x5f, 0x17, 0x75, 0xe7, 0x87, 0xcb, 0xfa, 0xcc,
+	0x8b, 0xcb, 0xfa, 0xcc, 0xef, 0x97, 0xf5, 0x99, 0x93, 0x8f, 0x4b, 0x5f, 0x51, 0x2e, 0x62, 0x10,
+	0x7d, 0xae, 0x9f, 0xee, 0xb6, 0xfb, 0x3c, 0x89, 0xbc, 0xf2, 0x9b, 0xf1, 0xbb, 0x57, 0xbc, 0x1a,
+	0xcd, 0x37, 0xb6, 0xbd, 0x68, 0xde, 0x89, 0xf7, 0xfe, 0x19, 0x00, 0x64, 0xd7, 0x2d, 0xc9, 0x27,
+	0x0b, 0x00, 0x00,
 }
 
 func (this *ParamsV1) Equal(that interface{}) bool {
@@ -475,6 +616,44 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UnbondingEnabled != that1.UnbondingEnabled {
 		return false
 	}
+	if len(this.FeeRoutes) != len(that1.FeeRoutes) {
+		return false
+	}
+	for i := range this.FeeRoutes {
+		if !this.FeeRoutes[i].Equal(&that1.FeeRoutes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeeRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeRoute)
+	if !ok {
+		that2, ok := that.(FeeRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Share.Equal(that1.Share) {
+		return false
+	}
 	return true
 }
 func (m *ParamsV1) Marshal() (dAtA []byte, err error) {
@@ -540,6 +719,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeRoutes) > 0 {
+		for iNdEx := len(m.FeeRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.UnbondingEnabled {
 		i--
 		if m.UnbondingEnabled {
@@ -573,6 +766,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Destination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationsForZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -691,6 +929,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TimedOutDelegations) > 0 {
+		for iNdEx := len(m.TimedOutDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedOutDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.EpochReports) > 0 {
+		for iNdEx := len(m.EpochReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ZoneFees) > 0 {
+		for iNdEx := len(m.ZoneFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ZoneFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ConversionRecords) > 0 {
+		for iNdEx := len(m.ConversionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.IcaPacketRecords) > 0 {
+		for iNdEx := len(m.IcaPacketRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaPacketRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RedemptionRateRecords) > 0 {
+		for iNdEx := len(m.RedemptionRateRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.WithdrawalRecords) > 0 {
 		for iNdEx := len(m.WithdrawalRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -847,6 +1183,30 @@ func (m *Params) Size() (n int) {
 	if m.UnbondingEnabled {
 		n += 2
 	}
+	if len(m.FeeRoutes) > 0 {
+		for _, e := range m.FeeRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovGenesis(uint64(m.Destination))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -941,25 +1301,67 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ParamsV1) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
+	if len(m.RedemptionRateRecords) > 0 {
+		for _, e := range m.RedemptionRateRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaPacketRecords) > 0 {
+		for _, e := range m.IcaPacketRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConversionRecords) > 0 {
+		for _, e := range m.ConversionRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ZoneFees) > 0 {
+		for _, e := range m.ZoneFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochReports) > 0 {
+		for _, e := range m.EpochReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimedOutDelegations) > 0 {
+		for _, e := range m.TimedOutDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1193,6 +1595,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UnbondingEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRoutes = append(m.FeeRoutes, FeeRoute{})
+			if err := m.FeeRoutes[len(m.FeeRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1766,6 +2337,244 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateRecords = append(m.RedemptionRateRecords, RedemptionRateRecord{})
+			if err := m.RedemptionRateRecords[len(m.RedemptionRateRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaPacketRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaPacketRecords = append(m.IcaPacketRecords, ICAPacketRecord{})
+			if err := m.IcaPacketRecords[len(m.IcaPacketRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRecords = append(m.ConversionRecords, ConversionRecord{})
+			if err := m.ConversionRecords[len(m.ConversionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZoneFees = append(m.ZoneFees, ZoneFees{})
+			if err := m.ZoneFees[len(m.ZoneFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochReports = append(m.EpochReports, EpochReport{})
+			if err := m.EpochReports[len(m.EpochReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedOutDelegations = append(m.TimedOutDelegations, TimedOutDelegation{})
+			if err := m.TimedOutDelegations[len(m.TimedOutDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
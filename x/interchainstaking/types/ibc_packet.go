@@ -9,6 +9,9 @@ import (
 const (
 	MsgTypeWithdrawal = "withdrawal"
 	MsgTypeRebalance  = "rebalance"
+	MsgTypeOffboard   = "offboard"
+	// MsgTypeConversion prefixes the memo of delegations of converted deposit assets; the suffix is the deposit hash.
+	MsgTypeConversion = "conversion"
 	// TransferPort is the portID for ibc transfer module.
 	TransferPort = "transfer"
 )
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultICATimeout is the default timeout of packets sent over zone interchain account channels.
+	DefaultICATimeout = 24 * time.Hour
+	// MaxICATimeout is the maximum timeout of packets sent over zone interchain account channels.
+	MaxICATimeout = 28 * 24 * time.Hour
+	// DefaultICAMsgChunkSize is the default maximum number of messages per interchain account packet.
+	DefaultICAMsgChunkSize uint64 = 5
+	// DefaultMsgGas is the estimated gas of messages without a specific estimate.
+	DefaultMsgGas uint64 = 100000
+)
+
+// msgGasEstimates are the estimated gas of executing each message type on the host chain. Estimates are deliberately
+// conservative, as staking messages may also withdraw pending rewards.
+var msgGasEstimates = map[string]uint64{
+	"/cosmos.bank.v1beta1.MsgSend":               80000,
+	"/cosmos.staking.v1beta1.MsgDelegate":        200000,
+	"/cosmos.staking.v1beta1.MsgUndelegate":      250000,
+	"/cosmos.staking.v1beta1.MsgBeginRedelegate": 300000,
+}
+
+// ICAPacketLimits bounds the packets sent over a zone's interchain account channels.
+type ICAPacketLimits struct {
+	Timeout   time.Duration
+	ChunkSize uint64
+	// GasBudget is the maximum estimated gas of the messages in a packet; zero disables gas-aware chunking.
+	GasBudget uint64
+}
+
+// DefaultICAPacketLimits returns the ICAPacketLimits applied to zones that do not override them.
+func DefaultICAPacketLimits() ICAPacketLimits {
+	return ICAPacketLimits{
+		Timeout:   DefaultICATimeout,
+		ChunkSize: DefaultICAMsgChunkSize,
+	}
+}
+
+// EstimateMsgGas returns the estimated gas of executing msg on the host chain.
+func EstimateMsgGas(msg sdk.Msg) uint64 {
+	if gas, found := msgGasEstimates[sdk.MsgTypeURL(msg)]; found {
+		return gas
+	}
+	return DefaultMsgGas
+}
+
+// ChunkMsgs splits msgs, in order, into chunks of at most limits.ChunkSize messages and, where limits.GasBudget is set,
+// at most limits.GasBudget estimated gas. A message whose estimate exceeds the budget is sent in a chunk of its own.
+func ChunkMsgs(msgs []sdk.Msg, limits ICAPacketLimits) [][]sdk.Msg {
+	chunks := make([][]sdk.Msg, 0)
+	chunk := make([]sdk.Msg, 0)
+	chunkGas := uint64(0)
+
+	for _, msg := range msgs {
+		gas := EstimateMsgGas(msg)
+		full := limits.ChunkSize > 0 && uint64(len(chunk)) >= limits.ChunkSize
+		overBudget := limits.GasBudget > 0 && chunkGas+gas > limits.GasBudget
+		if len(chunk) > 0 && (full || overBudget) {
+			chunks = append(chunks, chunk)
+			chunk = make([]sdk.Msg, 0)
+			chunkGas = 0
+		}
+		chunk = append(chunk, msg)
+		chunkGas += gas
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
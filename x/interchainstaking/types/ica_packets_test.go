@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestChunkMsgs(t *testing.T) {
+	send := &banktypes.MsgSend{}
+	delegate := &stakingtypes.MsgDelegate{}
+	undelegate := &stakingtypes.MsgUndelegate{}
+	redelegate := &stakingtypes.MsgBeginRedelegate{}
+
+	require.Equal(t, uint64(80000), types.EstimateMsgGas(send))
+	require.Equal(t, uint64(300000), types.EstimateMsgGas(redelegate))
+	require.Equal(t, types.DefaultMsgGas, types.EstimateMsgGas(&stakingtypes.MsgCreateValidator{}))
+
+	tcs := []struct {
+		name     string
+		msgs     []sdk.Msg
+		limits   types.ICAPacketLimits
+		expected []int
+	}{
+		{
+			name:     "no messages",
+			msgs:     []sdk.Msg{},
+			limits:   types.DefaultICAPacketLimits(),
+			expected: []int{},
+		},
+		{
+			name:     "chunked by size",
+			msgs:     []sdk.Msg{send, send, send, send, send, send, send},
+			limits:   types.ICAPacketLimits{ChunkSize: 3},
+			expected: []int{3, 3, 1},
+		},
+		{
+			name:     "chunked by gas",
+			msgs:     []sdk.Msg{delegate, delegate, undelegate, send, send, redelegate},
+			limits:   types.ICAPacketLimits{ChunkSize: 5, GasBudget: 500000},
+			expected: []int{2, 3, 1},
+		},
+		{
+			name:     "message exceeding budget sent alone",
+			msgs:     []sdk.Msg{send, redelegate, send},
+			limits:   types.ICAPacketLimits{ChunkSize: 5, GasBudget: 100000},
+			expected: []int{1, 1, 1},
+		},
+		{
+			name:     "size limit applies within gas budget",
+			msgs:     []sdk.Msg{send, send, send},
+			limits:   types.ICAPacketLimits{ChunkSize: 2, GasBudget: 1000000},
+			expected: []int{2, 1},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			chunks := types.ChunkMsgs(tc.msgs, tc.limits)
+			sizes := make([]int, 0, len(chunks))
+			msgs := make([]sdk.Msg, 0, len(tc.msgs))
+			for _, chunk := range chunks {
+				sizes = append(sizes, len(chunk))
+				msgs = append(msgs, chunk...)
+			}
+			require.Equal(t, tc.expected, sizes)
+			require.Equal(t, tc.msgs, msgs)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxIntentLifetime is the maximum lifetime of delegator intents.
+	MaxIntentLifetime = 5 * 365 * 24 * time.Hour
+	// MaxIntentDecay is the maximum period over which expired delegator intents decay.
+	MaxIntentDecay = 365 * 24 * time.Hour
+)
+
+// IntentLifetimeDuration returns the zone intent lifetime, capped at MaxIntentLifetime, or zero if unset.
+func (z Zone) IntentLifetimeDuration() time.Duration {
+	if z.IntentLifetime >= uint64(MaxIntentLifetime/time.Second) {
+		return MaxIntentLifetime
+	}
+	return time.Duration(z.IntentLifetime) * time.Second
+}
+
+// IntentDecayDuration returns the zone intent decay period, capped at MaxIntentDecay, or zero if unset.
+func (z Zone) IntentDecayDuration() time.Duration {
+	if z.IntentDecay >= uint64(MaxIntentDecay/time.Second) {
+		return MaxIntentDecay
+	}
+	return time.Duration(z.IntentDecay) * time.Second
+}
+
+// IntentExpiry returns the time at which the intent expires, or nil if the zone applies no intent lifetime or the
+// intent has not been signalled.
+func (z Zone) IntentExpiry(intent DelegatorIntent) *time.Time {
+	if z.IntentLifetime == 0 || intent.Updated == nil {
+		return nil
+	}
+	expiry := intent.Updated.Add(z.IntentLifetimeDuration())
+	return &expiry
+}
+
+// IntentDecayFactor returns the proportion of the intent retained at now; one until the intent expires, falling
+// linearly to zero over the zone intent decay period. Zero indicates that the intent should be dropped.
+func (z Zone) IntentDecayFactor(intent DelegatorIntent, now time.Time) sdk.Dec {
+	expiry := z.IntentExpiry(intent)
+	if expiry == nil || now.Before(*expiry) {
+		return sdk.OneDec()
+	}
+
+	decay := z.IntentDecayDuration()
+	elapsed := now.Sub(*expiry)
+	if elapsed >= decay {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(int64(decay - elapsed)).QuoInt64(int64(decay))
+}
+
+// DecayIntent returns the intent with each weight scaled by factor, and the remaining weight spread across the zone
+// default aggregate intent.
+func (z *Zone) DecayIntent(intent DelegatorIntent, factor sdk.Dec) DelegatorIntent {
+	decayed := DelegatorIntent{Delegator: intent.Delegator, Updated: intent.Updated, Intents: make(ValidatorIntents, 0)}
+	for _, valIntent := range intent.Intents {
+		decayed.Intents = decayed.Intents.SetForValoper(valIntent.ValoperAddress, &ValidatorIntent{
+			ValoperAddress: valIntent.ValoperAddress,
+			Weight:         valIntent.Weight.Mul(factor),
+		})
+	}
+
+	remainder := sdk.OneDec().Sub(factor)
+	for _, valIntent := range z.DefaultAggregateIntents() {
+		existing, found := decayed.Intents.GetForValoper(valIntent.ValoperAddress)
+		if !found {
+			existing = &ValidatorIntent{ValoperAddress: valIntent.ValoperAddress, Weight: sdk.ZeroDec()}
+		}
+		existing.Weight = existing.Weight.Add(valIntent.Weight.Mul(remainder))
+		decayed.Intents = decayed.Intents.SetForValoper(valIntent.ValoperAddress, existing)
+	}
+
+	return decayed
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestIntentDecayFactor(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	intent := func(age time.Duration) types.DelegatorIntent {
+		updated := now.Add(-age)
+		return types.DelegatorIntent{Delegator: "cosmos1user", Updated: &updated}
+	}
+
+	zone := types.Zone{IntentLifetime: 100, IntentDecay: 100}
+	require.Equal(t, sdk.OneDec(), zone.IntentDecayFactor(intent(50*time.Second), now))
+	require.Equal(t, sdk.OneDec(), zone.IntentDecayFactor(types.DelegatorIntent{Delegator: "cosmos1user"}, now))
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), zone.IntentDecayFactor(intent(125*time.Second), now))
+	require.Equal(t, sdk.ZeroDec(), zone.IntentDecayFactor(intent(200*time.Second), now))
+
+	expiry := zone.IntentExpiry(intent(50 * time.Second))
+	require.NotNil(t, expiry)
+	require.Equal(t, now.Add(50*time.Second), *expiry)
+
+	// without a decay period, expired intents are dropped.
+	zone.IntentDecay = 0
+	require.Equal(t, sdk.ZeroDec(), zone.IntentDecayFactor(intent(100*time.Second), now))
+
+	// lifetimes and decay periods beyond the maximum are capped, rather than overflowing.
+	zone.IntentLifetime = math.MaxUint64
+	zone.IntentDecay = math.MaxUint64
+	require.Equal(t, types.MaxIntentLifetime, zone.IntentLifetimeDuration())
+	require.Equal(t, types.MaxIntentDecay, zone.IntentDecayDuration())
+	require.Equal(t, sdk.OneDec(), zone.IntentDecayFactor(intent(1000*time.Second), now))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), zone.IntentDecayFactor(intent(types.MaxIntentLifetime+types.MaxIntentDecay/2), now))
+
+	// without a lifetime, intents do not expire.
+	zone.IntentLifetime = 0
+	require.Equal(t, sdk.OneDec(), zone.IntentDecayFactor(intent(1000*time.Second), now))
+	require.Nil(t, zone.IntentExpiry(intent(0)))
+}
+
+func TestDecayIntent(t *testing.T) {
+	zone := types.Zone{Validators: []*types.Validator{
+		{ValoperAddress: "cosmosvaloper1a", CommissionRate: sdk.NewDecWithPrec(5, 2), Status: stakingtypes.BondStatusBonded},
+		{ValoperAddress: "cosmosvaloper1b", CommissionRate: sdk.NewDecWithPrec(5, 2), Status: stakingtypes.BondStatusBonded},
+	}}
+	intent := types.DelegatorIntent{
+		Delegator: "cosmos1user",
+		Intents:   types.ValidatorIntents{{ValoperAddress: "cosmosvaloper1a", Weight: sdk.OneDec()}},
+	}
+
+	decayed := zone.DecayIntent(intent, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), decayed.Intents.MustGetForValoper("cosmosvaloper1a").Weight)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), decayed.Intents.MustGetForValoper("cosmosvaloper1b").Weight)
+
+	// the stored intent is unchanged.
+	require.Equal(t, sdk.OneDec(), intent.Intents[0].Weight)
+}
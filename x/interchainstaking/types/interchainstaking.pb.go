@@ -57,6 +57,83 @@ type Zone struct {
 	UnbondingEnabled             bool                                   `protobuf:"varint,24,opt,name=unbonding_enabled,json=unbondingEnabled,proto3" json:"unbonding_enabled,omitempty"`
 	DepositsEnabled              bool                                   `protobuf:"varint,25,opt,name=deposits_enabled,json=depositsEnabled,proto3" json:"deposits_enabled,omitempty"`
 	ReturnToSender               bool                                   `protobuf:"varint,26,opt,name=return_to_sender,json=returnToSender,proto3" json:"return_to_sender,omitempty"`
+	// max_rebalance_total is the maximum proportion of unlocked stake that may
+	// be subject to redelegation at any one time. Zero implies the default.
+	MaxRebalanceTotal github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,27,opt,name=max_rebalance_total,json=maxRebalanceTotal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rebalance_total"`
+	// max_rebalance_per_epoch is the maximum proportion of total stake that may
+	// be redelegated in a single epoch. Zero implies the default.
+	MaxRebalancePerEpoch github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,28,opt,name=max_rebalance_per_epoch,json=maxRebalancePerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rebalance_per_epoch"`
+	// max_rebalance_iterations caps the number of redelegations per epoch. Zero
+	// implies the default.
+	MaxRebalanceIterations uint64 `protobuf:"varint,29,opt,name=max_rebalance_iterations,json=maxRebalanceIterations,proto3" json:"max_rebalance_iterations,omitempty"`
+	// instant_redemption_buffer_rate is the share of the zone's native assets
+	// held undelegated in the delegation account to satisfy instant
+	// redemptions. Zero disables instant redemptions.
+	InstantRedemptionBufferRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,30,opt,name=instant_redemption_buffer_rate,json=instantRedemptionBufferRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_redemption_buffer_rate"`
+	// instant_redemption_fee is the share of an instant redemption retained in
+	// the buffer.
+	InstantRedemptionFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,31,opt,name=instant_redemption_fee,json=instantRedemptionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_redemption_fee"`
+	// instant_redemption_buffer is the amount of native assets currently held
+	// in the delegation account for instant redemptions.
+	InstantRedemptionBuffer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,32,opt,name=instant_redemption_buffer,json=instantRedemptionBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"instant_redemption_buffer"`
+	// deposit_limit_rate is the maximum proportion of the zone's TVL that may be
+	// deposited per epoch. Zero disables the limit.
+	DepositLimitRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,33,opt,name=deposit_limit_rate,json=depositLimitRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deposit_limit_rate"`
+	// redemption_limit_rate is the maximum proportion of the zone's qAsset
+	// supply that may be redeemed per epoch. Zero disables the limit.
+	RedemptionLimitRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,34,opt,name=redemption_limit_rate,json=redemptionLimitRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_limit_rate"`
+	// epoch_deposits is the amount of native assets deposited this epoch.
+	EpochDeposits github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,35,opt,name=epoch_deposits,json=epochDeposits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_deposits"`
+	// epoch_redemptions is the amount of qAssets redeemed this epoch.
+	EpochRedemptions github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,36,opt,name=epoch_redemptions,json=epochRedemptions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_redemptions"`
+	// max_commission_rate is the highest commission rate of an eligible
+	// validator. Zero disables the rule.
+	MaxCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,37,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_rate"`
+	// min_uptime is the lowest performance score of an eligible validator.
+	// Validators not yet scored are eligible. Zero disables the rule.
+	MinUptime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,38,opt,name=min_uptime,json=minUptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_uptime"`
+	// max_validator_share is the largest proportion of the zone's stake that
+	// may be targeted to a single validator. Zero disables the cap.
+	MaxValidatorShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,39,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share"`
+	// validator_denylist contains validators that are never eligible.
+	ValidatorDenylist []string `protobuf:"bytes,40,rep,name=validator_denylist,json=validatorDenylist,proto3" json:"validator_denylist,omitempty"`
+	// delegation_shards are the zone's delegation accounts in addition to
+	// delegation_address, registered with port owners chain_id.delegate.N.
+	DelegationShards []*ICAAccount `protobuf:"bytes,41,rep,name=delegation_shards,json=delegationShards,proto3" json:"delegation_shards,omitempty"`
+	// ica_timeout is the timeout, in seconds, of packets sent over the zone's
+	// interchain account channels. Zero applies the default of 24 hours.
+	IcaTimeout uint64 `protobuf:"varint,42,opt,name=ica_timeout,json=icaTimeout,proto3" json:"ica_timeout,omitempty"`
+	// ica_chunk_size is the maximum number of messages per interchain account
+	// packet. Zero applies the default.
+	IcaChunkSize uint64 `protobuf:"varint,43,opt,name=ica_chunk_size,json=icaChunkSize,proto3" json:"ica_chunk_size,omitempty"`
+	// ica_gas_budget is the maximum estimated gas of the messages in an
+	// interchain account packet. Zero disables gas-aware chunking.
+	IcaGasBudget uint64 `protobuf:"varint,44,opt,name=ica_gas_budget,json=icaGasBudget,proto3" json:"ica_gas_budget,omitempty"`
+	// offboarding_status is the stage of the zone's offboarding; zero if the
+	// zone is not offboarding.
+	OffboardingStatus int32 `protobuf:"varint,45,opt,name=offboarding_status,json=offboardingStatus,proto3" json:"offboarding_status,omitempty"`
+	// offboarding_unbonding_completion is the latest completion time of the
+	// undelegations issued while offboarding.
+	OffboardingUnbondingCompletion *time.Time `protobuf:"bytes,46,opt,name=offboarding_unbonding_completion,json=offboardingUnbondingCompletion,proto3,stdtime" json:"offboarding_unbonding_completion,omitempty"`
+	// offboarding_rate is the final rate at which qAssets are redeemed for the
+	// native assets unbonded by an offboarded zone.
+	OffboardingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,47,opt,name=offboarding_rate,json=offboardingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"offboarding_rate"`
+	// deposit_assets are the assets, other than the base denom and tokenized
+	// shares, accepted as deposits.
+	DepositAssets []*DepositAsset `protobuf:"bytes,48,rep,name=deposit_assets,json=depositAssets,proto3" json:"deposit_assets,omitempty"`
+	// commission_rate is the share of the zone's rewards retained as protocol
+	// fees, or nil to apply the module commission rate.
+	CommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,49,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty"`
+	// intent_lifetime is the time, in seconds, after which a delegator intent
+	// that has not been refreshed expires. Zero disables expiry.
+	IntentLifetime uint64 `protobuf:"varint,50,opt,name=intent_lifetime,json=intentLifetime,proto3" json:"intent_lifetime,omitempty"`
+	// intent_decay is the time, in seconds, over which an expired intent decays
+	// toward an equal weighting of validators before it is dropped. Zero drops
+	// expired intents immediately.
+	IntentDecay uint64 `protobuf:"varint,51,opt,name=intent_decay,json=intentDecay,proto3" json:"intent_decay,omitempty"`
+	// epoch_limits_height is the block height at which epoch_deposits and
+	// epoch_redemptions were last reset.
+	EpochLimitsHeight int64 `protobuf:"varint,52,opt,name=epoch_limits_height,json=epochLimitsHeight,proto3" json:"epoch_limits_height,omitempty"`
 }
 
 func (m *Zone) Reset()         { *m = Zone{} }
@@ -253,6 +330,212 @@ func (m *Zone) GetReturnToSender() bool {
 	return false
 }
 
+func (m *Zone) GetMaxRebalanceIterations() uint64 {
+	if m != nil {
+		return m.MaxRebalanceIterations
+	}
+	return 0
+}
+
+func (m *Zone) GetValidatorDenylist() []string {
+	if m != nil {
+		return m.ValidatorDenylist
+	}
+	return nil
+}
+
+func (m *Zone) GetDelegationShards() []*ICAAccount {
+	if m != nil {
+		return m.DelegationShards
+	}
+	return nil
+}
+
+func (m *Zone) GetIcaTimeout() uint64 {
+	if m != nil {
+		return m.IcaTimeout
+	}
+	return 0
+}
+
+func (m *Zone) GetIcaChunkSize() uint64 {
+	if m != nil {
+		return m.IcaChunkSize
+	}
+	return 0
+}
+
+func (m *Zone) GetIcaGasBudget() uint64 {
+	if m != nil {
+		return m.IcaGasBudget
+	}
+	return 0
+}
+
+func (m *Zone) GetOffboardingStatus() int32 {
+	if m != nil {
+		return m.OffboardingStatus
+	}
+	return 0
+}
+
+func (m *Zone) GetOffboardingUnbondingCompletion() *time.Time {
+	if m != nil {
+		return m.OffboardingUnbondingCompletion
+	}
+	return nil
+}
+
+func (m *Zone) GetDepositAssets() []*DepositAsset {
+	if m != nil {
+		return m.DepositAssets
+	}
+	return nil
+}
+
+func (m *Zone) GetIntentLifetime() uint64 {
+	if m != nil {
+		return m.IntentLifetime
+	}
+	return 0
+}
+
+func (m *Zone) GetIntentDecay() uint64 {
+	if m != nil {
+		return m.IntentDecay
+	}
+	return 0
+}
+
+func (m *Zone) GetEpochLimitsHeight() int64 {
+	if m != nil {
+		return m.EpochLimitsHeight
+	}
+	return 0
+}
+
+// DepositAsset is an asset accepted as a deposit by a zone, which is converted
+// to the zone base denom before delegation.
+type DepositAsset struct {
+	// denom is the denomination of the asset on the host chain.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion is the path by which the asset is converted; 1 (unwind) or 2
+	// (swap).
+	Conversion int32 `protobuf:"varint,2,opt,name=conversion,proto3" json:"conversion,omitempty"`
+	// channel_id is the host chain transfer channel over which the asset is sent
+	// for conversion.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// return_channel_id is the counterparty chain transfer channel over which the
+	// converted asset is returned to the host chain.
+	ReturnChannelId string `protobuf:"bytes,4,opt,name=return_channel_id,json=returnChannelId,proto3" json:"return_channel_id,omitempty"`
+	// swap_contract is the counterparty chain contract by which the asset is
+	// swapped; swap only.
+	SwapContract string `protobuf:"bytes,5,opt,name=swap_contract,json=swapContract,proto3" json:"swap_contract,omitempty"`
+	// swap_output_denom is the denomination of the zone base denom on the
+	// counterparty chain; swap only.
+	SwapOutputDenom string `protobuf:"bytes,6,opt,name=swap_output_denom,json=swapOutputDenom,proto3" json:"swap_output_denom,omitempty"`
+	// max_slippage is the maximum proportion by which the swap output may fall
+	// short of the value of the asset; swap only.
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+	// rate_source is the source of the rate at which the asset is valued in the
+	// zone base denom; 1 (unity), 2 (fixed) or 3 (zone).
+	RateSource int32 `protobuf:"varint,8,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty"`
+	// rate is the governance set rate; fixed only.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// rate_chain_id is the zone whose redemption rate values the asset; zone
+	// only.
+	RateChainId string `protobuf:"bytes,10,opt,name=rate_chain_id,json=rateChainId,proto3" json:"rate_chain_id,omitempty"`
+}
+
+func (m *DepositAsset) Reset()         { *m = DepositAsset{} }
+func (m *DepositAsset) String() string { return proto.CompactTextString(m) }
+func (*DepositAsset) ProtoMessage()    {}
+func (*DepositAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{1}
+}
+func (m *DepositAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositAsset.Merge(m, src)
+}
+func (m *DepositAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositAsset proto.InternalMessageInfo
+
+func (m *DepositAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DepositAsset) GetConversion() int32 {
+	if m != nil {
+		return m.Conversion
+	}
+	return 0
+}
+
+func (m *DepositAsset) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *DepositAsset) GetReturnChannelId() string {
+	if m != nil {
+		return m.ReturnChannelId
+	}
+	return ""
+}
+
+func (m *DepositAsset) GetSwapContract() string {
+	if m != nil {
+		return m.SwapContract
+	}
+	return ""
+}
+
+func (m *DepositAsset) GetSwapOutputDenom() string {
+	if m != nil {
+		return m.SwapOutputDenom
+	}
+	return ""
+}
+
+func (m *DepositAsset) GetRateSource() int32 {
+	if m != nil {
+		return m.RateSource
+	}
+	return 0
+}
+
+func (m *DepositAsset) GetRateChainId() string {
+	if m != nil {
+		return m.RateChainId
+	}
+	return ""
+}
+
 type ICAAccount struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// balance defines the different coins this balance holds.
@@ -266,7 +549,7 @@ func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
 func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
 func (*ICAAccount) ProtoMessage()    {}
 func (*ICAAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{1}
+	return fileDescriptor_0d755cfd37ef9fee, []int{2}
 }
 func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,13 +616,16 @@ func (m *ICAAccount) GetBalanceWaitgroup() uint32 {
 type Distribution struct {
 	Valoper string `protobuf:"bytes,1,opt,name=valoper,proto3" json:"valoper,omitempty"`
 	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// delegator is the delegation account from which amount is unbonded; empty
+	// denotes the zone delegation_address.
+	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
 func (m *Distribution) Reset()         { *m = Distribution{} }
 func (m *Distribution) String() string { return proto.CompactTextString(m) }
 func (*Distribution) ProtoMessage()    {}
 func (*Distribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{2}
+	return fileDescriptor_0d755cfd37ef9fee, []int{3}
 }
 func (m *Distribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,6 +668,13 @@ func (m *Distribution) GetAmount() uint64 {
 	return 0
 }
 
+func (m *Distribution) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
 type WithdrawalRecord struct {
 	ChainId        string                                   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Delegator      string                                   `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
@@ -393,13 +686,18 @@ type WithdrawalRecord struct {
 	Status         int32                                    `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
 	CompletionTime time.Time                                `protobuf:"bytes,9,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 	Requeued       bool                                     `protobuf:"varint,10,opt,name=requeued,proto3" json:"requeued,omitempty"`
+	// instant is set for redemptions paid from the instant redemption buffer.
+	Instant    bool        `protobuf:"varint,11,opt,name=instant,proto3" json:"instant,omitempty"`
+	InstantFee *types.Coin `protobuf:"bytes,12,opt,name=instant_fee,json=instantFee,proto3" json:"instant_fee,omitempty"`
+	// request_height is the block height at which the redemption was requested.
+	RequestHeight int64 `protobuf:"varint,13,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
 }
 
 func (m *WithdrawalRecord) Reset()         { *m = WithdrawalRecord{} }
 func (m *WithdrawalRecord) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalRecord) ProtoMessage()    {}
 func (*WithdrawalRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{3}
+	return fileDescriptor_0d755cfd37ef9fee, []int{4}
 }
 func (m *WithdrawalRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,6 +789,27 @@ func (m *WithdrawalRecord) GetRequeued() bool {
 	return false
 }
 
+func (m *WithdrawalRecord) GetInstant() bool {
+	if m != nil {
+		return m.Instant
+	}
+	return false
+}
+
+func (m *WithdrawalRecord) GetInstantFee() *types.Coin {
+	if m != nil {
+		return m.InstantFee
+	}
+	return nil
+}
+
+func (m *WithdrawalRecord) GetRequestHeight() int64 {
+	if m != nil {
+		return m.RequestHeight
+	}
+	return 0
+}
+
 type UnbondingRecord struct {
 	ChainId       string   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	EpochNumber   int64    `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
@@ -502,7 +821,7 @@ func (m *UnbondingRecord) Reset()         { *m = UnbondingRecord{} }
 func (m *UnbondingRecord) String() string { return proto.CompactTextString(m) }
 func (*UnbondingRecord) ProtoMessage()    {}
 func (*UnbondingRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{4}
+	return fileDescriptor_0d755cfd37ef9fee, []int{5}
 }
 func (m *UnbondingRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -572,7 +891,7 @@ func (m *RedelegationRecord) Reset()         { *m = RedelegationRecord{} }
 func (m *RedelegationRecord) String() string { return proto.CompactTextString(m) }
 func (*RedelegationRecord) ProtoMessage()    {}
 func (*RedelegationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{5}
+	return fileDescriptor_0d755cfd37ef9fee, []int{6}
 }
 func (m *RedelegationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,6 +962,110 @@ func (m *RedelegationRecord) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+type RebalanceTarget struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Source string                                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Target string                                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *RebalanceTarget) Reset()         { *m = RebalanceTarget{} }
+func (m *RebalanceTarget) String() string { return proto.CompactTextString(m) }
+func (*RebalanceTarget) ProtoMessage()    {}
+func (*RebalanceTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{7}
+}
+func (m *RebalanceTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalanceTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalanceTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RebalanceTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceTarget.Merge(m, src)
+}
+func (m *RebalanceTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalanceTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceTarget proto.InternalMessageInfo
+
+func (m *RebalanceTarget) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *RebalanceTarget) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type ValidatorPlan struct {
+	ValoperAddress string                                 `protobuf:"bytes,1,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	Current        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=current,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current"`
+	Delegate       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=delegate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegate"`
+	Undelegate     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=undelegate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"undelegate"`
+	RedelegateIn   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=redelegate_in,json=redelegateIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redelegate_in"`
+	RedelegateOut  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=redelegate_out,json=redelegateOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redelegate_out"`
+	Locked         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=locked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked"`
+	Projected      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=projected,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"projected"`
+}
+
+func (m *ValidatorPlan) Reset()         { *m = ValidatorPlan{} }
+func (m *ValidatorPlan) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPlan) ProtoMessage()    {}
+func (*ValidatorPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{8}
+}
+func (m *ValidatorPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPlan.Merge(m, src)
+}
+func (m *ValidatorPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPlan proto.InternalMessageInfo
+
+func (m *ValidatorPlan) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
+	}
+	return ""
+}
+
 type TransferRecord struct {
 	Sender    string                                  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Recipient string                                  `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
@@ -653,7 +1076,7 @@ func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
 func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
 func (*TransferRecord) ProtoMessage()    {}
 func (*TransferRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{6}
+	return fileDescriptor_0d755cfd37ef9fee, []int{9}
 }
 func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -706,13 +1129,16 @@ type Validator struct {
 	Jailed          bool                                   `protobuf:"varint,7,opt,name=jailed,proto3" json:"jailed,omitempty"`
 	Tombstoned      bool                                   `protobuf:"varint,8,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
 	JailedSince     time.Time                              `protobuf:"bytes,9,opt,name=jailed_since,json=jailedSince,proto3,stdtime" json:"jailed_since"`
+	// performance_score is the proportion of expected rewards earned by the
+	// zone performance account in the last epoch, or nil if not yet scored.
+	PerformanceScore *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=performance_score,json=performanceScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"performance_score,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{7}
+	return fileDescriptor_0d755cfd37ef9fee, []int{10}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,13 +1205,15 @@ func (m *Validator) GetJailedSince() time.Time {
 type DelegatorIntent struct {
 	Delegator string           `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 	Intents   ValidatorIntents `protobuf:"bytes,2,rep,name=intents,proto3,castrepeated=ValidatorIntents" json:"intents,omitempty"`
+	// updated is the time at which the intent was last signalled or confirmed.
+	Updated *time.Time `protobuf:"bytes,3,opt,name=updated,proto3,stdtime" json:"updated,omitempty"`
 }
 
 func (m *DelegatorIntent) Reset()         { *m = DelegatorIntent{} }
 func (m *DelegatorIntent) String() string { return proto.CompactTextString(m) }
 func (*DelegatorIntent) ProtoMessage()    {}
 func (*DelegatorIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{8}
+	return fileDescriptor_0d755cfd37ef9fee, []int{11}
 }
 func (m *DelegatorIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,6 +1256,13 @@ func (m *DelegatorIntent) GetIntents() ValidatorIntents {
 	return nil
 }
 
+func (m *DelegatorIntent) GetUpdated() *time.Time {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
 type ValidatorIntent struct {
 	ValoperAddress string                                 `protobuf:"bytes,1,opt,name=valoper_address,proto3" json:"valoper_address,omitempty"`
 	Weight         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
@@ -837,7 +1272,7 @@ func (m *ValidatorIntent) Reset()         { *m = ValidatorIntent{} }
 func (m *ValidatorIntent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIntent) ProtoMessage()    {}
 func (*ValidatorIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{9}
+	return fileDescriptor_0d755cfd37ef9fee, []int{12}
 }
 func (m *ValidatorIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -885,7 +1320,7 @@ func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{10}
+	return fileDescriptor_0d755cfd37ef9fee, []int{13}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -951,7 +1386,7 @@ func (m *PortConnectionTuple) Reset()         { *m = PortConnectionTuple{} }
 func (m *PortConnectionTuple) String() string { return proto.CompactTextString(m) }
 func (*PortConnectionTuple) ProtoMessage()    {}
 func (*PortConnectionTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{11}
+	return fileDescriptor_0d755cfd37ef9fee, []int{14}
 }
 func (m *PortConnectionTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,13 +1436,16 @@ type Receipt struct {
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	FirstSeen *time.Time                               `protobuf:"bytes,5,opt,name=first_seen,json=firstSeen,proto3,stdtime" json:"first_seen,omitempty"`
 	Completed *time.Time                               `protobuf:"bytes,6,opt,name=completed,proto3,stdtime" json:"completed,omitempty"`
+	// refunded is true where the deposit exceeded the zone deposit limit and
+	// was returned to the sender.
+	Refunded bool `protobuf:"varint,7,opt,name=refunded,proto3" json:"refunded,omitempty"`
 }
 
 func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{12}
+	return fileDescriptor_0d755cfd37ef9fee, []int{15}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1078,674 +1516,912 @@ func (m *Receipt) GetCompleted() *time.Time {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Zone)(nil), "quicksilver.interchainstaking.v1.Zone")
-	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
-	proto.RegisterType((*Distribution)(nil), "quicksilver.interchainstaking.v1.Distribution")
-	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
-	proto.RegisterType((*UnbondingRecord)(nil), "quicksilver.interchainstaking.v1.UnbondingRecord")
-	proto.RegisterType((*RedelegationRecord)(nil), "quicksilver.interchainstaking.v1.RedelegationRecord")
-	proto.RegisterType((*TransferRecord)(nil), "quicksilver.interchainstaking.v1.TransferRecord")
-	proto.RegisterType((*Validator)(nil), "quicksilver.interchainstaking.v1.Validator")
-	proto.RegisterType((*DelegatorIntent)(nil), "quicksilver.interchainstaking.v1.DelegatorIntent")
-	proto.RegisterType((*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.ValidatorIntent")
-	proto.RegisterType((*Delegation)(nil), "quicksilver.interchainstaking.v1.Delegation")
-	proto.RegisterType((*PortConnectionTuple)(nil), "quicksilver.interchainstaking.v1.PortConnectionTuple")
-	proto.RegisterType((*Receipt)(nil), "quicksilver.interchainstaking.v1.Receipt")
+func (m *Receipt) GetRefunded() bool {
+	if m != nil {
+		return m.Refunded
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/interchainstaking/v1/interchainstaking.proto", fileDescriptor_0d755cfd37ef9fee)
+// RedemptionRateRecord is a snapshot of a zone's redemption rate, taken at the
+// end of each epoch.
+type RedemptionRateRecord struct {
+	ChainId string                                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch   int64                                  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height  int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time    time.Time                              `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	Rate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	Tvl     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=tvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tvl"`
 }
 
-var fileDescriptor_0d755cfd37ef9fee = []byte{
-	// 1744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0x4a, 0x14, 0x29, 0x3e, 0x52, 0xa2, 0x34, 0x52, 0x9c, 0xb5, 0xda, 0x52, 0x2c, 0x8b,
-	0xb6, 0x6c, 0x5d, 0x91, 0x91, 0x0b, 0xb4, 0x45, 0x50, 0x14, 0x91, 0x2c, 0x23, 0x11, 0x0a, 0x1b,
-	0xc6, 0x4a, 0x69, 0x00, 0x17, 0xc5, 0x62, 0xb8, 0x3b, 0x22, 0xa7, 0xde, 0x9d, 0xa1, 0x67, 0x66,
-	0x25, 0xb9, 0x9f, 0x22, 0x1f, 0xa1, 0xb7, 0x00, 0x41, 0xd1, 0x93, 0x8f, 0xfd, 0x00, 0x39, 0x06,
-	0x3e, 0x15, 0x45, 0x61, 0x17, 0xf6, 0xb5, 0xb9, 0xf4, 0x13, 0x14, 0xf3, 0x67, 0x97, 0x2b, 0x45,
-	0x35, 0x25, 0x47, 0xe9, 0x89, 0x9c, 0xf7, 0xe7, 0x37, 0x6f, 0x66, 0xde, 0xbc, 0xdf, 0x9b, 0x85,
-	0x5f, 0x3d, 0xc9, 0x68, 0xf4, 0x58, 0xd2, 0xe4, 0x98, 0x88, 0x01, 0x65, 0x8a, 0x88, 0x68, 0x8c,
-	0x29, 0x93, 0x0a, 0x3f, 0xa6, 0x6c, 0x34, 0x38, 0xde, 0xfe, 0xba, 0xb0, 0x3f, 0x11, 0x5c, 0x71,
-	0xd4, 0x29, 0x79, 0xf6, 0xbf, 0x6e, 0x74, 0xbc, 0xbd, 0xb1, 0x3e, 0xe2, 0x23, 0x6e, 0x8c, 0x07,
-	0xfa, 0x9f, 0xf5, 0xdb, 0xb8, 0x15, 0x71, 0x99, 0x72, 0x19, 0x5a, 0x85, 0x1d, 0x38, 0x55, 0xdb,
-	0x8e, 0x06, 0x43, 0x2c, 0xc9, 0xe0, 0x78, 0x7b, 0x48, 0x14, 0xde, 0x1e, 0x44, 0x9c, 0x32, 0xa7,
-	0xdf, 0x1c, 0x71, 0x3e, 0x4a, 0xc8, 0xc0, 0x8c, 0x86, 0xd9, 0xd1, 0x40, 0xd1, 0x94, 0x48, 0x85,
-	0xd3, 0x89, 0x35, 0xe8, 0x7e, 0xd6, 0x84, 0xca, 0x23, 0xce, 0x08, 0xfa, 0x01, 0x2c, 0x45, 0x9c,
-	0x31, 0x12, 0x29, 0xca, 0x59, 0x48, 0x63, 0xdf, 0xeb, 0x78, 0xbd, 0x7a, 0xd0, 0x9c, 0x0a, 0xf7,
-	0x63, 0x74, 0x0b, 0x16, 0x4d, 0xc8, 0x5a, 0x3f, 0x67, 0xf4, 0x35, 0x33, 0xde, 0x8f, 0xd1, 0xc7,
-	0xd0, 0x8a, 0xc9, 0x84, 0x4b, 0xaa, 0x42, 0x1c, 0xc7, 0x82, 0x48, 0xe9, 0xcf, 0x77, 0xbc, 0x5e,
-	0xe3, 0xce, 0xcf, 0xfa, 0xb3, 0x96, 0xdd, 0xdf, 0xbf, 0xbb, 0xb3, 0x13, 0x45, 0x3c, 0x63, 0x2a,
-	0x58, 0x76, 0x20, 0x3b, 0x16, 0x03, 0xfd, 0x1e, 0xd0, 0x09, 0x55, 0xe3, 0x58, 0xe0, 0x13, 0x9c,
-	0x14, 0xc8, 0x95, 0xb7, 0x40, 0x5e, 0x9d, 0xe2, 0xe4, 0xe0, 0x7f, 0x80, 0xb5, 0x09, 0x11, 0x47,
-	0x5c, 0xa4, 0x98, 0x45, 0xa4, 0x40, 0x5f, 0x78, 0x0b, 0x74, 0x54, 0x02, 0x2a, 0xc5, 0x1e, 0x93,
-	0x84, 0x8c, 0xb0, 0xd9, 0xd2, 0x1c, 0xbd, 0xfa, 0x36, 0xb1, 0x4f, 0x71, 0x72, 0xf0, 0x1f, 0xc2,
-	0x32, 0xb6, 0xda, 0x70, 0x22, 0xc8, 0x11, 0x3d, 0xf5, 0x6b, 0xe6, 0x40, 0x96, 0x9c, 0xf4, 0xa1,
-	0x11, 0xa2, 0x4d, 0x68, 0x24, 0x3c, 0xc2, 0x49, 0x18, 0x13, 0xc6, 0x53, 0x7f, 0xd1, 0xd8, 0x80,
-	0x11, 0xed, 0x69, 0x09, 0xfa, 0x1e, 0x80, 0x4e, 0x1e, 0xa7, 0xaf, 0x1b, 0x7d, 0x5d, 0x4b, 0xac,
-	0x9a, 0x40, 0x4b, 0x90, 0x98, 0xa4, 0x13, 0xb3, 0x06, 0x81, 0x15, 0xf1, 0x41, 0xdb, 0xec, 0xfe,
-	0xfa, 0x8b, 0x17, 0x9b, 0x37, 0xfe, 0xf1, 0x62, 0xf3, 0x47, 0x23, 0xaa, 0xc6, 0xd9, 0xb0, 0x1f,
-	0xf1, 0xd4, 0xa5, 0xa6, 0xfb, 0xd9, 0x92, 0xf1, 0xe3, 0x81, 0x7a, 0x3a, 0x21, 0xb2, 0xbf, 0x47,
-	0xa2, 0xe7, 0xcf, 0xb6, 0xc0, 0x65, 0xee, 0x1e, 0x89, 0x82, 0xe5, 0x29, 0x68, 0x80, 0x15, 0x41,
-	0x0c, 0xd6, 0x13, 0x2c, 0x55, 0x78, 0x7e, 0xae, 0xc6, 0x35, 0xcc, 0x85, 0x34, 0x72, 0x70, 0x76,
-	0xbe, 0xdf, 0x02, 0x1c, 0xe3, 0x84, 0xc6, 0x58, 0x71, 0x21, 0xfd, 0x66, 0x67, 0xbe, 0xd7, 0xb8,
-	0x73, 0x7b, 0xf6, 0x91, 0xfc, 0x2e, 0xf7, 0x09, 0x4a, 0xee, 0x48, 0xc0, 0x0a, 0x1e, 0x8d, 0x84,
-	0x3e, 0x20, 0x12, 0x6a, 0x3f, 0xa6, 0xfc, 0x25, 0x03, 0xb9, 0x7d, 0x05, 0xc8, 0x7d, 0xe3, 0xb8,
-	0xbb, 0xfe, 0xf9, 0xcb, 0xcd, 0x95, 0x73, 0x42, 0x19, 0xb4, 0x8a, 0x09, 0xac, 0x44, 0x1f, 0x5b,
-	0x9a, 0x25, 0x8a, 0x86, 0x92, 0xb0, 0xd8, 0x5f, 0xee, 0x78, 0xbd, 0xc5, 0xa0, 0x6e, 0x24, 0x07,
-	0x84, 0xc5, 0xe8, 0x27, 0xb0, 0x92, 0xd0, 0x27, 0x19, 0x8d, 0xa9, 0x7a, 0x1a, 0xa6, 0x3c, 0xce,
-	0x12, 0xe2, 0xb7, 0x8c, 0x51, 0xab, 0x90, 0xdf, 0x37, 0x62, 0xb4, 0x0d, 0xeb, 0xa5, 0x1b, 0x76,
-	0x82, 0xa9, 0x1a, 0x09, 0x9e, 0x4d, 0xfc, 0x95, 0x8e, 0xd7, 0x5b, 0x0a, 0xd6, 0xa6, 0xba, 0x4f,
-	0x72, 0x15, 0xfa, 0x25, 0xf8, 0x74, 0x18, 0x85, 0x8c, 0x9c, 0xaa, 0x70, 0xba, 0x0f, 0xe1, 0x18,
-	0xcb, 0xb1, 0xbf, 0xda, 0xf1, 0x7a, 0xcd, 0xe0, 0x1d, 0x3a, 0x8c, 0x1e, 0x90, 0x53, 0x55, 0x2c,
-	0x44, 0x7e, 0x84, 0xe5, 0x18, 0xed, 0x41, 0xbb, 0xb0, 0x0f, 0x25, 0x49, 0x5c, 0xb5, 0xc1, 0x89,
-	0x4e, 0x48, 0xfd, 0xd7, 0x47, 0x1d, 0xaf, 0x57, 0x09, 0xbe, 0x5b, 0x58, 0x1d, 0xe4, 0x46, 0x3b,
-	0x85, 0x0d, 0x1a, 0xc0, 0xda, 0x98, 0x27, 0x31, 0x65, 0x23, 0x59, 0x76, 0x5d, 0x33, 0xae, 0x28,
-	0x57, 0x95, 0x1c, 0x7e, 0x0a, 0xab, 0x26, 0xbb, 0xc8, 0x84, 0x47, 0xe3, 0x70, 0x4c, 0xe8, 0x68,
-	0xac, 0xfc, 0xf5, 0x8e, 0xd7, 0x9b, 0x0f, 0x5a, 0x5a, 0x71, 0x4f, 0xcb, 0x3f, 0x32, 0x62, 0xf4,
-	0x00, 0xe6, 0xd5, 0x71, 0xe2, 0xbf, 0x73, 0x0d, 0x89, 0xa7, 0x81, 0xf4, 0x49, 0x64, 0x6c, 0xc8,
-	0x99, 0x8e, 0x29, 0x9c, 0x10, 0x41, 0x79, 0xec, 0xdf, 0xb4, 0x53, 0x17, 0xf2, 0x87, 0x46, 0x8c,
-	0x36, 0x60, 0x31, 0x26, 0x11, 0x4d, 0x71, 0x22, 0xfd, 0x77, 0x8d, 0x49, 0x31, 0x46, 0xb7, 0x61,
-	0x75, 0x0a, 0x43, 0x18, 0x1e, 0x26, 0x24, 0xf6, 0x7d, 0x73, 0xa2, 0x53, 0xfc, 0x7b, 0x56, 0xae,
-	0xe7, 0x74, 0x65, 0x54, 0x16, 0xb6, 0xb7, 0xec, 0xe9, 0xe7, 0xf2, 0xdc, 0xb4, 0x07, 0x2b, 0x82,
-	0xa8, 0x4c, 0xb0, 0x50, 0x71, 0x93, 0x4b, 0x44, 0xf8, 0x1b, 0xc6, 0x74, 0xd9, 0xca, 0x0f, 0xf9,
-	0x81, 0x91, 0x76, 0xff, 0x3c, 0x07, 0x30, 0x2d, 0x49, 0xe8, 0x0e, 0xd4, 0xf2, 0x8a, 0x66, 0x98,
-	0x62, 0xd7, 0x7f, 0xfe, 0x6c, 0x6b, 0xdd, 0xad, 0xde, 0x15, 0xa9, 0x03, 0x25, 0x28, 0x1b, 0x05,
-	0xb9, 0x21, 0x22, 0x50, 0x1b, 0xe2, 0x44, 0x97, 0x48, 0x7f, 0xce, 0xdc, 0x8f, 0x5b, 0x7d, 0xe7,
-	0xa0, 0x0b, 0x4e, 0xdf, 0xf1, 0x57, 0xff, 0x2e, 0xa7, 0x6c, 0xf7, 0x3d, 0xbd, 0xf5, 0x9f, 0xbf,
-	0xdc, 0xec, 0x5d, 0x62, 0xeb, 0xb5, 0x83, 0x0c, 0x72, 0x6c, 0xf4, 0x1d, 0xa8, 0x4f, 0xb8, 0x50,
-	0x21, 0xc3, 0x29, 0x31, 0x24, 0x54, 0x0f, 0x16, 0xb5, 0xe0, 0x01, 0x4e, 0x09, 0xda, 0xfa, 0x9f,
-	0x84, 0x52, 0xbf, 0x88, 0x22, 0x6e, 0xc3, 0xaa, 0x83, 0x2d, 0x5d, 0x8d, 0x05, 0x73, 0x35, 0x56,
-	0x9c, 0xa2, 0xb8, 0x17, 0xdd, 0x0f, 0xa0, 0xb9, 0x47, 0xa5, 0x12, 0x74, 0x98, 0x99, 0xbc, 0xf3,
-	0xa1, 0x76, 0x8c, 0x13, 0x3e, 0x21, 0xc2, 0xb1, 0x69, 0x3e, 0x44, 0x37, 0xa1, 0x8a, 0x53, 0xbd,
-	0x8f, 0x86, 0x46, 0x2b, 0x81, 0x1b, 0x75, 0xbf, 0xaa, 0xc0, 0xca, 0x27, 0x45, 0x10, 0x01, 0x89,
-	0xb8, 0x38, 0xcb, 0xba, 0xde, 0x59, 0xd6, 0xfd, 0x05, 0xd4, 0x1d, 0x35, 0x70, 0x61, 0x19, 0xf9,
-	0x0d, 0xe7, 0x30, 0x35, 0x45, 0x01, 0x34, 0xe3, 0x52, 0xa4, 0xfe, 0xbc, 0x39, 0x8e, 0xfe, 0xec,
-	0x72, 0x55, 0x5e, 0x5f, 0x70, 0x06, 0x43, 0xc7, 0x22, 0x48, 0x44, 0x27, 0x54, 0xd7, 0xbf, 0xca,
-	0xac, 0x58, 0x0a, 0x53, 0x14, 0x15, 0x7b, 0xb1, 0x70, 0xfd, 0x49, 0xe1, 0xa0, 0xd1, 0x9f, 0xa0,
-	0x31, 0xd4, 0x59, 0xee, 0x66, 0xb2, 0x24, 0xfc, 0x86, 0x99, 0x7e, 0xe3, 0x6e, 0xfe, 0x8f, 0x2f,
-	0x39, 0xd3, 0xf3, 0x67, 0x5b, 0x0d, 0x07, 0xa6, 0x87, 0x01, 0xe8, 0xd9, 0x76, 0xec, 0xdc, 0x37,
-	0xa1, 0xaa, 0x4e, 0x4d, 0x71, 0xb4, 0x14, 0xed, 0x46, 0x5a, 0x2e, 0x15, 0x56, 0x99, 0x34, 0xb4,
-	0xbc, 0x10, 0xb8, 0x11, 0xba, 0x0f, 0xad, 0x88, 0xa7, 0x93, 0x84, 0x98, 0xe2, 0xa8, 0x3b, 0x36,
-	0xc3, 0xcb, 0x8d, 0x3b, 0x1b, 0x7d, 0xdb, 0xce, 0xf5, 0xf3, 0x76, 0xae, 0x7f, 0x98, 0xb7, 0x73,
-	0xbb, 0x8b, 0x3a, 0xe0, 0x4f, 0x5f, 0x6e, 0x7a, 0xc1, 0xf2, 0xd4, 0x59, 0xab, 0x75, 0x59, 0x11,
-	0xe4, 0x49, 0x46, 0x32, 0x12, 0x1b, 0xee, 0x5e, 0x0c, 0x8a, 0x71, 0xf7, 0xaf, 0x1e, 0xb4, 0x3e,
-	0xce, 0xcb, 0xc7, 0xec, 0x74, 0xfb, 0x3e, 0x34, 0x6d, 0x0d, 0x65, 0x59, 0x3a, 0x24, 0x36, 0xe3,
-	0xe6, 0x83, 0x86, 0x91, 0x3d, 0x30, 0x22, 0x9d, 0x05, 0x45, 0xf1, 0xb6, 0x97, 0xef, 0x4d, 0x59,
-	0x50, 0x98, 0xea, 0x7e, 0x46, 0x90, 0x04, 0x2b, 0x12, 0x87, 0x6e, 0xb3, 0x2a, 0x9d, 0x79, 0xdd,
-	0xcf, 0x38, 0xe9, 0xa1, 0x11, 0x76, 0x3f, 0x9b, 0x03, 0xa4, 0xb9, 0x3c, 0x6f, 0x87, 0xae, 0x25,
-	0xe6, 0xf7, 0xa0, 0x2a, 0x79, 0x26, 0x22, 0x32, 0x33, 0x60, 0x67, 0x87, 0xde, 0x87, 0x46, 0x4c,
-	0xa4, 0xa2, 0xcc, 0x52, 0xcf, 0xac, 0x6c, 0x2f, 0x1b, 0x97, 0xee, 0xfe, 0x82, 0x09, 0x25, 0x4f,
-	0xd1, 0x0b, 0x8e, 0xbd, 0xfa, 0xf6, 0xc7, 0xde, 0xfd, 0xca, 0x83, 0xe5, 0x43, 0x81, 0x99, 0x3c,
-	0x22, 0xc2, 0xed, 0x92, 0x5e, 0xa7, 0x2d, 0xf1, 0xde, 0xcc, 0x75, 0x1a, 0xbb, 0xb3, 0x77, 0x7a,
-	0xee, 0xf2, 0x77, 0xfa, 0x49, 0xb1, 0xc6, 0xf9, 0x6f, 0xfb, 0xa6, 0xe5, 0xa5, 0xf3, 0xdf, 0x15,
-	0xa8, 0x17, 0xed, 0x06, 0xda, 0x81, 0x96, 0xab, 0xb5, 0xe1, 0x65, 0x69, 0x6a, 0xd9, 0x39, 0xec,
-	0x14, 0x6c, 0xa5, 0xcf, 0x23, 0xa5, 0x52, 0x16, 0xed, 0xe8, 0xdc, 0x75, 0xb4, 0xbe, 0x53, 0x50,
-	0xd3, 0x8a, 0x8e, 0x34, 0x59, 0xbb, 0xba, 0x1c, 0xca, 0x31, 0x16, 0x44, 0xba, 0x34, 0xfc, 0x66,
-	0xf3, 0xb4, 0x0a, 0xd4, 0x03, 0x03, 0x8a, 0x42, 0x68, 0x1e, 0x73, 0x65, 0xda, 0x10, 0x7e, 0x42,
-	0x84, 0x4b, 0xda, 0xab, 0x4c, 0xb2, 0xcf, 0x54, 0x69, 0x92, 0x7d, 0xa6, 0x82, 0x86, 0x45, 0x7c,
-	0xa8, 0x01, 0x51, 0x00, 0x0b, 0x32, 0xe2, 0x82, 0x98, 0xbc, 0xfe, 0xa6, 0xe1, 0x5b, 0xa8, 0x52,
-	0x8d, 0xac, 0xda, 0xda, 0xe9, 0x6a, 0xe4, 0x4d, 0xa8, 0xfe, 0x11, 0x53, 0xdd, 0xd8, 0xd4, 0x4c,
-	0x49, 0x73, 0x23, 0xd4, 0x06, 0x50, 0x3c, 0x1d, 0x4a, 0xc5, 0x19, 0x89, 0x4d, 0x5d, 0x5d, 0x0c,
-	0x4a, 0x12, 0xf4, 0x21, 0x34, 0xad, 0x65, 0x28, 0xa9, 0xee, 0x43, 0xae, 0x52, 0x58, 0x1b, 0xd6,
-	0xf3, 0x40, 0x3b, 0x76, 0xff, 0xe2, 0x41, 0x6b, 0x2f, 0xdf, 0x61, 0xd7, 0x94, 0x9f, 0x61, 0x63,
-	0xef, 0xf2, 0x6c, 0x8c, 0xa1, 0x66, 0x9f, 0x0d, 0xd2, 0xf5, 0x45, 0xd7, 0xf6, 0x6e, 0xc8, 0x71,
-	0xbb, 0x7f, 0xf3, 0xa0, 0x75, 0x4e, 0x8b, 0x76, 0xaf, 0x7e, 0x47, 0xce, 0x3b, 0x20, 0x02, 0xd5,
-	0x13, 0xdb, 0x4f, 0xdb, 0xbb, 0x71, 0xff, 0x6a, 0x87, 0xfe, 0x9f, 0x17, 0x9b, 0x4b, 0x4f, 0x71,
-	0x9a, 0xbc, 0xdf, 0xb5, 0x28, 0xdd, 0x73, 0x59, 0x50, 0xcd, 0xc5, 0x73, 0x00, 0x7b, 0x45, 0xd1,
-	0x47, 0x1f, 0x5e, 0xf8, 0xb2, 0x9e, 0x15, 0xfc, 0x05, 0xaf, 0xe8, 0x7b, 0xb0, 0x3a, 0x7d, 0x90,
-	0xe4, 0x38, 0xb3, 0xea, 0xdc, 0x4a, 0xe1, 0x92, 0xc3, 0xfc, 0xff, 0xcb, 0x9d, 0xbe, 0x00, 0xee,
-	0x21, 0x53, 0xb1, 0x2c, 0x62, 0x47, 0xba, 0xf7, 0x17, 0x25, 0x7e, 0x0c, 0xf5, 0xf3, 0xd0, 0xf2,
-	0x4c, 0xab, 0x2c, 0xbf, 0xc7, 0xe2, 0xee, 0x01, 0xac, 0x3d, 0xe4, 0x42, 0xdd, 0x2d, 0xbe, 0xf0,
-	0x1c, 0x66, 0x93, 0xe4, 0x92, 0x5f, 0x82, 0xde, 0x85, 0x9a, 0xe9, 0xb1, 0x8b, 0x0f, 0x41, 0x55,
-	0x3d, 0xdc, 0x8f, 0xbb, 0xff, 0x9c, 0x83, 0x5a, 0x40, 0x22, 0x42, 0x27, 0xea, 0x4d, 0xac, 0x3c,
-	0xa5, 0xa2, 0xb9, 0x4b, 0x52, 0xd1, 0xb4, 0x8b, 0x9a, 0x3f, 0xd3, 0x45, 0x4d, 0xdb, 0xc7, 0xca,
-	0xb7, 0xd7, 0x3e, 0xde, 0x05, 0x38, 0xa2, 0x42, 0xaa, 0x50, 0x12, 0xc2, 0xdc, 0x07, 0xa2, 0x59,
-	0x45, 0xc3, 0x33, 0x45, 0xa3, 0x6e, 0xfc, 0x0e, 0x08, 0x61, 0x68, 0x17, 0xea, 0x8e, 0xa3, 0x49,
-	0x7c, 0x49, 0x6a, 0x77, 0x18, 0x85, 0xdb, 0xee, 0xa3, 0x2f, 0x5e, 0xb5, 0xbd, 0x2f, 0x5f, 0xb5,
-	0xbd, 0x7f, 0xbd, 0x6a, 0x7b, 0x9f, 0xbe, 0x6e, 0xdf, 0xf8, 0xf2, 0x75, 0xfb, 0xc6, 0xdf, 0x5f,
-	0xb7, 0x6f, 0x3c, 0xfa, 0xa0, 0xb4, 0x28, 0xca, 0x46, 0x84, 0x65, 0x54, 0x3d, 0xdd, 0x1a, 0x66,
-	0x34, 0x89, 0x07, 0xe5, 0x4f, 0x96, 0xa7, 0x17, 0x7c, 0xb4, 0x34, 0x4b, 0x1e, 0x56, 0x4d, 0x10,
-	0x3f, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x46, 0x44, 0xf7, 0xe2, 0x14, 0x00, 0x00,
+func (m *RedemptionRateRecord) Reset()         { *m = RedemptionRateRecord{} }
+func (m *RedemptionRateRecord) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateRecord) ProtoMessage()    {}
+func (*RedemptionRateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{16}
 }
-
-func (m *Zone) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Zone) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RedemptionRateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReturnToSender {
-		i--
-		if m.ReturnToSender {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RedemptionRateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd0
+		return b[:n], nil
 	}
-	if m.DepositsEnabled {
-		i--
-		if m.DepositsEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc8
+}
+func (m *RedemptionRateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateRecord.Merge(m, src)
+}
+func (m *RedemptionRateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateRecord proto.InternalMessageInfo
+
+func (m *RedemptionRateRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.UnbondingEnabled {
-		i--
-		if m.UnbondingEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc0
+	return ""
+}
+
+func (m *RedemptionRateRecord) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if m.Decimals != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+	return 0
+}
+
+func (m *RedemptionRateRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.UnbondingPeriod != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.UnbondingPeriod))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+	return 0
+}
+
+func (m *RedemptionRateRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	{
-		size := m.Tvl.Size()
-		i -= size
-		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return time.Time{}
+}
+
+// SlashRecord records a slash of a zone validator, as detected by a drop in the
+// validator's tokens per share.
+type SlashRecord struct {
+	ChainId string    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Valoper string    `protobuf:"bytes,2,opt,name=valoper,proto3" json:"valoper,omitempty"`
+	Height  int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time    time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// fraction is the proportion of the validator's tokens that were slashed.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// delegations are the zone's delegations to the validator prior to the
+	// slash.
+	Delegations []Delegation `protobuf:"bytes,6,rep,name=delegations,proto3" json:"delegations"`
+	// amount is the estimated native asset loss to the zone.
+	Amount               github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	RedemptionRateBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=redemption_rate_before,json=redemptionRateBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate_before"`
+	RedemptionRateAfter  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=redemption_rate_after,json=redemptionRateAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate_after"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{17}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	if m.LastEpochHeight != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.LastEpochHeight))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.HoldingsAllocation != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.HoldingsAllocation))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	return ""
+}
+
+func (m *SlashRecord) GetValoper() string {
+	if m != nil {
+		return m.Valoper
 	}
-	if m.ValidatorSelectionAllocation != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.ValidatorSelectionAllocation))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	return ""
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.IbcNextValidatorsHash) > 0 {
-		i -= len(m.IbcNextValidatorsHash)
-		copy(dAtA[i:], m.IbcNextValidatorsHash)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.IbcNextValidatorsHash)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return 0
+}
+
+func (m *SlashRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if m.WithdrawalWaitgroup != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.WithdrawalWaitgroup))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	return time.Time{}
+}
+
+func (m *SlashRecord) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
 	}
-	if m.LiquidityModule {
-		i--
-		if m.LiquidityModule {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// ICAPacketRecord records an outbound interchain account packet and the outcome
+// of its acknowledgement or timeout.
+type ICAPacketRecord struct {
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// account_role is the role of the sending account; e.g. delegate, or
+	// delegate.1 for a delegation shard.
+	AccountRole      string     `protobuf:"bytes,5,opt,name=account_role,json=accountRole,proto3" json:"account_role,omitempty"`
+	MsgTypes         []string   `protobuf:"bytes,6,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	Memo             string     `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	SendHeight       int64      `protobuf:"varint,8,opt,name=send_height,json=sendHeight,proto3" json:"send_height,omitempty"`
+	SendTime         time.Time  `protobuf:"bytes,9,opt,name=send_time,json=sendTime,proto3,stdtime" json:"send_time"`
+	TimeoutTimestamp uint64     `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Status           int32      `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"`
+	CompletionHeight int64      `protobuf:"varint,12,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+	CompletionTime   *time.Time `protobuf:"bytes,13,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time,omitempty"`
+	TimedOut         bool       `protobuf:"varint,14,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// error is the error returned by the host chain in an error
+	// acknowledgement.
+	Error string `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ICAPacketRecord) Reset()         { *m = ICAPacketRecord{} }
+func (m *ICAPacketRecord) String() string { return proto.CompactTextString(m) }
+func (*ICAPacketRecord) ProtoMessage()    {}
+func (*ICAPacketRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{18}
+}
+func (m *ICAPacketRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICAPacketRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICAPacketRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x78
+		return b[:n], nil
 	}
-	if m.MultiSend {
-		i--
-		if m.MultiSend {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+}
+func (m *ICAPacketRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAPacketRecord.Merge(m, src)
+}
+func (m *ICAPacketRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICAPacketRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAPacketRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICAPacketRecord proto.InternalMessageInfo
+
+func (m *ICAPacketRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.AggregateIntent) > 0 {
-		for iNdEx := len(m.AggregateIntent) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregateIntent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
+	return ""
+}
+
+func (m *ICAPacketRecord) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	return ""
+}
+
+func (m *ICAPacketRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	{
-		size := m.LastRedemptionRate.Size()
-		i -= size
-		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ICAPacketRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.RedemptionRate.Size()
-		i -= size
-		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.LocalDenom) > 0 {
-		i -= len(m.LocalDenom)
-		copy(dAtA[i:], m.LocalDenom)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.LocalDenom)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.AccountPrefix) > 0 {
-		i -= len(m.AccountPrefix)
-		copy(dAtA[i:], m.AccountPrefix)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.AccountPrefix)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.DelegationAddress != nil {
-		{
-			size, err := m.DelegationAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	return 0
+}
+
+func (m *ICAPacketRecord) GetAccountRole() string {
+	if m != nil {
+		return m.AccountRole
 	}
-	if m.PerformanceAddress != nil {
-		{
-			size, err := m.PerformanceAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *ICAPacketRecord) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
 	}
-	if m.WithdrawalAddress != nil {
-		{
-			size, err := m.WithdrawalAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *ICAPacketRecord) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	if m.DepositAddress != nil {
-		{
-			size, err := m.DepositAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *ICAPacketRecord) GetSendHeight() int64 {
+	if m != nil {
+		return m.SendHeight
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *ICAPacketRecord) GetSendTime() time.Time {
+	if m != nil {
+		return m.SendTime
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+	return time.Time{}
+}
+
+func (m *ICAPacketRecord) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ICAPacketRecord) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ICAPacketRecord) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
 }
 
-func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BalanceWaitgroup != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.BalanceWaitgroup))
-		i--
-		dAtA[i] = 0x28
+func (m *ICAPacketRecord) GetCompletionTime() *time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	if len(m.WithdrawalAddress) > 0 {
-		i -= len(m.WithdrawalAddress)
-		copy(dAtA[i:], m.WithdrawalAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.WithdrawalAddress)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *ICAPacketRecord) GetTimedOut() bool {
+	if m != nil {
+		return m.TimedOut
 	}
-	if len(m.PortName) > 0 {
-		i -= len(m.PortName)
-		copy(dAtA[i:], m.PortName)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortName)))
-		i--
-		dAtA[i] = 0x1a
+	return false
+}
+
+func (m *ICAPacketRecord) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return ""
+}
+
+// ConversionRecord tracks the conversion of a deposited asset to the zone base
+// denom, and the delegation of the converted asset.
+type ConversionRecord struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// txhash is the hash of the deposit.
+	Txhash string `protobuf:"bytes,2,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	// delegator is the delegation account to which the converted asset is
+	// returned.
+	Delegator string     `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// expected is the minimum amount of the zone base denom returned, which is
+	// delegated once it has been received by the delegator.
+	Expected types.Coin `protobuf:"bytes,5,opt,name=expected,proto3" json:"expected"`
+	Status   int32      `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	SentTime time.Time  `protobuf:"bytes,7,opt,name=sent_time,json=sentTime,proto3,stdtime" json:"sent_time"`
+	// depositor is the host chain address of the depositor, to whose local
+	// account qAssets are minted against the expected output once it has been
+	// received by the delegator, and to which the asset is refunded should it
+	// fail to be converted.
+	Depositor string `protobuf:"bytes,8,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *ConversionRecord) Reset()         { *m = ConversionRecord{} }
+func (m *ConversionRecord) String() string { return proto.CompactTextString(m) }
+func (*ConversionRecord) ProtoMessage()    {}
+func (*ConversionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{19}
+}
+func (m *ConversionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ConversionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRecord.Merge(m, src)
+}
+func (m *ConversionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRecord proto.InternalMessageInfo
+
+func (m *ConversionRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Distribution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConversionRecord) GetTxhash() string {
+	if m != nil {
+		return m.Txhash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConversionRecord) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+func (m *ConversionRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Valoper) > 0 {
-		i -= len(m.Valoper)
-		copy(dAtA[i:], m.Valoper)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Valoper)))
-		i--
-		dAtA[i] = 0xa
+	return types.Coin{}
+}
+
+func (m *ConversionRecord) GetExpected() types.Coin {
+	if m != nil {
+		return m.Expected
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConversionRecord) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *WithdrawalRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConversionRecord) GetSentTime() time.Time {
+	if m != nil {
+		return m.SentTime
+	}
+	return time.Time{}
 }
 
-func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Requeued {
-		i--
-		if m.Requeued {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err5 != nil {
-		return 0, err5
+func (m *ConversionRecord) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	i -= n5
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x4a
-	if m.Status != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+// ZoneFees records the cumulative protocol fees collected from a zone.
+type ZoneFees struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// collected is the fees sent from the zone withdrawal account, in host chain
+	// denominations.
+	Collected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=collected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected"`
+}
+
+func (m *ZoneFees) Reset()         { *m = ZoneFees{} }
+func (m *ZoneFees) String() string { return proto.CompactTextString(m) }
+func (*ZoneFees) ProtoMessage()    {}
+func (*ZoneFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{20}
+}
+func (m *ZoneFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Txhash) > 0 {
-		i -= len(m.Txhash)
-		copy(dAtA[i:], m.Txhash)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *ZoneFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneFees.Merge(m, src)
+}
+func (m *ZoneFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneFees proto.InternalMessageInfo
+
+func (m *ZoneFees) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	{
-		size := m.BurnAmount.Size()
-		i -= size
-		if _, err := m.BurnAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ZoneFees) GetCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collected
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	return nil
+}
+
+// EpochReport records the rewards earned, and the commission taken, by a zone
+// in an epoch. The report is opened at the end of the epoch and completed once
+// the epoch's rewards have been distributed.
+type EpochReport struct {
+	ChainId string    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch   int64     `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height  int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time    time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// tvl is the value of the zone's qAssets, in the base denom, at the end of
+	// the epoch.
+	Tvl github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=tvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tvl"`
+	// gross_rewards is the base denom withdrawn as rewards, before commission.
+	GrossRewards         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=gross_rewards,json=grossRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gross_rewards"`
+	Commission           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"commission"`
+	Redelegated          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=redelegated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redelegated"`
+	RedemptionRateBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=redemption_rate_before,json=redemptionRateBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate_before"`
+	RedemptionRateAfter  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=redemption_rate_after,json=redemptionRateAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate_after"`
+	RedemptionRateDelta  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=redemption_rate_delta,json=redemptionRateDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate_delta"`
+	// annualised_yield is the redemption rate growth over the epoch, annualised
+	// over the duration of the epoch.
+	AnnualisedYield github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=annualised_yield,json=annualisedYield,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annualised_yield"`
+	// distribution_height is the height at which the epoch's rewards were
+	// distributed, or zero if yet to be distributed.
+	DistributionHeight int64 `protobuf:"varint,13,opt,name=distribution_height,json=distributionHeight,proto3" json:"distribution_height,omitempty"`
+}
+
+func (m *EpochReport) Reset()         { *m = EpochReport{} }
+func (m *EpochReport) String() string { return proto.CompactTextString(m) }
+func (*EpochReport) ProtoMessage()    {}
+func (*EpochReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{21}
+}
+func (m *EpochReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EpochReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochReport.Merge(m, src)
+}
+func (m *EpochReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochReport proto.InternalMessageInfo
+
+func (m *EpochReport) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.Distribution) > 0 {
-		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return ""
+}
+
+func (m *EpochReport) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EpochReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EpochReport) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *UnbondingRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EpochReport) GetDistributionHeight() int64 {
+	if m != nil {
+		return m.DistributionHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UnbondingRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TimedOutDelegation records a delegation the ICA packet of which timed out,
+// to be resent with the original memo once the delegation account channel has
+// been reopened.
+type TimedOutDelegation struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// memo is the memo of the timed out packet; e.g. the hash of the deposit.
+	Memo             string     `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	DelegatorAddress string     `protobuf:"bytes,3,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *UnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RelatedTxhash) > 0 {
-		for iNdEx := len(m.RelatedTxhash) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RelatedTxhash[iNdEx])
-			copy(dAtA[i:], m.RelatedTxhash[iNdEx])
-			i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.RelatedTxhash[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *TimedOutDelegation) Reset()         { *m = TimedOutDelegation{} }
+func (m *TimedOutDelegation) String() string { return proto.CompactTextString(m) }
+func (*TimedOutDelegation) ProtoMessage()    {}
+func (*TimedOutDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{22}
+}
+func (m *TimedOutDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimedOutDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimedOutDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *TimedOutDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedOutDelegation.Merge(m, src)
+}
+func (m *TimedOutDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimedOutDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedOutDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimedOutDelegation proto.InternalMessageInfo
+
+func (m *TimedOutDelegation) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *TimedOutDelegation) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TimedOutDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
+func (m *TimedOutDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *TimedOutDelegation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Zone)(nil), "quicksilver.interchainstaking.v1.Zone")
+	proto.RegisterType((*DepositAsset)(nil), "quicksilver.interchainstaking.v1.DepositAsset")
+	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
+	proto.RegisterType((*Distribution)(nil), "quicksilver.interchainstaking.v1.Distribution")
+	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
+	proto.RegisterType((*UnbondingRecord)(nil), "quicksilver.interchainstaking.v1.UnbondingRecord")
+	proto.RegisterType((*RedelegationRecord)(nil), "quicksilver.interchainstaking.v1.RedelegationRecord")
+	proto.RegisterType((*RebalanceTarget)(nil), "quicksilver.interchainstaking.v1.RebalanceTarget")
+	proto.RegisterType((*ValidatorPlan)(nil), "quicksilver.interchainstaking.v1.ValidatorPlan")
+	proto.RegisterType((*TransferRecord)(nil), "quicksilver.interchainstaking.v1.TransferRecord")
+	proto.RegisterType((*Validator)(nil), "quicksilver.interchainstaking.v1.Validator")
+	proto.RegisterType((*DelegatorIntent)(nil), "quicksilver.interchainstaking.v1.DelegatorIntent")
+	proto.RegisterType((*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.ValidatorIntent")
+	proto.RegisterType((*Delegation)(nil), "quicksilver.interchainstaking.v1.Delegation")
+	proto.RegisterType((*PortConnectionTuple)(nil), "quicksilver.interchainstaking.v1.PortConnectionTuple")
+	proto.RegisterType((*Receipt)(nil), "quicksilver.interchainstaking.v1.Receipt")
+	proto.RegisterType((*RedemptionRateRecord)(nil), "quicksilver.interchainstaking.v1.RedemptionRateRecord")
+	proto.RegisterType((*SlashRecord)(nil), "quicksilver.interchainstaking.v1.SlashRecord")
+	proto.RegisterType((*ICAPacketRecord)(nil), "quicksilver.interchainstaking.v1.ICAPacketRecord")
+	proto.RegisterType((*ConversionRecord)(nil), "quicksilver.interchainstaking.v1.ConversionRecord")
+	proto.RegisterType((*ZoneFees)(nil), "quicksilver.interchainstaking.v1.ZoneFees")
+	proto.RegisterType((*EpochReport)(nil), "quicksilver.interchainstaking.v1.EpochReport")
+	proto.RegisterType((*TimedOutDelegation)(nil), "quicksilver.interchainstaking.v1.TimedOutDelegation")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/interchainstaking/v1/interchainstaking.proto", fileDescriptor_0d755cfd37ef9fee)
+}
+
+var fileDescriptor_0d755cfd37ef9fee = []byte{
+	// 3259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcb, 0x6f, 0x5b, 0xc7,
+	0xd5, 0x37, 0x5f, 0x12, 0x79, 0x48, 0x8a, 0xd4, 0x48, 0xb1, 0xc7, 0x76, 0x22, 0x29, 0xcc, 0x4b,
+	0x89, 0x63, 0xca, 0x76, 0x3e, 0x7c, 0x09, 0xf2, 0x7d, 0x08, 0xaa, 0x87, 0x93, 0x08, 0x4d, 0x1c,
+	0xe1, 0x52, 0xce, 0xc3, 0x69, 0x73, 0x31, 0xbc, 0x77, 0x44, 0xdd, 0xf8, 0xf2, 0x5e, 0x7a, 0x66,
+	0xae, 0x2c, 0x67, 0xd5, 0x6e, 0xbb, 0xca, 0x9f, 0xd0, 0x5d, 0x81, 0xa0, 0xe8, 0x2a, 0xbb, 0xf6,
+	0x0f, 0x08, 0xd0, 0x4d, 0x90, 0x45, 0x1b, 0x14, 0x85, 0x53, 0x24, 0xbb, 0x22, 0xdd, 0xb4, 0x9b,
+	0x2e, 0x8b, 0x79, 0xdc, 0x07, 0x65, 0xd9, 0xa4, 0x9c, 0xeb, 0xa0, 0x2b, 0x71, 0xce, 0x9c, 0xf9,
+	0x9d, 0xb9, 0x33, 0x67, 0xce, 0x6b, 0x46, 0xf0, 0xca, 0xad, 0xc8, 0x73, 0x6e, 0x72, 0xcf, 0x3f,
+	0xa0, 0x6c, 0xcd, 0x0b, 0x04, 0x65, 0xce, 0x3e, 0xf1, 0x02, 0x2e, 0xc8, 0x4d, 0x2f, 0x18, 0xac,
+	0x1d, 0x5c, 0xbe, 0x97, 0xd8, 0x1d, 0xb1, 0x50, 0x84, 0x68, 0x25, 0x33, 0xb2, 0x7b, 0x2f, 0xd3,
+	0xc1, 0xe5, 0x73, 0x8b, 0x83, 0x70, 0x10, 0x2a, 0xe6, 0x35, 0xf9, 0x4b, 0x8f, 0x3b, 0x77, 0xd6,
+	0x09, 0xf9, 0x30, 0xe4, 0xb6, 0xee, 0xd0, 0x0d, 0xd3, 0xb5, 0xa4, 0x5b, 0x6b, 0x7d, 0xc2, 0xe9,
+	0xda, 0xc1, 0xe5, 0x3e, 0x15, 0xe4, 0xf2, 0x9a, 0x13, 0x7a, 0x81, 0xe9, 0x5f, 0x1e, 0x84, 0xe1,
+	0xc0, 0xa7, 0x6b, 0xaa, 0xd5, 0x8f, 0xf6, 0xd6, 0x84, 0x37, 0xa4, 0x5c, 0x90, 0xe1, 0x48, 0x33,
+	0x74, 0xfe, 0xf4, 0x04, 0x94, 0x6f, 0x84, 0x01, 0x45, 0x4f, 0x41, 0xd3, 0x09, 0x83, 0x80, 0x3a,
+	0xc2, 0x0b, 0x03, 0xdb, 0x73, 0x71, 0x61, 0xa5, 0xb0, 0x5a, 0xb3, 0x1a, 0x29, 0x71, 0xdb, 0x45,
+	0x67, 0xa1, 0xaa, 0xa6, 0x2c, 0xfb, 0x8b, 0xaa, 0x7f, 0x56, 0xb5, 0xb7, 0x5d, 0x74, 0x1d, 0x5a,
+	0x2e, 0x1d, 0x85, 0xdc, 0x13, 0x36, 0x71, 0x5d, 0x46, 0x39, 0xc7, 0xa5, 0x95, 0xc2, 0x6a, 0xfd,
+	0xca, 0x8b, 0xdd, 0x49, 0x9f, 0xdd, 0xdd, 0xde, 0x5c, 0x5f, 0x77, 0x9c, 0x30, 0x0a, 0x84, 0x35,
+	0x67, 0x40, 0xd6, 0x35, 0x06, 0xfa, 0x10, 0xd0, 0x6d, 0x4f, 0xec, 0xbb, 0x8c, 0xdc, 0x26, 0x7e,
+	0x82, 0x5c, 0x7e, 0x08, 0xe4, 0xf9, 0x14, 0x27, 0x06, 0xff, 0x39, 0x2c, 0x8c, 0x28, 0xdb, 0x0b,
+	0xd9, 0x90, 0x04, 0x0e, 0x4d, 0xd0, 0x2b, 0x0f, 0x81, 0x8e, 0x32, 0x40, 0x99, 0xb9, 0xbb, 0xd4,
+	0xa7, 0x03, 0xa2, 0x96, 0x34, 0x46, 0x9f, 0x79, 0x98, 0xb9, 0xa7, 0x38, 0x31, 0xf8, 0x33, 0x30,
+	0x47, 0x74, 0xaf, 0x3d, 0x62, 0x74, 0xcf, 0x3b, 0xc4, 0xb3, 0x6a, 0x43, 0x9a, 0x86, 0xba, 0xa3,
+	0x88, 0x68, 0x19, 0xea, 0x7e, 0xe8, 0x10, 0xdf, 0x76, 0x69, 0x10, 0x0e, 0x71, 0x55, 0xf1, 0x80,
+	0x22, 0x6d, 0x49, 0x0a, 0x7a, 0x02, 0x40, 0x2a, 0x8f, 0xe9, 0xaf, 0xa9, 0xfe, 0x9a, 0xa4, 0xe8,
+	0x6e, 0x0a, 0x2d, 0x46, 0x5d, 0x3a, 0x1c, 0xa9, 0x6f, 0x60, 0x44, 0x50, 0x0c, 0x92, 0x67, 0xe3,
+	0xff, 0xbf, 0xb8, 0xbb, 0x7c, 0xea, 0x2f, 0x77, 0x97, 0x9f, 0x1d, 0x78, 0x62, 0x3f, 0xea, 0x77,
+	0x9d, 0x70, 0x68, 0x54, 0xd3, 0xfc, 0xb9, 0xc8, 0xdd, 0x9b, 0x6b, 0xe2, 0xce, 0x88, 0xf2, 0xee,
+	0x16, 0x75, 0xbe, 0xfa, 0xfc, 0x22, 0x68, 0xba, 0x6c, 0x59, 0x73, 0x29, 0xa8, 0x45, 0x04, 0x45,
+	0x01, 0x2c, 0xfa, 0x84, 0x0b, 0xfb, 0xa8, 0xac, 0x7a, 0x0e, 0xb2, 0x90, 0x44, 0xb6, 0xc6, 0xe5,
+	0xfd, 0x14, 0xe0, 0x80, 0xf8, 0x9e, 0x4b, 0x44, 0xc8, 0x38, 0x6e, 0xac, 0x94, 0x56, 0xeb, 0x57,
+	0x2e, 0x4c, 0xde, 0x92, 0x77, 0xe3, 0x31, 0x56, 0x66, 0x38, 0x62, 0xd0, 0x26, 0x83, 0x01, 0x93,
+	0x1b, 0x44, 0x6d, 0x39, 0x2e, 0x10, 0xb8, 0xa9, 0x20, 0x2f, 0x9f, 0x00, 0x72, 0x5b, 0x0d, 0xdc,
+	0x58, 0xfc, 0xec, 0x9b, 0xe5, 0xf6, 0x11, 0x22, 0xb7, 0x5a, 0x89, 0x00, 0x4d, 0x91, 0xdb, 0x36,
+	0x8c, 0x7c, 0xe1, 0xd9, 0x9c, 0x06, 0x2e, 0x9e, 0x5b, 0x29, 0xac, 0x56, 0xad, 0x9a, 0xa2, 0xf4,
+	0x68, 0xe0, 0xa2, 0xe7, 0xa1, 0xed, 0x7b, 0xb7, 0x22, 0xcf, 0xf5, 0xc4, 0x1d, 0x7b, 0x18, 0xba,
+	0x91, 0x4f, 0x71, 0x4b, 0x31, 0xb5, 0x12, 0xfa, 0xdb, 0x8a, 0x8c, 0x2e, 0xc3, 0x62, 0xe6, 0x84,
+	0xdd, 0x26, 0x9e, 0x18, 0xb0, 0x30, 0x1a, 0xe1, 0xf6, 0x4a, 0x61, 0xb5, 0x69, 0x2d, 0xa4, 0x7d,
+	0xef, 0xc5, 0x5d, 0xe8, 0x65, 0xc0, 0x5e, 0xdf, 0xb1, 0x03, 0x7a, 0x28, 0xec, 0x74, 0x1d, 0xec,
+	0x7d, 0xc2, 0xf7, 0xf1, 0xfc, 0x4a, 0x61, 0xb5, 0x61, 0x3d, 0xe6, 0xf5, 0x9d, 0x6b, 0xf4, 0x50,
+	0x24, 0x1f, 0xc2, 0xdf, 0x24, 0x7c, 0x1f, 0x6d, 0xc1, 0x52, 0xc2, 0x6f, 0x73, 0xea, 0x1b, 0x6b,
+	0x43, 0x7c, 0xa9, 0x90, 0xf2, 0x27, 0x46, 0x2b, 0x85, 0xd5, 0xb2, 0xf5, 0x78, 0xc2, 0xd5, 0x8b,
+	0x99, 0xd6, 0x13, 0x1e, 0xb4, 0x06, 0x0b, 0xfb, 0xa1, 0xef, 0x7a, 0xc1, 0x80, 0x67, 0x87, 0x2e,
+	0xa8, 0xa1, 0x28, 0xee, 0xca, 0x0c, 0x78, 0x01, 0xe6, 0x95, 0x76, 0xd1, 0x51, 0xe8, 0xec, 0xdb,
+	0xfb, 0xd4, 0x1b, 0xec, 0x0b, 0xbc, 0xb8, 0x52, 0x58, 0x2d, 0x59, 0x2d, 0xd9, 0x71, 0x55, 0xd2,
+	0xdf, 0x54, 0x64, 0x74, 0x0d, 0x4a, 0xe2, 0xc0, 0xc7, 0x8f, 0xe5, 0xa0, 0x78, 0x12, 0x48, 0xee,
+	0x44, 0x14, 0xf4, 0xc3, 0x40, 0xce, 0xc9, 0x1e, 0x51, 0xe6, 0x85, 0x2e, 0x3e, 0xad, 0x45, 0x27,
+	0xf4, 0x1d, 0x45, 0x46, 0xe7, 0xa0, 0xea, 0x52, 0xc7, 0x1b, 0x12, 0x9f, 0xe3, 0x33, 0x8a, 0x25,
+	0x69, 0xa3, 0x0b, 0x30, 0x9f, 0xc2, 0xd0, 0x80, 0xf4, 0x7d, 0xea, 0x62, 0xac, 0x76, 0x34, 0xc5,
+	0xbf, 0xaa, 0xe9, 0x52, 0xa6, 0x31, 0xa3, 0x3c, 0xe1, 0x3d, 0xab, 0x77, 0x3f, 0xa6, 0xc7, 0xac,
+	0xab, 0xd0, 0x66, 0x54, 0x44, 0x2c, 0xb0, 0x45, 0xa8, 0x74, 0x89, 0x32, 0x7c, 0x4e, 0xb1, 0xce,
+	0x69, 0xfa, 0x6e, 0xd8, 0x53, 0x54, 0xe4, 0xc3, 0xc2, 0x90, 0x1c, 0xda, 0x8c, 0xf6, 0x89, 0xaf,
+	0xcc, 0xa5, 0x08, 0x05, 0xf1, 0xf1, 0xf9, 0x1c, 0x16, 0x6a, 0x7e, 0x48, 0x0e, 0xad, 0x18, 0x77,
+	0x57, 0xc2, 0x22, 0x0e, 0x67, 0xc6, 0xa5, 0x8d, 0x28, 0xd3, 0xfb, 0x87, 0x1f, 0xcf, 0x41, 0xe2,
+	0x62, 0x56, 0xe2, 0x0e, 0x65, 0x4a, 0x03, 0xd0, 0x2b, 0x80, 0xc7, 0x85, 0x7a, 0x82, 0x32, 0xa5,
+	0x42, 0x1c, 0x3f, 0xa1, 0xb4, 0xeb, 0x74, 0x76, 0xdc, 0x76, 0xd2, 0x8b, 0x7e, 0x59, 0x80, 0x25,
+	0x75, 0xac, 0x83, 0x31, 0x1b, 0xd6, 0x8f, 0xf6, 0xf6, 0x28, 0xd3, 0xa6, 0x6c, 0x29, 0x87, 0x69,
+	0x9f, 0x37, 0x32, 0x52, 0x6b, 0xb6, 0xa1, 0x24, 0x28, 0x9b, 0xc6, 0xe0, 0xf4, 0x31, 0x53, 0xd8,
+	0xa3, 0x14, 0x2f, 0xe7, 0xb1, 0x62, 0xf7, 0x88, 0x7e, 0x9d, 0x52, 0x74, 0x08, 0x67, 0xef, 0xfb,
+	0xd9, 0x78, 0xe5, 0xc4, 0x62, 0xb7, 0x03, 0x91, 0x11, 0xbb, 0x1d, 0x08, 0xeb, 0xcc, 0x7d, 0xbe,
+	0x18, 0x7d, 0x0c, 0xc8, 0xe8, 0xb2, 0xed, 0x7b, 0x43, 0x4f, 0xe8, 0x45, 0x7e, 0x32, 0x87, 0x2f,
+	0x8d, 0xcf, 0xce, 0x5b, 0x12, 0x56, 0xad, 0xec, 0x08, 0x1e, 0xcb, 0x7c, 0x5d, 0x46, 0x5c, 0x27,
+	0x07, 0x71, 0x0b, 0x29, 0x74, 0x2a, 0xd1, 0x81, 0x39, 0x6d, 0xac, 0xe2, 0xf3, 0x8a, 0x9f, 0xca,
+	0x61, 0x31, 0x9b, 0x0a, 0x73, 0xcb, 0x40, 0x22, 0x0f, 0xe6, 0xb5, 0x90, 0x74, 0x06, 0x1c, 0x3f,
+	0x9d, 0x83, 0x9c, 0xb6, 0x82, 0x4d, 0xb7, 0x8c, 0xc7, 0xc6, 0xc3, 0x09, 0x87, 0x43, 0x8f, 0xf3,
+	0xc4, 0xbd, 0x3f, 0x93, 0x93, 0xf1, 0xd8, 0x4c, 0x70, 0xd5, 0xea, 0x7d, 0x08, 0x30, 0xf4, 0x02,
+	0x3b, 0x1a, 0xc9, 0x68, 0x17, 0x3f, 0x9b, 0x83, 0x90, 0xda, 0xd0, 0x0b, 0xae, 0x2b, 0xb8, 0xf8,
+	0x53, 0x32, 0x7e, 0x6c, 0x9f, 0x30, 0x8a, 0x9f, 0xcb, 0xe9, 0x53, 0x12, 0x8f, 0xd9, 0x93, 0xb0,
+	0xe8, 0x22, 0xa0, 0x54, 0x92, 0x4b, 0x83, 0x3b, 0xbe, 0xc7, 0x05, 0x5e, 0x5d, 0x29, 0xad, 0xd6,
+	0xac, 0xf9, 0xa4, 0x67, 0xcb, 0x74, 0xa0, 0x0f, 0x20, 0x13, 0x2a, 0xaa, 0x99, 0xb9, 0x1c, 0x3f,
+	0xbf, 0x52, 0x3a, 0x71, 0xc4, 0xd9, 0x4e, 0x61, 0x7a, 0x0a, 0x45, 0x46, 0x92, 0x9e, 0x43, 0x6c,
+	0xb9, 0x06, 0x61, 0x24, 0xf0, 0x0b, 0xca, 0x1e, 0x82, 0xe7, 0x90, 0x5d, 0x4d, 0x41, 0x4f, 0xc3,
+	0x9c, 0x64, 0x70, 0xf6, 0xa3, 0xe0, 0xa6, 0xcd, 0xbd, 0x4f, 0x28, 0xbe, 0xa0, 0x78, 0x1a, 0x9e,
+	0x43, 0x36, 0x25, 0xb1, 0xe7, 0x7d, 0x42, 0x63, 0xae, 0x01, 0xe1, 0x76, 0x3f, 0x72, 0x07, 0x54,
+	0xe0, 0x17, 0x13, 0xae, 0x37, 0x08, 0xdf, 0x50, 0x34, 0xf9, 0xd9, 0xe1, 0xde, 0x5e, 0x3f, 0x24,
+	0x4c, 0x39, 0x3c, 0x2e, 0x88, 0x88, 0x38, 0xbe, 0xb8, 0x52, 0x58, 0xad, 0x58, 0xf3, 0x99, 0x9e,
+	0x9e, 0xea, 0x40, 0x01, 0xac, 0x64, 0xd9, 0x53, 0x4f, 0xe9, 0x84, 0xc3, 0x91, 0x4f, 0x55, 0x78,
+	0xd0, 0x55, 0x71, 0xf7, 0xb9, 0xae, 0xce, 0x88, 0xba, 0x71, 0x46, 0xd4, 0xdd, 0x8d, 0x33, 0xa2,
+	0x8d, 0xea, 0x17, 0x77, 0x97, 0x0b, 0x9f, 0x7e, 0xb3, 0x5c, 0xb0, 0x96, 0x32, 0x68, 0xd7, 0x63,
+	0xb0, 0xcd, 0x04, 0x0b, 0x0d, 0xa0, 0x9d, 0x95, 0xa7, 0x74, 0x79, 0x2d, 0x07, 0x05, 0x68, 0x65,
+	0x50, 0x95, 0x26, 0x5f, 0x87, 0xb9, 0x24, 0xab, 0xe2, 0x9c, 0x0a, 0x8e, 0x2f, 0xa9, 0xcd, 0xec,
+	0x4e, 0xde, 0x4c, 0x73, 0xcc, 0xd7, 0xe5, 0x30, 0xab, 0xe9, 0x66, 0x5a, 0x5c, 0x46, 0xf5, 0x47,
+	0x8f, 0xe2, 0xe5, 0x64, 0xfa, 0x85, 0x87, 0x8f, 0xea, 0x9d, 0xf1, 0x73, 0xf8, 0x1c, 0xb4, 0x74,
+	0x38, 0x6c, 0xfb, 0xde, 0x1e, 0x55, 0x87, 0xf1, 0x8a, 0xda, 0xec, 0x39, 0x4d, 0x7e, 0xcb, 0x50,
+	0xd1, 0x93, 0xd0, 0x30, 0x8c, 0x2e, 0x75, 0xc8, 0x1d, 0xfc, 0x92, 0xe2, 0xaa, 0x6b, 0xda, 0x96,
+	0x24, 0xa1, 0x2e, 0x2c, 0x68, 0x63, 0xa5, 0xcc, 0x2f, 0x8f, 0xa3, 0xb8, 0xff, 0x51, 0x71, 0x92,
+	0xb6, 0x63, 0xca, 0x7c, 0x72, 0x1d, 0xc7, 0x75, 0xbe, 0x2e, 0x41, 0x23, 0xbb, 0x04, 0x68, 0x11,
+	0x2a, 0x3a, 0xc7, 0xd1, 0x89, 0xad, 0x6e, 0xa0, 0x25, 0x00, 0x27, 0x0c, 0x0e, 0x28, 0x93, 0x93,
+	0x56, 0x39, 0x6d, 0xc5, 0xca, 0x50, 0x64, 0x9c, 0xed, 0xec, 0x93, 0x20, 0xa0, 0xbe, 0xcc, 0x79,
+	0x4b, 0x6a, 0x68, 0xcd, 0x50, 0xb6, 0x5d, 0x19, 0x59, 0x9a, 0xf0, 0x29, 0xc3, 0x55, 0x56, 0x5c,
+	0x2d, 0xdd, 0xb1, 0x99, 0xf0, 0x3e, 0x05, 0x4d, 0x7e, 0x9b, 0x8c, 0x6c, 0x27, 0x0c, 0x04, 0x23,
+	0x8e, 0x50, 0x79, 0x66, 0xcd, 0x6a, 0x48, 0xe2, 0xa6, 0xa1, 0x49, 0x40, 0xc5, 0x14, 0x46, 0x62,
+	0x14, 0x09, 0x93, 0x95, 0xcd, 0x68, 0x40, 0xd9, 0xf1, 0x8e, 0xa2, 0xeb, 0xdc, 0xcc, 0x86, 0x86,
+	0xb4, 0x44, 0xdc, 0xf7, 0x46, 0x23, 0x32, 0xa0, 0x78, 0x36, 0xd9, 0xc2, 0x87, 0xd7, 0xc0, 0xfa,
+	0x90, 0x1c, 0xf6, 0x0c, 0xa0, 0x3c, 0xf2, 0x52, 0x37, 0x6c, 0x1e, 0x46, 0xcc, 0xa1, 0x2a, 0x79,
+	0xac, 0x58, 0x20, 0x49, 0x3d, 0x45, 0x41, 0x3b, 0x50, 0x96, 0x2d, 0x5c, 0xcb, 0x41, 0xb2, 0x42,
+	0x42, 0x1d, 0x68, 0x2a, 0x91, 0x49, 0x99, 0x41, 0x65, 0x9b, 0x96, 0x9a, 0xc7, 0xa6, 0x2e, 0x35,
+	0x74, 0x7e, 0x5d, 0x04, 0x48, 0x4d, 0x15, 0xba, 0x02, 0xb3, 0x71, 0x6e, 0xad, 0xb6, 0x76, 0x03,
+	0x7f, 0xf5, 0xf9, 0xc5, 0x45, 0x83, 0x6c, 0xd2, 0xe5, 0x9e, 0x60, 0xf2, 0x48, 0xc5, 0x8c, 0x88,
+	0xc2, 0xac, 0x09, 0xe2, 0x70, 0x51, 0x1d, 0xa8, 0xb3, 0x5d, 0x33, 0x40, 0xa6, 0xbe, 0x5d, 0x53,
+	0x49, 0xe9, 0x6e, 0x86, 0x5e, 0xb0, 0x71, 0x49, 0x7e, 0xd6, 0x67, 0xdf, 0x2c, 0xaf, 0x4e, 0xf1,
+	0x59, 0x72, 0x00, 0xb7, 0x62, 0x6c, 0x74, 0x1e, 0x6a, 0xa3, 0x90, 0x09, 0x3b, 0x20, 0x43, 0x6a,
+	0x94, 0xa7, 0x2a, 0x09, 0xd7, 0xc8, 0x50, 0x99, 0xf6, 0xfb, 0x94, 0x36, 0x6a, 0xc7, 0x15, 0x2b,
+	0x2e, 0xc0, 0x7c, 0x1c, 0x96, 0xa6, 0x49, 0x5a, 0x45, 0x25, 0x69, 0x6d, 0xd3, 0x91, 0x64, 0x68,
+	0x9d, 0x8f, 0xa0, 0xb1, 0xe5, 0x71, 0xc1, 0xbc, 0x7e, 0xa4, 0x0c, 0x16, 0x86, 0xd9, 0x03, 0xe2,
+	0x87, 0x23, 0xca, 0x8c, 0xfa, 0xc7, 0x4d, 0x74, 0x1a, 0x66, 0xc8, 0x50, 0xae, 0xa3, 0x52, 0xfe,
+	0xb2, 0x65, 0x5a, 0xe8, 0x71, 0xa8, 0x19, 0x17, 0x10, 0xb2, 0x58, 0xef, 0x13, 0x42, 0xe7, 0xef,
+	0x15, 0x68, 0xbf, 0x97, 0x4c, 0xd1, 0xa2, 0x4e, 0xc8, 0xc6, 0xab, 0x43, 0x85, 0xf1, 0xea, 0xd0,
+	0xff, 0x66, 0xd1, 0x8a, 0x13, 0x76, 0x29, 0x65, 0x45, 0x16, 0x34, 0xdc, 0xcc, 0x77, 0xe0, 0xd2,
+	0xd4, 0xd6, 0x2f, 0x33, 0xca, 0x1a, 0xc3, 0x90, 0x73, 0x61, 0xd4, 0xf1, 0x46, 0x9e, 0xcc, 0xd3,
+	0xcb, 0x93, 0xe6, 0x92, 0xb0, 0x22, 0x27, 0x59, 0xa9, 0x4a, 0xfe, 0x2a, 0x13, 0x2f, 0xfb, 0x27,
+	0x50, 0xef, 0x4b, 0x73, 0x62, 0x24, 0xe9, 0x62, 0xd1, 0x03, 0x24, 0xbd, 0x66, 0xce, 0xdc, 0x73,
+	0x53, 0x4a, 0xfa, 0xea, 0xf3, 0x8b, 0x75, 0x03, 0x26, 0x9b, 0x16, 0x48, 0x69, 0xeb, 0x5a, 0xf6,
+	0x69, 0x98, 0x11, 0x87, 0x2a, 0x89, 0xd7, 0xa5, 0x24, 0xd3, 0x92, 0x74, 0xe3, 0x80, 0xb5, 0x05,
+	0x30, 0x2d, 0xf4, 0xb6, 0xf2, 0x22, 0xc6, 0x27, 0xaa, 0xc0, 0x00, 0xd7, 0xa6, 0x72, 0xb2, 0xa7,
+	0x94, 0x93, 0x9d, 0x4b, 0x07, 0xcb, 0x6e, 0x99, 0xfe, 0x32, 0x7a, 0x2b, 0xa2, 0x11, 0xd5, 0xa7,
+	0xbe, 0x6a, 0x25, 0x6d, 0xa9, 0xbf, 0x26, 0x11, 0x50, 0x25, 0xa1, 0xaa, 0x15, 0x37, 0xd1, 0xab,
+	0x50, 0x37, 0x3f, 0x55, 0xaa, 0xd3, 0x98, 0xb0, 0x60, 0x16, 0x18, 0x6e, 0x99, 0xbd, 0x3c, 0x03,
+	0x73, 0x4a, 0x02, 0x17, 0xb1, 0x3b, 0x69, 0x2a, 0x77, 0xd2, 0x34, 0x54, 0xe3, 0x4a, 0x7e, 0x57,
+	0x80, 0x56, 0x12, 0x05, 0x4c, 0xd6, 0xf5, 0x27, 0xa1, 0xa1, 0x3d, 0x55, 0x10, 0x0d, 0xfb, 0x54,
+	0xab, 0x7b, 0xc9, 0xaa, 0x2b, 0xda, 0x35, 0x45, 0x92, 0x2a, 0x98, 0xc4, 0x6e, 0xb8, 0x34, 0x49,
+	0x05, 0x13, 0x56, 0x3d, 0x61, 0x9f, 0x08, 0xea, 0xda, 0x66, 0xa7, 0xca, 0x2a, 0x12, 0x6c, 0x1a,
+	0xea, 0xae, 0x22, 0x76, 0x7e, 0x53, 0x04, 0x64, 0x51, 0x73, 0x8a, 0xe4, 0x01, 0xc8, 0x63, 0xce,
+	0x97, 0x60, 0xc6, 0xf8, 0x81, 0x49, 0x13, 0x36, 0x7c, 0x72, 0x6b, 0x5c, 0xca, 0x85, 0x17, 0xe8,
+	0xfa, 0xcc, 0xa4, 0xa3, 0x96, 0x65, 0xce, 0x98, 0xa5, 0x8a, 0x9a, 0x8a, 0x69, 0x1d, 0xa7, 0x73,
+	0x33, 0x0f, 0xaf, 0x73, 0x9d, 0x3f, 0x16, 0xa0, 0x95, 0x56, 0x1e, 0x08, 0x93, 0xb1, 0xe7, 0x6e,
+	0x22, 0xba, 0x90, 0x43, 0x2e, 0x14, 0x4f, 0x3c, 0x5d, 0xbe, 0xe2, 0x94, 0xcb, 0x77, 0x09, 0x66,
+	0x84, 0x9a, 0xd1, 0xe4, 0x05, 0xd7, 0x7c, 0x9d, 0xbf, 0x56, 0xa0, 0x99, 0xe4, 0x0f, 0x3b, 0x3e,
+	0x09, 0xd0, 0x3a, 0xb4, 0x8c, 0xa1, 0xb7, 0xa7, 0xf5, 0x91, 0x73, 0x66, 0x80, 0xa1, 0xa2, 0x77,
+	0x61, 0xd6, 0x89, 0x18, 0xa3, 0xc6, 0x43, 0xfc, 0xd0, 0xf5, 0x88, 0xc1, 0xd0, 0xfb, 0x50, 0x35,
+	0x1a, 0x1a, 0x6b, 0xd4, 0x0f, 0x03, 0x4e, 0xd0, 0xd0, 0xcf, 0x00, 0xa2, 0x20, 0xc1, 0x2e, 0xe7,
+	0x80, 0x9d, 0xc1, 0x43, 0x04, 0x9a, 0x2c, 0x3e, 0x5b, 0xb2, 0xdc, 0x8b, 0x2b, 0x39, 0x08, 0x68,
+	0xa4, 0x90, 0xdb, 0x81, 0xcc, 0xfe, 0x33, 0x22, 0x64, 0xb6, 0x35, 0x93, 0x47, 0xf6, 0x9f, 0x62,
+	0xbe, 0x13, 0x29, 0x35, 0xf7, 0x43, 0xe7, 0x26, 0x75, 0xf1, 0x6c, 0x0e, 0xe0, 0x06, 0x0b, 0xdd,
+	0x80, 0xda, 0x88, 0x85, 0x1f, 0x53, 0x47, 0x50, 0x17, 0x57, 0x73, 0x00, 0x4e, 0xe1, 0x3a, 0xff,
+	0x28, 0xc0, 0xdc, 0x2e, 0x23, 0x01, 0x97, 0x15, 0x2f, 0x6d, 0xd2, 0xe4, 0xa9, 0xd2, 0x45, 0xcb,
+	0xc2, 0xc4, 0x53, 0xa5, 0xf8, 0xc6, 0xbd, 0x7f, 0x71, 0x7a, 0xef, 0x7f, 0x2b, 0xb1, 0x0a, 0xa5,
+	0x47, 0xed, 0x93, 0x8d, 0xa0, 0xce, 0x9f, 0x2b, 0x50, 0x4b, 0x8e, 0x73, 0x1e, 0x47, 0xf9, 0x98,
+	0xb4, 0xaf, 0x98, 0xc7, 0x65, 0xce, 0x91, 0xb4, 0x6f, 0x00, 0xed, 0x24, 0x82, 0xd3, 0xd5, 0x11,
+	0x8e, 0x4b, 0x39, 0xc8, 0x69, 0x25, 0xa8, 0xaa, 0x36, 0xc2, 0x65, 0x02, 0x74, 0x10, 0x0a, 0x55,
+	0x58, 0x0f, 0x6f, 0x53, 0x96, 0xcb, 0x51, 0xaf, 0x6b, 0xc4, 0x1d, 0x09, 0x88, 0x2c, 0xa8, 0x70,
+	0x27, 0x64, 0x14, 0x57, 0x72, 0x98, 0xbe, 0x86, 0xca, 0x44, 0x53, 0x3a, 0xad, 0x33, 0x2d, 0x49,
+	0xff, 0x98, 0x78, 0xbe, 0x39, 0x8f, 0x55, 0xcb, 0xb4, 0x64, 0x86, 0x2a, 0xc2, 0x61, 0x9f, 0x8b,
+	0x30, 0x30, 0x47, 0xaa, 0x6a, 0x65, 0x28, 0xe8, 0x0d, 0x68, 0x68, 0x4e, 0x9b, 0x7b, 0x81, 0x73,
+	0xb2, 0x10, 0xac, 0xae, 0x47, 0xf6, 0xe4, 0x40, 0x59, 0x0e, 0xcc, 0xde, 0x86, 0xea, 0x0f, 0x87,
+	0x1c, 0xca, 0x02, 0xed, 0x0c, 0x6c, 0x4f, 0xa2, 0x76, 0xbe, 0x2f, 0x40, 0x6b, 0x2b, 0xde, 0x4c,
+	0x73, 0xa3, 0x35, 0x96, 0x22, 0x14, 0xa6, 0x4f, 0x11, 0x88, 0x0c, 0x0d, 0x25, 0x02, 0xc7, 0xc5,
+	0x7c, 0x2f, 0xdd, 0x62, 0x5c, 0xf4, 0x1a, 0xcc, 0x46, 0x23, 0x57, 0x06, 0x58, 0xb8, 0x34, 0xd5,
+	0xea, 0xea, 0x2a, 0x52, 0x3c, 0xa8, 0xf3, 0x87, 0x02, 0xb4, 0x8e, 0xa0, 0xa3, 0x8d, 0x93, 0x1f,
+	0xe7, 0xa3, 0x03, 0x10, 0x85, 0x99, 0xdb, 0x3a, 0x6e, 0xd5, 0xc7, 0xf8, 0xed, 0x93, 0xe9, 0xe7,
+	0x3f, 0xef, 0x2e, 0x37, 0xef, 0x90, 0xa1, 0xff, 0x6a, 0x47, 0xa3, 0x74, 0x8e, 0xec, 0xdb, 0x4c,
+	0x4c, 0x2e, 0x02, 0x6c, 0x25, 0xc1, 0x24, 0x7a, 0xe3, 0xd8, 0x6b, 0xed, 0x49, 0x93, 0x3f, 0xe6,
+	0x0a, 0xfb, 0x2a, 0xa4, 0x15, 0xcc, 0x04, 0x67, 0x92, 0x49, 0x6e, 0x27, 0x43, 0x62, 0x98, 0x1f,
+	0xdf, 0x32, 0xcb, 0xb3, 0x6a, 0x12, 0x86, 0xb2, 0x8e, 0x4e, 0x75, 0x4b, 0x5e, 0xbc, 0xb1, 0x4c,
+	0xdc, 0x6d, 0xcb, 0xbb, 0x59, 0x1d, 0xbf, 0xb6, 0xb2, 0xf4, 0xab, 0x81, 0xdb, 0xe9, 0xc1, 0xc2,
+	0x4e, 0xc8, 0xc4, 0x66, 0xf2, 0xbc, 0x62, 0x37, 0x1a, 0xf9, 0x53, 0x3e, 0xc3, 0x38, 0x03, 0xb3,
+	0xaa, 0xac, 0x90, 0xbc, 0xc2, 0x98, 0x91, 0xcd, 0x6d, 0xb7, 0xf3, 0xef, 0x22, 0xcc, 0x5a, 0xd4,
+	0xa1, 0xde, 0x48, 0x3c, 0x28, 0xda, 0x4f, 0xbd, 0x66, 0x71, 0x4a, 0xaf, 0x99, 0xa6, 0x86, 0xa5,
+	0xb1, 0xd4, 0x30, 0xcd, 0x89, 0xcb, 0x8f, 0x2e, 0x27, 0xde, 0x04, 0xd8, 0xf3, 0x18, 0x17, 0x36,
+	0xa7, 0x34, 0xc0, 0x95, 0x13, 0x9c, 0xc0, 0x9a, 0x1a, 0xd7, 0xa3, 0x34, 0x40, 0x1b, 0x50, 0x33,
+	0xb1, 0x3f, 0x75, 0xf1, 0xcc, 0x49, 0x30, 0x92, 0x61, 0x3a, 0x43, 0xdd, 0x93, 0xb1, 0x60, 0x6c,
+	0xa4, 0x93, 0x76, 0xe7, 0xf7, 0x45, 0x58, 0x1c, 0x7f, 0x64, 0x30, 0x39, 0xeb, 0x5a, 0x84, 0x8a,
+	0xbe, 0xd2, 0xd4, 0xe9, 0x96, 0x6e, 0x64, 0x94, 0xab, 0x34, 0xa6, 0x5c, 0xaf, 0x40, 0x59, 0xe5,
+	0x3b, 0xe5, 0x13, 0x18, 0x78, 0x35, 0x22, 0x29, 0xd3, 0x55, 0x72, 0x2b, 0xd3, 0x99, 0x5b, 0xf2,
+	0x3c, 0xc2, 0x52, 0x09, 0xd4, 0xf9, 0x45, 0x05, 0xea, 0x3d, 0x9f, 0xf0, 0xfd, 0xc9, 0x8b, 0x96,
+	0x29, 0x65, 0x15, 0xef, 0x29, 0x65, 0xe5, 0xbc, 0x70, 0xef, 0x43, 0x75, 0x4f, 0x96, 0x65, 0x65,
+	0xfa, 0x9a, 0xc7, 0xe2, 0x25, 0x68, 0x68, 0x17, 0xea, 0xa9, 0x3d, 0x90, 0xa1, 0xc0, 0x94, 0x57,
+	0x34, 0xa9, 0x1d, 0xde, 0x28, 0xcb, 0xa9, 0x58, 0x59, 0x98, 0x4c, 0xea, 0x3a, 0x9b, 0x63, 0xea,
+	0xca, 0xe0, 0xf4, 0x91, 0x77, 0x39, 0x76, 0x9f, 0xee, 0xc9, 0xe8, 0xa0, 0x9a, 0xc7, 0xc5, 0xf2,
+	0xf8, 0x53, 0xa0, 0x0d, 0x85, 0x7c, 0xe4, 0xca, 0x55, 0xc9, 0x24, 0x7b, 0x82, 0x32, 0x5c, 0xcb,
+	0xf7, 0xca, 0x55, 0x8a, 0x5c, 0x97, 0xc0, 0x9d, 0xdf, 0x96, 0xa1, 0xb5, 0xbd, 0xb9, 0xbe, 0x43,
+	0x9c, 0x9b, 0x54, 0x4c, 0x56, 0xc3, 0xfb, 0xd9, 0xe0, 0x49, 0x37, 0x06, 0xe7, 0xa0, 0xca, 0x65,
+	0x75, 0x29, 0x70, 0xb4, 0x42, 0x96, 0xad, 0xa4, 0x2d, 0xab, 0x30, 0xf1, 0x9b, 0x2e, 0x16, 0xfa,
+	0xe6, 0xbc, 0x5a, 0x75, 0x43, 0xb3, 0x42, 0x5f, 0x55, 0x94, 0x87, 0x7c, 0x60, 0xab, 0x2f, 0x53,
+	0x5a, 0x53, 0xb3, 0xaa, 0x43, 0x3e, 0xd8, 0x95, 0x6d, 0x84, 0xa0, 0x3c, 0xa4, 0xc3, 0xd0, 0x94,
+	0xef, 0xd4, 0x6f, 0x59, 0xc3, 0x97, 0x36, 0x3c, 0x2e, 0x70, 0x55, 0xd5, 0xc9, 0x00, 0x49, 0x32,
+	0x0f, 0x5e, 0xd6, 0xa1, 0xa6, 0x18, 0x4e, 0x5c, 0xbf, 0xab, 0xca, 0x61, 0xb2, 0x43, 0x96, 0xa6,
+	0xcd, 0xb5, 0xa0, 0x9d, 0xbc, 0x2f, 0x54, 0x91, 0x63, 0xd9, 0x6a, 0x9b, 0x8e, 0x04, 0x20, 0x13,
+	0xff, 0xd6, 0xc7, 0xaa, 0x89, 0x17, 0x60, 0x3e, 0x53, 0xd9, 0x31, 0xd3, 0x6d, 0xa8, 0xe9, 0xb6,
+	0xd3, 0x0e, 0x33, 0xe9, 0x63, 0xca, 0x40, 0xcd, 0x13, 0xd8, 0xf4, 0xa3, 0xa5, 0xc7, 0xf3, 0x50,
+	0x93, 0x18, 0xae, 0xca, 0xb5, 0xf5, 0x63, 0xaa, 0xaa, 0x22, 0xc8, 0x44, 0x59, 0x5a, 0x69, 0xc6,
+	0x42, 0xa6, 0x1e, 0x50, 0xd5, 0x2c, 0xdd, 0xe8, 0x7c, 0x5f, 0x84, 0xf6, 0x66, 0x72, 0x4f, 0x34,
+	0x59, 0x5f, 0x52, 0x0f, 0x5a, 0x1c, 0xf3, 0xa0, 0x0f, 0xac, 0xb3, 0xa3, 0x97, 0x33, 0xfe, 0x75,
+	0x42, 0x6c, 0xa3, 0xcd, 0x41, 0x7c, 0x66, 0xff, 0x0f, 0xaa, 0xf4, 0x70, 0xa4, 0xd3, 0xf0, 0xca,
+	0x74, 0x43, 0x93, 0x01, 0x47, 0x52, 0x94, 0x74, 0x8b, 0xb4, 0xaa, 0xe8, 0x4d, 0xc6, 0xb3, 0x53,
+	0xad, 0x77, 0xaa, 0x2a, 0x4a, 0x05, 0x74, 0x94, 0xaf, 0x6e, 0xe5, 0x42, 0x86, 0xab, 0x13, 0xa2,
+	0x8f, 0x94, 0xb5, 0xf3, 0x69, 0x01, 0xaa, 0xf2, 0x9d, 0xea, 0xeb, 0x94, 0xf2, 0x07, 0x2d, 0xb3,
+	0x27, 0xdd, 0xbc, 0xef, 0xeb, 0x0f, 0x7f, 0x04, 0x57, 0x3b, 0x29, 0x7a, 0xe7, 0x5f, 0xb3, 0x50,
+	0xbf, 0xaa, 0x1f, 0x3a, 0xc8, 0xa3, 0xff, 0xdf, 0xe0, 0xe8, 0x8d, 0x5b, 0xae, 0xe4, 0xe4, 0x96,
+	0x65, 0xad, 0x6b, 0xc0, 0x42, 0xce, 0x6d, 0x46, 0x6f, 0xab, 0xa7, 0x04, 0x79, 0x38, 0xfc, 0x86,
+	0x82, 0xb4, 0x34, 0xa2, 0x2c, 0xd6, 0xa5, 0xe5, 0x83, 0x5c, 0xdc, 0x56, 0x06, 0x0f, 0x7d, 0x04,
+	0xf5, 0xb4, 0xea, 0x95, 0x4f, 0x41, 0x2a, 0x0b, 0xf8, 0x00, 0xd7, 0x58, 0xfb, 0xf1, 0x5d, 0x23,
+	0x3c, 0x22, 0xd7, 0x78, 0x9c, 0x44, 0x97, 0xfa, 0x82, 0xe0, 0x7a, 0xfe, 0x12, 0xb7, 0x24, 0xb0,
+	0x2c, 0x21, 0x91, 0x20, 0x88, 0x88, 0xef, 0x71, 0xea, 0xda, 0x77, 0x3c, 0xea, 0xbb, 0xb8, 0x91,
+	0x83, 0xb0, 0x56, 0x8a, 0xfa, 0x81, 0x04, 0x95, 0x6f, 0x49, 0xb3, 0x97, 0x83, 0xe3, 0xf7, 0x40,
+	0x28, 0xdb, 0x65, 0x2e, 0x83, 0x7e, 0x55, 0x04, 0xb4, 0x6b, 0x5c, 0x43, 0x26, 0x29, 0x7e, 0xc0,
+	0xe1, 0x8f, 0xbd, 0x72, 0x31, 0xe3, 0x95, 0xaf, 0x26, 0xef, 0x74, 0x32, 0xa9, 0xef, 0xa4, 0x32,
+	0x7f, 0x5a, 0x55, 0x7b, 0x60, 0x06, 0x5d, 0x3e, 0x71, 0x06, 0xfd, 0xf2, 0xd8, 0x65, 0xcb, 0xf4,
+	0x5e, 0x66, 0xe3, 0xc6, 0x17, 0xdf, 0x2e, 0x15, 0xbe, 0xfc, 0x76, 0xa9, 0xf0, 0xb7, 0x6f, 0x97,
+	0x0a, 0x9f, 0x7e, 0xb7, 0x74, 0xea, 0xcb, 0xef, 0x96, 0x4e, 0x7d, 0xfd, 0xdd, 0xd2, 0xa9, 0x1b,
+	0x3f, 0xc9, 0x6c, 0x8f, 0x17, 0x0c, 0x68, 0x10, 0x79, 0xe2, 0xce, 0xc5, 0x7e, 0xe4, 0xf9, 0xee,
+	0x5a, 0xf6, 0x1f, 0x28, 0x0e, 0x8f, 0xf9, 0x17, 0x0a, 0xb5, 0x79, 0xfd, 0x19, 0x65, 0xef, 0x5e,
+	0xfa, 0xcf, 0x00, 0x75, 0x29, 0x9f, 0x2c, 0x70, 0x31, 0x00, 0x00,
+}
+
+func (m *Zone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1755,432 +2431,420 @@ func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *Zone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err7 != nil {
-		return 0, err7
+	if m.EpochLimitsHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochLimitsHeight))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
 	}
-	i -= n7
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x32
-	if m.Amount != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Amount))
+	if m.IntentDecay != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.IntentDecay))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Destination)))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x98
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Source)))
+	if m.IntentLifetime != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.IntentLifetime))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x90
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+	if m.CommissionRate != nil {
+		{
+			size := m.CommissionRate.Size()
+			i -= size
+			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.DepositAssets) > 0 {
+		for iNdEx := len(m.DepositAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.Amount.Size()
+		size := m.OffboardingRate.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.OffboardingRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Recipient)))
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xfa
+	if m.OffboardingUnbondingCompletion != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.OffboardingUnbondingCompletion, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.OffboardingUnbondingCompletion):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n1))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Sender)))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0xf2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedSince, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedSince):])
-	if err9 != nil {
-		return 0, err9
+	if m.OffboardingStatus != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.OffboardingStatus))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
 	}
-	i -= n9
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x4a
-	if m.Tombstoned {
+	if m.IcaGasBudget != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.IcaGasBudget))
 		i--
-		if m.Tombstoned {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0xe0
 	}
-	if m.Jailed {
+	if m.IcaChunkSize != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.IcaChunkSize))
 		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0xd8
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Status)))
+	if m.IcaTimeout != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.IcaTimeout))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.DelegationShards) > 0 {
+		for iNdEx := len(m.DelegationShards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.ValidatorDenylist) > 0 {
+		for iNdEx := len(m.ValidatorDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorDenylist[iNdEx])
+			copy(dAtA[i:], m.ValidatorDenylist[iNdEx])
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValidatorDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
 	{
-		size := m.Score.Size()
+		size := m.MaxValidatorShare.Size()
 		i -= size
-		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xba
 	{
-		size := m.VotingPower.Size()
+		size := m.MinUptime.Size()
 		i -= size
-		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinUptime.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xb2
 	{
-		size := m.DelegatorShares.Size()
+		size := m.MaxCommissionRate.Size()
 		i -= size
-		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xaa
 	{
-		size := m.CommissionRate.Size()
+		size := m.EpochRedemptions.Size()
 		i -= size
-		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.EpochRedemptions.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.ValoperAddress) > 0 {
-		i -= len(m.ValoperAddress)
-		copy(dAtA[i:], m.ValoperAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DelegatorIntent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Intents) > 0 {
-		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.EpochDeposits.Size()
+		i -= size
+		if _, err := m.EpochDeposits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.RedemptionLimitRate.Size()
+		i -= size
+		if _, err := m.RedemptionLimitRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorIntent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValidatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x92
 	{
-		size := m.Weight.Size()
+		size := m.DepositLimitRate.Size()
 		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DepositLimitRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.ValoperAddress) > 0 {
-		i -= len(m.ValoperAddress)
-		copy(dAtA[i:], m.ValoperAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.InstantRedemptionBuffer.Size()
+		i -= size
+		if _, err := m.InstantRedemptionBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.InstantRedemptionFee.Size()
+		i -= size
+		if _, err := m.InstantRedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	{
+		size := m.InstantRedemptionBufferRate.Size()
+		i -= size
+		if _, err := m.InstantRedemptionBufferRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if m.MaxRebalanceIterations != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.MaxRebalanceIterations))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	{
+		size := m.MaxRebalancePerEpoch.Size()
+		i -= size
+		if _, err := m.MaxRebalancePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RedelegationEnd != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.RedelegationEnd))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.MaxRebalanceTotal.Size()
+		i -= size
+		if _, err := m.MaxRebalanceTotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.ReturnToSender {
 		i--
-		dAtA[i] = 0x28
+		if m.ReturnToSender {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	if m.Height != 0 {
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+	if m.DepositsEnabled {
 		i--
-		dAtA[i] = 0x20
+		if m.DepositsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.UnbondingEnabled {
+		i--
+		if m.UnbondingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
 	{
-		size := m.Amount.Size()
+		size := m.Tvl.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.LastEpochHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.LastEpochHeight))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegationAddress) > 0 {
-		i -= len(m.DelegationAddress)
-		copy(dAtA[i:], m.DelegationAddress)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.DelegationAddress)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa0
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PortConnectionTuple) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.HoldingsAllocation != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.HoldingsAllocation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PortConnectionTuple) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PortConnectionTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortId)))
+	if m.ValidatorSelectionAllocation != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.ValidatorSelectionAllocation))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ConnectionId)))
+	if len(m.IbcNextValidatorsHash) > 0 {
+		i -= len(m.IbcNextValidatorsHash)
+		copy(dAtA[i:], m.IbcNextValidatorsHash)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.IbcNextValidatorsHash)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Receipt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.WithdrawalWaitgroup != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.WithdrawalWaitgroup))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Completed != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Completed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Completed):])
-		if err11 != nil {
-			return 0, err11
+	if m.LiquidityModule {
+		i--
+		if m.LiquidityModule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= n11
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(n11))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x78
 	}
-	if m.FirstSeen != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FirstSeen, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstSeen):])
-		if err12 != nil {
-			return 0, err12
+	if m.MultiSend {
+		i--
+		if m.MultiSend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= n12
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(n12))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x70
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AggregateIntent) > 0 {
+		for iNdEx := len(m.AggregateIntent) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AggregateIntent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2188,462 +2852,6293 @@ func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x6a
 		}
 	}
-	if len(m.Txhash) > 0 {
-		i -= len(m.Txhash)
-		copy(dAtA[i:], m.Txhash)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.LastRedemptionRate.Size()
+		i -= size
+		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.BaseDenom)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x4a
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Sender)))
+	if len(m.LocalDenom) > 0 {
+		i -= len(m.LocalDenom)
+		copy(dAtA[i:], m.LocalDenom)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.LocalDenom)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
+	}
+	if len(m.AccountPrefix) > 0 {
+		i -= len(m.AccountPrefix)
+		copy(dAtA[i:], m.AccountPrefix)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.AccountPrefix)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DelegationAddress != nil {
+		{
+			size, err := m.DelegationAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PerformanceAddress != nil {
+		{
+			size, err := m.PerformanceAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WithdrawalAddress != nil {
+		{
+			size, err := m.WithdrawalAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DepositAddress != nil {
+		{
+			size, err := m.DepositAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintInterchainstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovInterchainstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DepositAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Zone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DepositAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.RateChainId) > 0 {
+		i -= len(m.RateChainId)
+		copy(dAtA[i:], m.RateChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.RateChainId)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.DepositAddress != nil {
-		l = m.DepositAddress.Size()
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	if m.RateSource != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.RateSource))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.WithdrawalAddress != nil {
-		l = m.WithdrawalAddress.Size()
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.PerformanceAddress != nil {
-		l = m.PerformanceAddress.Size()
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.SwapOutputDenom) > 0 {
+		i -= len(m.SwapOutputDenom)
+		copy(dAtA[i:], m.SwapOutputDenom)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.SwapOutputDenom)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.DelegationAddress != nil {
-		l = m.DelegationAddress.Size()
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.SwapContract) > 0 {
+		i -= len(m.SwapContract)
+		copy(dAtA[i:], m.SwapContract)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.SwapContract)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.AccountPrefix)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.ReturnChannelId) > 0 {
+		i -= len(m.ReturnChannelId)
+		copy(dAtA[i:], m.ReturnChannelId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ReturnChannelId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.LocalDenom)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Conversion != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Conversion))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.RedemptionRate.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.LastRedemptionRate.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.AggregateIntent) > 0 {
-		for _, e := range m.AggregateIntent {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MultiSend {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BalanceWaitgroup != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.BalanceWaitgroup))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.LiquidityModule {
-		n += 2
+	if len(m.WithdrawalAddress) > 0 {
+		i -= len(m.WithdrawalAddress)
+		copy(dAtA[i:], m.WithdrawalAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.WithdrawalAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.WithdrawalWaitgroup != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.WithdrawalWaitgroup))
-	}
-	l = len(m.IbcNextValidatorsHash)
-	if l > 0 {
-		n += 2 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.ValidatorSelectionAllocation != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.ValidatorSelectionAllocation))
-	}
-	if m.HoldingsAllocation != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.HoldingsAllocation))
-	}
-	if m.LastEpochHeight != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.LastEpochHeight))
-	}
-	l = m.Tvl.Size()
-	n += 2 + l + sovInterchainstaking(uint64(l))
-	if m.UnbondingPeriod != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.UnbondingPeriod))
-	}
-	if m.Decimals != 0 {
-		n += 2 + sovInterchainstaking(uint64(m.Decimals))
-	}
-	if m.UnbondingEnabled {
-		n += 3
-	}
-	if m.DepositsEnabled {
-		n += 3
-	}
-	if m.ReturnToSender {
-		n += 3
-	}
-	return n
-}
-
-func (m *ICAAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.PortName) > 0 {
+		i -= len(m.PortName)
+		copy(dAtA[i:], m.PortName)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortName)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.PortName)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.WithdrawalAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.BalanceWaitgroup != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.BalanceWaitgroup))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Distribution) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Valoper)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Amount != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.Amount))
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Valoper) > 0 {
+		i -= len(m.Valoper)
+		copy(dAtA[i:], m.Valoper)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Valoper)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawalRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.RequestHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.RequestHeight))
+		i--
+		dAtA[i] = 0x68
 	}
-	if len(m.Distribution) > 0 {
-		for _, e := range m.Distribution {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.InstantFee != nil {
+		{
+			size, err := m.InstantFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Instant {
+		i--
+		if m.Instant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	l = m.BurnAmount.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = len(m.Txhash)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.Status))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovInterchainstaking(uint64(l))
 	if m.Requeued {
-		n += 2
-	}
-	return n
-}
-
-func (m *UnbondingRecord) Size() (n int) {
-	if m == nil {
-		return 0
+		i--
+		if m.Requeued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	i -= n7
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.RelatedTxhash) > 0 {
-		for _, s := range m.RelatedTxhash {
-			l = len(s)
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	{
+		size := m.BurnAmount.Size()
+		i -= size
+		if _, err := m.BurnAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *RedelegationRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Amount != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.Amount))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+func (m *UnbondingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnbondingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValoperAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.RelatedTxhash) > 0 {
+		for iNdEx := len(m.RelatedTxhash) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RelatedTxhash[iNdEx])
+			copy(dAtA[i:], m.RelatedTxhash[iNdEx])
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.RelatedTxhash[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.CommissionRate.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.VotingPower.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = m.Score.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Jailed {
-		n += 2
+	if m.EpochNumber != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Tombstoned {
-		n += 2
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedSince)
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorIntent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	if len(m.Intents) > 0 {
-		for _, e := range m.Intents {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
-		}
+func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValidatorIntent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValoperAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = m.Weight.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	return n
+func (m *RedelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegationAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovInterchainstaking(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.Height))
+	i -= n9
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if m.Amount != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.RedelegationEnd != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.RedelegationEnd))
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *PortConnectionTuple) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.EpochNumber != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Receipt) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RebalanceTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebalanceTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Txhash)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovInterchainstaking(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Projected.Size()
+		i -= size
+		if _, err := m.Projected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	if m.FirstSeen != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstSeen)
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RedelegateOut.Size()
+		i -= size
+		if _, err := m.RedelegateOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RedelegateIn.Size()
+		i -= size
+		if _, err := m.RedelegateIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Undelegate.Size()
+		i -= size
+		if _, err := m.Undelegate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Delegate.Size()
+		i -= size
+		if _, err := m.Delegate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Current.Size()
+		i -= size
+		if _, err := m.Current.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerformanceScore != nil {
+		{
+			size := m.PerformanceScore.Size()
+			i -= size
+			if _, err := m.PerformanceScore.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedSince, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedSince):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x4a
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Updated != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Updated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Updated):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Intents) > 0 {
+		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedelegationEnd != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.RedelegationEnd))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegationAddress) > 0 {
+		i -= len(m.DelegationAddress)
+		copy(dAtA[i:], m.DelegationAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.DelegationAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PortConnectionTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortConnectionTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortConnectionTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refunded {
+		i--
+		if m.Refunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Completed != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Completed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Completed):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FirstSeen != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FirstSeen, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstSeen):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionRateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedemptionRateAfter.Size()
+		i -= size
+		if _, err := m.RedemptionRateAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.RedemptionRateBefore.Size()
+		i -= size
+		if _, err := m.RedemptionRateBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Valoper) > 0 {
+		i -= len(m.Valoper)
+		copy(dAtA[i:], m.Valoper)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Valoper)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAPacketRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAPacketRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAPacketRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.TimedOut {
+		i--
+		if m.TimedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.CompletionTime != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CompletionTime):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CompletionHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Status != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SendTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SendTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x4a
+	if m.SendHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.SendHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AccountRole) > 0 {
+		i -= len(m.AccountRole)
+		copy(dAtA[i:], m.AccountRole)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.AccountRole)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SentTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SentTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x3a
+	if m.Status != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Expected.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collected) > 0 {
+		for iNdEx := len(m.Collected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.DistributionHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.AnnualisedYield.Size()
+		i -= size
+		if _, err := m.AnnualisedYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.RedemptionRateDelta.Size()
+		i -= size
+		if _, err := m.RedemptionRateDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.RedemptionRateAfter.Size()
+		i -= size
+		if _, err := m.RedemptionRateAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RedemptionRateBefore.Size()
+		i -= size
+		if _, err := m.RedemptionRateBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Redelegated.Size()
+		i -= size
+		if _, err := m.Redelegated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GrossRewards.Size()
+		i -= size
+		if _, err := m.GrossRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimedOutDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedOutDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimedOutDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterchainstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterchainstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Zone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.DepositAddress != nil {
+		l = m.DepositAddress.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.WithdrawalAddress != nil {
+		l = m.WithdrawalAddress.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.PerformanceAddress != nil {
+		l = m.PerformanceAddress.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.DelegationAddress != nil {
+		l = m.DelegationAddress.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.AccountPrefix)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.LastRedemptionRate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if len(m.AggregateIntent) > 0 {
+		for _, e := range m.AggregateIntent {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if m.MultiSend {
+		n += 2
+	}
+	if m.LiquidityModule {
+		n += 2
+	}
+	if m.WithdrawalWaitgroup != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.WithdrawalWaitgroup))
+	}
+	l = len(m.IbcNextValidatorsHash)
+	if l > 0 {
+		n += 2 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.ValidatorSelectionAllocation != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.ValidatorSelectionAllocation))
+	}
+	if m.HoldingsAllocation != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.HoldingsAllocation))
+	}
+	if m.LastEpochHeight != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.LastEpochHeight))
+	}
+	l = m.Tvl.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	if m.UnbondingPeriod != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.UnbondingPeriod))
+	}
+	if m.Decimals != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.Decimals))
+	}
+	if m.UnbondingEnabled {
+		n += 3
+	}
+	if m.DepositsEnabled {
+		n += 3
+	}
+	if m.ReturnToSender {
+		n += 3
+	}
+	l = m.MaxRebalanceTotal.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.MaxRebalancePerEpoch.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	if m.MaxRebalanceIterations != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.MaxRebalanceIterations))
+	}
+	l = m.InstantRedemptionBufferRate.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.InstantRedemptionFee.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.InstantRedemptionBuffer.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.DepositLimitRate.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.RedemptionLimitRate.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.EpochDeposits.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.EpochRedemptions.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.MaxCommissionRate.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.MinUptime.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.MaxValidatorShare.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	if len(m.ValidatorDenylist) > 0 {
+		for _, s := range m.ValidatorDenylist {
+			l = len(s)
+			n += 2 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if len(m.DelegationShards) > 0 {
+		for _, e := range m.DelegationShards {
+			l = e.Size()
+			n += 2 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if m.IcaTimeout != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.IcaTimeout))
+	}
+	if m.IcaChunkSize != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.IcaChunkSize))
+	}
+	if m.IcaGasBudget != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.IcaGasBudget))
+	}
+	if m.OffboardingStatus != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.OffboardingStatus))
+	}
+	if m.OffboardingUnbondingCompletion != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.OffboardingUnbondingCompletion)
+		n += 2 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.OffboardingRate.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	if len(m.DepositAssets) > 0 {
+		for _, e := range m.DepositAssets {
+			l = e.Size()
+			n += 2 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 2 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.IntentLifetime != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.IntentLifetime))
+	}
+	if m.IntentDecay != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.IntentDecay))
+	}
+	if m.EpochLimitsHeight != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.EpochLimitsHeight))
+	}
+	return n
+}
+
+func (m *DepositAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Conversion != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Conversion))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.ReturnChannelId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.SwapContract)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.SwapOutputDenom)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.RateSource != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.RateSource))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = len(m.RateChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ICAAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = len(m.PortName)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.WithdrawalAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.BalanceWaitgroup != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.BalanceWaitgroup))
+	}
+	return n
+}
+
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Valoper)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Amount))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = m.BurnAmount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Requeued {
+		n += 2
+	}
+	if m.Instant {
+		n += 2
+	}
+	if m.InstantFee != nil {
+		l = m.InstantFee.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.RequestHeight != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.RequestHeight))
+	}
+	return n
+}
+
+func (m *UnbondingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.RelatedTxhash) > 0 {
+		for _, s := range m.RelatedTxhash {
+			l = len(s)
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Amount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *RebalanceTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Current.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Delegate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Undelegate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedelegateIn.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedelegateOut.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Projected.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.VotingPower.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedSince)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.PerformanceScore != nil {
+		l = m.PerformanceScore.Size()
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Intents) > 0 {
+		for _, e := range m.Intents {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if m.Updated != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Updated)
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegationAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	if m.RedelegationEnd != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.RedelegationEnd))
+	}
+	return n
+}
+
+func (m *PortConnectionTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	if m.FirstSeen != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstSeen)
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Completed != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Completed)
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Refunded {
+		n += 2
+	}
+	return n
+}
+
+func (m *RedemptionRateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Tvl.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Valoper)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedemptionRateBefore.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedemptionRateAfter.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *ICAPacketRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Sequence))
+	}
+	l = len(m.AccountRole)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.SendHeight != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.SendHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SendTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.TimeoutTimestamp))
+	}
+	if m.Status != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Status))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.CompletionHeight))
+	}
+	if m.CompletionTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CompletionTime)
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.TimedOut {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ConversionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Expected.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SentTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ZoneFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if len(m.Collected) > 0 {
+		for _, e := range m.Collected {
+			l = e.Size()
+			n += 1 + l + sovInterchainstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Tvl.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.GrossRewards.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Redelegated.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedemptionRateBefore.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedemptionRateAfter.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedemptionRateDelta.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.AnnualisedYield.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.DistributionHeight != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.DistributionHeight))
+	}
+	return n
+}
+
+func (m *TimedOutDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func sovInterchainstaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterchainstaking(x uint64) (n int) {
+	return sovInterchainstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Zone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Zone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Zone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositAddress == nil {
+				m.DepositAddress = &ICAAccount{}
+			}
+			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawalAddress == nil {
+				m.WithdrawalAddress = &ICAAccount{}
+			}
+			if err := m.WithdrawalAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PerformanceAddress == nil {
+				m.PerformanceAddress = &ICAAccount{}
+			}
+			if err := m.PerformanceAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationAddress == nil {
+				m.DelegationAddress = &ICAAccount{}
+			}
+			if err := m.DelegationAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateIntent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateIntent = append(m.AggregateIntent, &ValidatorIntent{})
+			if err := m.AggregateIntent[len(m.AggregateIntent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiSend = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidityModule = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWaitgroup", wireType)
+			}
+			m.WithdrawalWaitgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalWaitgroup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcNextValidatorsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcNextValidatorsHash = append(m.IbcNextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcNextValidatorsHash == nil {
+				m.IbcNextValidatorsHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+			}
+			m.ValidatorSelectionAllocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSelectionAllocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+			}
+			m.HoldingsAllocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldingsAllocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochHeight", wireType)
+			}
+			m.LastEpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpochHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnbondingEnabled = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsEnabled = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnToSender", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReturnToSender = bool(v != 0)
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRebalanceTotal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRebalanceTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRebalancePerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRebalancePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRebalanceIterations", wireType)
+			}
+			m.MaxRebalanceIterations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRebalanceIterations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionBufferRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantRedemptionBufferRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantRedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionBuffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantRedemptionBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositLimitRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositLimitRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionLimitRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionLimitRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDeposits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochDeposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRedemptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRedemptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinUptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDenylist = append(m.ValidatorDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationShards = append(m.DelegationShards, &ICAAccount{})
+			if err := m.DelegationShards[len(m.DelegationShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaTimeout", wireType)
+			}
+			m.IcaTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcaTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaChunkSize", wireType)
+			}
+			m.IcaChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcaChunkSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaGasBudget", wireType)
+			}
+			m.IcaGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcaGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffboardingStatus", wireType)
+			}
+			m.OffboardingStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffboardingStatus |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffboardingUnbondingCompletion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OffboardingUnbondingCompletion == nil {
+				m.OffboardingUnbondingCompletion = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.OffboardingUnbondingCompletion, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffboardingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OffboardingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAssets = append(m.DepositAssets, &DepositAsset{})
+			if err := m.DepositAssets[len(m.DepositAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentLifetime", wireType)
+			}
+			m.IntentLifetime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntentLifetime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentDecay", wireType)
+			}
+			m.IntentDecay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntentDecay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLimitsHeight", wireType)
+			}
+			m.EpochLimitsHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLimitsHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
+			}
+			m.Conversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conversion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapOutputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			m.RateSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateSource |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+			}
+			m.BalanceWaitgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valoper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, &Distribution{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requeued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Requeued = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Instant = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantFee == nil {
+				m.InstantFee = &types.Coin{}
+			}
+			if err := m.InstantFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelatedTxhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelatedTxhash = append(m.RelatedTxhash, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebalanceTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebalanceTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebalanceTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Undelegate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegateIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegateIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegateOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegateOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Projected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Completed != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Completed)
-		n += 1 + l + sovInterchainstaking(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovInterchainstaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozInterchainstaking(x uint64) (n int) {
-	return sovInterchainstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Zone) Unmarshal(dAtA []byte) error {
+func (m *Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2666,15 +9161,115 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Zone: wiretype end group for non-group")
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Zone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2702,11 +9297,13 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2734,13 +9331,15 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -2750,31 +9349,67 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositAddress == nil {
-				m.DepositAddress = &ICAAccount{}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+			m.Jailed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedSince", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2801,18 +9436,15 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithdrawalAddress == nil {
-				m.WithdrawalAddress = &ICAAccount{}
-			}
-			if err := m.WithdrawalAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedSince, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceScore", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -2822,33 +9454,83 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PerformanceAddress == nil {
-				m.PerformanceAddress = &ICAAccount{}
-			}
-			if err := m.PerformanceAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.PerformanceScore = &v
+			if err := m.PerformanceScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -2858,33 +9540,29 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegationAddress == nil {
-				m.DelegationAddress = &ICAAccount{}
-			}
-			if err := m.DelegationAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -2894,29 +9572,31 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			m.Intents = append(m.Intents, &ValidatorIntent{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -2926,27 +9606,81 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			if m.Updated == nil {
+				m.Updated = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Updated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2974,11 +9708,11 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3006,13 +9740,63 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3040,15 +9824,13 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3058,29 +9840,27 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateIntent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3107,16 +9887,15 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateIntent = append(m.AggregateIntent, &ValidatorIntent{})
-			if err := m.AggregateIntent[len(m.AggregateIntent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3126,17 +9905,16 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MultiSend = bool(v != 0)
-		case 15:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEnd", wireType)
 			}
-			var v int
+			m.RedelegationEnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3146,17 +9924,66 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RedelegationEnd |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LiquidityModule = bool(v != 0)
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWaitgroup", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.WithdrawalWaitgroup = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortConnectionTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortConnectionTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3166,16 +9993,29 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawalWaitgroup |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcNextValidatorsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3185,31 +10025,79 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcNextValidatorsHash = append(m.IbcNextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.IbcNextValidatorsHash == nil {
-				m.IbcNextValidatorsHash = []byte{}
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Receipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.ValidatorSelectionAllocation = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3219,35 +10107,29 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorSelectionAllocation |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			m.HoldingsAllocation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HoldingsAllocation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochHeight", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastEpochHeight = 0
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3257,14 +10139,27 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastEpochHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3292,15 +10187,13 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.UnbondingPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3310,35 +10203,31 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingPeriod |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEnabled", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeen", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3348,17 +10237,33 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UnbondingEnabled = bool(v != 0)
-		case 25:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositsEnabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstSeen == nil {
+				m.FirstSeen = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FirstSeen, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3368,15 +10273,31 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DepositsEnabled = bool(v != 0)
-		case 26:
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Completed == nil {
+				m.Completed = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Completed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnToSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3393,7 +10314,7 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ReturnToSender = bool(v != 0)
+			m.Refunded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -3415,7 +10336,7 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+func (m *RedemptionRateRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3438,15 +10359,15 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedemptionRateRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedemptionRateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3474,13 +10395,13 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3490,63 +10411,16 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3556,29 +10430,16 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WithdrawalAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+				}
 			}
-			m.BalanceWaitgroup = 0
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3588,64 +10449,28 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Distribution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3673,13 +10498,15 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valoper = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3689,11 +10516,26 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -3715,7 +10557,7 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3738,10 +10580,10 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3778,7 +10620,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3806,11 +10648,30 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Valoper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3837,14 +10698,13 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Distribution = append(m.Distribution, &Distribution{})
-			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3872,11 +10732,13 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3903,16 +10765,16 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -3922,28 +10784,29 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3971,32 +10834,15 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txhash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.RedemptionRateBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateAfter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4006,45 +10852,26 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedemptionRateAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requeued", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Requeued = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -4066,7 +10893,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+func (m *ICAPacketRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4089,15 +10916,79 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ICAPacketRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ICAPacketRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4125,13 +11016,13 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.EpochNumber = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4141,14 +11032,14 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRole", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4176,11 +11067,11 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.AccountRole = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelatedTxhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4208,61 +11099,11 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelatedTxhash = append(m.RelatedTxhash, string(dAtA[iNdEx:postIndex]))
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4290,13 +11131,13 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendHeight", wireType)
 			}
-			m.EpochNumber = 0
+			m.SendHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4306,16 +11147,16 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.SendHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4325,29 +11166,30 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4357,29 +11199,16 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Amount = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4389,16 +11218,16 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int64(b&0x7F) << shift
+				m.Status |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
 			}
-			var msglen int
+			m.CompletionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4408,80 +11237,16 @@ func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CompletionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransferRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4491,29 +11256,33 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if m.CompletionTime == nil {
+				m.CompletionTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4523,29 +11292,17 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.TimedOut = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4555,24 +11312,23 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4595,7 +11351,7 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *ConversionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4618,15 +11374,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConversionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConversionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4654,11 +11410,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4686,13 +11442,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4720,15 +11474,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4738,31 +11490,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4772,31 +11523,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Expected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4806,29 +11556,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTime", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4838,37 +11575,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			m.Tombstoned = bool(v != 0)
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedSince", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4878,24 +11608,23 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedSince, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4918,7 +11647,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
+func (m *ZoneFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4941,15 +11670,15 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorIntent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZoneFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZoneFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4977,11 +11706,11 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5008,8 +11737,8 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Intents = append(m.Intents, &ValidatorIntent{})
-			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collected = append(m.Collected, types.Coin{})
+			if err := m.Collected[len(m.Collected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5034,7 +11763,7 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
+func (m *EpochReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5057,15 +11786,152 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIntent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrossRewards", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5093,11 +11959,13 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.GrossRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5125,63 +11993,13 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Delegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegated", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5209,11 +12027,13 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Redelegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5241,13 +12061,15 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.RedemptionRateBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateAfter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5257,49 +12079,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedemptionRateAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEnd", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateDelta", wireType)
 			}
-			m.RedelegationEnd = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5309,64 +12113,29 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedelegationEnd |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RedemptionRateDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PortConnectionTuple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortConnectionTuple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualisedYield", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5394,13 +12163,15 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.AnnualisedYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionHeight", wireType)
 			}
-			var stringLen uint64
+			m.DistributionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5410,24 +12181,11 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DistributionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
@@ -5449,7 +12207,7 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Receipt) Unmarshal(dAtA []byte) error {
+func (m *TimedOutDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5472,10 +12230,10 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimedOutDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimedOutDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5512,7 +12270,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5540,11 +12298,11 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5572,13 +12330,13 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txhash = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5588,65 +12346,27 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeen", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInterchainstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FirstSeen == nil {
-				m.FirstSeen = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FirstSeen, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5673,10 +12393,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Completed == nil {
-				m.Completed = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Completed, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
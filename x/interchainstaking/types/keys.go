@@ -5,8 +5,10 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 )
 
 const (
@@ -39,6 +41,9 @@ const (
 	ICASuffixWithdrawal  = "withdrawal"
 	ICASuffixPerformance = "performance"
 
+	// MaxDelegationShards is the maximum number of delegation accounts per zone.
+	MaxDelegationShards = 16
+
 	BankStoreKey        = "store/bank/key"
 	EscrowModuleAccount = "ics-escrow-account"
 )
@@ -54,8 +59,14 @@ var (
 	KeyPrefixPerformanceDelegation       = []byte{0x08}
 	KeyPrefixSnapshotIntent              = []byte{0x09}
 	KeyPrefixRequeuedWithdrawalRecordSeq = []byte{0x0a}
-	// fill in missing 0b - 0f before adding 0x11!
-	KeyPrefixRedelegationRecord = []byte{0x10}
+	KeyPrefixRedemptionRateRecord        = []byte{0x0b}
+	KeyPrefixSlashRecord                 = []byte{0x0c}
+	KeyPrefixICAPacketRecord             = []byte{0x0d}
+	KeyPrefixConversionRecord            = []byte{0x0e}
+	KeyPrefixZoneFees                    = []byte{0x0f}
+	KeyPrefixRedelegationRecord          = []byte{0x10}
+	KeyPrefixEpochReport                 = []byte{0x11}
+	KeyPrefixTimedOutDelegation          = []byte{0x12}
 )
 
 // ParseStakingDelegationKey parses the KV store key for a delegation from Cosmos x/staking module,
@@ -106,6 +117,15 @@ func GetPerformanceDelegationsKey(zone *Zone, delAddr sdk.AccAddress) []byte {
 	return append(append(KeyPrefixPerformanceDelegation, []byte(zone.ChainId)...), delAddr.Bytes()...)
 }
 
+// GetDelegationPortOwner returns the ICA port owner for the zone delegation account at the given index. Index zero is
+// the zone delegation address, which retains the unindexed port owner.
+func GetDelegationPortOwner(chainID string, index int) string {
+	if index == 0 {
+		return fmt.Sprintf("%s.%s", chainID, ICASuffixDelegate)
+	}
+	return fmt.Sprintf("%s.%s.%d", chainID, ICASuffixDelegate, index)
+}
+
 func GetReceiptKey(chainID string, txhash string) string {
 	return fmt.Sprintf("%s/%s", chainID, txhash)
 }
@@ -126,6 +146,105 @@ func GetWithdrawalKey(chainID string, status int32) []byte {
 	return key
 }
 
+// GetRedemptionRateRecordsKey gets the prefix for the redemption rate records of a zone.
+func GetRedemptionRateRecordsKey(chainID string) []byte {
+	return append(append(KeyPrefixRedemptionRateRecord, []byte(chainID)...), byte('/'))
+}
+
+// GetRedemptionRateRecordKey gets the redemption rate record key.
+// Records are keyed by chainId and epoch, so iteration yields them oldest first.
+func GetRedemptionRateRecordKey(chainID string, epochNumber int64) []byte {
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, uint64(epochNumber))
+	return append(GetRedemptionRateRecordsKey(chainID), epochBytes...)
+}
+
+// GetEpochReportsKey gets the prefix for the epoch reports of a zone.
+func GetEpochReportsKey(chainID string) []byte {
+	return append(append(KeyPrefixEpochReport, []byte(chainID)...), byte('/'))
+}
+
+// GetEpochReportKey gets the epoch report key.
+// Reports are keyed by chainId and epoch, so iteration yields them oldest first.
+func GetEpochReportKey(chainID string, epochNumber int64) []byte {
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, uint64(epochNumber))
+	return append(GetEpochReportsKey(chainID), epochBytes...)
+}
+
+// GetSlashRecordsKey gets the prefix for the slash records of a zone.
+func GetSlashRecordsKey(chainID string) []byte {
+	return append(append(KeyPrefixSlashRecord, []byte(chainID)...), byte('/'))
+}
+
+// GetValidatorSlashRecordsKey gets the prefix for the slash records of a zone validator.
+func GetValidatorSlashRecordsKey(chainID string, valoper string) []byte {
+	return append(append(GetSlashRecordsKey(chainID), []byte(valoper)...), byte('/'))
+}
+
+// GetSlashRecordKey gets the slash record key.
+// Records are keyed by chainId, validator and height, as they must be unique with regard to this triple.
+func GetSlashRecordKey(chainID string, valoper string, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(GetValidatorSlashRecordsKey(chainID, valoper), heightBytes...)
+}
+
+// GetICAPacketRecordsKey gets the prefix for the ICA packet records of a zone with the given status.
+func GetICAPacketRecordsKey(chainID string, status int32) []byte {
+	statusBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(statusBytes, uint64(status))
+	return append(append(append(KeyPrefixICAPacketRecord, []byte(chainID)...), byte('/')), statusBytes...)
+}
+
+// GetICAPacketRecordKey gets the ICA packet record key.
+// Records are keyed by chainId, status, channel and sequence; the status is part of the key so that in-flight and
+// failed packets may be iterated without traversing the whole ledger.
+func GetICAPacketRecordKey(chainID string, status int32, channelID string, sequence uint64) []byte {
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	return append(append(append(GetICAPacketRecordsKey(chainID, status), []byte(channelID)...), byte('/')), sequenceBytes...)
+}
+
+// GetConversionRecordsKey gets the prefix for the conversion records of a zone with the given status.
+func GetConversionRecordsKey(chainID string, status int32) []byte {
+	statusBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(statusBytes, uint64(status))
+	return append(append(append(KeyPrefixConversionRecord, []byte(chainID)...), byte('/')), statusBytes...)
+}
+
+// GetConversionRecordKey gets the conversion record key.
+// Records are keyed by chainId, status, txhash and denom, as a single deposit may include several assets.
+func GetConversionRecordKey(chainID string, status int32, txhash string, denom string) []byte {
+	return append(append(append(GetConversionRecordsKey(chainID, status), []byte(txhash)...), byte('/')), []byte(denom)...)
+}
+
+// GetTimedOutDelegationsKey gets the prefix for the timed out delegations of a zone.
+func GetTimedOutDelegationsKey(chainID string) []byte {
+	return append(append(KeyPrefixTimedOutDelegation, []byte(chainID)...), byte('/'))
+}
+
+// GetTimedOutDelegationKey gets the timed out delegation key.
+// Delegations are keyed by chainId, memo, delegator and validator, such that those of a single packet are adjacent.
+func GetTimedOutDelegationKey(chainID string, memo string, delegator string, validator string) []byte {
+	return append(append(append(append(GetTimedOutDelegationsKey(chainID), []byte(memo)...), byte('/')), []byte(delegator)...), []byte(validator)...)
+}
+
+// GetZoneFeesKey gets the key for the cumulative fees of a zone.
+func GetZoneFeesKey(chainID string) []byte {
+	return append(KeyPrefixZoneFees, []byte(chainID)...)
+}
+
+// GetICAAccountRole returns the role of the zone ICA account for the given controller port; e.g. delegate, or
+// delegate.1 for a delegation shard.
+func GetICAAccountRole(portID string) string {
+	parts := strings.SplitN(strings.TrimPrefix(portID, icatypes.PortPrefix), ".", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[1]
+}
+
 // GetUnbondingKey gets the unbonding key.
 // unbondigng records are keyed by chainId, validator and epoch, as they must be unique with regard to this triple.
 func GetUnbondingKey(chainID string, validator string, epochNumber int64) []byte {
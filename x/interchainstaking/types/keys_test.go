@@ -52,3 +52,10 @@ func TestParseStakingDelegationKeyInvalidTruncated(t *testing.T) {
 	_, _, err = types.ParseStakingDelegationKey(key[:len(key)-1])
 	require.Error(t, err, "out of bounds reading validator address")
 }
+
+func TestGetICAAccountRole(t *testing.T) {
+	require.Equal(t, "delegate", types.GetICAAccountRole("icacontroller-cosmoshub-4.delegate"))
+	require.Equal(t, "delegate.3", types.GetICAAccountRole("icacontroller-cosmoshub-4.delegate.3"))
+	require.Equal(t, "deposit", types.GetICAAccountRole("icacontroller-cosmoshub-4.deposit"))
+	require.Equal(t, "", types.GetICAAccountRole("transfer"))
+}
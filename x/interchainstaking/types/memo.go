@@ -0,0 +1,151 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// Deposit memos are base64 encoded. A legacy memo is a sequence of 21 byte validator intents: a one byte weight (0-200)
+// followed by a 20 byte validator address. A structured memo begins with MemoStructuredPrefix, which can never be a valid
+// intent weight, followed by a sequence of fields, each of a one byte field type, a two byte big-endian length and the field value.
+const (
+	MemoStructuredPrefix byte = 0xFF
+
+	// MemoFieldIntent holds validator intents in the legacy memo format.
+	MemoFieldIntent byte = 0x00
+	// MemoFieldForwardChannel holds the local channel over which to forward minted qAssets.
+	MemoFieldForwardChannel byte = 0x01
+	// MemoFieldForwardReceiver holds the address on the counterparty chain to receive forwarded qAssets.
+	MemoFieldForwardReceiver byte = 0x02
+	// MemoFieldSignalOnly, which has no value, marks the transfer as an intent signal; the transferred assets are
+	// refunded and no qAssets are minted.
+	MemoFieldSignalOnly byte = 0x03
+)
+
+// DepositMemo represents a parsed deposit memo.
+type DepositMemo struct {
+	// Intent is the raw validator intent bytes, if any.
+	Intent []byte
+	// ForwardChannel is the local channel over which minted qAssets are forwarded, if any.
+	ForwardChannel string
+	// ForwardReceiver is the counterparty address to which minted qAssets are forwarded, if any.
+	ForwardReceiver string
+	// SignalOnly is true if the transfer only signals the validator intent of the sender.
+	SignalOnly bool
+}
+
+// HasForward returns true if the memo requests minted qAssets be forwarded over IBC.
+func (m DepositMemo) HasForward() bool {
+	return m.ForwardChannel != ""
+}
+
+// ParseDepositMemo decodes the given base64 memo, in either the legacy or structured format.
+func ParseDepositMemo(memo string) (DepositMemo, error) {
+	out := DepositMemo{}
+
+	if len(memo) == 0 {
+		return out, errors.New("memo length unexpectedly zero")
+	}
+
+	memoBytes, err := base64.StdEncoding.DecodeString(memo)
+	if err != nil {
+		return out, fmt.Errorf("unable to determine intent from memo: Failed to decode base64 message: %s", err.Error())
+	}
+
+	if len(memoBytes) == 0 || memoBytes[0] != MemoStructuredPrefix {
+		out.Intent = memoBytes
+		return out, nil
+	}
+
+	seen := make(map[byte]bool)
+	for index := 1; index < len(memoBytes); {
+		if index+3 > len(memoBytes) {
+			return DepositMemo{}, errors.New("unable to parse memo: truncated field header")
+		}
+		fieldType := memoBytes[index]
+		length := int(binary.BigEndian.Uint16(memoBytes[index+1 : index+3]))
+		index += 3
+		if index+length > len(memoBytes) {
+			return DepositMemo{}, fmt.Errorf("unable to parse memo: field %d exceeds memo length", fieldType)
+		}
+		value := memoBytes[index : index+length]
+		index += length
+
+		if seen[fieldType] {
+			return DepositMemo{}, fmt.Errorf("unable to parse memo: duplicate field %d", fieldType)
+		}
+		seen[fieldType] = true
+
+		switch fieldType {
+		case MemoFieldIntent:
+			out.Intent = value
+		case MemoFieldForwardChannel:
+			out.ForwardChannel = string(value)
+		case MemoFieldForwardReceiver:
+			out.ForwardReceiver = string(value)
+		case MemoFieldSignalOnly:
+			if len(value) != 0 {
+				return DepositMemo{}, errors.New("unable to parse memo: signal only field must be empty")
+			}
+			out.SignalOnly = true
+		default:
+			return DepositMemo{}, fmt.Errorf("unable to parse memo: unknown field %d", fieldType)
+		}
+	}
+
+	if err := out.ValidateForward(); err != nil {
+		return DepositMemo{}, err
+	}
+
+	if out.SignalOnly && (len(out.Intent) == 0 || out.HasForward()) {
+		return DepositMemo{}, errors.New("unable to parse memo: signal only memo must include intents and no forward")
+	}
+
+	return out, nil
+}
+
+// ValidateForward checks that the forward channel and receiver are either both set and valid, or both unset.
+func (m DepositMemo) ValidateForward() error {
+	if m.ForwardChannel == "" && m.ForwardReceiver == "" {
+		return nil
+	}
+	if m.ForwardChannel == "" || m.ForwardReceiver == "" {
+		return errors.New("unable to parse memo: forward channel and receiver must both be set")
+	}
+	if err := host.ChannelIdentifierValidator(m.ForwardChannel); err != nil {
+		return fmt.Errorf("unable to parse memo: invalid forward channel: %w", err)
+	}
+	if _, _, err := bech32.DecodeAndConvert(m.ForwardReceiver); err != nil {
+		return fmt.Errorf("unable to parse memo: invalid forward receiver: %w", err)
+	}
+	return nil
+}
+
+// Encode returns the base64 encoded structured representation of the memo.
+func (m DepositMemo) Encode() string {
+	out := []byte{MemoStructuredPrefix}
+	if len(m.Intent) > 0 {
+		out = appendMemoField(out, MemoFieldIntent, m.Intent)
+	}
+	if m.ForwardChannel != "" {
+		out = appendMemoField(out, MemoFieldForwardChannel, []byte(m.ForwardChannel))
+	}
+	if m.ForwardReceiver != "" {
+		out = appendMemoField(out, MemoFieldForwardReceiver, []byte(m.ForwardReceiver))
+	}
+	if m.SignalOnly {
+		out = appendMemoField(out, MemoFieldSignalOnly, nil)
+	}
+	return base64.StdEncoding.EncodeToString(out)
+}
+
+func appendMemoField(memo []byte, fieldType byte, value []byte) []byte {
+	memo = append(memo, fieldType)
+	memo = binary.BigEndian.AppendUint16(memo, uint16(len(value)))
+	return append(memo, value...)
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestParseDepositMemo(t *testing.T) {
+	legacy := "WoS/+Ex92tEcuMBzhukZKMVnXKS8bqaQBJTx9zza4rrxyLiP9fwLijOc"
+	intent, err := base64.StdEncoding.DecodeString(legacy)
+	require.NoError(t, err)
+	receiver := utils.GenerateAccAddressForTestWithPrefix("osmo")
+
+	// legacy memos are treated as intent only.
+	memo, err := types.ParseDepositMemo(legacy)
+	require.NoError(t, err)
+	require.Equal(t, intent, memo.Intent)
+	require.False(t, memo.HasForward())
+
+	// structured memos round trip.
+	expected := types.DepositMemo{Intent: intent, ForwardChannel: "channel-1", ForwardReceiver: receiver}
+	memo, err = types.ParseDepositMemo(expected.Encode())
+	require.NoError(t, err)
+	require.Equal(t, expected, memo)
+	require.True(t, memo.HasForward())
+
+	// forward without intent.
+	expected = types.DepositMemo{ForwardChannel: "channel-1", ForwardReceiver: receiver}
+	memo, err = types.ParseDepositMemo(expected.Encode())
+	require.NoError(t, err)
+	require.Equal(t, expected, memo)
+
+	// signal only.
+	expected = types.DepositMemo{Intent: intent, SignalOnly: true}
+	memo, err = types.ParseDepositMemo(expected.Encode())
+	require.NoError(t, err)
+	require.Equal(t, expected, memo)
+
+	bad := []struct {
+		name string
+		memo string
+	}{
+		{"empty", ""},
+		{"not base64", "not base64!"},
+		{"channel without receiver", types.DepositMemo{ForwardChannel: "channel-1"}.Encode()},
+		{"receiver without channel", types.DepositMemo{ForwardReceiver: receiver}.Encode()},
+		{"invalid channel", types.DepositMemo{ForwardChannel: "chan", ForwardReceiver: receiver}.Encode()},
+		{"invalid receiver", types.DepositMemo{ForwardChannel: "channel-1", ForwardReceiver: "osmo1xxx"}.Encode()},
+		{"truncated header", base64.StdEncoding.EncodeToString([]byte{types.MemoStructuredPrefix, types.MemoFieldIntent, 0x00})},
+		{"truncated value", base64.StdEncoding.EncodeToString([]byte{types.MemoStructuredPrefix, types.MemoFieldIntent, 0x00, 0x02, 0x01})},
+		{"unknown field", base64.StdEncoding.EncodeToString([]byte{types.MemoStructuredPrefix, 0x10, 0x00, 0x00})},
+		{"signal only without intent", types.DepositMemo{SignalOnly: true}.Encode()},
+		{"signal only with forward", types.DepositMemo{Intent: intent, ForwardChannel: "channel-1", ForwardReceiver: receiver, SignalOnly: true}.Encode()},
+		{"signal only with value", base64.StdEncoding.EncodeToString(append(append([]byte{types.MemoStructuredPrefix, types.MemoFieldIntent, 0x00, byte(len(intent))}, intent...), types.MemoFieldSignalOnly, 0x00, 0x01, 0x01))},
+		{"duplicate field", base64.StdEncoding.EncodeToString([]byte{types.MemoStructuredPrefix, types.MemoFieldIntent, 0x00, 0x00, types.MemoFieldIntent, 0x00, 0x00})},
+	}
+	for _, tc := range bad {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.ParseDepositMemo(tc.memo)
+			require.Error(t, err)
+		})
+	}
+}
@@ -113,15 +113,140 @@ func (m *MsgSignalIntent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSignalIntent proto.InternalMessageInfo
 
+// MsgConfirmIntent represents a message type for refreshing a signalled
+// intent.
+type MsgConfirmIntent struct {
+	ChainId     string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	FromAddress string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgConfirmIntent) Reset()         { *m = MsgConfirmIntent{} }
+func (m *MsgConfirmIntent) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmIntent) ProtoMessage()    {}
+func (*MsgConfirmIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{2}
+}
+func (m *MsgConfirmIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfirmIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmIntent.Merge(m, src)
+}
+func (m *MsgConfirmIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfirmIntent proto.InternalMessageInfo
+
+// MsgCancelRedemption represents a message type to cancel a queued
+// redemption, returning the escrowed qAssets to the sender.
+type MsgCancelRedemption struct {
+	ChainId     string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	Hash        string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	FromAddress string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgCancelRedemption) Reset()         { *m = MsgCancelRedemption{} }
+func (m *MsgCancelRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemption) ProtoMessage()    {}
+func (*MsgCancelRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{3}
+}
+func (m *MsgCancelRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemption.Merge(m, src)
+}
+func (m *MsgCancelRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRedemption proto.InternalMessageInfo
+
+// MsgUpdateRedemptionDestination represents a message type to update the
+// destination address of a queued redemption.
+type MsgUpdateRedemptionDestination struct {
+	ChainId            string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	Hash               string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	DestinationAddress string `protobuf:"bytes,3,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	FromAddress        string `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgUpdateRedemptionDestination) Reset()         { *m = MsgUpdateRedemptionDestination{} }
+func (m *MsgUpdateRedemptionDestination) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRedemptionDestination) ProtoMessage()    {}
+func (*MsgUpdateRedemptionDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{4}
+}
+func (m *MsgUpdateRedemptionDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRedemptionDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRedemptionDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRedemptionDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRedemptionDestination.Merge(m, src)
+}
+func (m *MsgUpdateRedemptionDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRedemptionDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRedemptionDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRedemptionDestination proto.InternalMessageInfo
+
 // MsgRequestRedemptionResponse defines the MsgRequestRedemption response type.
 type MsgRequestRedemptionResponse struct {
+	// hash is the identifier of the resulting withdrawal record.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
 func (m *MsgRequestRedemptionResponse) Reset()         { *m = MsgRequestRedemptionResponse{} }
 func (m *MsgRequestRedemptionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestRedemptionResponse) ProtoMessage()    {}
 func (*MsgRequestRedemptionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{2}
+	return fileDescriptor_ee484030fa140a82, []int{5}
 }
 func (m *MsgRequestRedemptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,6 +275,98 @@ func (m *MsgRequestRedemptionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestRedemptionResponse proto.InternalMessageInfo
 
+func (m *MsgRequestRedemptionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// MsgCancelRedemptionResponse defines the MsgCancelRedemption response type.
+type MsgCancelRedemptionResponse struct {
+	Returned types.Coin `protobuf:"bytes,1,opt,name=returned,proto3" json:"returned"`
+}
+
+func (m *MsgCancelRedemptionResponse) Reset()         { *m = MsgCancelRedemptionResponse{} }
+func (m *MsgCancelRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemptionResponse) ProtoMessage()    {}
+func (*MsgCancelRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{6}
+}
+func (m *MsgCancelRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemptionResponse.Merge(m, src)
+}
+func (m *MsgCancelRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgCancelRedemptionResponse) GetReturned() types.Coin {
+	if m != nil {
+		return m.Returned
+	}
+	return types.Coin{}
+}
+
+// MsgUpdateRedemptionDestinationResponse defines the
+// MsgUpdateRedemptionDestination response type.
+type MsgUpdateRedemptionDestinationResponse struct {
+}
+
+func (m *MsgUpdateRedemptionDestinationResponse) Reset() {
+	*m = MsgUpdateRedemptionDestinationResponse{}
+}
+func (m *MsgUpdateRedemptionDestinationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRedemptionDestinationResponse) ProtoMessage()    {}
+func (*MsgUpdateRedemptionDestinationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{7}
+}
+func (m *MsgUpdateRedemptionDestinationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRedemptionDestinationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRedemptionDestinationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRedemptionDestinationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRedemptionDestinationResponse.Merge(m, src)
+}
+func (m *MsgUpdateRedemptionDestinationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRedemptionDestinationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRedemptionDestinationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRedemptionDestinationResponse proto.InternalMessageInfo
+
 // MsgSignalIntentResponse defines the MsgSignalIntent response type.
 type MsgSignalIntentResponse struct {
 }
@@ -158,7 +375,7 @@ func (m *MsgSignalIntentResponse) Reset()         { *m = MsgSignalIntentResponse
 func (m *MsgSignalIntentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSignalIntentResponse) ProtoMessage()    {}
 func (*MsgSignalIntentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{3}
+	return fileDescriptor_ee484030fa140a82, []int{8}
 }
 func (m *MsgSignalIntentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,11 +404,54 @@ func (m *MsgSignalIntentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSignalIntentResponse proto.InternalMessageInfo
 
+// MsgConfirmIntentResponse defines the MsgConfirmIntent response type.
+type MsgConfirmIntentResponse struct {
+}
+
+func (m *MsgConfirmIntentResponse) Reset()         { *m = MsgConfirmIntentResponse{} }
+func (m *MsgConfirmIntentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmIntentResponse) ProtoMessage()    {}
+func (*MsgConfirmIntentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{9}
+}
+func (m *MsgConfirmIntentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmIntentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmIntentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfirmIntentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmIntentResponse.Merge(m, src)
+}
+func (m *MsgConfirmIntentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmIntentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmIntentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfirmIntentResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRequestRedemption)(nil), "quicksilver.interchainstaking.v1.MsgRequestRedemption")
 	proto.RegisterType((*MsgSignalIntent)(nil), "quicksilver.interchainstaking.v1.MsgSignalIntent")
+	proto.RegisterType((*MsgConfirmIntent)(nil), "quicksilver.interchainstaking.v1.MsgConfirmIntent")
+	proto.RegisterType((*MsgCancelRedemption)(nil), "quicksilver.interchainstaking.v1.MsgCancelRedemption")
+	proto.RegisterType((*MsgUpdateRedemptionDestination)(nil), "quicksilver.interchainstaking.v1.MsgUpdateRedemptionDestination")
 	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "quicksilver.interchainstaking.v1.MsgRequestRedemptionResponse")
+	proto.RegisterType((*MsgCancelRedemptionResponse)(nil), "quicksilver.interchainstaking.v1.MsgCancelRedemptionResponse")
+	proto.RegisterType((*MsgUpdateRedemptionDestinationResponse)(nil), "quicksilver.interchainstaking.v1.MsgUpdateRedemptionDestinationResponse")
 	proto.RegisterType((*MsgSignalIntentResponse)(nil), "quicksilver.interchainstaking.v1.MsgSignalIntentResponse")
+	proto.RegisterType((*MsgConfirmIntentResponse)(nil), "quicksilver.interchainstaking.v1.MsgConfirmIntentResponse")
 }
 
 func init() {
@@ -199,47 +459,61 @@ func init() {
 }
 
 var fileDescriptor_ee484030fa140a82 = []byte{
-	// 640 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4f, 0x13, 0x41,
-	0x18, 0xc6, 0x3b, 0x10, 0xf9, 0x33, 0x10, 0xd1, 0x85, 0x44, 0x68, 0xc8, 0x96, 0xec, 0x89, 0xa8,
-	0xec, 0x52, 0x50, 0x44, 0x14, 0xa2, 0xc5, 0x84, 0x70, 0xe0, 0xb2, 0xdc, 0xb8, 0x34, 0xd3, 0xdd,
-	0xd7, 0x61, 0xc2, 0xee, 0xcc, 0xb2, 0x33, 0xdd, 0xc0, 0xd5, 0x93, 0x47, 0x13, 0xfd, 0x00, 0x7c,
-	0x08, 0xe2, 0x17, 0xd0, 0x03, 0x47, 0xa2, 0x17, 0x4f, 0x8d, 0x01, 0x0f, 0x9e, 0x3c, 0xf0, 0x09,
-	0xcc, 0xee, 0x4e, 0x1b, 0x0a, 0x4d, 0x5a, 0x1b, 0x6f, 0x33, 0x7d, 0xde, 0xdf, 0xfb, 0x3e, 0x4f,
-	0x67, 0x66, 0xb1, 0x73, 0x58, 0x67, 0xde, 0x81, 0x64, 0x41, 0x02, 0xb1, 0xc3, 0xb8, 0x82, 0xd8,
-	0xdb, 0x27, 0x8c, 0x4b, 0x45, 0x0e, 0x18, 0xa7, 0x4e, 0x52, 0x76, 0x42, 0x90, 0x92, 0x50, 0x90,
-	0x76, 0x14, 0x0b, 0x25, 0x8c, 0xb9, 0x6b, 0x80, 0x7d, 0x0b, 0xb0, 0x93, 0x72, 0x71, 0x8a, 0x0a,
-	0x2a, 0xb2, 0x62, 0x27, 0x5d, 0xe5, 0x5c, 0x71, 0xc6, 0x13, 0x32, 0x14, 0xb2, 0x9a, 0x0b, 0xf9,
-	0x46, 0x4b, 0x66, 0xbe, 0x73, 0x6a, 0x44, 0x82, 0x93, 0x94, 0x6b, 0xa0, 0x48, 0xd9, 0xf1, 0x04,
-	0xe3, 0x5a, 0x5f, 0xed, 0xea, 0xf1, 0xb6, 0x8f, 0x9c, 0x5c, 0xec, 0x4a, 0x46, 0xb1, 0x88, 0x84,
-	0x24, 0x41, 0xd3, 0xcb, 0x2c, 0x15, 0x82, 0x06, 0xe0, 0x90, 0x88, 0x39, 0x84, 0x73, 0xa1, 0x88,
-	0x62, 0x82, 0x6b, 0xd5, 0xfa, 0x83, 0xf0, 0xd4, 0x8e, 0xa4, 0x2e, 0x1c, 0xd6, 0x41, 0x2a, 0x17,
-	0x7c, 0x08, 0xa3, 0x54, 0x37, 0xde, 0xe0, 0x3b, 0x09, 0x09, 0xea, 0x30, 0x8d, 0xe6, 0xd0, 0xfc,
-	0xd8, 0xd2, 0x8c, 0xad, 0x03, 0xa6, 0x91, 0x6c, 0x1d, 0xc9, 0xde, 0x14, 0x8c, 0x57, 0x26, 0xcf,
-	0x1a, 0xa5, 0xc2, 0x55, 0xa3, 0x34, 0x76, 0x4c, 0xc2, 0x60, 0xcd, 0x4a, 0x63, 0x5a, 0x6e, 0x0e,
-	0x1b, 0xdb, 0x78, 0xd2, 0x07, 0xa9, 0x18, 0xcf, 0x86, 0x56, 0x89, 0xef, 0xc7, 0x20, 0xe5, 0xf4,
-	0xc0, 0x1c, 0x9a, 0x1f, 0xad, 0x4c, 0x7f, 0x3b, 0x5d, 0x98, 0xd2, 0x6d, 0x5f, 0xe7, 0xca, 0xae,
-	0x8a, 0x19, 0xa7, 0xae, 0x71, 0x0d, 0xd2, 0x8a, 0xf1, 0x02, 0x8f, 0xbf, 0x8d, 0x45, 0xd8, 0xea,
-	0x31, 0xd8, 0xa5, 0xc7, 0x58, 0x5a, 0xad, 0x7f, 0x5a, 0x1b, 0x79, 0x7f, 0x52, 0x2a, 0xfc, 0x3e,
-	0x29, 0x15, 0xac, 0xcf, 0x08, 0x4f, 0xec, 0x48, 0xba, 0xcb, 0x28, 0x27, 0xc1, 0x36, 0x57, 0xc0,
-	0x95, 0x61, 0xe3, 0x91, 0xec, 0x5f, 0xac, 0x32, 0x3f, 0x8b, 0x3b, 0x5a, 0x99, 0xbc, 0x6a, 0x94,
-	0x26, 0x74, 0x1e, 0xad, 0x58, 0xee, 0x70, 0xb6, 0xdc, 0xf6, 0x8d, 0xc7, 0x78, 0x98, 0x65, 0x64,
-	0x33, 0x89, 0x71, 0xd5, 0x28, 0xdd, 0xcd, 0xcb, 0xb5, 0x60, 0xb9, 0xcd, 0x92, 0xff, 0x65, 0xdc,
-	0xc4, 0xb3, 0x9d, 0x0e, 0xca, 0x05, 0x19, 0x09, 0x2e, 0xc1, 0x9a, 0xc1, 0x0f, 0x6e, 0xe4, 0x6a,
-	0x4a, 0x4b, 0x9f, 0x86, 0xf0, 0xe0, 0x8e, 0xa4, 0xc6, 0x17, 0x84, 0xef, 0xdf, 0x3e, 0xe9, 0x15,
-	0xbb, 0xdb, 0x03, 0xb0, 0x3b, 0x0d, 0x2e, 0x6e, 0xf4, 0xc7, 0xb5, 0x0c, 0xaf, 0xbc, 0xfb, 0xfe,
-	0xeb, 0xe3, 0xc0, 0xe2, 0x1a, 0x7a, 0x68, 0x3d, 0x6a, 0x7b, 0xb4, 0xea, 0xa8, 0xe3, 0x0b, 0x70,
-	0x62, 0xf0, 0x01, 0x42, 0xe3, 0x14, 0xe1, 0xf1, 0xb6, 0xe3, 0x2b, 0xf7, 0x64, 0xe4, 0x3a, 0x52,
-	0x7c, 0xfe, 0xcf, 0x48, 0xff, 0xb6, 0xf3, 0x7b, 0x60, 0x7c, 0x45, 0x78, 0x62, 0x4b, 0x24, 0x9b,
-	0x81, 0x90, 0xb0, 0xb9, 0x4f, 0x38, 0x87, 0xc0, 0x78, 0xd2, 0x93, 0x8d, 0x1b, 0x54, 0xf1, 0x65,
-	0x3f, 0x54, 0xcb, 0xff, 0x7a, 0xe6, 0xff, 0x59, 0xea, 0x7f, 0xa9, 0x27, 0xff, 0x5e, 0xda, 0xa5,
-	0xea, 0x69, 0xcb, 0x67, 0x08, 0xdf, 0xdb, 0x12, 0x89, 0x0b, 0x22, 0x02, 0xde, 0xcc, 0xf1, 0xb4,
-	0x57, 0x47, 0x6d, 0x58, 0x71, 0xbd, 0x2f, 0xac, 0x95, 0x64, 0x23, 0x4b, 0xb2, 0x9a, 0x26, 0x59,
-	0xee, 0xf1, 0x02, 0xa5, 0x6d, 0x9a, 0x51, 0x2a, 0x7b, 0x67, 0x17, 0x26, 0x3a, 0xbf, 0x30, 0xd1,
-	0xcf, 0x0b, 0x13, 0x7d, 0xb8, 0x34, 0x0b, 0xe7, 0x97, 0x66, 0xe1, 0xc7, 0xa5, 0x59, 0xd8, 0x7b,
-	0x45, 0x99, 0xda, 0xaf, 0xd7, 0x6c, 0x4f, 0x84, 0x0e, 0xe3, 0x14, 0x78, 0x9d, 0xa9, 0xe3, 0x85,
-	0x5a, 0x9d, 0x05, 0x7e, 0xdb, 0xa0, 0xa3, 0x0e, 0x43, 0xd4, 0x71, 0x04, 0xb2, 0x36, 0x94, 0x7d,
-	0x5e, 0x97, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x73, 0xec, 0x43, 0x60, 0x8e, 0x06, 0x00, 0x00,
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0x1b, 0x45,
+	0x1c, 0xc6, 0x3d, 0x4e, 0xa0, 0xe9, 0xb8, 0xe0, 0xb0, 0x8e, 0x84, 0xb3, 0xad, 0xd6, 0xd1, 0x22,
+	0xa1, 0x08, 0xe8, 0x6e, 0xed, 0x42, 0x28, 0x0e, 0xa9, 0x5a, 0xbb, 0x52, 0xc9, 0xc1, 0x97, 0xad,
+	0xb8, 0xe4, 0x62, 0x8d, 0x77, 0xa7, 0xeb, 0x51, 0x77, 0x67, 0xb6, 0x3b, 0xb3, 0x56, 0x73, 0xe5,
+	0x02, 0xdc, 0x90, 0xfa, 0x05, 0xfa, 0x15, 0x90, 0x22, 0xae, 0x1c, 0xe0, 0x10, 0x89, 0x4b, 0x04,
+	0x17, 0x4e, 0x16, 0x4a, 0x38, 0x70, 0x40, 0x1c, 0xf2, 0x09, 0xd0, 0xbe, 0x78, 0xfd, 0x4a, 0xbc,
+	0x59, 0xd2, 0x9b, 0x77, 0xff, 0xf3, 0x3c, 0xf3, 0x7b, 0x66, 0x76, 0xfe, 0x63, 0xa8, 0x3f, 0x0f,
+	0x88, 0xf9, 0x8c, 0x13, 0x67, 0x80, 0x7d, 0x9d, 0x50, 0x81, 0x7d, 0xb3, 0x8f, 0x08, 0xe5, 0x02,
+	0x3d, 0x23, 0xd4, 0xd6, 0x07, 0x75, 0xdd, 0xc5, 0x9c, 0x23, 0x1b, 0x73, 0xcd, 0xf3, 0x99, 0x60,
+	0xd2, 0xd6, 0x84, 0x40, 0x9b, 0x13, 0x68, 0x83, 0xba, 0xbc, 0x61, 0x33, 0x9b, 0x45, 0x83, 0xf5,
+	0xf0, 0x57, 0xac, 0x93, 0x37, 0x4d, 0xc6, 0x5d, 0xc6, 0xbb, 0x71, 0x21, 0x7e, 0x48, 0x4a, 0x4a,
+	0xfc, 0xa4, 0xf7, 0x10, 0xc7, 0xfa, 0xa0, 0xde, 0xc3, 0x02, 0xd5, 0x75, 0x93, 0x11, 0x9a, 0xd4,
+	0xef, 0x2d, 0x65, 0x9c, 0xe7, 0x88, 0x95, 0x77, 0x96, 0x2a, 0x3d, 0x9f, 0x79, 0x8c, 0x23, 0x67,
+	0xc4, 0x72, 0xcb, 0x66, 0xcc, 0x76, 0xb0, 0x8e, 0x3c, 0xa2, 0x23, 0x4a, 0x99, 0x40, 0x82, 0x30,
+	0x9a, 0x54, 0xd5, 0x7f, 0x00, 0xdc, 0xe8, 0x70, 0xdb, 0xc0, 0xcf, 0x03, 0xcc, 0x85, 0x81, 0x2d,
+	0xec, 0x7a, 0x61, 0x5d, 0x7a, 0x04, 0xdf, 0x18, 0x20, 0x27, 0xc0, 0x55, 0xb0, 0x05, 0xb6, 0x4b,
+	0x8d, 0x4d, 0x2d, 0x09, 0x18, 0x46, 0xd2, 0x92, 0x48, 0x5a, 0x9b, 0x11, 0xda, 0xaa, 0x1c, 0x0f,
+	0x6b, 0x85, 0xf3, 0x61, 0xad, 0x74, 0x88, 0x5c, 0xa7, 0xa9, 0x86, 0x31, 0x55, 0x23, 0x16, 0x4b,
+	0xfb, 0xb0, 0x62, 0x61, 0x2e, 0x08, 0x8d, 0x26, 0xed, 0x22, 0xcb, 0xf2, 0x31, 0xe7, 0xd5, 0xe2,
+	0x16, 0xd8, 0xbe, 0xde, 0xaa, 0xfe, 0x7a, 0x74, 0x7b, 0x23, 0xb1, 0x7d, 0x18, 0x57, 0x9e, 0x08,
+	0x9f, 0x50, 0xdb, 0x90, 0x26, 0x44, 0x49, 0x45, 0xda, 0x85, 0x37, 0x9e, 0xfa, 0xcc, 0x4d, 0x3d,
+	0x56, 0x96, 0x78, 0x94, 0xc2, 0xd1, 0xc9, 0xab, 0xe6, 0xda, 0x37, 0xaf, 0x6a, 0x85, 0xbf, 0x5e,
+	0xd5, 0x0a, 0xea, 0x0f, 0x00, 0x96, 0x3b, 0xdc, 0x7e, 0x42, 0x6c, 0x8a, 0x9c, 0x7d, 0x2a, 0x30,
+	0x15, 0x92, 0x06, 0xd7, 0xa2, 0x55, 0xec, 0x12, 0x2b, 0x8a, 0x7b, 0xbd, 0x55, 0x39, 0x1f, 0xd6,
+	0xca, 0x49, 0x9e, 0xa4, 0xa2, 0x1a, 0xd7, 0xa2, 0x9f, 0xfb, 0x96, 0xf4, 0x11, 0xbc, 0x46, 0x22,
+	0xe5, 0x28, 0x89, 0x74, 0x3e, 0xac, 0xbd, 0x1d, 0x0f, 0x4f, 0x0a, 0xaa, 0x31, 0x1a, 0x72, 0x55,
+	0xe0, 0xdf, 0x02, 0xb8, 0xde, 0xe1, 0x76, 0x9b, 0xd1, 0xa7, 0xc4, 0x77, 0x73, 0x92, 0xcf, 0xb2,
+	0x14, 0xf3, 0xb1, 0x7c, 0x0f, 0x60, 0x25, 0x64, 0x41, 0xd4, 0xc4, 0xce, 0xc4, 0x47, 0x73, 0x59,
+	0x9c, 0xf7, 0xe0, 0x6a, 0x1f, 0xf1, 0x7e, 0x82, 0x51, 0x1e, 0x7f, 0x44, 0xe1, 0x5b, 0xd5, 0x88,
+	0x8a, 0x57, 0xb5, 0x7e, 0x5f, 0x17, 0xa1, 0xd2, 0xe1, 0xf6, 0x97, 0x9e, 0x85, 0x04, 0x1e, 0x33,
+	0x3f, 0x1a, 0x7f, 0x69, 0xaf, 0x07, 0xff, 0x3f, 0x8e, 0xc0, 0xca, 0x15, 0x1c, 0x81, 0xd5, 0x7c,
+	0x2b, 0xd1, 0x80, 0xb7, 0x16, 0x1d, 0x79, 0x03, 0x73, 0x8f, 0x51, 0x8e, 0x25, 0x29, 0x89, 0x15,
+	0x2d, 0x41, 0x9c, 0x42, 0x3d, 0x80, 0x37, 0x17, 0x6c, 0x78, 0x2a, 0xd9, 0x85, 0x6b, 0x3e, 0x16,
+	0x81, 0x4f, 0xb1, 0xb5, 0xbc, 0x61, 0xac, 0x86, 0x0d, 0xc3, 0x48, 0x05, 0xea, 0x36, 0x7c, 0xff,
+	0xe2, 0x8d, 0x19, 0x4d, 0xa3, 0x6e, 0xc2, 0x77, 0x67, 0xce, 0x6e, 0x5a, 0x92, 0x61, 0x75, 0xf6,
+	0x74, 0x8c, 0x6a, 0x8d, 0x97, 0x25, 0xb8, 0xd2, 0xe1, 0xb6, 0xf4, 0x13, 0x80, 0xef, 0xcc, 0x77,
+	0xba, 0x1d, 0x6d, 0xd9, 0x05, 0xa0, 0x2d, 0x5a, 0x2e, 0xf9, 0x7e, 0x3e, 0x5d, 0x4a, 0xbc, 0xf3,
+	0xd5, 0x6f, 0x7f, 0xbe, 0x2c, 0xde, 0x51, 0x3f, 0x9c, 0xba, 0xb1, 0xc4, 0x8b, 0x85, 0xed, 0x5f,
+	0xf7, 0xb1, 0x85, 0xb1, 0xdb, 0x04, 0x1f, 0x48, 0x47, 0x00, 0xde, 0x98, 0x6a, 0x5f, 0xf5, 0x4c,
+	0x20, 0x93, 0x12, 0xf9, 0xb3, 0x4b, 0x4b, 0x72, 0x62, 0xc7, 0x4d, 0x30, 0xc4, 0xfe, 0x11, 0xc0,
+	0xb7, 0xa6, 0x9b, 0x57, 0x23, 0x13, 0xc4, 0x94, 0x46, 0x6e, 0x5e, 0x5e, 0x93, 0x92, 0xdf, 0x8f,
+	0xc8, 0xef, 0xa9, 0x77, 0x33, 0x91, 0x9b, 0xb1, 0x47, 0x77, 0x9c, 0xe0, 0x67, 0x00, 0xcb, 0x8f,
+	0xd9, 0xa0, 0xed, 0x30, 0x8e, 0xdb, 0x7d, 0x44, 0x29, 0x76, 0xa4, 0x8f, 0x33, 0xf1, 0xcc, 0xa8,
+	0xe4, 0xcf, 0xf3, 0xa8, 0xd2, 0x1c, 0x7b, 0x51, 0x8e, 0x4f, 0xd5, 0x46, 0xb6, 0x1c, 0xa1, 0x45,
+	0xd7, 0x8c, 0x3d, 0xc2, 0x18, 0xc7, 0x00, 0xae, 0x3f, 0x66, 0x03, 0x03, 0x33, 0x0f, 0xd3, 0x51,
+	0x8e, 0x4f, 0xb2, 0x12, 0x4d, 0xc9, 0xe4, 0xbd, 0x5c, 0xb2, 0x9c, 0x3b, 0xe2, 0x47, 0x1e, 0x93,
+	0x51, 0x7e, 0x01, 0x70, 0x7d, 0xee, 0x12, 0xca, 0x16, 0x65, 0x56, 0x26, 0xef, 0xe5, 0x92, 0xa5,
+	0x51, 0x1e, 0x46, 0x51, 0x76, 0xd5, 0x9d, 0x6c, 0x9b, 0x12, 0xd9, 0x74, 0xfd, 0xd4, 0x27, 0x4c,
+	0xf3, 0x37, 0x80, 0x37, 0x2f, 0xba, 0x9e, 0x1e, 0x64, 0x22, 0xbc, 0xc0, 0x41, 0xfe, 0xe2, 0xff,
+	0x3a, 0xe4, 0x8c, 0x1b, 0x44, 0x8e, 0xd3, 0x71, 0x5b, 0x07, 0xc7, 0xa7, 0x0a, 0x38, 0x39, 0x55,
+	0xc0, 0x1f, 0xa7, 0x0a, 0xf8, 0xee, 0x4c, 0x29, 0x9c, 0x9c, 0x29, 0x85, 0xdf, 0xcf, 0x94, 0xc2,
+	0xc1, 0x03, 0x9b, 0x88, 0x7e, 0xd0, 0xd3, 0x4c, 0xe6, 0xea, 0x84, 0xda, 0x98, 0x06, 0x44, 0x1c,
+	0xde, 0xee, 0x05, 0xc4, 0xb1, 0xa6, 0xa6, 0x7b, 0xb1, 0x60, 0x2a, 0x71, 0xe8, 0x61, 0xde, 0x7b,
+	0x33, 0xfa, 0x77, 0x7b, 0xf7, 0xdf, 0x01, 0x00, 0x8a, 0x22, 0xd6, 0x31, 0x0d, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -260,10 +534,19 @@ type MsgClient interface {
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	SignalIntent(ctx context.Context, in *MsgSignalIntent, opts ...grpc.CallOption) (*MsgSignalIntentResponse, error)
+	// ConfirmIntent defines a method for refreshing a signalled intent, without
+	// changing it, such that it does not expire.
+	ConfirmIntent(ctx context.Context, in *MsgConfirmIntent, opts ...grpc.CallOption) (*MsgConfirmIntentResponse, error)
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	GovCloseChannel(ctx context.Context, in *MsgGovCloseChannel, opts ...grpc.CallOption) (*MsgGovCloseChannelResponse, error)
 	GovReopenChannel(ctx context.Context, in *MsgGovReopenChannel, opts ...grpc.CallOption) (*MsgGovReopenChannelResponse, error)
+	// CancelRedemption defines a method for cancelling a queued redemption and
+	// returning the escrowed qAssets.
+	CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error)
+	// UpdateRedemptionDestination defines a method for updating the destination
+	// address of a queued redemption.
+	UpdateRedemptionDestination(ctx context.Context, in *MsgUpdateRedemptionDestination, opts ...grpc.CallOption) (*MsgUpdateRedemptionDestinationResponse, error)
 }
 
 type msgClient struct {
@@ -292,6 +575,15 @@ func (c *msgClient) SignalIntent(ctx context.Context, in *MsgSignalIntent, opts
 	return out, nil
 }
 
+func (c *msgClient) ConfirmIntent(ctx context.Context, in *MsgConfirmIntent, opts ...grpc.CallOption) (*MsgConfirmIntentResponse, error) {
+	out := new(MsgConfirmIntentResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/ConfirmIntent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) GovCloseChannel(ctx context.Context, in *MsgGovCloseChannel, opts ...grpc.CallOption) (*MsgGovCloseChannelResponse, error) {
 	out := new(MsgGovCloseChannelResponse)
 	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/GovCloseChannel", in, out, opts...)
@@ -310,6 +602,24 @@ func (c *msgClient) GovReopenChannel(ctx context.Context, in *MsgGovReopenChanne
 	return out, nil
 }
 
+func (c *msgClient) CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error) {
+	out := new(MsgCancelRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/CancelRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRedemptionDestination(ctx context.Context, in *MsgUpdateRedemptionDestination, opts ...grpc.CallOption) (*MsgUpdateRedemptionDestinationResponse, error) {
+	out := new(MsgUpdateRedemptionDestinationResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/UpdateRedemptionDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RequestRedemption defines a method for requesting burning of qAssets for
@@ -318,10 +628,19 @@ type MsgServer interface {
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	SignalIntent(context.Context, *MsgSignalIntent) (*MsgSignalIntentResponse, error)
+	// ConfirmIntent defines a method for refreshing a signalled intent, without
+	// changing it, such that it does not expire.
+	ConfirmIntent(context.Context, *MsgConfirmIntent) (*MsgConfirmIntentResponse, error)
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	GovCloseChannel(context.Context, *MsgGovCloseChannel) (*MsgGovCloseChannelResponse, error)
 	GovReopenChannel(context.Context, *MsgGovReopenChannel) (*MsgGovReopenChannelResponse, error)
+	// CancelRedemption defines a method for cancelling a queued redemption and
+	// returning the escrowed qAssets.
+	CancelRedemption(context.Context, *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error)
+	// UpdateRedemptionDestination defines a method for updating the destination
+	// address of a queued redemption.
+	UpdateRedemptionDestination(context.Context, *MsgUpdateRedemptionDestination) (*MsgUpdateRedemptionDestinationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -334,12 +653,21 @@ func (*UnimplementedMsgServer) RequestRedemption(ctx context.Context, req *MsgRe
 func (*UnimplementedMsgServer) SignalIntent(ctx context.Context, req *MsgSignalIntent) (*MsgSignalIntentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalIntent not implemented")
 }
+func (*UnimplementedMsgServer) ConfirmIntent(ctx context.Context, req *MsgConfirmIntent) (*MsgConfirmIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmIntent not implemented")
+}
 func (*UnimplementedMsgServer) GovCloseChannel(ctx context.Context, req *MsgGovCloseChannel) (*MsgGovCloseChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovCloseChannel not implemented")
 }
 func (*UnimplementedMsgServer) GovReopenChannel(ctx context.Context, req *MsgGovReopenChannel) (*MsgGovReopenChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovReopenChannel not implemented")
 }
+func (*UnimplementedMsgServer) CancelRedemption(ctx context.Context, req *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRedemption not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRedemptionDestination(ctx context.Context, req *MsgUpdateRedemptionDestination) (*MsgUpdateRedemptionDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedemptionDestination not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -381,6 +709,24 @@ func _Msg_SignalIntent_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConfirmIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmIntent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/ConfirmIntent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmIntent(ctx, req.(*MsgConfirmIntent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_GovCloseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgGovCloseChannel)
 	if err := dec(in); err != nil {
@@ -417,6 +763,42 @@ func _Msg_GovReopenChannel_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/CancelRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRedemption(ctx, req.(*MsgCancelRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRedemptionDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRedemptionDestination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRedemptionDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/UpdateRedemptionDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRedemptionDestination(ctx, req.(*MsgUpdateRedemptionDestination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "quicksilver.interchainstaking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -429,6 +811,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SignalIntent",
 			Handler:    _Msg_SignalIntent_Handler,
 		},
+		{
+			MethodName: "ConfirmIntent",
+			Handler:    _Msg_ConfirmIntent_Handler,
+		},
 		{
 			MethodName: "GovCloseChannel",
 			Handler:    _Msg_GovCloseChannel_Handler,
@@ -437,6 +823,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GovReopenChannel",
 			Handler:    _Msg_GovReopenChannel_Handler,
 		},
+		{
+			MethodName: "CancelRedemption",
+			Handler:    _Msg_CancelRedemption_Handler,
+		},
+		{
+			MethodName: "UpdateRedemptionDestination",
+			Handler:    _Msg_UpdateRedemptionDestination_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quicksilver/interchainstaking/v1/messages.proto",
@@ -533,7 +927,7 @@ func (m *MsgSignalIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgConfirmIntent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -543,20 +937,34 @@ func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConfirmIntent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConfirmIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSignalIntentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelRedemption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -566,28 +974,232 @@ func (m *MsgSignalIntentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSignalIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelRedemption) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSignalIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessages(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateRedemptionDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRedemptionDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRedemptionDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRedemptionDestinationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRedemptionDestinationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRedemptionDestinationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgRequestRedemption) Size() (n int) {
@@ -630,7 +1242,94 @@ func (m *MsgSignalIntent) Size() (n int) {
 	return n
 }
 
+func (m *MsgConfirmIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRedemptionDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgRequestRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Returned.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRedemptionDestinationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -648,6 +1347,15 @@ func (m *MsgSignalIntentResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgConfirmIntentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMessages(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -947,7 +1655,7 @@ func (m *MsgSignalIntent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmIntent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -970,15 +1678,618 @@ func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmIntent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmIntent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessages(dAtA[iNdEx:])
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRedemptionDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRedemptionDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRedemptionDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRedemptionDestinationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRedemptionDestinationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRedemptionDestinationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -1047,6 +2358,56 @@ func (m *MsgSignalIntentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgConfirmIntentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmIntentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"regexp"
@@ -15,15 +17,24 @@ import (
 
 // interchainstaking message types
 const (
-	TypeMsgRequestRedemption = "requestredemption"
-	TypeMsgSignalIntent      = "signalintent"
+	TypeMsgRequestRedemption           = "requestredemption"
+	TypeMsgCancelRedemption            = "cancelredemption"
+	TypeMsgUpdateRedemptionDestination = "updateredemptiondestination"
+	TypeMsgSignalIntent                = "signalintent"
+	TypeMsgConfirmIntent               = "confirmintent"
 )
 
 var (
 	_ sdk.Msg            = &MsgRequestRedemption{}
+	_ sdk.Msg            = &MsgCancelRedemption{}
+	_ sdk.Msg            = &MsgUpdateRedemptionDestination{}
 	_ sdk.Msg            = &MsgSignalIntent{}
+	_ sdk.Msg            = &MsgConfirmIntent{}
 	_ legacytx.LegacyMsg = &MsgRequestRedemption{}
+	_ legacytx.LegacyMsg = &MsgCancelRedemption{}
+	_ legacytx.LegacyMsg = &MsgUpdateRedemptionDestination{}
 	_ legacytx.LegacyMsg = &MsgSignalIntent{}
+	_ legacytx.LegacyMsg = &MsgConfirmIntent{}
 )
 
 // NewMsgRequestRedemption - construct a msg to request redemption.
@@ -79,6 +90,116 @@ func (msg MsgRequestRedemption) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{fromAddress}
 }
 
+// NewMsgCancelRedemption - construct a msg to cancel a queued redemption.
+func NewMsgCancelRedemption(chainID string, hash string, fromAddress sdk.Address) *MsgCancelRedemption {
+	return &MsgCancelRedemption{ChainId: chainID, Hash: hash, FromAddress: fromAddress.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgCancelRedemption) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCancelRedemption) Type() string { return TypeMsgCancelRedemption }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelRedemption) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		errs["FromAddress"] = err
+	}
+
+	if msg.ChainId == "" {
+		errs["ChainId"] = errors.New("undefined")
+	}
+
+	if err := validateRedemptionHash(msg.Hash); err != nil {
+		errs["Hash"] = err
+	}
+
+	if len(errs) > 0 {
+		return multierror.New(errs)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelRedemption) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// NewMsgUpdateRedemptionDestination - construct a msg to update the destination of a queued redemption.
+func NewMsgUpdateRedemptionDestination(chainID string, hash string, destinationAddress string, fromAddress sdk.Address) *MsgUpdateRedemptionDestination {
+	return &MsgUpdateRedemptionDestination{ChainId: chainID, Hash: hash, DestinationAddress: destinationAddress, FromAddress: fromAddress.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateRedemptionDestination) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateRedemptionDestination) Type() string { return TypeMsgUpdateRedemptionDestination }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateRedemptionDestination) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		errs["FromAddress"] = err
+	}
+
+	if msg.ChainId == "" {
+		errs["ChainId"] = errors.New("undefined")
+	}
+
+	if err := validateRedemptionHash(msg.Hash); err != nil {
+		errs["Hash"] = err
+	}
+
+	// validate recipient address; the prefix is checked against the zone by the msg server.
+	if len(msg.DestinationAddress) == 0 {
+		errs["DestinationAddress"] = errors.New("recipient address not provided")
+	} else if _, _, err := bech32.DecodeAndConvert(msg.DestinationAddress); err != nil {
+		errs["DestinationAddress"] = err
+	}
+
+	if len(errs) > 0 {
+		return multierror.New(errs)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateRedemptionDestination) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateRedemptionDestination) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// validateRedemptionHash checks the given string is a hex encoded sha256 hash, as
+// used to key withdrawal records.
+func validateRedemptionHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return fmt.Errorf("invalid hash: %w", err)
+	}
+	if len(bz) != sha256.Size {
+		return fmt.Errorf("invalid hash length, expected %d bytes, got %d", sha256.Size, len(bz))
+	}
+	return nil
+}
+
 //----------------------------------------------------------------
 
 // IntentsFromString parses and validates the given string into a slice
@@ -194,6 +315,47 @@ func (msg MsgSignalIntent) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{fromAddress}
 }
 
+// NewMsgConfirmIntent - construct a msg to refresh signalled intent.
+func NewMsgConfirmIntent(chainID string, fromAddress sdk.Address) *MsgConfirmIntent {
+	return &MsgConfirmIntent{ChainId: chainID, FromAddress: fromAddress.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgConfirmIntent) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgConfirmIntent) Type() string { return TypeMsgConfirmIntent }
+
+// ValidateBasic Implements Msg.
+func (msg MsgConfirmIntent) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		errs["FromAddress"] = err
+	}
+
+	if msg.ChainId == "" {
+		errs["ChainId"] = errors.New("undefined")
+	}
+
+	if len(errs) > 0 {
+		return multierror.New(errs)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgConfirmIntent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgConfirmIntent) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
 // NewMsgGovCloseChannel - construct a msg to update signalled intent.
 func NewMsgGovCloseChannel(channelID string, portName string, fromAddress sdk.Address) *MsgGovCloseChannel {
 	return &MsgGovCloseChannel{ChannelId: channelID, PortId: portName, Authority: fromAddress.String()}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -133,6 +134,45 @@ func TestMsgSignalIntent_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgConfirmIntent_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     types.MsgConfirmIntent
+		wantErr bool
+	}{
+		{
+			"nil",
+			types.MsgConfirmIntent{},
+			true,
+		},
+		{
+			"invalid_nil_chain_id",
+			types.MsgConfirmIntent{FromAddress: utils.GenerateAccAddressForTest().String()},
+			true,
+		},
+		{
+			"invalid_from_address",
+			types.MsgConfirmIntent{ChainId: "cosmoshub-4", FromAddress: "cosmos1invalid"},
+			true,
+		},
+		{
+			"valid",
+			types.MsgConfirmIntent{ChainId: "cosmoshub-4", FromAddress: utils.GenerateAccAddressForTest().String()},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgRequestRedemption_ValidateBasic(t *testing.T) {
 	type fields struct {
 		Value              sdk.Coin
@@ -238,3 +278,88 @@ func TestMsgRequestRedemption_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCancelRedemption_ValidateBasic(t *testing.T) {
+	validHash := fmt.Sprintf("%064d", 1)
+	tests := []struct {
+		name    string
+		msg     types.MsgCancelRedemption
+		wantErr bool
+	}{
+		{
+			"nil",
+			types.MsgCancelRedemption{},
+			true,
+		},
+		{
+			"invalid_hash",
+			types.MsgCancelRedemption{ChainId: "cosmoshub-4", Hash: "nothex", FromAddress: utils.GenerateAccAddressForTest().String()},
+			true,
+		},
+		{
+			"invalid_hash_length",
+			types.MsgCancelRedemption{ChainId: "cosmoshub-4", Hash: "abcd", FromAddress: utils.GenerateAccAddressForTest().String()},
+			true,
+		},
+		{
+			"invalid_nil_chain_id",
+			types.MsgCancelRedemption{Hash: validHash, FromAddress: utils.GenerateAccAddressForTest().String()},
+			true,
+		},
+		{
+			"valid",
+			types.MsgCancelRedemption{ChainId: "cosmoshub-4", Hash: validHash, FromAddress: utils.GenerateAccAddressForTest().String()},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateRedemptionDestination_ValidateBasic(t *testing.T) {
+	validHash := fmt.Sprintf("%064d", 1)
+	tests := []struct {
+		name    string
+		msg     types.MsgUpdateRedemptionDestination
+		wantErr bool
+	}{
+		{
+			"nil",
+			types.MsgUpdateRedemptionDestination{},
+			true,
+		},
+		{
+			"invalid_nil_destination_address",
+			types.MsgUpdateRedemptionDestination{ChainId: "cosmoshub-4", Hash: validHash, FromAddress: utils.GenerateAccAddressForTest().String()},
+			true,
+		},
+		{
+			"invalid_destination_address",
+			types.MsgUpdateRedemptionDestination{ChainId: "cosmoshub-4", Hash: validHash, DestinationAddress: "cosmos1xxx", FromAddress: utils.GenerateAccAddressForTest().String()},
+			true,
+		},
+		{
+			"valid",
+			types.MsgUpdateRedemptionDestination{ChainId: "cosmoshub-4", Hash: validHash, DestinationAddress: utils.GenerateAccAddressForTest().String(), FromAddress: utils.GenerateAccAddressForTest().String()},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// OffboardingStatusNone is the status of zones that are not offboarding; as the zero value, it is omitted when
+	// (un)marshalling.
+	OffboardingStatusNone int32 = iota
+	// OffboardingStatusUnbonding is the status of an offboarding zone while its delegations are undelegated and
+	// the resulting unbondings mature.
+	OffboardingStatusUnbonding
+	// OffboardingStatusSettling is the status of an offboarding zone while the balances of its delegation accounts
+	// are queried, to determine the final rate.
+	OffboardingStatusSettling
+	// OffboardingStatusClaimable is the status of an offboarding zone whose unbonded native assets may be claimed by
+	// redeeming qAssets at the final rate.
+	OffboardingStatusClaimable
+)
+
+// MaxOffboardingUndelegationsPerEpoch is the maximum number of undelegations issued per epoch by an offboarding zone.
+// Each delegation is undelegated in full by a single message, so each delegator and validator pair consumes a single
+// unbonding entry per epoch.
+const MaxOffboardingUndelegationsPerEpoch = 20
+
+// IsOffboarding returns true if the zone is being offboarded.
+func (z Zone) IsOffboarding() bool {
+	return z.OffboardingStatus != OffboardingStatusNone
+}
+
+// GetOffboardingRate returns the final rate of an offboarded zone, or zero if unset.
+func (z Zone) GetOffboardingRate() sdk.Dec {
+	if z.OffboardingRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.OffboardingRate
+}
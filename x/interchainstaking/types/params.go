@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
@@ -16,6 +17,7 @@ var (
 	DefaultValidatorSetInterval uint64  = 200
 	DefaultCommissionRate       sdk.Dec = sdk.MustNewDecFromStr("0.025")
 	DefaultUnbondingEnabled             = false
+	DefaultFeeRoutes                    = []FeeRoute{}
 
 	// KeyDepositInterval is store's key for the DepositInterval option
 	KeyDepositInterval = []byte("DepositInterval")
@@ -25,6 +27,8 @@ var (
 	KeyCommissionRate = []byte("CommissionRate")
 	// KeyUnbondingEnabled is a globla flag to indicated whether unbonding txs are permitted
 	KeyUnbondingEnabled = []byte("UnbondingEnabled")
+	// KeyFeeRoutes is store's key for the FeeRoutes option
+	KeyFeeRoutes = []byte("FeeRoutes")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -58,12 +62,14 @@ func NewParams(
 	valsetInterval uint64,
 	commissionRate sdk.Dec,
 	unbondingEnabled bool,
+	feeRoutes []FeeRoute,
 ) Params {
 	return Params{
 		DepositInterval:      depositInterval,
 		ValidatorsetInterval: valsetInterval,
 		CommissionRate:       commissionRate,
 		UnbondingEnabled:     unbondingEnabled,
+		FeeRoutes:            feeRoutes,
 	}
 }
 
@@ -74,6 +80,7 @@ func DefaultParams() Params {
 		DefaultValidatorSetInterval,
 		DefaultCommissionRate,
 		DefaultUnbondingEnabled,
+		DefaultFeeRoutes,
 	)
 }
 
@@ -91,12 +98,25 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid commission rate: %w", err)
 	}
 
+	if err := ValidateFeeRoutes(p.FeeRoutes, nil); err != nil {
+		return fmt.Errorf("invalid fee routes: %w", err)
+	}
+
 	return nil
 }
 
-// ParamKeyTable for ics module.
-func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+// ParamKeyTable for ics module. Param changes that route fees to an address for which blockedAddr returns true are
+// rejected.
+func ParamKeyTable(blockedAddr func(sdk.AccAddress) bool) paramtypes.KeyTable {
+	pairs := (&Params{}).ParamSetPairs()
+	for i := range pairs {
+		if bytes.Equal(pairs[i].Key, KeyFeeRoutes) {
+			pairs[i].ValidatorFn = func(i interface{}) error {
+				return validateFeeRoutesFor(i, blockedAddr)
+			}
+		}
+	}
+	return paramtypes.NewKeyTable(pairs...)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -106,6 +126,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyValidatorSetInterval, &p.ValidatorsetInterval, validatePositiveInt),
 		paramtypes.NewParamSetPair(KeyCommissionRate, &p.CommissionRate, validateNonNegativeDec),
 		paramtypes.NewParamSetPair(KeyUnbondingEnabled, &p.UnbondingEnabled, validateBoolean),
+		paramtypes.NewParamSetPair(KeyFeeRoutes, &p.FeeRoutes, validateFeeRoutes),
 	}
 }
 
@@ -137,6 +158,19 @@ func validateBoolean(i interface{}) error {
 	return nil
 }
 
+func validateFeeRoutes(i interface{}) error {
+	return validateFeeRoutesFor(i, nil)
+}
+
+func validateFeeRoutesFor(i interface{}, blockedAddr func(sdk.AccAddress) bool) error {
+	routes, ok := i.([]FeeRoute)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateFeeRoutes(routes, blockedAddr)
+}
+
 func validatePositiveInt(i interface{}) error {
 	intval, ok := i.(uint64)
 	if !ok {
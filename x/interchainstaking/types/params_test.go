@@ -6,13 +6,40 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ingenuity-build/quicksilver/utils"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate(), "default")
-	require.NoError(t, types.NewParams(1, 1, sdk.NewDec(1), true).Validate(), "valid")
-	require.Error(t, types.NewParams(0, 1, sdk.NewDec(1), true).Validate(), "0 deposit interval")
-	require.Error(t, types.NewParams(1, 0, sdk.NewDec(1), true).Validate(), "0 valset interval")
-	require.Error(t, types.NewParams(1, 1, sdk.NewDec(-1), true).Validate(), "negative commission rate")
+	require.NoError(t, types.NewParams(1, 1, sdk.NewDec(1), true, nil).Validate(), "valid")
+	require.Error(t, types.NewParams(0, 1, sdk.NewDec(1), true, nil).Validate(), "0 deposit interval")
+	require.Error(t, types.NewParams(1, 0, sdk.NewDec(1), true, nil).Validate(), "0 valset interval")
+	require.Error(t, types.NewParams(1, 1, sdk.NewDec(-1), true, nil).Validate(), "negative commission rate")
+
+	treasury := utils.GenerateAccAddressForTest().String()
+	routes := []types.FeeRoute{
+		{Destination: types.FeeDestinationCommunityPool, Share: sdk.NewDecWithPrec(2, 1)},
+		{Destination: types.FeeDestinationTreasury, Address: treasury, Share: sdk.NewDecWithPrec(3, 1)},
+		{Destination: types.FeeDestinationParticipationRewards, Share: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, types.NewParams(1, 1, sdk.NewDec(1), true, routes).Validate(), "valid fee routes")
+
+	tests := []struct {
+		name  string
+		route types.FeeRoute
+	}{
+		{"unknown destination", types.FeeRoute{Destination: 5, Share: sdk.NewDecWithPrec(1, 1)}},
+		{"missing treasury address", types.FeeRoute{Destination: types.FeeDestinationTreasury, Share: sdk.NewDecWithPrec(1, 1)}},
+		{"community pool address", types.FeeRoute{Destination: types.FeeDestinationCommunityPool, Address: treasury, Share: sdk.NewDecWithPrec(1, 1)}},
+		{"zero share", types.FeeRoute{Destination: types.FeeDestinationBuyback, Address: treasury, Share: sdk.ZeroDec()}},
+		{"shares exceed one", types.FeeRoute{Destination: types.FeeDestinationBuyback, Address: treasury, Share: sdk.NewDecWithPrec(6, 1)}},
+	}
+	for _, tt := range tests {
+		require.Error(t, types.NewParams(1, 1, sdk.NewDec(1), true, append(routes[:2:2], tt.route)).Validate(), tt.name)
+	}
+
+	blocked := func(addr sdk.AccAddress) bool { return addr.String() == treasury }
+	require.Error(t, types.ValidateFeeRoutes(routes, blocked), "blocked treasury address")
+	require.NoError(t, types.ValidateFeeRoutes(routes[:1], blocked), "blocked address not routed to")
 }
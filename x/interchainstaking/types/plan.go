@@ -0,0 +1,119 @@
+package types
+
+import (
+	"math"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorPlan returns a ValidatorPlan for the given validator with no planned movements.
+func NewValidatorPlan(valoper string, current sdkmath.Int, locked sdkmath.Int) *ValidatorPlan {
+	return &ValidatorPlan{
+		ValoperAddress: valoper,
+		Current:        current,
+		Delegate:       sdk.ZeroInt(),
+		Undelegate:     sdk.ZeroInt(),
+		RedelegateIn:   sdk.ZeroInt(),
+		RedelegateOut:  sdk.ZeroInt(),
+		Locked:         locked,
+		Projected:      current,
+	}
+}
+
+// ValidatorPlans is a map of validator address to ValidatorPlan.
+type ValidatorPlans map[string]*ValidatorPlan
+
+// ApplyRebalances adds the given redelegations to the source and target validator plans.
+func (vp ValidatorPlans) ApplyRebalances(rebalances []RebalanceTarget) {
+	for _, rebalance := range rebalances {
+		vp.get(rebalance.Source).RedelegateOut = vp.get(rebalance.Source).RedelegateOut.Add(rebalance.Amount)
+		vp.get(rebalance.Target).RedelegateIn = vp.get(rebalance.Target).RedelegateIn.Add(rebalance.Amount)
+	}
+}
+
+// ApplyDelegations adds the given per-validator delegation amounts to the validator plans.
+func (vp ValidatorPlans) ApplyDelegations(allocations map[string]sdkmath.Int) {
+	for valoper, amount := range allocations {
+		vp.get(valoper).Delegate = vp.get(valoper).Delegate.Add(amount)
+	}
+}
+
+// ApplyUndelegations adds the given per-validator undelegation amounts to the validator plans.
+func (vp ValidatorPlans) ApplyUndelegations(allocations map[string]sdkmath.Int) {
+	for valoper, amount := range allocations {
+		vp.get(valoper).Undelegate = vp.get(valoper).Undelegate.Add(amount)
+	}
+}
+
+func (vp ValidatorPlans) get(valoper string) *ValidatorPlan {
+	plan, found := vp[valoper]
+	if !found {
+		plan = NewValidatorPlan(valoper, sdk.ZeroInt(), sdk.ZeroInt())
+		vp[valoper] = plan
+	}
+	return plan
+}
+
+// Current returns the map of validator address to current delegated amount.
+func (vp ValidatorPlans) Current() map[string]sdkmath.Int {
+	out := make(map[string]sdkmath.Int, len(vp))
+	for valoper, plan := range vp {
+		out[valoper] = plan.Current
+	}
+	return out
+}
+
+// Projected returns the map of validator address to delegated amount once all planned movements are applied.
+func (vp ValidatorPlans) Projected() map[string]sdkmath.Int {
+	out := make(map[string]sdkmath.Int, len(vp))
+	for valoper, plan := range vp {
+		out[valoper] = plan.Current.Add(plan.Delegate).Sub(plan.Undelegate).Add(plan.RedelegateIn).Sub(plan.RedelegateOut)
+	}
+	return out
+}
+
+// Sorted returns the validator plans, with projected amounts populated, sorted by validator address.
+func (vp ValidatorPlans) Sorted() []ValidatorPlan {
+	projected := vp.Projected()
+	out := make([]ValidatorPlan, 0, len(vp))
+	for valoper, plan := range vp {
+		plan.Projected = projected[valoper]
+		out = append(out, *plan)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ValoperAddress < out[j].ValoperAddress })
+	return out
+}
+
+// AllocationsAsIntent returns the given map of validator address to delegated amount as normalized ValidatorIntents.
+func AllocationsAsIntent(allocations map[string]sdkmath.Int) ValidatorIntents {
+	intents := make(ValidatorIntents, 0, len(allocations))
+	sum := sdk.ZeroInt()
+	for valoper, amount := range allocations {
+		intents = append(intents, &ValidatorIntent{ValoperAddress: valoper, Weight: sdk.NewDecFromInt(amount)})
+		sum = sum.Add(amount)
+	}
+	if sum.IsZero() {
+		return ValidatorIntents{}
+	}
+	return intents.Normalize()
+}
+
+// DistanceToTarget returns the euclidean distance between the current and target intents over the given validators.
+func DistanceToTarget(current ValidatorIntents, target ValidatorIntents, valopers []string) float64 {
+	preSqRt := sdk.ZeroDec()
+
+	for _, valoper := range valopers {
+		c := current.MustGetForValoper(valoper)
+		t := target.MustGetForValoper(valoper)
+		v := c.Weight.Sub(t.Weight)
+		preSqRt = preSqRt.AddMut(v.Mul(v))
+	}
+
+	psqrtf, err := preSqRt.Float64()
+	if err != nil {
+		panic("this value should never be greater than 64-bit dec!")
+	}
+	return math.Sqrt(psqrtf)
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestValidatorPlans(t *testing.T) {
+	plans := types.ValidatorPlans{
+		"val1": types.NewValidatorPlan("val1", sdk.NewInt(3000), sdk.ZeroInt()),
+		"val2": types.NewValidatorPlan("val2", sdk.NewInt(1000), sdk.NewInt(500)),
+	}
+
+	plans.ApplyRebalances([]types.RebalanceTarget{{Amount: sdk.NewInt(1000), Source: "val1", Target: "val3"}})
+	plans.ApplyDelegations(map[string]sdkmath.Int{"val2": sdk.NewInt(400)})
+	plans.ApplyUndelegations(map[string]sdkmath.Int{"val1": sdk.NewInt(200)})
+
+	sorted := plans.Sorted()
+	require.Len(t, sorted, 3)
+	require.Equal(t, "val1", sorted[0].ValoperAddress)
+	require.Equal(t, sdk.NewInt(1800), sorted[0].Projected)
+	require.Equal(t, sdk.NewInt(1400), sorted[1].Projected)
+	require.Equal(t, sdk.NewInt(500), sorted[1].Locked)
+	require.Equal(t, "val3", sorted[2].ValoperAddress)
+	require.Equal(t, sdk.NewInt(1000), sorted[2].RedelegateIn)
+	require.Equal(t, sdk.NewInt(1000), sorted[2].Projected)
+}
+
+func TestDistanceToTarget(t *testing.T) {
+	valopers := []string{"val1", "val2"}
+	target := types.ValidatorIntents{
+		{ValoperAddress: "val1", Weight: sdk.NewDecWithPrec(5, 1)},
+		{ValoperAddress: "val2", Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+
+	// current allocations already at target.
+	current := types.AllocationsAsIntent(map[string]sdkmath.Int{"val1": sdk.NewInt(100), "val2": sdk.NewInt(100)})
+	require.Equal(t, float64(0), types.DistanceToTarget(current, target, valopers))
+
+	// all allocated to a single validator.
+	current = types.AllocationsAsIntent(map[string]sdkmath.Int{"val1": sdk.NewInt(100)})
+	require.InDelta(t, 0.707107, types.DistanceToTarget(current, target, valopers), 0.000001)
+
+	// no allocations at all.
+	current = types.AllocationsAsIntent(map[string]sdkmath.Int{"val1": sdk.ZeroInt()})
+	require.Empty(t, current)
+	require.InDelta(t, 0.707107, types.DistanceToTarget(current, target, valopers), 0.000001)
+}
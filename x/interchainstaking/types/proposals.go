@@ -12,11 +12,13 @@ import (
 const (
 	ProposalTypeRegisterZone = "RegisterZone"
 	ProposalTypeUpdateZone   = "UpdateZone"
+	ProposalTypeOffboardZone = "OffboardZone"
 )
 
 var (
 	_ govv1beta1.Content = &RegisterZoneProposal{}
 	_ govv1beta1.Content = &UpdateZoneProposal{}
+	_ govv1beta1.Content = &OffboardZoneProposal{}
 )
 
 func NewRegisterZoneProposal(
@@ -159,6 +161,45 @@ func (m UpdateZoneProposal) String() string {
 	return b.String()
 }
 
+func NewOffboardZoneProposal(
+	title string,
+	description string,
+	chainID string,
+) *OffboardZoneProposal {
+	return &OffboardZoneProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+	}
+}
+
+func (m OffboardZoneProposal) GetDescription() string { return m.Description }
+func (m OffboardZoneProposal) GetTitle() string       { return m.Title }
+func (m OffboardZoneProposal) ProposalRoute() string  { return RouterKey }
+func (m OffboardZoneProposal) ProposalType() string   { return ProposalTypeOffboardZone }
+
+// ValidateBasic runs basic stateless validity checks
+func (m OffboardZoneProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if m.ChainId == "" {
+		return errors.New("chain id must not be empty")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (m OffboardZoneProposal) String() string {
+	return fmt.Sprintf(`Interchain Staking Zone Offboarding Proposal:
+  Title:       %s
+  Description: %s
+  Chain Id:    %s
+`, m.Title, m.Description, m.ChainId)
+}
+
 func (v UpdateZoneValue) Validate() error {
 	return nil
 }
@@ -411,6 +411,84 @@ func (m *MsgGovCloseChannelResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgGovCloseChannelResponse proto.InternalMessageInfo
 
+type OffboardZoneProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+}
+
+func (m *OffboardZoneProposal) Reset()      { *m = OffboardZoneProposal{} }
+func (*OffboardZoneProposal) ProtoMessage() {}
+func (*OffboardZoneProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{9}
+}
+func (m *OffboardZoneProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffboardZoneProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffboardZoneProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OffboardZoneProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffboardZoneProposal.Merge(m, src)
+}
+func (m *OffboardZoneProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *OffboardZoneProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffboardZoneProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OffboardZoneProposal proto.InternalMessageInfo
+
+type OffboardZoneProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	Deposit     string `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *OffboardZoneProposalWithDeposit) Reset()         { *m = OffboardZoneProposalWithDeposit{} }
+func (m *OffboardZoneProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*OffboardZoneProposalWithDeposit) ProtoMessage()    {}
+func (*OffboardZoneProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{10}
+}
+func (m *OffboardZoneProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffboardZoneProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffboardZoneProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OffboardZoneProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffboardZoneProposalWithDeposit.Merge(m, src)
+}
+func (m *OffboardZoneProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OffboardZoneProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffboardZoneProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OffboardZoneProposalWithDeposit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*RegisterZoneProposal)(nil), "quicksilver.interchainstaking.v1.RegisterZoneProposal")
 	proto.RegisterType((*RegisterZoneProposalWithDeposit)(nil), "quicksilver.interchainstaking.v1.RegisterZoneProposalWithDeposit")
@@ -421,6 +499,8 @@ func init() {
 	proto.RegisterType((*MsgGovReopenChannelResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovReopenChannelResponse")
 	proto.RegisterType((*MsgGovCloseChannel)(nil), "quicksilver.interchainstaking.v1.MsgGovCloseChannel")
 	proto.RegisterType((*MsgGovCloseChannelResponse)(nil), "quicksilver.interchainstaking.v1.MsgGovCloseChannelResponse")
+	proto.RegisterType((*OffboardZoneProposal)(nil), "quicksilver.interchainstaking.v1.OffboardZoneProposal")
+	proto.RegisterType((*OffboardZoneProposalWithDeposit)(nil), "quicksilver.interchainstaking.v1.OffboardZoneProposalWithDeposit")
 }
 
 func init() {
@@ -428,67 +508,69 @@ func init() {
 }
 
 var fileDescriptor_04d034c830a7acfe = []byte{
-	// 946 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0x8e, 0xf7, 0x3b, 0x93, 0xec, 0x47, 0xa7, 0x29, 0xb8, 0xdb, 0x6e, 0x1c, 0xcd, 0x01, 0x05,
-	0x95, 0xc6, 0x2c, 0xac, 0x00, 0x55, 0x42, 0x2a, 0xe9, 0x07, 0xec, 0xa1, 0x52, 0xe5, 0xe5, 0x43,
-	0x5a, 0x0e, 0x96, 0xe3, 0x19, 0x9c, 0xd1, 0x3a, 0x33, 0xae, 0x67, 0x1c, 0x35, 0xff, 0xa0, 0x47,
-	0x2e, 0x48, 0x1c, 0xf7, 0x3f, 0xd0, 0x1f, 0x81, 0x38, 0x55, 0x9c, 0x38, 0x59, 0x68, 0xf7, 0xc2,
-	0x15, 0x5f, 0xb9, 0x20, 0xcf, 0xd8, 0x1b, 0x6f, 0x12, 0xb4, 0x07, 0x44, 0x41, 0xe2, 0x36, 0xef,
-	0xfb, 0x3c, 0xef, 0xeb, 0xf1, 0xe3, 0xe7, 0x1d, 0x0f, 0x78, 0xf7, 0x59, 0x42, 0xfd, 0x13, 0x41,
-	0xc3, 0x31, 0x89, 0x6d, 0xca, 0x24, 0x89, 0xfd, 0xa1, 0x47, 0x99, 0x90, 0xde, 0x09, 0x65, 0x81,
-	0x3d, 0xde, 0xb7, 0xa3, 0x98, 0x47, 0x5c, 0x78, 0xa1, 0xe8, 0x45, 0x31, 0x97, 0x1c, 0x76, 0x2a,
-	0x15, 0xbd, 0xb9, 0x8a, 0xde, 0x78, 0x7f, 0xb7, 0x15, 0xf0, 0x80, 0x2b, 0xb2, 0x9d, 0xaf, 0x74,
-	0xdd, 0xee, 0x4d, 0x9f, 0x8b, 0x11, 0x17, 0xae, 0x06, 0x74, 0x50, 0x40, 0xb7, 0x03, 0xce, 0x83,
-	0x90, 0xd8, 0x5e, 0x44, 0x6d, 0x8f, 0x31, 0x2e, 0x3d, 0x49, 0x39, 0x2b, 0x50, 0xf4, 0x72, 0x05,
-	0xb4, 0x1c, 0x12, 0x50, 0x21, 0x49, 0x7c, 0xcc, 0x19, 0x79, 0x5a, 0x6c, 0x08, 0xb6, 0xc0, 0xaa,
-	0xa4, 0x32, 0x24, 0xa6, 0xd1, 0x31, 0xba, 0x75, 0x47, 0x07, 0xb0, 0x03, 0x1a, 0x98, 0x08, 0x3f,
-	0xa6, 0x51, 0xde, 0xc4, 0x5c, 0x52, 0x58, 0x35, 0x05, 0x3f, 0x06, 0x9b, 0x3e, 0x67, 0x8c, 0xf8,
-	0x79, 0xe4, 0x52, 0x6c, 0x2e, 0xe7, 0x9c, 0xbe, 0x99, 0xa5, 0x56, 0x6b, 0xe2, 0x8d, 0xc2, 0x7b,
-	0xe8, 0x12, 0x8c, 0x9c, 0xe6, 0x34, 0x3e, 0xc4, 0xf0, 0x00, 0x80, 0x81, 0x27, 0x88, 0x8b, 0x09,
-	0xe3, 0x23, 0x73, 0x45, 0xd5, 0xde, 0xc8, 0x52, 0xeb, 0x9a, 0xae, 0x9d, 0x62, 0xc8, 0xa9, 0xe7,
-	0xc1, 0xc3, 0x7c, 0x0d, 0x3f, 0x04, 0x8d, 0x90, 0xfb, 0x5e, 0x58, 0x94, 0xad, 0xaa, 0xb2, 0x37,
-	0xb2, 0xd4, 0x82, 0xba, 0xac, 0x02, 0x22, 0x07, 0xa8, 0x48, 0x17, 0xde, 0x07, 0x5b, 0x9e, 0xef,
-	0xf3, 0x84, 0x49, 0x37, 0x8a, 0xc9, 0x37, 0xf4, 0xb9, 0xb9, 0xa6, 0x6a, 0x6f, 0x66, 0xa9, 0x75,
-	0x43, 0xd7, 0x5e, 0xc6, 0x91, 0xb3, 0x59, 0x24, 0x9e, 0xaa, 0x18, 0xee, 0x01, 0x30, 0x4a, 0x42,
-	0x49, 0x5d, 0x41, 0x18, 0x36, 0xd7, 0x3b, 0x46, 0x77, 0xc3, 0xa9, 0xab, 0xcc, 0x11, 0x61, 0x18,
-	0xbe, 0x0d, 0x76, 0x42, 0xfa, 0x2c, 0xa1, 0x98, 0xca, 0x89, 0x3b, 0xe2, 0x38, 0x09, 0x89, 0xb9,
-	0xa1, 0x48, 0xdb, 0x17, 0xf9, 0x27, 0x2a, 0x0d, 0xbb, 0x60, 0x27, 0x26, 0x32, 0x89, 0x99, 0x2b,
-	0xb9, 0xea, 0x46, 0x62, 0xb3, 0xae, 0xa8, 0x5b, 0x3a, 0xff, 0x39, 0x3f, 0x52, 0xd9, 0xbc, 0x29,
-	0x26, 0x11, 0x17, 0x54, 0x0a, 0x97, 0x30, 0x6f, 0x10, 0x12, 0x6c, 0x02, 0xdd, 0xb4, 0xcc, 0x3f,
-	0xd2, 0x69, 0x78, 0x07, 0x5c, 0x4b, 0xd8, 0x80, 0x33, 0x4c, 0x59, 0x70, 0xc1, 0x6d, 0x28, 0xee,
-	0xce, 0x05, 0x50, 0x92, 0x77, 0xc1, 0x06, 0x26, 0x3e, 0x1d, 0x79, 0xa1, 0x30, 0x9b, 0x1d, 0xa3,
-	0xbb, 0xec, 0x5c, 0xc4, 0xf7, 0x9a, 0x2f, 0x4e, 0xad, 0xda, 0xf7, 0xa7, 0x56, 0xed, 0xb7, 0x53,
-	0xab, 0x86, 0x7e, 0x58, 0x03, 0xd6, 0x22, 0xdb, 0x7c, 0x45, 0xe5, 0xf0, 0xa1, 0xde, 0x02, 0x7c,
-	0xeb, 0x92, 0x83, 0xfa, 0x3b, 0x59, 0x6a, 0x35, 0xb5, 0xa4, 0x2a, 0x8d, 0x4a, 0x4f, 0x7d, 0xb4,
-	0xc0, 0x53, 0xd5, 0x8f, 0x57, 0x01, 0xd1, 0xff, 0xdb, 0x6b, 0x07, 0xf3, 0x5e, 0xab, 0x6e, 0x78,
-	0x8a, 0xa1, 0xaa, 0x05, 0x1f, 0xff, 0x95, 0x05, 0xfb, 0xb7, 0xb2, 0xd4, 0x7a, 0xb3, 0xd8, 0xf5,
-	0x0c, 0x03, 0xcd, 0xfb, 0xf3, 0x1d, 0xb0, 0x5e, 0xb8, 0x4b, 0xd9, 0xb2, 0xde, 0x87, 0x59, 0x6a,
-	0x6d, 0x95, 0xdf, 0x48, 0x01, 0xc8, 0x29, 0x29, 0xf0, 0xd1, 0x02, 0x37, 0x83, 0xd9, 0xa7, 0xce,
-	0x32, 0xd0, 0x9c, 0xd5, 0x1f, 0x2f, 0xb0, 0x7a, 0x63, 0xb6, 0xcd, 0x2c, 0x03, 0xcd, 0xcf, 0xc1,
-	0x67, 0x8b, 0xe6, 0xa0, 0x79, 0x75, 0xa3, 0xf9, 0x21, 0xb1, 0x2b, 0x43, 0xb2, 0x99, 0x0f, 0x49,
-	0xff, 0x7a, 0x96, 0x5a, 0xdb, 0x65, 0x03, 0x8d, 0xa0, 0xca, 0xe4, 0x6c, 0xbc, 0x28, 0xa7, 0xe6,
-	0xbb, 0x25, 0x00, 0xbf, 0x88, 0xb0, 0x27, 0xc9, 0xa5, 0xa3, 0xf6, 0x9f, 0x1f, 0x94, 0x1e, 0xd8,
-	0x50, 0xff, 0x91, 0xe9, 0x8c, 0x54, 0xf6, 0x5c, 0x22, 0xc8, 0x59, 0x57, 0xcb, 0x43, 0x0c, 0x5d,
-	0x90, 0x2f, 0x59, 0x40, 0x84, 0xb9, 0xd2, 0x59, 0xee, 0x36, 0xde, 0xdb, 0xef, 0x5d, 0xf5, 0x63,
-	0xea, 0x4d, 0x5f, 0xec, 0x4b, 0x2f, 0x4c, 0x48, 0xd5, 0x1d, 0x45, 0x2f, 0xfd, 0x80, 0x7c, 0x35,
-	0x73, 0x9a, 0xfc, 0xb4, 0x04, 0xf6, 0xe6, 0x75, 0x79, 0xbd, 0x67, 0xc9, 0x7f, 0x4d, 0xa2, 0xea,
-	0xb8, 0xad, 0x5e, 0x39, 0x6e, 0x15, 0x93, 0x7d, 0x0d, 0xb6, 0x67, 0x9e, 0x03, 0x3b, 0x60, 0xf9,
-	0x84, 0x4c, 0x0a, 0xed, 0xb6, 0xb2, 0xd4, 0x02, 0xba, 0xcd, 0x09, 0x99, 0x20, 0x27, 0x87, 0x72,
-	0x7d, 0xc7, 0x39, 0xb5, 0x50, 0xac, 0xa2, 0xaf, 0x4a, 0x23, 0x47, 0xc3, 0xe8, 0x0f, 0x03, 0x5c,
-	0x7f, 0x22, 0x82, 0x4f, 0xf9, 0xd8, 0x21, 0x3c, 0x22, 0xec, 0xc1, 0xd0, 0x63, 0x8c, 0xfc, 0x6b,
-	0xb7, 0x85, 0x3b, 0x60, 0x3d, 0xe2, 0xb1, 0xcc, 0x0b, 0x57, 0x66, 0x35, 0x2a, 0x00, 0xe4, 0xac,
-	0xe5, 0xab, 0x43, 0x0c, 0x3f, 0x00, 0x75, 0x2f, 0x91, 0x43, 0x1e, 0x53, 0x39, 0x29, 0x24, 0x35,
-	0x7f, 0x7e, 0x79, 0xb7, 0x55, 0xdc, 0x96, 0x3e, 0xc1, 0x38, 0x26, 0x42, 0x1c, 0xc9, 0x98, 0xb2,
-	0xc0, 0x99, 0x52, 0x2b, 0xd2, 0xee, 0x81, 0x5b, 0x0b, 0x5e, 0xde, 0x21, 0x22, 0xe2, 0x4c, 0x10,
-	0xf4, 0xbb, 0x01, 0xa0, 0xc6, 0x1f, 0x84, 0x5c, 0x90, 0xbf, 0xab, 0xcd, 0x01, 0x00, 0xbe, 0x6e,
-	0x31, 0x15, 0xa6, 0x72, 0xda, 0x4f, 0x31, 0xe4, 0xd4, 0x8b, 0xe0, 0xf5, 0x4b, 0x72, 0x1b, 0xec,
-	0xce, 0xbf, 0x72, 0xa9, 0x48, 0xff, 0xf8, 0xc7, 0xb3, 0xb6, 0xf1, 0xea, 0xac, 0x6d, 0xfc, 0x7a,
-	0xd6, 0x36, 0xbe, 0x3d, 0x6f, 0xd7, 0x5e, 0x9d, 0xb7, 0x6b, 0xbf, 0x9c, 0xb7, 0x6b, 0xc7, 0xf7,
-	0x03, 0x2a, 0x87, 0xc9, 0xa0, 0xe7, 0xf3, 0x91, 0x4d, 0x59, 0x40, 0x58, 0x42, 0xe5, 0xe4, 0xee,
-	0x20, 0xa1, 0x21, 0xb6, 0xab, 0xb7, 0xe6, 0xe7, 0x0b, 0xee, 0xcd, 0x72, 0x12, 0x11, 0x31, 0x58,
-	0x53, 0x17, 0xd8, 0xf7, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x0b, 0x94, 0xc4, 0x65, 0x0b,
-	0x00, 0x00,
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xdb, 0xb4, 0x4d, 0x26, 0xe9, 0x9f, 0x9d, 0xcd, 0x82, 0xb7, 0xbb, 0x8d, 0xa3, 0x39,
+	0xa0, 0xa0, 0x65, 0x13, 0x0a, 0x15, 0xa0, 0x95, 0x90, 0x96, 0xec, 0x1f, 0xe8, 0x61, 0xc5, 0xca,
+	0xe5, 0x8f, 0x54, 0x0e, 0x91, 0xe3, 0x99, 0x3a, 0xa3, 0x3a, 0x33, 0x5e, 0xcf, 0x38, 0xda, 0x7c,
+	0x83, 0x3d, 0x72, 0x41, 0xe2, 0xd8, 0xef, 0xc0, 0x8a, 0xcf, 0x80, 0x38, 0xad, 0x38, 0x71, 0x8a,
+	0x50, 0x7b, 0x41, 0xe2, 0x84, 0xaf, 0x5c, 0x90, 0x67, 0x9c, 0xc4, 0x75, 0x82, 0x2a, 0x84, 0x28,
+	0x95, 0xb8, 0xcd, 0x7b, 0xbf, 0xf7, 0x9e, 0x9f, 0x7f, 0xfe, 0xbd, 0x67, 0x1b, 0xbc, 0xfd, 0x2c,
+	0xa2, 0xee, 0xb1, 0xa0, 0xfe, 0x90, 0x84, 0x6d, 0xca, 0x24, 0x09, 0xdd, 0xbe, 0x43, 0x99, 0x90,
+	0xce, 0x31, 0x65, 0x5e, 0x7b, 0xb8, 0xdb, 0x0e, 0x42, 0x1e, 0x70, 0xe1, 0xf8, 0xa2, 0x15, 0x84,
+	0x5c, 0x72, 0xd8, 0xc8, 0x64, 0xb4, 0xe6, 0x32, 0x5a, 0xc3, 0xdd, 0xed, 0x9a, 0xc7, 0x3d, 0xae,
+	0x82, 0xdb, 0xc9, 0x49, 0xe7, 0x6d, 0xdf, 0x74, 0xb9, 0x18, 0x70, 0xd1, 0xd5, 0x80, 0x36, 0x52,
+	0xe8, 0xb6, 0xc7, 0xb9, 0xe7, 0x93, 0xb6, 0x13, 0xd0, 0xb6, 0xc3, 0x18, 0x97, 0x8e, 0xa4, 0x9c,
+	0xa5, 0x28, 0x7a, 0x59, 0x04, 0x35, 0x9b, 0x78, 0x54, 0x48, 0x12, 0x1e, 0x72, 0x46, 0x9e, 0xa6,
+	0x0d, 0xc1, 0x1a, 0x58, 0x91, 0x54, 0xfa, 0xc4, 0x34, 0x1a, 0x46, 0xb3, 0x6c, 0x6b, 0x03, 0x36,
+	0x40, 0x05, 0x13, 0xe1, 0x86, 0x34, 0x48, 0x8a, 0x98, 0x4b, 0x0a, 0xcb, 0xba, 0xe0, 0x87, 0x60,
+	0xdd, 0xe5, 0x8c, 0x11, 0x37, 0xb1, 0xba, 0x14, 0x9b, 0xcb, 0x49, 0x4c, 0xc7, 0x8c, 0xc7, 0x56,
+	0x6d, 0xe4, 0x0c, 0xfc, 0x7b, 0xe8, 0x1c, 0x8c, 0xec, 0xea, 0xcc, 0xde, 0xc7, 0x70, 0x0f, 0x80,
+	0x9e, 0x23, 0x48, 0x17, 0x13, 0xc6, 0x07, 0x66, 0x51, 0xe5, 0xde, 0x88, 0xc7, 0xd6, 0x35, 0x9d,
+	0x3b, 0xc3, 0x90, 0x5d, 0x4e, 0x8c, 0x87, 0xc9, 0x19, 0xbe, 0x0f, 0x2a, 0x3e, 0x77, 0x1d, 0x3f,
+	0x4d, 0x5b, 0x51, 0x69, 0xaf, 0xc5, 0x63, 0x0b, 0xea, 0xb4, 0x0c, 0x88, 0x6c, 0xa0, 0x2c, 0x9d,
+	0x78, 0x1f, 0x6c, 0x38, 0xae, 0xcb, 0x23, 0x26, 0xbb, 0x41, 0x48, 0x8e, 0xe8, 0x73, 0x73, 0x55,
+	0xe5, 0xde, 0x8c, 0xc7, 0xd6, 0x0d, 0x9d, 0x7b, 0x1e, 0x47, 0xf6, 0x7a, 0xea, 0x78, 0xaa, 0x6c,
+	0xb8, 0x03, 0xc0, 0x20, 0xf2, 0x25, 0xed, 0x0a, 0xc2, 0xb0, 0xb9, 0xd6, 0x30, 0x9a, 0x25, 0xbb,
+	0xac, 0x3c, 0x07, 0x84, 0x61, 0xf8, 0x26, 0xd8, 0xf2, 0xe9, 0xb3, 0x88, 0x62, 0x2a, 0x47, 0xdd,
+	0x01, 0xc7, 0x91, 0x4f, 0xcc, 0x92, 0x0a, 0xda, 0x9c, 0xfa, 0x9f, 0x28, 0x37, 0x6c, 0x82, 0xad,
+	0x90, 0xc8, 0x28, 0x64, 0x5d, 0xc9, 0x55, 0x35, 0x12, 0x9a, 0x65, 0x15, 0xba, 0xa1, 0xfd, 0x9f,
+	0xf1, 0x03, 0xe5, 0x4d, 0x8a, 0x62, 0x12, 0x70, 0x41, 0xa5, 0xe8, 0x12, 0xe6, 0xf4, 0x7c, 0x82,
+	0x4d, 0xa0, 0x8b, 0x4e, 0xfc, 0x8f, 0xb4, 0x1b, 0xde, 0x01, 0xd7, 0x22, 0xd6, 0xe3, 0x0c, 0x53,
+	0xe6, 0x4d, 0x63, 0x2b, 0x2a, 0x76, 0x6b, 0x0a, 0x4c, 0x82, 0xb7, 0x41, 0x09, 0x13, 0x97, 0x0e,
+	0x1c, 0x5f, 0x98, 0xd5, 0x86, 0xd1, 0x5c, 0xb6, 0xa7, 0xf6, 0xbd, 0xea, 0x8b, 0x13, 0xab, 0xf0,
+	0xed, 0x89, 0x55, 0xf8, 0xf5, 0xc4, 0x2a, 0xa0, 0xef, 0x56, 0x81, 0xb5, 0x48, 0x36, 0x5f, 0x52,
+	0xd9, 0x7f, 0xa8, 0x5b, 0x80, 0x6f, 0x9c, 0x53, 0x50, 0x67, 0x2b, 0x1e, 0x5b, 0x55, 0x4d, 0xa9,
+	0x72, 0xa3, 0x89, 0xa6, 0x3e, 0x58, 0xa0, 0xa9, 0xec, 0xc3, 0xcb, 0x80, 0xe8, 0xff, 0xad, 0xb5,
+	0xbd, 0x79, 0xad, 0x65, 0x1b, 0x9e, 0x61, 0x28, 0x2b, 0xc1, 0xc7, 0x7f, 0x25, 0xc1, 0xce, 0xad,
+	0x78, 0x6c, 0xbd, 0x9e, 0x76, 0x9d, 0x8b, 0x40, 0xf3, 0xfa, 0x7c, 0x0b, 0xac, 0xa5, 0xea, 0x52,
+	0xb2, 0x2c, 0x77, 0x60, 0x3c, 0xb6, 0x36, 0x26, 0xcf, 0x48, 0x01, 0xc8, 0x9e, 0x84, 0xc0, 0x47,
+	0x0b, 0xd4, 0x0c, 0xf2, 0x57, 0xcd, 0x47, 0xa0, 0x39, 0xa9, 0x3f, 0x5e, 0x20, 0xf5, 0x4a, 0xbe,
+	0x4c, 0x3e, 0x02, 0xcd, 0xcf, 0xc1, 0x27, 0x8b, 0xe6, 0xa0, 0x7a, 0x71, 0xa1, 0xf9, 0x21, 0x69,
+	0x67, 0x86, 0x64, 0x3d, 0x19, 0x92, 0xce, 0xf5, 0x78, 0x6c, 0x6d, 0x4e, 0x0a, 0x68, 0x04, 0x65,
+	0x26, 0xa7, 0xf4, 0x62, 0x32, 0x35, 0xdf, 0x2c, 0x01, 0xf8, 0x79, 0x80, 0x1d, 0x49, 0xce, 0xad,
+	0xda, 0x7f, 0x7f, 0x50, 0x5a, 0xa0, 0xa4, 0xde, 0x23, 0xb3, 0x19, 0xc9, 0xf4, 0x3c, 0x41, 0x90,
+	0xbd, 0xa6, 0x8e, 0xfb, 0x18, 0x76, 0x41, 0x72, 0x64, 0x1e, 0x11, 0x66, 0xb1, 0xb1, 0xdc, 0xac,
+	0xbc, 0xb3, 0xdb, 0xba, 0xe8, 0xc5, 0xd4, 0x9a, 0xdd, 0xd8, 0x17, 0x8e, 0x1f, 0x91, 0xac, 0x3a,
+	0xd2, 0x5a, 0xfa, 0x02, 0xc9, 0x29, 0xb7, 0x4d, 0x7e, 0x5c, 0x02, 0x3b, 0xf3, 0xbc, 0x5c, 0xee,
+	0x2e, 0xb9, 0x6a, 0x14, 0x65, 0xc7, 0x6d, 0xe5, 0xc2, 0x71, 0xcb, 0x88, 0xec, 0x2b, 0xb0, 0x99,
+	0xbb, 0x0e, 0x6c, 0x80, 0xe5, 0x63, 0x32, 0x4a, 0xb9, 0xdb, 0x88, 0xc7, 0x16, 0xd0, 0x65, 0x8e,
+	0xc9, 0x08, 0xd9, 0x09, 0x94, 0xf0, 0x3b, 0x4c, 0x42, 0xcd, 0xa5, 0x3c, 0xbf, 0xca, 0x8d, 0x6c,
+	0x0d, 0xa3, 0x3f, 0x0c, 0x70, 0xfd, 0x89, 0xf0, 0x3e, 0xe6, 0x43, 0x9b, 0xf0, 0x80, 0xb0, 0x07,
+	0x7d, 0x87, 0x31, 0xf2, 0x9f, 0x7d, 0x2d, 0xdc, 0x01, 0x6b, 0x01, 0x0f, 0x65, 0x92, 0x58, 0xcc,
+	0x73, 0x94, 0x02, 0xc8, 0x5e, 0x4d, 0x4e, 0xfb, 0x18, 0xbe, 0x07, 0xca, 0x4e, 0x24, 0xfb, 0x3c,
+	0xa4, 0x72, 0x94, 0x52, 0x6a, 0xfe, 0xf4, 0xf2, 0x6e, 0x2d, 0xfd, 0x5a, 0xfa, 0x08, 0xe3, 0x90,
+	0x08, 0x71, 0x20, 0x43, 0xca, 0x3c, 0x7b, 0x16, 0x9a, 0xa1, 0x76, 0x07, 0xdc, 0x5a, 0x70, 0xf3,
+	0x36, 0x11, 0x01, 0x67, 0x82, 0xa0, 0xdf, 0x0d, 0x00, 0x35, 0xfe, 0xc0, 0xe7, 0x82, 0xfc, 0x53,
+	0x6e, 0xf6, 0x00, 0x70, 0x75, 0x89, 0x19, 0x31, 0x99, 0x6d, 0x3f, 0xc3, 0x90, 0x5d, 0x4e, 0x8d,
+	0xcb, 0xa7, 0xe4, 0x36, 0xd8, 0x9e, 0xbf, 0xe5, 0x29, 0x23, 0xdf, 0x1b, 0xa0, 0xf6, 0xe9, 0xd1,
+	0x51, 0x8f, 0x3b, 0x21, 0xbe, 0xda, 0x2b, 0x2f, 0xb7, 0x91, 0x7e, 0x33, 0x80, 0xb5, 0xa8, 0xf1,
+	0xab, 0xbd, 0x93, 0x32, 0x2b, 0xa3, 0xf8, 0x37, 0x56, 0x46, 0xe7, 0xf0, 0x87, 0xd3, 0xba, 0xf1,
+	0xea, 0xb4, 0x6e, 0xfc, 0x72, 0x5a, 0x37, 0xbe, 0x3e, 0xab, 0x17, 0x5e, 0x9d, 0xd5, 0x0b, 0x3f,
+	0x9f, 0xd5, 0x0b, 0x87, 0xf7, 0x3d, 0x2a, 0xfb, 0x51, 0xaf, 0xe5, 0xf2, 0x41, 0x9b, 0x32, 0x8f,
+	0xb0, 0x88, 0xca, 0xd1, 0xdd, 0x5e, 0x44, 0x7d, 0xdc, 0xce, 0xfe, 0xdc, 0x3c, 0x5f, 0xf0, 0x7b,
+	0x23, 0x47, 0x01, 0x11, 0xbd, 0x55, 0xf5, 0x9f, 0xf1, 0xee, 0x9f, 0x03, 0x00, 0xde, 0x94, 0xaf,
+	0x19, 0x0c, 0x0d, 0x00, 0x00,
 }
 
 func (m *RegisterZoneProposal) Marshal() (dAtA []byte, err error) {
@@ -1060,6 +1142,101 @@ func (m *MsgGovCloseChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *OffboardZoneProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffboardZoneProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffboardZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OffboardZoneProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffboardZoneProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffboardZoneProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposals(v)
 	base := offset
@@ -1328,6 +1505,52 @@ func (m *MsgGovCloseChannelResponse) Size() (n int) {
 	return n
 }
 
+func (m *OffboardZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *OffboardZoneProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
 func sovProposals(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3114,6 +3337,330 @@ func (m *MsgGovCloseChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OffboardZoneProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffboardZoneProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffboardZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OffboardZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffboardZoneProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffboardZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposals(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -192,6 +192,40 @@ func TestRegisterZoneProposal_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestOffboardZoneProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal *types.OffboardZoneProposal
+		wantErr  bool
+	}{
+		{
+			name:     "test valid",
+			proposal: types.NewOffboardZoneProposal("Offboard testzone-1", "offboard testzone-1", "testzone-1"),
+			wantErr:  false,
+		},
+		{
+			name:     "invalid gov content",
+			proposal: types.NewOffboardZoneProposal("", "", "testzone-1"),
+			wantErr:  true,
+		},
+		{
+			name:     "empty chain id",
+			proposal: types.NewOffboardZoneProposal("Offboard testzone-1", "offboard testzone-1", ""),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 var sink interface{}
 
 func BenchmarkUpdateZoneProposalString(b *testing.B) {
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRateLimit returns a RateLimit of rate applied to base, with used already consumed. The limit is disabled where
+// rate or base is not positive; a zone with nothing at stake is not limited.
+func NewRateLimit(denom string, rate sdk.Dec, base sdkmath.Int, used sdkmath.Int) RateLimit {
+	if !rate.IsPositive() || !base.IsPositive() {
+		return RateLimit{Enabled: false, Denom: denom, Limit: sdk.ZeroInt(), Used: used, Remaining: sdk.ZeroInt()}
+	}
+
+	limit := rate.MulInt(base).TruncateInt()
+	remaining := sdk.ZeroInt()
+	if limit.GT(used) {
+		remaining = limit.Sub(used)
+	}
+	return RateLimit{Enabled: true, Denom: denom, Limit: limit, Used: used, Remaining: remaining}
+}
+
+// Allows returns true if amount may be consumed without exceeding the limit.
+func (rl RateLimit) Allows(amount sdkmath.Int) bool {
+	return !rl.Enabled || amount.LTE(rl.Remaining)
+}
+
+// GetDepositValue returns the value of the deposited assets in the zone base denom, valuing each denom separately;
+// the base denom at par, and tokenized shares, of denom {valoper}/{record}, at the tokens per share of their validator.
+// Shares of a validator without known shares are valued at par.
+func (z *Zone) GetDepositValue(assets sdk.Coins) sdkmath.Int {
+	value := sdk.ZeroInt()
+	for _, coin := range assets {
+		if coin.Denom == z.BaseDenom {
+			value = value.Add(coin.Amount)
+			continue
+		}
+		val, found := z.GetValidatorByValoper(strings.Split(coin.Denom, "/")[0])
+		if !found || !val.DelegatorShares.IsPositive() {
+			value = value.Add(coin.Amount)
+			continue
+		}
+		value = value.Add(sdk.NewDecFromInt(coin.Amount).MulInt(val.VotingPower).Quo(val.DelegatorShares).TruncateInt())
+	}
+	return value
+}
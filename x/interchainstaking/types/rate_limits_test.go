@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestRateLimit(t *testing.T) {
+	// disabled by zero rate.
+	rl := types.NewRateLimit("uatom", sdk.ZeroDec(), sdk.NewInt(1000), sdk.NewInt(500))
+	require.False(t, rl.Enabled)
+	require.True(t, rl.Allows(sdk.NewInt(1000000)))
+
+	// disabled by zero base.
+	rl = types.NewRateLimit("uatom", sdk.NewDecWithPrec(1, 1), sdk.ZeroInt(), sdk.ZeroInt())
+	require.False(t, rl.Enabled)
+	require.True(t, rl.Allows(sdk.NewInt(1000000)))
+
+	rl = types.NewRateLimit("uatom", sdk.NewDecWithPrec(1, 1), sdk.NewInt(1000), sdk.NewInt(40))
+	require.True(t, rl.Enabled)
+	require.Equal(t, sdk.NewInt(100), rl.Limit)
+	require.Equal(t, sdk.NewInt(60), rl.Remaining)
+	require.True(t, rl.Allows(sdk.NewInt(60)))
+	require.False(t, rl.Allows(sdk.NewInt(61)))
+
+	// usage beyond the limit (e.g. after the base shrinks) leaves no headroom.
+	rl = types.NewRateLimit("uatom", sdk.NewDecWithPrec(1, 1), sdk.NewInt(1000), sdk.NewInt(150))
+	require.True(t, rl.Remaining.IsZero())
+	require.False(t, rl.Allows(sdk.OneInt()))
+}
+
+func TestGetDepositValue(t *testing.T) {
+	valoper := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	zone := types.Zone{
+		ChainId:   "cosmoshub-4",
+		BaseDenom: "uatom",
+		Validators: []*types.Validator{
+			{ValoperAddress: valoper, VotingPower: sdk.NewInt(900), DelegatorShares: sdk.NewDec(1000)},
+		},
+	}
+
+	// tokenized shares are valued at the tokens per share of their validator, rather than at par with the base denom.
+	assets := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)), sdk.NewCoin(valoper+"/1", sdk.NewInt(100)))
+	require.Equal(t, sdk.NewInt(190), zone.GetDepositValue(assets))
+
+	// shares of unknown validators are valued at par.
+	require.Equal(t, sdk.NewInt(100), zone.GetDepositValue(sdk.NewCoins(sdk.NewCoin("cosmosvaloper1unknown/1", sdk.NewInt(100)))))
+	require.Equal(t, sdk.ZeroInt(), zone.GetDepositValue(sdk.Coins{}))
+}
@@ -57,10 +57,40 @@ func CalculateDeltas(currentAllocations map[string]sdkmath.Int, currentSum sdkma
 	return deltas
 }
 
-type RebalanceTarget struct {
-	Amount sdkmath.Int
-	Source string
-	Target string
+// DefaultMaxRebalanceTotal is the default maximum proportion of unlocked stake that may be redelegated at any one time.
+var DefaultMaxRebalanceTotal = sdk.NewDecWithPrec(5, 1)
+
+const (
+	// DefaultRebalanceEpochs is the default number of epochs over which total stake may be redelegated; i.e. by
+	// default, at most 1/DefaultRebalanceEpochs of total stake may be redelegated per epoch.
+	DefaultRebalanceEpochs int64 = 7
+	// DefaultMaxRebalanceIterations is the default maximum number of redelegations per epoch.
+	DefaultMaxRebalanceIterations uint64 = 20
+)
+
+// RebalanceLimits bounds the redelegations determined by DetermineAllocationsForRebalancing. A nil MaxPerEpoch
+// applies the default per-epoch limit of 1/DefaultRebalanceEpochs.
+type RebalanceLimits struct {
+	MaxTotal      sdk.Dec
+	MaxPerEpoch   sdk.Dec
+	MaxIterations uint64
+}
+
+// DefaultRebalanceLimits returns the RebalanceLimits applied to zones that do not override them.
+func DefaultRebalanceLimits() RebalanceLimits {
+	return RebalanceLimits{
+		MaxTotal:      DefaultMaxRebalanceTotal,
+		MaxIterations: DefaultMaxRebalanceIterations,
+	}
+}
+
+// MaxPerEpochOf returns the maximum amount of total stake that may be redelegated per epoch. The default limit is
+// applied by integer division, as 1/DefaultRebalanceEpochs is not exactly representable as a Dec.
+func (l RebalanceLimits) MaxPerEpochOf(total sdkmath.Int) sdkmath.Int {
+	if l.MaxPerEpoch.IsNil() {
+		return total.QuoRaw(DefaultRebalanceEpochs)
+	}
+	return l.MaxPerEpoch.MulInt(total).TruncateInt()
 }
 
 func DetermineAllocationsForRebalancing(
@@ -69,6 +99,7 @@ func DetermineAllocationsForRebalancing(
 	currentSum sdkmath.Int,
 	targetAllocations ValidatorIntents,
 	existingRedelegations []RedelegationRecord,
+	limits RebalanceLimits,
 	log log.Logger,
 ) []RebalanceTarget {
 	out := make([]RebalanceTarget, 0)
@@ -100,9 +131,8 @@ func DetermineAllocationsForRebalancing(
 		}
 	}
 
-	// TODO: make these params
-	maxCanRebalanceTotal := currentSum.Sub(sdkmath.NewInt(totalLocked)).Quo(sdk.NewInt(2))
-	maxCanRebalance := sdkmath.MinInt(maxCanRebalanceTotal, currentSum.Quo(sdk.NewInt(7)))
+	maxCanRebalanceTotal := limits.MaxTotal.MulInt(currentSum.Sub(sdkmath.NewInt(totalLocked))).TruncateInt()
+	maxCanRebalance := sdkmath.MinInt(maxCanRebalanceTotal, limits.MaxPerEpochOf(currentSum))
 	if log != nil {
 		log.Debug("Rebalancing", "totalLocked", totalLocked, "lockedPerValidator", lockedPerValidator, "canRebalanceTotal", maxCanRebalanceTotal, "canRebalanceEpoch", maxCanRebalance)
 	}
@@ -142,7 +172,7 @@ func DetermineAllocationsForRebalancing(
 	srcIdx := len(deltas) - 1
 	for i := 0; toRebalance.GT(sdk.ZeroInt()); {
 		i++
-		if i > 20 {
+		if uint64(i) > limits.MaxIterations {
 			break
 		}
 		src := deltas[srcIdx]
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RedemptionRateHistoryLength is the number of redemption rate records retained per zone. Once reached, the oldest
+// record is pruned as each new record is written.
+const RedemptionRateHistoryLength = 120
+
+var (
+	// MinSlashFraction is the smallest drop in a validator's tokens per share treated as a slash; smaller changes are
+	// attributed to rounding on the host chain.
+	MinSlashFraction = sdk.NewDecWithPrec(1, 6)
+	// MaxSlashAdjustment is the largest proportion by which a single slash may reduce the redemption rate ahead of the
+	// end of the epoch; 5% is the theoretical max if _all_ controlled tokens were tombstoned.
+	MaxSlashAdjustment = sdk.NewDecWithPrec(5, 2)
+)
+
+// SlashAdjustedRedemptionRate returns rate reduced by loss, in native assets, spread across supply, in qAssets. The
+// reduction is bounded by MaxSlashAdjustment. Where supply is not positive, rate is returned unchanged.
+func SlashAdjustedRedemptionRate(rate sdk.Dec, loss sdkmath.Int, supply sdkmath.Int) sdk.Dec {
+	if !supply.IsPositive() || !loss.IsPositive() {
+		return rate
+	}
+	adjusted := rate.Sub(sdk.NewDecFromInt(loss).QuoInt(supply))
+	floor := rate.Mul(sdk.OneDec().Sub(MaxSlashAdjustment))
+	if adjusted.LT(floor) {
+		return floor
+	}
+	return adjusted
+}
+
+// RedemptionRateTWAP returns the time-weighted average of the given records, which must be ordered oldest first.
+// Each rate is weighted by the time it was in effect; that is, until the next record or, for the latest record, until
+// now. Where no time has elapsed across the records, the simple mean is returned.
+func RedemptionRateTWAP(records []RedemptionRateRecord, now time.Time) sdk.Dec {
+	if len(records) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	weighted := sdk.ZeroDec()
+	sum := sdk.ZeroDec()
+	totalWeight := sdk.ZeroDec()
+	for idx, record := range records {
+		end := now
+		if idx+1 < len(records) {
+			end = records[idx+1].Time
+		}
+		weight := sdk.ZeroDec()
+		if end.After(record.Time) {
+			weight = sdk.NewDec(end.Sub(record.Time).Nanoseconds())
+		}
+		weighted = weighted.Add(record.Rate.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+		sum = sum.Add(record.Rate)
+	}
+
+	if totalWeight.IsZero() {
+		return sum.QuoInt64(int64(len(records)))
+	}
+	return weighted.Quo(totalWeight)
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestRedemptionRateTWAP(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	record := func(epoch int64, offset time.Duration, rate string) types.RedemptionRateRecord {
+		return types.RedemptionRateRecord{ChainId: "cosmoshub-4", Epoch: epoch, Time: start.Add(offset), Rate: sdk.MustNewDecFromStr(rate), Tvl: sdk.ZeroInt()}
+	}
+
+	tests := []struct {
+		name     string
+		records  []types.RedemptionRateRecord
+		now      time.Time
+		expected sdk.Dec
+	}{
+		{
+			name:     "no records",
+			records:  []types.RedemptionRateRecord{},
+			now:      start,
+			expected: sdk.ZeroDec(),
+		},
+		{
+			name:     "single record",
+			records:  []types.RedemptionRateRecord{record(1, 0, "1.05")},
+			now:      start.Add(time.Hour),
+			expected: sdk.MustNewDecFromStr("1.05"),
+		},
+		{
+			name:     "equal epochs",
+			records:  []types.RedemptionRateRecord{record(1, 0, "1.0"), record(2, time.Hour, "1.1")},
+			now:      start.Add(2 * time.Hour),
+			expected: sdk.MustNewDecFromStr("1.05"),
+		},
+		{
+			name:     "unequal epochs",
+			records:  []types.RedemptionRateRecord{record(1, 0, "1.0"), record(2, 3*time.Hour, "1.2")},
+			now:      start.Add(4 * time.Hour),
+			expected: sdk.MustNewDecFromStr("1.05"),
+		},
+		{
+			name:     "no elapsed time",
+			records:  []types.RedemptionRateRecord{record(1, 0, "1.0"), record(2, 0, "1.2")},
+			now:      start,
+			expected: sdk.MustNewDecFromStr("1.1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, types.RedemptionRateTWAP(tt.records, tt.now))
+		})
+	}
+}
+
+func TestSlashAdjustedRedemptionRate(t *testing.T) {
+	rate := sdk.MustNewDecFromStr("1.1")
+
+	// no supply or loss leaves the rate unchanged.
+	require.Equal(t, rate, types.SlashAdjustedRedemptionRate(rate, sdk.NewInt(1000), sdk.ZeroInt()))
+	require.Equal(t, rate, types.SlashAdjustedRedemptionRate(rate, sdk.ZeroInt(), sdk.NewInt(1000000)))
+
+	// loss of 10000 native assets across 1000000 qAssets.
+	require.Equal(t, sdk.MustNewDecFromStr("1.09"), types.SlashAdjustedRedemptionRate(rate, sdk.NewInt(10000), sdk.NewInt(1000000)))
+
+	// reduction is bounded at 5%.
+	require.Equal(t, sdk.MustNewDecFromStr("1.045"), types.SlashAdjustedRedemptionRate(rate, sdk.NewInt(500000), sdk.NewInt(1000000)))
+}
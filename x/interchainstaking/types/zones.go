@@ -1,12 +1,12 @@
 package types
 
 import (
-	"encoding/base64"
-	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -17,7 +17,233 @@ func (z Zone) IsUnbondingEnabled() bool    { return z.UnbondingEnabled }
 func (z Zone) SupportLsm() bool            { return z.LiquidityModule }
 
 func (z Zone) IsDelegateAddress(addr string) bool {
-	return z.DelegationAddress.Address == addr
+	_, found := z.GetDelegationAccountByAddress(addr)
+	return found
+}
+
+// GetDelegationAccounts returns the zone's delegation accounts; the delegation address, if set, followed by the
+// delegation shards.
+func (z Zone) GetDelegationAccounts() []*ICAAccount {
+	accounts := make([]*ICAAccount, 0, len(z.DelegationShards)+1)
+	if z.DelegationAddress != nil {
+		accounts = append(accounts, z.DelegationAddress)
+	}
+	for _, shard := range z.DelegationShards {
+		if shard != nil {
+			accounts = append(accounts, shard)
+		}
+	}
+	return accounts
+}
+
+// GetDelegationAccountByAddress returns the delegation account with the given address.
+func (z Zone) GetDelegationAccountByAddress(addr string) (*ICAAccount, bool) {
+	for _, account := range z.GetDelegationAccounts() {
+		if account.Address == addr {
+			return account, true
+		}
+	}
+	return nil, false
+}
+
+// GetDelegationAccountByPort returns the delegation account registered on the given port.
+func (z Zone) GetDelegationAccountByPort(portID string) (*ICAAccount, bool) {
+	for _, account := range z.GetDelegationAccounts() {
+		if account.PortName == portID {
+			return account, true
+		}
+	}
+	return nil, false
+}
+
+// GetRebalanceLimits returns the zone's rebalancing limits, substituting the defaults for unset values.
+func (z Zone) GetRebalanceLimits() RebalanceLimits {
+	limits := DefaultRebalanceLimits()
+	if !z.MaxRebalanceTotal.IsNil() && z.MaxRebalanceTotal.IsPositive() {
+		limits.MaxTotal = z.MaxRebalanceTotal
+	}
+	if !z.MaxRebalancePerEpoch.IsNil() && z.MaxRebalancePerEpoch.IsPositive() {
+		limits.MaxPerEpoch = z.MaxRebalancePerEpoch
+	}
+	if z.MaxRebalanceIterations > 0 {
+		limits.MaxIterations = z.MaxRebalanceIterations
+	}
+	return limits
+}
+
+// GetICAPacketLimits returns the zone's interchain account packet limits, substituting the defaults for unset values.
+// The timeout is capped at MaxICATimeout.
+func (z Zone) GetICAPacketLimits() ICAPacketLimits {
+	limits := DefaultICAPacketLimits()
+	if z.IcaTimeout > 0 {
+		limits.Timeout = MaxICATimeout
+		if z.IcaTimeout < uint64(MaxICATimeout/time.Second) {
+			limits.Timeout = time.Duration(z.IcaTimeout) * time.Second
+		}
+	}
+	if z.IcaChunkSize > 0 {
+		limits.ChunkSize = z.IcaChunkSize
+	}
+	limits.GasBudget = z.IcaGasBudget
+	return limits
+}
+
+// GetInstantRedemptionBufferRate returns the share of native assets to hold for instant redemptions, or zero if unset.
+func (z Zone) GetInstantRedemptionBufferRate() sdk.Dec {
+	if z.InstantRedemptionBufferRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.InstantRedemptionBufferRate
+}
+
+// GetInstantRedemptionFee returns the share of an instant redemption retained as a fee, or zero if unset.
+func (z Zone) GetInstantRedemptionFee() sdk.Dec {
+	if z.InstantRedemptionFee.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.InstantRedemptionFee
+}
+
+// GetInstantRedemptionFeeAmount returns the fee retained from an instant redemption of amount.
+func (z Zone) GetInstantRedemptionFeeAmount(amount sdkmath.Int) sdkmath.Int {
+	return z.GetInstantRedemptionFee().MulInt(amount).TruncateInt()
+}
+
+// GetInstantRedemptionBuffer returns the native assets held for instant redemptions, or zero if unset.
+func (z Zone) GetInstantRedemptionBuffer() sdkmath.Int {
+	if z.InstantRedemptionBuffer.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return z.InstantRedemptionBuffer
+}
+
+// SupportInstantRedemption returns true if redemptions may be paid from the instant redemption buffer. LSM zones
+// redeem via tokenized shares, so never use the buffer.
+func (z Zone) SupportInstantRedemption() bool {
+	return !z.LiquidityModule && z.DelegationAddress != nil && z.GetInstantRedemptionBufferRate().IsPositive()
+}
+
+// GetDepositLimitRate returns the proportion of TVL that may be deposited per epoch, or zero if unset.
+func (z Zone) GetDepositLimitRate() sdk.Dec {
+	if z.DepositLimitRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.DepositLimitRate
+}
+
+// GetRedemptionLimitRate returns the proportion of qAsset supply that may be redeemed per epoch, or zero if unset.
+func (z Zone) GetRedemptionLimitRate() sdk.Dec {
+	if z.RedemptionLimitRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.RedemptionLimitRate
+}
+
+// GetEpochDeposits returns the native assets deposited this epoch, or zero if unset.
+func (z Zone) GetEpochDeposits() sdkmath.Int {
+	if z.EpochDeposits.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return z.EpochDeposits
+}
+
+// GetEpochRedemptions returns the qAssets redeemed this epoch, or zero if unset.
+func (z Zone) GetEpochRedemptions() sdkmath.Int {
+	if z.EpochRedemptions.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return z.EpochRedemptions
+}
+
+// GetMaxCommissionRate returns the highest commission rate of an eligible validator, or zero if unset.
+func (z Zone) GetMaxCommissionRate() sdk.Dec {
+	if z.MaxCommissionRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.MaxCommissionRate
+}
+
+// GetMinUptime returns the lowest performance score of an eligible validator, or zero if unset.
+func (z Zone) GetMinUptime() sdk.Dec {
+	if z.MinUptime.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.MinUptime
+}
+
+// GetMaxValidatorShare returns the largest proportion of stake that may be targeted to a single validator, or zero if
+// unset.
+func (z Zone) GetMaxValidatorShare() sdk.Dec {
+	if z.MaxValidatorShare.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return z.MaxValidatorShare
+}
+
+// IsValidatorDenied returns true if the validator is in the zone's denylist.
+func (z Zone) IsValidatorDenied(valoper string) bool {
+	for _, denied := range z.ValidatorDenylist {
+		if denied == valoper {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidatorEligible returns true if the validator may be delegated to under the zone's eligibility rules.
+func (z Zone) IsValidatorEligible(val *Validator) bool {
+	// we should never let tombstoned validators into the list, even if they are explicitly selected
+	if val.Tombstoned {
+		return false
+	}
+
+	// we should never let denylist validators into the list, even if they are explicitly selected
+	if z.IsValidatorDenied(val.ValoperAddress) {
+		return false
+	}
+
+	if maxCommission := z.GetMaxCommissionRate(); maxCommission.IsPositive() && val.CommissionRate.GT(maxCommission) {
+		return false
+	}
+
+	// validators not yet scored by the performance account are given the benefit of the doubt.
+	if minUptime := z.GetMinUptime(); minUptime.IsPositive() && val.PerformanceScore != nil && val.PerformanceScore.LT(minUptime) {
+		return false
+	}
+
+	return true
+}
+
+// ApplyEligibility removes intents for ineligible validators, redistributing their weight across the remainder, and
+// caps the weight of each remaining intent at the zone's maximum validator share.
+func (z *Zone) ApplyEligibility(intents ValidatorIntents) ValidatorIntents {
+	var filteredIntents ValidatorIntents
+	filteredWeight := sdk.ZeroDec()
+	removed := false
+	for _, v := range intents {
+		val, found := z.GetValidatorByValoper(v.ValoperAddress)
+		// this case should not happen as we check the validity of a validator entry when intent is set.
+		if !found {
+			removed = true
+			continue
+		}
+		if !z.IsValidatorEligible(val) {
+			removed = true
+			continue
+		}
+		filteredIntents = append(filteredIntents, v)
+		filteredWeight = filteredWeight.Add(v.Weight)
+	}
+
+	if removed && filteredWeight.IsPositive() {
+		filteredIntents = filteredIntents.Normalize()
+	}
+
+	if maxShare := z.GetMaxValidatorShare(); maxShare.IsPositive() && len(filteredIntents) > 0 {
+		filteredIntents = filteredIntents.Cap(maxShare)
+	}
+
+	return filteredIntents
 }
 
 func (z *Zone) GetValidatorByValoper(valoper string) (*Validator, bool) {
@@ -45,6 +271,10 @@ COINS:
 			continue
 		}
 
+		if _, found := z.GetDepositAsset(coin.Denom); found {
+			continue
+		}
+
 		for _, v := range zoneVals {
 			if strings.HasPrefix(coin.Denom, v) {
 				// continue 2 levels
@@ -102,14 +332,11 @@ func (z *Zone) ConvertMemoToOrdinalIntents(coins sdk.Coins, memo string) (Valida
 	// should we be return DelegatorIntent here?
 	out := make(ValidatorIntents, 0)
 
-	if len(memo) == 0 {
-		return out, errors.New("memo length unexpectedly zero")
-	}
-
-	memoBytes, err := base64.StdEncoding.DecodeString(memo)
+	depositMemo, err := ParseDepositMemo(memo)
 	if err != nil {
-		return out, fmt.Errorf("unable to determine intent from memo: Failed to decode base64 message: %s", err.Error())
+		return out, err
 	}
+	memoBytes := depositMemo.Intent
 
 	if len(memoBytes)%21 != 0 { // memo must be one byte (1-200) weight then 20 byte valoperAddress
 		return out, fmt.Errorf("unable to determine intent from memo: Message was incorrect length: %d", len(memoBytes))
@@ -171,36 +398,16 @@ func (z Zone) GetBondedValidatorAddressesAsSlice() []string {
 	return l
 }
 
+// GetAggregateIntentOrDefault returns the zone's aggregate intent, or the default intent if none is set, with the
+// zone's validator eligibility rules applied. Where no validator in the aggregate intent is eligible, the default
+// intent is used.
 func (z *Zone) GetAggregateIntentOrDefault() ValidatorIntents {
-	var intents ValidatorIntents
-	var filteredIntents ValidatorIntents
-
-	if len(z.AggregateIntent) == 0 {
-		intents = z.DefaultAggregateIntents()
-	} else {
-		intents = z.AggregateIntent
-	}
-	// filter intents here...
-	// check validators for tombstoned
-	for _, v := range intents {
-		val, found := z.GetValidatorByValoper(v.ValoperAddress)
-		// this case should not happen as we check the validity of a validator entry when intent is set.
-		if !found {
-			continue
+	if len(z.AggregateIntent) > 0 {
+		if filteredIntents := z.ApplyEligibility(z.AggregateIntent); len(filteredIntents) > 0 {
+			return filteredIntents
 		}
-		// we should never let tombstoned validators into the list, even if they are explicitly selected
-		if val.Tombstoned {
-			continue
-		}
-
-		// we should never let denylist validators into the list, even if they are explicitly selected
-		// if in deny list {
-		// continue
-		// }
-		filteredIntents = append(filteredIntents, v)
 	}
-
-	return filteredIntents
+	return z.ApplyEligibility(z.DefaultAggregateIntents())
 }
 
 // DefaultAggregateIntents determines the default aggregate intent (for epoch 0)
@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -20,6 +22,82 @@ func TestIsDelegateAddress(t *testing.T) {
 	zone := types.Zone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom", DelegationAddress: &types.ICAAccount{Address: bech32}}
 	require.True(t, zone.IsDelegateAddress(bech32))
 	require.False(t, zone.IsDelegateAddress(bech322))
+
+	zone.DelegationShards = []*types.ICAAccount{{Address: bech322}}
+	require.True(t, zone.IsDelegateAddress(bech322))
+}
+
+func TestGetDelegationAccounts(t *testing.T) {
+	zone := types.Zone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	require.Equal(t, 0, len(zone.GetDelegationAccounts()))
+
+	primary := &types.ICAAccount{Address: utils.GenerateAccAddressForTestWithPrefix("cosmos"), PortName: "icacontroller-cosmoshub-4.delegate"}
+	shard := &types.ICAAccount{Address: utils.GenerateAccAddressForTestWithPrefix("cosmos"), PortName: "icacontroller-cosmoshub-4.delegate.1"}
+	zone.DelegationShards = []*types.ICAAccount{shard}
+	require.Equal(t, []*types.ICAAccount{shard}, zone.GetDelegationAccounts())
+
+	zone.DelegationAddress = primary
+	require.Equal(t, []*types.ICAAccount{primary, shard}, zone.GetDelegationAccounts())
+
+	account, found := zone.GetDelegationAccountByAddress(shard.Address)
+	require.True(t, found)
+	require.Equal(t, shard, account)
+
+	account, found = zone.GetDelegationAccountByPort("icacontroller-cosmoshub-4.delegate")
+	require.True(t, found)
+	require.Equal(t, primary, account)
+
+	_, found = zone.GetDelegationAccountByPort("icacontroller-cosmoshub-4.delegate.2")
+	require.False(t, found)
+
+	require.Equal(t, "cosmoshub-4.delegate", types.GetDelegationPortOwner("cosmoshub-4", 0))
+	require.Equal(t, "cosmoshub-4.delegate.2", types.GetDelegationPortOwner("cosmoshub-4", 2))
+}
+
+func TestGetRebalanceLimits(t *testing.T) {
+	zone := types.Zone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	require.Equal(t, types.DefaultRebalanceLimits(), zone.GetRebalanceLimits())
+	require.Equal(t, sdk.NewInt(142857142), zone.GetRebalanceLimits().MaxPerEpochOf(sdk.NewInt(999999999)))
+
+	zone.MaxRebalanceTotal = sdk.ZeroDec()
+	zone.MaxRebalancePerEpoch = sdk.ZeroDec()
+	require.Equal(t, types.DefaultRebalanceLimits(), zone.GetRebalanceLimits())
+
+	zone.MaxRebalanceTotal = sdk.NewDecWithPrec(25, 2)
+	zone.MaxRebalancePerEpoch = sdk.NewDecWithPrec(1, 1)
+	zone.MaxRebalanceIterations = 5
+	require.Equal(t, types.RebalanceLimits{MaxTotal: sdk.NewDecWithPrec(25, 2), MaxPerEpoch: sdk.NewDecWithPrec(1, 1), MaxIterations: 5}, zone.GetRebalanceLimits())
+	require.Equal(t, sdk.NewInt(99999999), zone.GetRebalanceLimits().MaxPerEpochOf(sdk.NewInt(999999999)))
+}
+
+func TestGetICAPacketLimits(t *testing.T) {
+	zone := types.Zone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	require.Equal(t, types.DefaultICAPacketLimits(), zone.GetICAPacketLimits())
+
+	zone.IcaTimeout = 3600
+	zone.IcaChunkSize = 10
+	zone.IcaGasBudget = 1000000
+	require.Equal(t, types.ICAPacketLimits{Timeout: time.Hour, ChunkSize: 10, GasBudget: 1000000}, zone.GetICAPacketLimits())
+
+	// timeouts are capped, rather than overflowing.
+	zone.IcaTimeout = math.MaxUint64
+	require.Equal(t, types.MaxICATimeout, zone.GetICAPacketLimits().Timeout)
+}
+
+func TestInstantRedemption(t *testing.T) {
+	zone := types.Zone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	require.False(t, zone.SupportInstantRedemption())
+	require.Equal(t, sdk.ZeroInt(), zone.GetInstantRedemptionBuffer())
+	require.Equal(t, sdk.ZeroInt(), zone.GetInstantRedemptionFeeAmount(sdk.NewInt(1000)))
+
+	zone.DelegationAddress = &types.ICAAccount{Address: utils.GenerateAccAddressForTest().String()}
+	zone.InstantRedemptionBufferRate = sdk.NewDecWithPrec(1, 1)
+	zone.InstantRedemptionFee = sdk.NewDecWithPrec(5, 3)
+	require.True(t, zone.SupportInstantRedemption())
+	require.Equal(t, sdk.NewInt(4), zone.GetInstantRedemptionFeeAmount(sdk.NewInt(999)))
+
+	zone.LiquidityModule = true
+	require.False(t, zone.SupportInstantRedemption())
 }
 
 func TestGetDelegationAccount(t *testing.T) {
@@ -261,6 +339,17 @@ func TestBase64MemoToIntent(t *testing.T) {
 				"cosmosvaloper1a3yjj7d3qnx4spgvjcwjq9cw9snrrrhu5h6jll": sdk.NewDecWithPrec(5, 1),
 			},
 		},
+		{
+			name:   "structured memo",
+			memo:   "/wAAVE6Ev/hMfdrRHLjAc4bpGSjFZ1ykvDSmkASU8fc82uK68ci4j/X8C4oznDyv+fWnFs3XATBOrm/H9CyA/e6lhArsSSl5sQTNWAUMlh0gFw4sJjGO/A==",
+			amount: 10,
+			expectedIntent: map[string]sdk.Dec{
+				"cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0": sdk.NewDecWithPrec(39, 1),
+				"cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf": sdk.NewDecWithPrec(26, 1),
+				"cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy": sdk.NewDec(3),
+				"cosmosvaloper1a3yjj7d3qnx4spgvjcwjq9cw9snrrrhu5h6jll": sdk.NewDecWithPrec(5, 1),
+			},
+		},
 		{
 			name:    "empty memo",
 			memo:    "",
@@ -728,3 +817,90 @@ func intentFromDecSlice(in map[string]sdk.Dec) types.DelegatorIntent {
 // 	}
 
 // }
+
+func TestZone_ApplyEligibility(t *testing.T) {
+	v1 := "cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy"
+	v2 := "cosmosvaloper1a3yjj7d3qnx4spgvjcwjq9cw9snrrrhu5h6jll"
+	v3 := "cosmosvaloper1qaa9zej9a0ge3ugpx3pxyx602lxh3ztqgfnp42"
+	v4 := "cosmosvaloper1z8zjv3lntpwxua0rtpvgrcwl0nm0tltgpgs6l7"
+	lowScore := sdk.MustNewDecFromStr("0.5")
+	highScore := sdk.OneDec()
+
+	newZone := func() types.Zone {
+		zone := types.Zone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+		zone.Validators = []*types.Validator{
+			{ValoperAddress: v1, CommissionRate: sdk.MustNewDecFromStr("0.05"), VotingPower: sdk.NewInt(2000), Status: stakingtypes.BondStatusBonded, PerformanceScore: &highScore},
+			{ValoperAddress: v2, CommissionRate: sdk.MustNewDecFromStr("0.2"), VotingPower: sdk.NewInt(2000), Status: stakingtypes.BondStatusBonded, PerformanceScore: &highScore},
+			{ValoperAddress: v3, CommissionRate: sdk.MustNewDecFromStr("0.05"), VotingPower: sdk.NewInt(2000), Status: stakingtypes.BondStatusBonded, PerformanceScore: &lowScore},
+			{ValoperAddress: v4, CommissionRate: sdk.MustNewDecFromStr("0.05"), VotingPower: sdk.NewInt(2000), Status: stakingtypes.BondStatusBonded},
+		}
+		zone.AggregateIntent = types.ValidatorIntents{
+			{ValoperAddress: v1, Weight: sdk.MustNewDecFromStr("0.7")},
+			{ValoperAddress: v2, Weight: sdk.MustNewDecFromStr("0.1")},
+			{ValoperAddress: v3, Weight: sdk.MustNewDecFromStr("0.1")},
+			{ValoperAddress: v4, Weight: sdk.MustNewDecFromStr("0.1")},
+		}
+		return zone
+	}
+
+	tests := []struct {
+		name     string
+		malleate func(zone *types.Zone)
+		expected map[string]string
+	}{
+		{
+			name:     "no rules",
+			malleate: func(zone *types.Zone) {},
+			expected: map[string]string{v1: "0.7", v2: "0.1", v3: "0.1", v4: "0.1"},
+		},
+		{
+			name: "denylist",
+			malleate: func(zone *types.Zone) {
+				zone.ValidatorDenylist = []string{v2, v3}
+			},
+			expected: map[string]string{v1: "0.875", v4: "0.125"},
+		},
+		{
+			name: "max commission",
+			malleate: func(zone *types.Zone) {
+				zone.MaxCommissionRate = sdk.MustNewDecFromStr("0.1")
+			},
+			expected: map[string]string{v1: "0.777777777777777778", v3: "0.111111111111111111", v4: "0.111111111111111111"},
+		},
+		{
+			name: "min uptime; unscored validators are eligible",
+			malleate: func(zone *types.Zone) {
+				zone.MinUptime = sdk.MustNewDecFromStr("0.9")
+			},
+			expected: map[string]string{v1: "0.777777777777777778", v2: "0.111111111111111111", v4: "0.111111111111111111"},
+		},
+		{
+			name: "max validator share",
+			malleate: func(zone *types.Zone) {
+				zone.MaxValidatorShare = sdk.MustNewDecFromStr("0.4")
+			},
+			expected: map[string]string{v1: "0.4", v2: "0.2", v3: "0.2", v4: "0.2"},
+		},
+		{
+			name: "no eligible intents falls back to default",
+			malleate: func(zone *types.Zone) {
+				zone.AggregateIntent = types.ValidatorIntents{{ValoperAddress: v1, Weight: sdk.OneDec()}}
+				zone.ValidatorDenylist = []string{v1}
+			},
+			expected: map[string]string{v2: "0.333333333333333333", v3: "0.333333333333333333", v4: "0.333333333333333333"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone := newZone()
+			tt.malleate(&zone)
+
+			expected := types.ValidatorIntents{}
+			for valoper, weight := range tt.expected {
+				expected = append(expected, &types.ValidatorIntent{ValoperAddress: valoper, Weight: sdk.MustNewDecFromStr(weight)})
+			}
+			require.Equal(t, expected.Sort(), zone.GetAggregateIntentOrDefault().Sort())
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
 	"github.com/ingenuity-build/quicksilver/x/participationrewards/types"
@@ -19,7 +20,80 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand()
+	cmd.AddCommand(
+		GetValidatorScoresCmd(),
+		GetZoneLeaderboardCmd(),
+	)
+
+	return cmd
+}
+
+// GetValidatorScoresCmd returns the recorded epoch scores of a zone validator.
+func GetValidatorScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-scores [chain_id] [valoper_address]",
+		Short: "Query the recorded epoch scores of a zone validator, oldest first.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryValidatorScoresRequest{
+				ChainId:        args[0],
+				ValoperAddress: args[1],
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.ValidatorScores(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-scores")
+
+	return cmd
+}
+
+// GetZoneLeaderboardCmd returns the validator scores of the latest recorded epoch for a zone.
+func GetZoneLeaderboardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leaderboard [chain_id]",
+		Short: "Query the validator scores of the latest recorded epoch for a zone, highest score first.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryZoneLeaderboardRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.ZoneLeaderboard(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
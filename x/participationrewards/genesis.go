@@ -15,12 +15,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, kpd := range genState.ProtocolData {
 		k.SetProtocolData(ctx, kpd.Key, kpd.ProtocolData)
 	}
+
+	for _, vs := range genState.ValidatorScores {
+		k.SetValidatorScore(ctx, *vs)
+	}
+
+	for _, vr := range genState.ValidatorRewardRatios {
+		k.SetValidatorRewardRatio(ctx, *vr)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:       k.GetParams(ctx),
-		ProtocolData: k.AllKeyedProtocolDatas(ctx),
+		Params:                k.GetParams(ctx),
+		ProtocolData:          k.AllKeyedProtocolDatas(ctx),
+		ValidatorScores:       k.AllValidatorScores(ctx),
+		ValidatorRewardRatios: k.AllValidatorRewardRatios(ctx),
 	}
 }
@@ -1,31 +1,39 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/ingenuity-build/quicksilver/osmosis-types/gamm"
 	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 	"github.com/ingenuity-build/quicksilver/x/participationrewards/types"
 )
 
 // Callbacks wrapper struct for interchainstaking keeper
 type Callback func(Keeper, sdk.Context, []byte, icqtypes.Query) error
 
+// FailureCallback is called when a query is abandoned without response.
+type FailureCallback func(Keeper, sdk.Context, icqtypes.Query) error
+
 type Callbacks struct {
 	k         Keeper
 	callbacks map[string]Callback
+	failures  map[string]FailureCallback
+	proofs    map[string]bool
 }
 
 var _ icqtypes.QueryCallbacks = Callbacks{}
 
 func (k Keeper) CallbackHandler() Callbacks {
-	return Callbacks{k, make(map[string]Callback)}
+	return Callbacks{k, make(map[string]Callback), make(map[string]FailureCallback), make(map[string]bool)}
 }
 
 // callback handler
@@ -43,56 +51,193 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 	return c
 }
 
+// failure callback handler
+func (c Callbacks) CallFailure(ctx sdk.Context, id string, query icqtypes.Query) error {
+	fn, found := c.failures[id]
+	if !found {
+		return nil
+	}
+	return fn(c.k, ctx, query)
+}
+
+func (c Callbacks) AddFailureCallback(id string, fn interface{}) icqtypes.QueryCallbacks {
+	c.failures[id] = fn.(FailureCallback)
+	return c
+}
+
+// proof policy
+func (c Callbacks) RequireProof(id string) icqtypes.QueryCallbacks {
+	c.proofs[id] = true
+	return c
+}
+
+func (c Callbacks) RequiresProof(id string) bool {
+	return c.proofs[id]
+}
+
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	a := c.
-		AddCallback("validatorselectionrewards", Callback(ValidatorSelectionRewardsCallback)).
+		AddCallback("validatorcurrentrewards", Callback(ValidatorCurrentRewardsCallback)).
+		AddFailureCallback("validatorcurrentrewards", FailureCallback(ValidatorRewardsFailureCallback)).
+		RequireProof("validatorcurrentrewards").
+		AddCallback("validatorhistoricalrewards", Callback(ValidatorHistoricalRewardsCallback)).
+		AddFailureCallback("validatorhistoricalrewards", FailureCallback(ValidatorRewardsFailureCallback)).
+		RequireProof("validatorhistoricalrewards").
 		AddCallback("osmosispoolupdate", Callback(OsmosisPoolUpdateCallback)).
-		AddCallback("epochblock", Callback(SetEpochBlockCallback))
+		AddCallback("epochblock", Callback(SetEpochBlockCallback)).
+		RequireProof("osmosispoolupdate")
 
 	return a.(Callbacks)
 }
 
 // Callbacks
 
-func ValidatorSelectionRewardsCallback(k Keeper, ctx sdk.Context, response []byte, query icqtypes.Query) error {
-	delegatorRewards := distrtypes.QueryDelegationTotalRewardsResponse{}
-	err := k.cdc.Unmarshal(response, &delegatorRewards)
-	if err != nil {
+// ValidatorCurrentRewardsCallback handles the proven current rewards of a zone
+// validator. The rewards per token of the current period are held against the
+// validator reward ratio, and the cumulative reward ratio of the preceding
+// period is queried.
+func ValidatorCurrentRewardsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	if len(query.Request) < 2 || query.Request[0] != distrtypes.ValidatorCurrentRewardsPrefix[0] {
+		return errors.New("query request has unexpected prefix")
+	}
+	valAddr := distrtypes.GetValidatorCurrentRewardsAddress(query.Request)
+	valoper, found := zoneValidatorForAddress(zone, valAddr)
+	if !found {
+		k.Logger(ctx).Error("validator not found for zone", "zone", zone.ChainId, "validator", valAddr.String())
+		k.decrementValidatorRewardsPending(ctx, zone)
+		return nil
+	}
+
+	// validators without distribution records have no rewards to score.
+	if len(args) == 0 {
+		k.Logger(ctx).Info("no current rewards for validator", "zone", zone.ChainId, "validator", valoper)
+		k.decrementValidatorRewardsPending(ctx, zone)
+		return nil
+	}
+
+	currentRewards := distrtypes.ValidatorCurrentRewards{}
+	if err := k.cdc.Unmarshal(args, &currentRewards); err != nil {
 		return err
 	}
+	if currentRewards.Period == 0 {
+		k.Logger(ctx).Info("no rewards period for validator", "zone", zone.ChainId, "validator", valoper)
+		k.decrementValidatorRewardsPending(ctx, zone)
+		return nil
+	}
+
+	ratio, found := k.GetValidatorRewardRatio(ctx, zone.ChainId, valoper)
+	if !found {
+		ratio = types.ValidatorRewardRatio{ChainId: zone.ChainId, ValoperAddress: valoper}
+	}
+
+	// the rewards of the current period are added to the ratio of the preceding
+	// period in the same manner as the distribution module does on increment.
+	ratio.Current = sdk.DecCoins{}
+	if val, found := zone.GetValidatorByValoper(valoper); found && val.VotingPower.IsPositive() {
+		ratio.Current = currentRewards.Rewards.QuoDecTruncate(sdk.NewDecFromInt(val.VotingPower))
+	}
+	k.SetValidatorRewardRatio(ctx, ratio)
 
+	k.IcqKeeper.MakeRequest(
+		ctx,
+		query.ConnectionId,
+		query.ChainId,
+		"store/distribution/key",
+		distrtypes.GetValidatorHistoricalRewardsKey(valAddr, currentRewards.Period-1),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"validatorhistoricalrewards",
+		0,
+	)
+
+	return nil
+}
+
+// ValidatorHistoricalRewardsCallback handles the proven historical rewards of
+// a zone validator for the period preceding the current period, recording the
+// cumulative reward ratio of the validator against the current epoch.
+func ValidatorHistoricalRewardsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
 	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
 	if !found {
 		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
 
-	zs, err := k.getZoneScores(ctx, zone, delegatorRewards)
-	if err != nil {
+	if len(query.Request) < 2 || query.Request[0] != distrtypes.ValidatorHistoricalRewardsPrefix[0] {
+		return errors.New("query request has unexpected prefix")
+	}
+	valAddr, _ := distrtypes.GetValidatorHistoricalRewardsAddressPeriod(query.Request)
+	valoper, found := zoneValidatorForAddress(zone, valAddr)
+	if !found {
+		k.Logger(ctx).Error("validator not found for zone", "zone", zone.ChainId, "validator", valAddr.String())
+		k.decrementValidatorRewardsPending(ctx, zone)
+		return nil
+	}
+
+	if len(args) == 0 {
+		k.Logger(ctx).Error("no historical rewards for validator", "zone", zone.ChainId, "validator", valoper)
+		k.decrementValidatorRewardsPending(ctx, zone)
+		return nil
+	}
+
+	historicalRewards := distrtypes.ValidatorHistoricalRewards{}
+	if err := k.cdc.Unmarshal(args, &historicalRewards); err != nil {
 		return err
 	}
 
-	k.Logger(ctx).Info(
-		"callback zone score",
-		"zone", zs.ZoneID,
-		"total voting power", zs.TotalVotingPower,
-		"validator scores", zs.ValidatorScores,
-	)
+	ratio, found := k.GetValidatorRewardRatio(ctx, zone.ChainId, valoper)
+	if !found {
+		ratio = types.ValidatorRewardRatio{ChainId: zone.ChainId, ValoperAddress: valoper}
+	}
 
-	// snapshot obtained and used here
-	userAllocations := k.calcUserValidatorSelectionAllocations(ctx, &zone, *zs)
+	epoch := k.epochsKeeper.GetEpochInfo(ctx, "epoch").CurrentEpoch
+	current := historicalRewards.CumulativeRewardRatio.Add(ratio.Current...)
+
+	switch {
+	case ratio.Ratio.Empty():
+		// the first ratio recorded for the validator is the baseline for the next epoch.
+		ratio.PreviousRatio = current
+		ratio.PreviousEpoch = epoch
+	case ratio.Epoch < epoch:
+		ratio.PreviousRatio = ratio.Ratio
+		ratio.PreviousEpoch = ratio.Epoch
+	}
+	ratio.Ratio = current
+	ratio.Epoch = epoch
+	ratio.Current = sdk.DecCoins{}
+	k.SetValidatorRewardRatio(ctx, ratio)
 
-	if err := k.distributeToUsers(ctx, userAllocations); err != nil {
-		return err
+	k.decrementValidatorRewardsPending(ctx, zone)
+
+	return nil
+}
+
+// zoneValidatorForAddress returns the valoper address of the zone validator
+// with the given address.
+func zoneValidatorForAddress(zone icstypes.Zone, valAddr sdk.ValAddress) (string, bool) {
+	for _, val := range zone.Validators {
+		_, addr, err := bech32.DecodeAndConvert(val.ValoperAddress)
+		if err == nil && bytes.Equal(addr, valAddr) {
+			return val.ValoperAddress, true
+		}
 	}
+	return "", false
+}
 
-	// create snapshot of current intents for next epoch boundary
-	for _, di := range k.icsKeeper.AllDelegatorIntents(ctx, &zone, false) {
-		k.icsKeeper.SetDelegatorIntent(ctx, &zone, di, true)
+// ValidatorRewardsFailureCallback is called when a validator rewards query is
+// abandoned; the validator is not scored for this epoch.
+func ValidatorRewardsFailureCallback(k Keeper, ctx sdk.Context, query icqtypes.Query) error {
+	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
 
-	// set zone ValidatorSelectionAllocation to zero
-	zone.ValidatorSelectionAllocation = 0
-	k.icsKeeper.SetZone(ctx, &zone)
+	k.Logger(ctx).Error("validator rewards query abandoned", "zone", zone.ChainId, "query", query.Id)
+	k.decrementValidatorRewardsPending(ctx, zone)
 
 	return nil
 }
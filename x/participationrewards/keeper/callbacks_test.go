@@ -2,15 +2,15 @@ package keeper_test
 
 import (
 	"encoding/json"
-	"fmt"
-	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/ingenuity-build/quicksilver/osmosis-types/gamm"
 	icqkeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 	"github.com/ingenuity-build/quicksilver/x/participationrewards/keeper"
 	"github.com/ingenuity-build/quicksilver/x/participationrewards/types"
 )
@@ -67,49 +67,33 @@ func (suite *KeeperTestSuite) executeOsmosisPoolUpdateCallback() {
 	suite.Require().Equal(want, oppd)
 }
 
-func (suite *KeeperTestSuite) executeValidatorSelectionRewardsCallback(performanceAddress string, valRewards map[string]sdk.Dec) {
+// executeValidatorRewardsCallbacks responds to the validator rewards queries
+// of the given zone with the given cumulative reward ratio of each validator.
+func (suite *KeeperTestSuite) executeValidatorRewardsCallbacks(zone icstypes.Zone, ratios map[string]sdk.Dec) {
 	prk := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
 	ctx := suite.chainA.GetContext()
 
-	rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: performanceAddress}
-	bz := prk.GetCodec().MustMarshal(&rewardsQuery)
+	for _, val := range zone.Validators {
+		_, valAddr, err := bech32.DecodeAndConvert(val.ValoperAddress)
+		suite.Require().NoError(err)
 
-	qid := icqkeeper.GenerateQueryHash(
-		suite.path.EndpointA.ConnectionID,
-		suite.chainB.ChainID,
-		"cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
-		bz,
-		types.ModuleName,
-	)
+		qid := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "store/distribution/key", distrtypes.GetValidatorCurrentRewardsKey(valAddr), types.ModuleName)
+		query, found := prk.IcqKeeper.GetQuery(ctx, qid)
+		suite.Require().True(found, "qid: %s", qid)
 
-	query, found := prk.IcqKeeper.GetQuery(ctx, qid)
-	suite.Require().True(found, "qid: %s", qid)
+		current := distrtypes.ValidatorCurrentRewards{Rewards: sdk.DecCoins{}, Period: 2}
+		suite.Require().NoError(keeper.ValidatorCurrentRewardsCallback(prk, ctx, prk.GetCodec().MustMarshal(&current), query))
 
-	var respJSON strings.Builder
-	respJSON.Write([]byte(`{"rewards":[`))
-	total := sdk.ZeroDec()
-	i := 0
-	for val, amount := range valRewards {
-		if i > 0 {
-			respJSON.Write([]byte(","))
+		qid = icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "store/distribution/key", distrtypes.GetValidatorHistoricalRewardsKey(valAddr, 1), types.ModuleName)
+		query, found = prk.IcqKeeper.GetQuery(ctx, qid)
+		suite.Require().True(found, "qid: %s", qid)
+
+		historical := distrtypes.ValidatorHistoricalRewards{
+			CumulativeRewardRatio: sdk.NewDecCoins(sdk.NewDecCoinFromDec(zone.BaseDenom, ratios[val.ValoperAddress])),
+			ReferenceCount:        1,
 		}
-		respJSON.Write([]byte(fmt.Sprintf(`{"validator_address":%q,"reward":[{"denom":"uatom","amount":%q}]}`, val, amount.String())))
-		total = total.Add(amount)
-		i++
+		suite.Require().NoError(keeper.ValidatorHistoricalRewardsCallback(prk, ctx, prk.GetCodec().MustMarshal(&historical), query))
 	}
-	respJSON.Write([]byte(fmt.Sprintf(`],"total":[{"denom":"uatom","amount":%q}]}`, total.String())))
-	qdtrResp := distrtypes.QueryDelegationTotalRewardsResponse{}
-	err := json.Unmarshal([]byte(respJSON.String()), &qdtrResp)
-	suite.Require().NoError(err)
-	resp, err := qdtrResp.Marshal()
-
-	err = keeper.ValidatorSelectionRewardsCallback(
-		prk,
-		ctx,
-		resp,
-		query,
-	)
-	suite.Require().NoError(err)
 }
 
 func (suite *KeeperTestSuite) executeSetEpochBlockCallback() {
@@ -143,3 +127,56 @@ func (suite *KeeperTestSuite) executeSetEpochBlockCallback() {
 	)
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestValidatorRewardsCallbacksRequireProof() {
+	prk := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+
+	callbacks := prk.CallbackHandler().RegisterCallbacks()
+	for _, id := range []string{"validatorcurrentrewards", "validatorhistoricalrewards", "osmosispoolupdate"} {
+		suite.Require().True(callbacks.RequiresProof(id), id)
+	}
+
+	// outstanding delegation rewards cannot be proven, so are not queried.
+	suite.Require().False(callbacks.Has("validatorselectionrewards"))
+}
+
+func (suite *KeeperTestSuite) TestValidatorRewardsFailureCallback() {
+	qApp := suite.GetQuicksilverApp(suite.chainA)
+	prk := qApp.ParticipationRewardsKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := qApp.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	epochInfo := qApp.EpochsKeeper.GetEpochInfo(ctx, "epoch")
+	epochInfo.CurrentEpoch++
+	qApp.EpochsKeeper.SetEpochInfo(ctx, epochInfo)
+	suite.Require().NoError(prk.AfterEpochEnd(ctx, "epoch", 5))
+
+	// the queries of the last validator are abandoned; the remaining validators are still scored.
+	abandoned := zone.Validators[len(zone.Validators)-1]
+	zone.Validators = zone.Validators[:len(zone.Validators)-1]
+
+	ratios := make(map[string]sdk.Dec)
+	for i, val := range zone.Validators {
+		ratios[val.ValoperAddress] = sdk.NewDec(2).Add(sdk.NewDecWithPrec(int64(i+1), 2))
+	}
+	suite.executeValidatorRewardsCallbacks(zone, ratios)
+
+	epoch, _ := prk.LatestValidatorScores(ctx, zone.ChainId)
+	suite.Require().Equal(epochInfo.CurrentEpoch-1, epoch)
+
+	_, valAddr, err := bech32.DecodeAndConvert(abandoned.ValoperAddress)
+	suite.Require().NoError(err)
+	qid := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "store/distribution/key", distrtypes.GetValidatorCurrentRewardsKey(valAddr), types.ModuleName)
+	query, found := prk.IcqKeeper.GetQuery(ctx, qid)
+	suite.Require().True(found)
+	suite.Require().NoError(keeper.ValidatorRewardsFailureCallback(prk, ctx, query))
+
+	epoch, scores := prk.LatestValidatorScores(ctx, zone.ChainId)
+	suite.Require().Equal(epochInfo.CurrentEpoch, epoch)
+	suite.Require().Len(scores, len(zone.Validators))
+	for _, score := range scores {
+		suite.Require().NotEqual(abandoned.ValoperAddress, score.ValoperAddress)
+	}
+}
@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/ingenuity-build/quicksilver/x/participationrewards/types"
 )
@@ -36,3 +41,54 @@ func (k Keeper) ProtocolData(c context.Context, q *types.QueryProtocolDataReques
 
 	return &types.QueryProtocolDataResponse{Data: out}, nil
 }
+
+// ValidatorScores returns the recorded epoch scores of a zone validator, oldest first.
+func (k Keeper) ValidatorScores(c context.Context, req *types.QueryValidatorScoresRequest) (*types.QueryValidatorScoresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.icsKeeper.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	scores := make([]types.ValidatorScore, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixValidatorScore, types.GetValidatorScoresKey(req.ChainId)...))
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		score := types.ValidatorScore{}
+		if err := k.cdc.Unmarshal(value, &score); err != nil {
+			return false, err
+		}
+		if score.ValoperAddress != req.ValoperAddress {
+			return false, nil
+		}
+		if accumulate {
+			scores = append(scores, score)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorScoresResponse{Scores: scores, Pagination: pageRes}, nil
+}
+
+// ZoneLeaderboard returns the validator scores of the latest recorded epoch for a zone, highest score first.
+func (k Keeper) ZoneLeaderboard(c context.Context, req *types.QueryZoneLeaderboardRequest) (*types.QueryZoneLeaderboardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.icsKeeper.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.ChainId))
+	}
+
+	epoch, scores := k.LatestValidatorScores(ctx, req.ChainId)
+
+	return &types.QueryZoneLeaderboardResponse{Epoch: epoch, Scores: scores}, nil
+}
@@ -74,7 +74,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			// create snapshot of current intents for the next epoch boundary
 			// requires intents to be set, no intents no snapshot...
 			// further snapshots will be taken during
-			// ValidatorSelectionRewardsCallback;
+			// allocateZoneValidatorSelectionRewards;
 			for _, zone := range k.icsKeeper.AllZones(ctx) {
 				zone := zone
 				for _, di := range k.icsKeeper.AllDelegatorIntents(ctx, &zone, false) {
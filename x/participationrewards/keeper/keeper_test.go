@@ -127,12 +127,24 @@ func (suite *KeeperTestSuite) coreTest() {
 	zone, found := qApp.InterchainstakingKeeper.GetZone(suite.chainA.GetContext(), suite.chainB.ChainID)
 	suite.Require().True(found)
 
-	valRewards := make(map[string]sdk.Dec)
+	// the first reward ratios recorded are the baseline for the next epoch.
+	ratios := make(map[string]sdk.Dec)
 	for _, val := range zone.Validators {
-		valRewards[val.ValoperAddress] = sdk.NewDec(100000000)
+		ratios[val.ValoperAddress] = sdk.NewDec(1)
 	}
+	suite.executeValidatorRewardsCallbacks(zone, ratios)
 
-	suite.executeValidatorSelectionRewardsCallback(zone.PerformanceAddress.Address, valRewards)
+	epochInfo := qApp.EpochsKeeper.GetEpochInfo(suite.chainA.GetContext(), "epoch")
+	epochInfo.CurrentEpoch++
+	qApp.EpochsKeeper.SetEpochInfo(suite.chainA.GetContext(), epochInfo)
+
+	err = qApp.ParticipationRewardsKeeper.AfterEpochEnd(suite.chainA.GetContext(), "epoch", 4)
+	suite.Require().NoError(err)
+
+	for i, val := range zone.Validators {
+		ratios[val.ValoperAddress] = sdk.NewDec(1).Add(sdk.NewDecWithPrec(int64(i+1), 2))
+	}
+	suite.executeValidatorRewardsCallbacks(zone, ratios)
 }
 
 func (suite *KeeperTestSuite) setupTestZones() {
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -39,47 +40,115 @@ type userScore struct {
 	Score   sdk.Dec
 }
 
-// allocateValidatorSelectionRewards utilizes IBC to query the performance
-// rewards account for each zone to determine validator performance and
-// corresponding rewards allocations. Each zone's response is dealt with
-// individually in a callback.
+// allocateValidatorSelectionRewards utilizes IBC to query the distribution
+// store of each zone for the cumulative reward ratio of each zone validator,
+// from which validator performance and corresponding rewards allocations are
+// determined. The responses are proven, and the current rewards of each
+// validator are dealt with individually in a callback that queries the ratio
+// of the preceding period. Once every validator of the zone has been handled
+// the rewards are allocated.
 func (k Keeper) allocateValidatorSelectionRewards(ctx sdk.Context) {
 	k.Logger(ctx).Info("allocateValidatorChoiceRewards")
 
 	for i, zone := range k.icsKeeper.AllZones(ctx) {
-		k.Logger(ctx).Info("zones", "i", i, "zone", zone.ChainId, "performance address", zone.PerformanceAddress.Address)
-
-		// obtain zone performance account rewards
-		rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: zone.PerformanceAddress.Address}
-		bz := k.cdc.MustMarshal(&rewardsQuery)
-
-		k.IcqKeeper.MakeRequest(
-			ctx,
-			zone.ConnectionId,
-			zone.ChainId,
-			"cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
-			bz,
-			sdk.NewInt(-1),
-			types.ModuleName,
-			"validatorselectionrewards",
-			0,
-		)
+		k.Logger(ctx).Info("zones", "i", i, "zone", zone.ChainId)
+
+		validators := zone.GetValidatorsSorted()
+		if len(validators) == 0 {
+			k.Logger(ctx).Error("zone has no validators", "zone", zone.ChainId)
+			continue
+		}
+
+		// any queries outstanding from the previous epoch are superseded.
+		k.setValidatorRewardsPending(ctx, zone.ChainId, uint64(len(validators)))
+
+		for _, val := range validators {
+			_, valAddr, err := bech32.DecodeAndConvert(val.ValoperAddress)
+			if err != nil {
+				k.Logger(ctx).Error("invalid validator address", "zone", zone.ChainId, "validator", val.ValoperAddress, "error", err)
+				k.decrementValidatorRewardsPending(ctx, zone)
+				continue
+			}
+
+			k.IcqKeeper.MakeRequest(
+				ctx,
+				zone.ConnectionId,
+				zone.ChainId,
+				"store/distribution/key",
+				distrtypes.GetValidatorCurrentRewardsKey(valAddr),
+				sdk.NewInt(-1),
+				types.ModuleName,
+				"validatorcurrentrewards",
+				0,
+			)
+		}
 	}
 }
 
-// getZoneScores returns an instance of zoneScore containing the calculated
-// zone validator scores.
-func (k Keeper) getZoneScores(
-	ctx sdk.Context,
-	zone icstypes.Zone,
-	delegatorRewards distrtypes.QueryDelegationTotalRewardsResponse,
-) (*zoneScore, error) {
+// decrementValidatorRewardsPending records that a validator reward query of
+// the given zone has been handled. Once none are outstanding, the validator
+// selection rewards of the zone are allocated. Allocation errors are logged,
+// and the state changes of the allocation discarded, as they must not fail the
+// query response or abandonment.
+func (k Keeper) decrementValidatorRewardsPending(ctx sdk.Context, zone icstypes.Zone) {
+	pending := k.getValidatorRewardsPending(ctx, zone.ChainId)
+	if pending == 0 {
+		// stale response from a previous epoch.
+		return
+	}
+
+	pending--
+	k.setValidatorRewardsPending(ctx, zone.ChainId, pending)
+	if pending > 0 {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.allocateZoneValidatorSelectionRewards(cacheCtx, zone); err != nil {
+		k.Logger(ctx).Error("unable to allocate validator selection rewards", "zone", zone.ChainId, "error", err)
+		return
+	}
+	write()
+}
+
+// allocateZoneValidatorSelectionRewards scores the validators of the given zone
+// and distributes the zone validator selection allocation to users according
+// to the snapshotted intents of the last epoch boundary.
+func (k Keeper) allocateZoneValidatorSelectionRewards(ctx sdk.Context, zone icstypes.Zone) error {
+	zs, err := k.getZoneScores(ctx, zone)
+	if err != nil {
+		return err
+	}
+
 	k.Logger(ctx).Info(
-		"performance rewards zone callback response",
-		"zone", zone.ChainId,
-		"rewards", delegatorRewards,
+		"callback zone score",
+		"zone", zs.ZoneID,
+		"total voting power", zs.TotalVotingPower,
+		"validator scores", zs.ValidatorScores,
 	)
 
+	// snapshot obtained and used here
+	userAllocations := k.calcUserValidatorSelectionAllocations(ctx, &zone, *zs)
+
+	if err := k.distributeToUsers(ctx, userAllocations); err != nil {
+		return err
+	}
+
+	// create snapshot of current intents for next epoch boundary
+	for _, di := range k.icsKeeper.AllDelegatorIntents(ctx, &zone, false) {
+		k.icsKeeper.SetDelegatorIntent(ctx, &zone, di, true)
+	}
+
+	// set zone ValidatorSelectionAllocation to zero
+	zone.ValidatorSelectionAllocation = 0
+	k.icsKeeper.SetZone(ctx, &zone)
+
+	return nil
+}
+
+// getZoneScores returns an instance of zoneScore containing the calculated
+// zone validator scores.
+func (k Keeper) getZoneScores(ctx sdk.Context, zone icstypes.Zone) (*zoneScore, error) {
 	zs := zoneScore{
 		ZoneID:           zone.ChainId,
 		TotalVotingPower: sdk.NewInt(0),
@@ -90,7 +159,7 @@ func (k Keeper) getZoneScores(
 		return nil, err
 	}
 
-	if err := k.calcOverallScores(ctx, zone, delegatorRewards, &zs); err != nil {
+	if err := k.calcOverallScores(ctx, zone, &zs); err != nil {
 		return nil, err
 	}
 
@@ -169,79 +238,93 @@ func (k Keeper) calcDistributionScores(ctx sdk.Context, zone icstypes.Zone, zs *
 // calcOverallScores calculates the overall validator scores for the given zone
 // based on the combination of performance score and distribution score.
 //
-// The performance score is first calculated based on validator rewards earned
-// from the zone performance account that delegates an exact amount to each
-// validator. The total rewards earned by the performance account is divided
-// by the number of active validators to obtain the expected rewards. The
-// performance score for each validator is then simply the percentage of actual
-// rewards compared to the expected rewards (capped at 100%).
+// The performance score is first calculated based on the rewards per token
+// earned by each validator since the preceding epoch, as proven from the
+// cumulative reward ratios of the zone distribution store. The mean rewards per
+// token of the validators is the expected rewards. The performance score for
+// each validator is then simply the percentage of actual rewards compared to
+// the expected rewards (capped at 100%). Validators without a ratio for both
+// epochs are not scored.
 //
-// On completion a msg is submitted to withdraw the zone performance rewards,
-// resetting zone performance scoring for the next epoch.
-func (k Keeper) calcOverallScores(
-	ctx sdk.Context,
-	zone icstypes.Zone,
-	delegatorRewards distrtypes.QueryDelegationTotalRewardsResponse,
-	zs *zoneScore,
-) error {
+// The scores of each validator are recorded against the current epoch, and
+// records that fall outside of the retention window are pruned.
+func (k Keeper) calcOverallScores(ctx sdk.Context, zone icstypes.Zone, zs *zoneScore) error {
 	k.Logger(ctx).Info("calculate performance & overall scores")
 
-	rewards := delegatorRewards.GetRewards()
-	if rewards == nil {
-		k.Logger(ctx).Error("No delegator rewards")
-		return nil
-	}
+	epoch := k.epochsKeeper.GetEpochInfo(ctx, "epoch").CurrentEpoch
 
-	total := delegatorRewards.GetTotal().AmountOf(zone.BaseDenom)
+	valopers := make([]string, 0)
+	rewards := make(map[string]sdk.Dec)
+	total := sdk.ZeroDec()
+	k.IterateZoneValidatorRewardRatios(ctx, zone.ChainId, func(_ int64, ratio types.ValidatorRewardRatio) bool {
+		if ratio.Epoch != epoch || ratio.PreviousEpoch >= ratio.Epoch {
+			return false
+		}
+		if _, exists := zs.ValidatorScores[ratio.ValoperAddress]; !exists {
+			k.Logger(ctx).Info("validator may have been removed from active set", "validator", ratio.ValoperAddress)
+			return false
+		}
+
+		reward := ratio.Ratio.AmountOf(zone.BaseDenom).Sub(ratio.PreviousRatio.AmountOf(zone.BaseDenom))
+		if reward.IsNegative() {
+			reward = sdk.ZeroDec()
+		}
+		reward = reward.QuoInt64(ratio.Epoch - ratio.PreviousEpoch)
+
+		valopers = append(valopers, ratio.ValoperAddress)
+		rewards[ratio.ValoperAddress] = reward
+		total = total.Add(reward)
+		return false
+	})
 
 	if total.IsZero() {
-		k.Logger(ctx).Error("No delegator rewards (2)")
+		k.Logger(ctx).Error("No validator rewards")
 		return nil
 	}
 
-	expected := total.Quo(sdk.NewDec(int64(len(rewards))))
+	expected := total.Quo(sdk.NewDec(int64(len(valopers))))
 
 	k.Logger(ctx).Info(
-		"performance account rewards",
+		"validator rewards per token",
 		"rewards", rewards,
 		"total", total,
 		"expected", expected,
 	)
 
-	var msgs []sdk.Msg
 	limit := sdk.NewDec(1.0)
-	for _, reward := range rewards {
-		vs, exists := zs.ValidatorScores[reward.ValidatorAddress]
-		if !exists {
-			k.Logger(ctx).Info("validator may have been removed from active set", "validator", reward.ValidatorAddress)
-			continue
-		}
+	for _, valoper := range valopers {
+		vs := zs.ValidatorScores[valoper]
 
-		vs.PerformanceScore = reward.Reward.AmountOf(zone.BaseDenom).Quo(expected)
+		vs.PerformanceScore = rewards[valoper].Quo(expected)
 		if vs.PerformanceScore.GT(limit) {
 			vs.PerformanceScore = limit
 		}
 		k.Logger(ctx).Info("performance score", "validator", vs.ValoperAddress, "performance", vs.PerformanceScore)
 
+		// record the performance score against the zone validator, for use in validator eligibility.
+		if val, found := zone.GetValidatorByValoper(vs.ValoperAddress); found {
+			performanceScore := vs.PerformanceScore
+			val.PerformanceScore = &performanceScore
+		}
+
 		// calculate overall score
 		vs.Score = vs.DistributionScore.Mul(vs.PerformanceScore)
 		k.Logger(ctx).Info("overall score", "validator", vs.ValoperAddress, "overall", vs.Score)
 
-		// prepare validator performance withdrawal msg
-		msg := &distrtypes.MsgWithdrawDelegatorReward{
-			DelegatorAddress: zone.PerformanceAddress.GetAddress(),
-			ValidatorAddress: vs.ValoperAddress,
-		}
-		msgs = append(msgs, msg)
+		// record validator scores for this epoch
+		k.SetValidatorScore(ctx, types.ValidatorScore{
+			ChainId:           zone.ChainId,
+			Epoch:             epoch,
+			ValoperAddress:    vs.ValoperAddress,
+			PowerPercentage:   vs.PowerPercentage,
+			DistributionScore: vs.DistributionScore,
+			PerformanceScore:  vs.PerformanceScore,
+			Score:             vs.Score,
+			Height:            ctx.BlockHeight(),
+		})
 	}
 
-	// submit rewards withdrawals to reset zone performance for next epoch
-	k.Logger(ctx).Info("send performance rewards withdrawal messages to reset scores for next epoch")
-	if len(msgs) > 0 {
-		if err := k.icsKeeper.SubmitTx(ctx, msgs, zone.PerformanceAddress, ""); err != nil {
-			return err
-		}
-	}
+	k.PruneValidatorScores(ctx, zone.ChainId, epoch)
 
 	// update zone with validator scores
 	k.icsKeeper.SetZone(ctx, &zone)
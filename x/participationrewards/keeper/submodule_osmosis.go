@@ -50,7 +50,22 @@ func (m *OsmosisModule) Hooks(ctx sdk.Context, k Keeper) {
 		}
 		pool, _ := ipool.(types.OsmosisPoolProtocolData)
 
-		// update pool datas
+		// update pool datas at the epoch boundary height, against which claims are proven.
+		if connectionData.LastEpoch > 0 {
+			k.IcqKeeper.MakeHistoricalRequest(
+				ctx,
+				connectionData.ConnectionID,
+				connectionData.ChainID,
+				"store/gamm/key",
+				m.GetKeyPrefixPools(pool.PoolID),
+				uint64(connectionData.LastEpoch),
+				types.ModuleName,
+				"osmosispoolupdate",
+				0,
+			) // query pool data
+			return false
+		}
+
 		k.IcqKeeper.MakeRequest(
 			ctx,
 			connectionData.ConnectionID,
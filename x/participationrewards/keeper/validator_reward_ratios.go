@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/participationrewards/types"
+)
+
+// GetValidatorRewardRatio returns the reward ratio of the given validator for the given zone.
+func (k Keeper) GetValidatorRewardRatio(ctx sdk.Context, chainID string, valoper string) (types.ValidatorRewardRatio, bool) {
+	ratio := types.ValidatorRewardRatio{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorRewardRatio)
+	bz := store.Get(types.GetValidatorRewardRatioKey(chainID, valoper))
+	if len(bz) == 0 {
+		return ratio, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &ratio)
+	return ratio, true
+}
+
+// SetValidatorRewardRatio stores the validator reward ratio.
+func (k Keeper) SetValidatorRewardRatio(ctx sdk.Context, ratio types.ValidatorRewardRatio) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorRewardRatio)
+	bz := k.cdc.MustMarshal(&ratio)
+	store.Set(types.GetValidatorRewardRatioKey(ratio.ChainId, ratio.ValoperAddress), bz)
+}
+
+// IterateZoneValidatorRewardRatios iterates through the validator reward ratios of the given zone.
+func (k Keeper) IterateZoneValidatorRewardRatios(ctx sdk.Context, chainID string, fn func(index int64, ratio types.ValidatorRewardRatio) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorRewardRatio)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorRewardRatiosKey(chainID))
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		ratio := types.ValidatorRewardRatio{}
+		k.cdc.MustUnmarshal(iterator.Value(), &ratio)
+		stop := fn(i, ratio)
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllValidatorRewardRatios returns every validator reward ratio in the store.
+func (k Keeper) AllValidatorRewardRatios(ctx sdk.Context) []*types.ValidatorRewardRatio {
+	out := make([]*types.ValidatorRewardRatio, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorRewardRatio)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ratio := types.ValidatorRewardRatio{}
+		k.cdc.MustUnmarshal(iterator.Value(), &ratio)
+		out = append(out, &ratio)
+	}
+	return out
+}
+
+// getValidatorRewardsPending returns the number of validator reward queries outstanding for the given zone.
+func (k Keeper) getValidatorRewardsPending(ctx sdk.Context, chainID string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorRewardsPending)
+	bz := store.Get([]byte(chainID))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setValidatorRewardsPending sets the number of validator reward queries outstanding for the given zone.
+func (k Keeper) setValidatorRewardsPending(ctx sdk.Context, chainID string, pending uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorRewardsPending)
+	if pending == 0 {
+		store.Delete([]byte(chainID))
+		return
+	}
+	store.Set([]byte(chainID), sdk.Uint64ToBigEndian(pending))
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/participationrewards/types"
+)
+
+// GetValidatorScore returns the score of the given validator for the given zone and epoch.
+func (k Keeper) GetValidatorScore(ctx sdk.Context, chainID string, epoch int64, valoper string) (types.ValidatorScore, bool) {
+	score := types.ValidatorScore{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorScore)
+	bz := store.Get(types.GetValidatorScoreKey(chainID, epoch, valoper))
+	if len(bz) == 0 {
+		return score, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &score)
+	return score, true
+}
+
+// SetValidatorScore stores the validator score.
+func (k Keeper) SetValidatorScore(ctx sdk.Context, score types.ValidatorScore) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorScore)
+	bz := k.cdc.MustMarshal(&score)
+	store.Set(types.GetValidatorScoreKey(score.ChainId, score.Epoch, score.ValoperAddress), bz)
+}
+
+// DeleteValidatorScore deletes the validator score.
+func (k Keeper) DeleteValidatorScore(ctx sdk.Context, chainID string, epoch int64, valoper string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorScore)
+	store.Delete(types.GetValidatorScoreKey(chainID, epoch, valoper))
+}
+
+// IterateZoneValidatorScores iterates through the validator scores of the given zone, oldest epoch first.
+func (k Keeper) IterateZoneValidatorScores(ctx sdk.Context, chainID string, fn func(index int64, score types.ValidatorScore) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorScore)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorScoresKey(chainID))
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		score := types.ValidatorScore{}
+		k.cdc.MustUnmarshal(iterator.Value(), &score)
+		stop := fn(i, score)
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllValidatorScores returns every validator score in the store.
+func (k Keeper) AllValidatorScores(ctx sdk.Context) []*types.ValidatorScore {
+	out := make([]*types.ValidatorScore, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorScore)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		score := types.ValidatorScore{}
+		k.cdc.MustUnmarshal(iterator.Value(), &score)
+		out = append(out, &score)
+	}
+	return out
+}
+
+// LatestValidatorScores returns the validator scores of the latest recorded
+// epoch for the given zone, ordered by overall score, highest first. Ties are
+// ordered by valoper address.
+func (k Keeper) LatestValidatorScores(ctx sdk.Context, chainID string) (int64, []types.ValidatorScore) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorScore)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetValidatorScoresKey(chainID))
+	defer iterator.Close()
+
+	epoch := int64(0)
+	scores := make([]types.ValidatorScore, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		score := types.ValidatorScore{}
+		k.cdc.MustUnmarshal(iterator.Value(), &score)
+		if len(scores) == 0 {
+			epoch = score.Epoch
+		}
+		if score.Epoch != epoch {
+			break
+		}
+		scores = append(scores, score)
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score.Equal(scores[j].Score) {
+			return scores[i].ValoperAddress < scores[j].ValoperAddress
+		}
+		return scores[i].Score.GT(scores[j].Score)
+	})
+
+	return epoch, scores
+}
+
+// PruneValidatorScores removes the validator scores of the given zone that
+// fall outside of the retention window ending at the given epoch.
+func (k Keeper) PruneValidatorScores(ctx sdk.Context, chainID string, epoch int64) {
+	cutoff := epoch - types.ValidatorScoreHistoryLength
+	if cutoff < 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorScore)
+	iterator := store.Iterator(types.GetValidatorScoresKey(chainID), types.GetValidatorScoresEpochKey(chainID, cutoff+1))
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
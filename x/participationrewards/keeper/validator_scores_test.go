@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ingenuity-build/quicksilver/x/participationrewards/types"
+)
+
+func (suite *KeeperTestSuite) TestValidatorScoresRecorded() {
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	// coreTest executes the validator selection rewards callback for chainB.
+	zone, found := appA.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	epoch, scores := appA.ParticipationRewardsKeeper.LatestValidatorScores(ctx, zone.ChainId)
+	suite.Require().Equal(appA.EpochsKeeper.GetEpochInfo(ctx, "epoch").CurrentEpoch, epoch)
+	suite.Require().Len(scores, len(zone.Validators))
+
+	for i, score := range scores {
+		suite.Require().NoError(score.ValidateBasic())
+		suite.Require().Equal(zone.ChainId, score.ChainId)
+		suite.Require().Equal(score.DistributionScore.Mul(score.PerformanceScore), score.Score)
+		if i > 0 {
+			suite.Require().True(scores[i-1].Score.GTE(score.Score))
+		}
+
+		val, found := zone.GetValidatorByValoper(score.ValoperAddress)
+		suite.Require().True(found)
+		suite.Require().Equal(score.Score, val.Score)
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneValidatorScores() {
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	prk := appA.ParticipationRewardsKeeper
+
+	for _, vs := range prk.AllValidatorScores(ctx) {
+		prk.DeleteValidatorScore(ctx, vs.ChainId, vs.Epoch, vs.ValoperAddress)
+	}
+
+	latest := int64(types.ValidatorScoreHistoryLength + 5)
+	for epoch := int64(1); epoch <= latest; epoch++ {
+		prk.SetValidatorScore(ctx, newTestValidatorScore("testchain-1", epoch, "cosmosvaloper1a", "0.5"))
+		prk.SetValidatorScore(ctx, newTestValidatorScore("testchain-10", epoch, "cosmosvaloper1a", "0.5"))
+	}
+
+	prk.PruneValidatorScores(ctx, "testchain-1", latest)
+
+	remaining := make([]int64, 0)
+	prk.IterateZoneValidatorScores(ctx, "testchain-1", func(_ int64, score types.ValidatorScore) (stop bool) {
+		remaining = append(remaining, score.Epoch)
+		return false
+	})
+	suite.Require().Len(remaining, types.ValidatorScoreHistoryLength)
+	suite.Require().Equal(latest-types.ValidatorScoreHistoryLength+1, remaining[0])
+	suite.Require().Equal(latest, remaining[len(remaining)-1])
+
+	// other zones are unaffected, even where the chain id shares a prefix.
+	count := 0
+	prk.IterateZoneValidatorScores(ctx, "testchain-10", func(_ int64, _ types.ValidatorScore) (stop bool) {
+		count++
+		return false
+	})
+	suite.Require().Equal(int(latest), count)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ValidatorScores() {
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	prk := appA.ParticipationRewardsKeeper
+	chainID := suite.chainB.ChainID
+
+	for _, vs := range prk.AllValidatorScores(ctx) {
+		prk.DeleteValidatorScore(ctx, vs.ChainId, vs.Epoch, vs.ValoperAddress)
+	}
+
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		prk.SetValidatorScore(ctx, newTestValidatorScore(chainID, epoch, "cosmosvaloper1a", "0.5"))
+		prk.SetValidatorScore(ctx, newTestValidatorScore(chainID, epoch, "cosmosvaloper1b", "0.6"))
+	}
+
+	_, err := prk.ValidatorScores(ctx, nil)
+	suite.Require().Error(err)
+
+	_, err = prk.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "unknown-1", ValoperAddress: "cosmosvaloper1a"})
+	suite.Require().Error(err)
+
+	res, err := prk.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: chainID, ValoperAddress: "cosmosvaloper1a"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Scores, 3)
+	for i, score := range res.Scores {
+		suite.Require().Equal("cosmosvaloper1a", score.ValoperAddress)
+		suite.Require().Equal(int64(i+1), score.Epoch)
+	}
+
+	res, err = prk.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{
+		ChainId:        chainID,
+		ValoperAddress: "cosmosvaloper1b",
+		Pagination:     &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Scores, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ZoneLeaderboard() {
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	prk := appA.ParticipationRewardsKeeper
+	chainID := suite.chainB.ChainID
+
+	for _, vs := range prk.AllValidatorScores(ctx) {
+		prk.DeleteValidatorScore(ctx, vs.ChainId, vs.Epoch, vs.ValoperAddress)
+	}
+
+	_, err := prk.ZoneLeaderboard(ctx, nil)
+	suite.Require().Error(err)
+
+	_, err = prk.ZoneLeaderboard(ctx, &types.QueryZoneLeaderboardRequest{ChainId: "unknown-1"})
+	suite.Require().Error(err)
+
+	res, err := prk.ZoneLeaderboard(ctx, &types.QueryZoneLeaderboardRequest{ChainId: chainID})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Scores)
+
+	prk.SetValidatorScore(ctx, newTestValidatorScore(chainID, 1, "cosmosvaloper1a", "0.9"))
+	prk.SetValidatorScore(ctx, newTestValidatorScore(chainID, 2, "cosmosvaloper1a", "0.2"))
+	prk.SetValidatorScore(ctx, newTestValidatorScore(chainID, 2, "cosmosvaloper1b", "0.7"))
+	prk.SetValidatorScore(ctx, newTestValidatorScore(chainID, 2, "cosmosvaloper1c", "0.4"))
+	prk.SetValidatorScore(ctx, newTestValidatorScore(chainID, 2, "cosmosvaloper1d", "0.7"))
+
+	res, err = prk.ZoneLeaderboard(ctx, &types.QueryZoneLeaderboardRequest{ChainId: chainID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), res.Epoch)
+	suite.Require().Len(res.Scores, 4)
+
+	order := make([]string, 0, len(res.Scores))
+	for _, score := range res.Scores {
+		order = append(order, score.ValoperAddress)
+	}
+	suite.Require().Equal([]string{"cosmosvaloper1b", "cosmosvaloper1d", "cosmosvaloper1c", "cosmosvaloper1a"}, order)
+}
+
+func newTestValidatorScore(chainID string, epoch int64, valoper string, score string) types.ValidatorScore {
+	return types.ValidatorScore{
+		ChainId:           chainID,
+		Epoch:             epoch,
+		ValoperAddress:    valoper,
+		PowerPercentage:   sdk.MustNewDecFromStr("0.25"),
+		DistributionScore: sdk.MustNewDecFromStr(score),
+		PerformanceScore:  sdk.OneDec(),
+		Score:             sdk.MustNewDecFromStr(score),
+	}
+}
@@ -34,6 +34,26 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, vs := range gs.ValidatorScores {
+		if vs == nil {
+			errors[fmt.Sprintf("ValidatorScores[%d]", i)] = ErrUndefinedAttribute
+			continue
+		}
+		if err := vs.ValidateBasic(); err != nil {
+			errors[fmt.Sprintf("ValidatorScores[%d]", i)] = err
+		}
+	}
+
+	for i, vr := range gs.ValidatorRewardRatios {
+		if vr == nil {
+			errors[fmt.Sprintf("ValidatorRewardRatios[%d]", i)] = ErrUndefinedAttribute
+			continue
+		}
+		if err := vr.ValidateBasic(); err != nil {
+			errors[fmt.Sprintf("ValidatorRewardRatios[%d]", i)] = err
+		}
+	}
+
 	if len(errors) > 0 {
 		return multierror.New(errors)
 	}
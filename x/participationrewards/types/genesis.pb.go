@@ -25,8 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the participationrewards module's genesis state.
 type GenesisState struct {
-	Params       Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ProtocolData []*KeyedProtocolData `protobuf:"bytes,2,rep,name=protocol_data,json=protocolData,proto3" json:"protocol_data,omitempty"`
+	Params                Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ProtocolData          []*KeyedProtocolData    `protobuf:"bytes,2,rep,name=protocol_data,json=protocolData,proto3" json:"protocol_data,omitempty"`
+	ValidatorScores       []*ValidatorScore       `protobuf:"bytes,3,rep,name=validator_scores,json=validatorScores,proto3" json:"validator_scores,omitempty"`
+	ValidatorRewardRatios []*ValidatorRewardRatio `protobuf:"bytes,4,rep,name=validator_reward_ratios,json=validatorRewardRatios,proto3" json:"validator_reward_ratios,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,20 @@ func (m *GenesisState) GetProtocolData() []*KeyedProtocolData {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorScores() []*ValidatorScore {
+	if m != nil {
+		return m.ValidatorScores
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorRewardRatios() []*ValidatorRewardRatio {
+	if m != nil {
+		return m.ValidatorRewardRatios
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "quicksilver.participationrewards.v1.GenesisState")
 }
@@ -85,24 +101,29 @@ func init() {
 }
 
 var fileDescriptor_1387494f116edd8c = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x2c, 0x2c, 0xcd, 0x4c,
-	0xce, 0x2e, 0xce, 0xcc, 0x29, 0x4b, 0x2d, 0xd2, 0x2f, 0x48, 0x2c, 0x2a, 0xc9, 0x4c, 0xce, 0x2c,
-	0x48, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0xd6, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x46,
-	0xd2, 0xa2, 0x87, 0x4d, 0x8b, 0x5e, 0x99, 0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0xbd,
-	0x3e, 0x88, 0x05, 0xd1, 0x2a, 0x65, 0x47, 0x8c, 0x6d, 0x58, 0x8d, 0x04, 0xeb, 0x57, 0xda, 0xc6,
-	0xc8, 0xc5, 0xe3, 0x0e, 0x71, 0x4c, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x27, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb6, 0x1e, 0x11, 0x8e,
-	0xd3, 0x0b, 0x00, 0x6b, 0x71, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0x6a, 0x80, 0x50, 0x34,
-	0x17, 0x2f, 0xd8, 0x92, 0xe4, 0xfc, 0x9c, 0xf8, 0x94, 0xc4, 0x92, 0x44, 0x09, 0x26, 0x05, 0x66,
-	0x0d, 0x6e, 0x23, 0x33, 0xa2, 0x4c, 0xf4, 0x4e, 0xad, 0x4c, 0x4d, 0x09, 0x80, 0x6a, 0x77, 0x49,
-	0x2c, 0x49, 0x0c, 0xe2, 0x29, 0x40, 0xe2, 0x39, 0xc5, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x73, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e,
-	0x66, 0x5e, 0x7a, 0x6a, 0x5e, 0x69, 0x66, 0x49, 0xa5, 0x6e, 0x52, 0x69, 0x66, 0x4e, 0x8a, 0x3e,
-	0x72, 0x68, 0x55, 0x60, 0x0f, 0xaf, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x65, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x75, 0x0d, 0xb2, 0xce, 0x01, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x4b, 0xfb, 0x30,
+	0x14, 0xc7, 0xdb, 0xdf, 0xc6, 0x0e, 0xdd, 0x7e, 0x28, 0x45, 0xb1, 0xec, 0x50, 0x87, 0x5e, 0x06,
+	0x62, 0xcb, 0x36, 0x10, 0xbc, 0x78, 0x98, 0x82, 0x88, 0x97, 0x91, 0x81, 0x07, 0x45, 0xc7, 0x5b,
+	0x1b, 0x6a, 0xb0, 0x6b, 0xb2, 0x24, 0x8d, 0xee, 0x4f, 0xf0, 0xe6, 0x9f, 0xb5, 0xe3, 0x8e, 0x9e,
+	0x44, 0xb6, 0x7f, 0x44, 0x9a, 0x4d, 0xac, 0xd0, 0x43, 0xbd, 0xe5, 0xbd, 0xe4, 0xf3, 0xf9, 0x3e,
+	0xc2, 0xb3, 0x3a, 0xd3, 0x94, 0x04, 0x4f, 0x82, 0xc4, 0x0a, 0x73, 0x9f, 0x01, 0x97, 0x24, 0x20,
+	0x0c, 0x24, 0xa1, 0x09, 0xc7, 0xcf, 0xc0, 0x43, 0xe1, 0xab, 0x8e, 0x1f, 0xe1, 0x04, 0x0b, 0x22,
+	0x3c, 0xc6, 0xa9, 0xa4, 0xf6, 0x61, 0x0e, 0xf1, 0x8a, 0x10, 0x4f, 0x75, 0x9a, 0x3b, 0x11, 0x8d,
+	0xa8, 0x7e, 0xef, 0x67, 0xa7, 0x35, 0xda, 0x3c, 0x2b, 0x93, 0x56, 0xa8, 0xd4, 0xfc, 0xc1, 0x6b,
+	0xc5, 0x6a, 0x5c, 0xae, 0x87, 0x19, 0x4a, 0x90, 0xd8, 0xbe, 0xb2, 0x6a, 0x0c, 0x38, 0x4c, 0x84,
+	0x63, 0xb6, 0xcc, 0x76, 0xbd, 0x7b, 0xe4, 0x95, 0x18, 0xce, 0x1b, 0x68, 0xa4, 0x5f, 0x9d, 0x7f,
+	0xec, 0x1b, 0x68, 0x23, 0xb0, 0xef, 0xac, 0xff, 0x3a, 0x24, 0xa0, 0xf1, 0x28, 0x04, 0x09, 0xce,
+	0xbf, 0x56, 0xa5, 0x5d, 0xef, 0x9e, 0x94, 0x32, 0x5e, 0xe3, 0x19, 0x0e, 0x07, 0x1b, 0xfc, 0x02,
+	0x24, 0xa0, 0x06, 0xcb, 0x55, 0xf6, 0x83, 0xb5, 0xad, 0x20, 0x26, 0x21, 0x48, 0xca, 0x47, 0x22,
+	0xa0, 0x1c, 0x0b, 0xa7, 0xa2, 0xfd, 0xbd, 0x52, 0xfe, 0x9b, 0x6f, 0x78, 0x98, 0xb1, 0x68, 0x4b,
+	0xfd, 0xaa, 0x85, 0x3d, 0xb5, 0xf6, 0x7e, 0xfc, 0x6b, 0x6e, 0xc4, 0x33, 0x89, 0x70, 0xaa, 0x3a,
+	0xe6, 0xf4, 0x6f, 0x31, 0x48, 0xf7, 0x50, 0x76, 0x8f, 0x76, 0x55, 0x41, 0x57, 0xf4, 0xef, 0xe7,
+	0x4b, 0xd7, 0x5c, 0x2c, 0x5d, 0xf3, 0x73, 0xe9, 0x9a, 0x6f, 0x2b, 0xd7, 0x58, 0xac, 0x5c, 0xe3,
+	0x7d, 0xe5, 0x1a, 0xb7, 0xe7, 0x11, 0x91, 0x8f, 0xe9, 0xd8, 0x0b, 0xe8, 0xc4, 0x27, 0x49, 0x84,
+	0x93, 0x94, 0xc8, 0xd9, 0xf1, 0x38, 0x25, 0x71, 0xe8, 0xe7, 0x17, 0xe0, 0xa5, 0x78, 0x05, 0xe4,
+	0x8c, 0x61, 0x31, 0xae, 0xe9, 0xff, 0xeb, 0x7d, 0x0d, 0x00, 0xd2, 0x11, 0xf5, 0x19, 0xa1, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +146,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorRewardRatios) > 0 {
+		for iNdEx := len(m.ValidatorRewardRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewardRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorScores) > 0 {
+		for iNdEx := len(m.ValidatorScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ProtocolData) > 0 {
 		for iNdEx := len(m.ProtocolData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +226,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorScores) > 0 {
+		for _, e := range m.ValidatorScores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorRewardRatios) > 0 {
+		for _, e := range m.ValidatorRewardRatios {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +343,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorScores = append(m.ValidatorScores, &ValidatorScore{})
+			if err := m.ValidatorScores[len(m.ValidatorScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewardRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewardRatios = append(m.ValidatorRewardRatios, &ValidatorRewardRatio{})
+			if err := m.ValidatorRewardRatios[len(m.ValidatorRewardRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
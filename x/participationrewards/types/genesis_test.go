@@ -18,6 +18,8 @@ func TestGenesisState(t *testing.T) {
 			},
 		},
 		nil,
+		nil,
+		nil,
 	}
 	defaultGenesisState := DefaultGenesisState()
 	require.Equal(t, *defaultGenesisState, testGenesisState)
@@ -40,6 +42,8 @@ func TestGenesisState(t *testing.T) {
 			},
 		},
 		nil,
+		nil,
+		nil,
 	}
 	require.Equal(t, *newGenesisState, testGenesisState)
 }
@@ -15,9 +15,21 @@ const (
 	RouterKey = ModuleName
 
 	OsmosisParamsKey = "osmosisparams"
+
+	// ValidatorScoreHistoryLength is the number of epochs for which validator
+	// scores are retained.
+	ValidatorScoreHistoryLength = 30
 )
 
-var KeyPrefixProtocolData = []byte{0x00}
+var (
+	KeyPrefixProtocolData   = []byte{0x00}
+	KeyPrefixValidatorScore = []byte{0x01}
+	// KeyPrefixValidatorRewardRatio is the prefix of the validator reward ratios.
+	KeyPrefixValidatorRewardRatio = []byte{0x02}
+	// KeyPrefixValidatorRewardsPending is the prefix of the number of validator
+	// reward queries outstanding for each zone.
+	KeyPrefixValidatorRewardsPending = []byte{0x03}
+)
 
 func GetProtocolDataKey(pdType ProtocolDataType, key string) []byte {
 	return append(sdk.Uint64ToBigEndian(uint64(pdType)), []byte(key)...)
@@ -26,3 +38,29 @@ func GetProtocolDataKey(pdType ProtocolDataType, key string) []byte {
 func GetPrefixProtocolDataKey(pdType ProtocolDataType) []byte {
 	return sdk.Uint64ToBigEndian(uint64(pdType))
 }
+
+// GetValidatorScoresKey gets the prefix for the validator scores of a zone.
+func GetValidatorScoresKey(chainID string) []byte {
+	return append([]byte(chainID), byte('/'))
+}
+
+// GetValidatorScoresEpochKey gets the prefix for the validator scores of a zone
+// for the given epoch.
+func GetValidatorScoresEpochKey(chainID string, epoch int64) []byte {
+	return append(GetValidatorScoresKey(chainID), sdk.Uint64ToBigEndian(uint64(epoch))...)
+}
+
+// GetValidatorScoreKey gets the validator score key.
+func GetValidatorScoreKey(chainID string, epoch int64, valoper string) []byte {
+	return append(GetValidatorScoresEpochKey(chainID, epoch), []byte(valoper)...)
+}
+
+// GetValidatorRewardRatiosKey gets the prefix for the validator reward ratios of a zone.
+func GetValidatorRewardRatiosKey(chainID string) []byte {
+	return append([]byte(chainID), byte('/'))
+}
+
+// GetValidatorRewardRatioKey gets the validator reward ratio key.
+func GetValidatorRewardRatioKey(chainID string, valoper string) []byte {
+	return append(GetValidatorRewardRatiosKey(chainID), []byte(valoper)...)
+}
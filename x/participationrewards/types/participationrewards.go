@@ -76,6 +76,79 @@ func (kpd KeyedProtocolData) ValidateBasic() error {
 	return nil
 }
 
+func (vs ValidatorScore) ValidateBasic() error {
+	errors := make(map[string]error)
+
+	if len(vs.ChainId) == 0 {
+		errors["ChainId"] = ErrUndefinedAttribute
+	}
+
+	if vs.Epoch < 0 {
+		errors["Epoch"] = ErrNegativeAttribute
+	}
+
+	if len(vs.ValoperAddress) == 0 {
+		errors["ValoperAddress"] = ErrUndefinedAttribute
+	}
+
+	for name, score := range map[string]sdk.Dec{
+		"PowerPercentage":   vs.PowerPercentage,
+		"DistributionScore": vs.DistributionScore,
+		"PerformanceScore":  vs.PerformanceScore,
+		"Score":             vs.Score,
+	} {
+		if score.IsNil() {
+			errors[name] = ErrUndefinedAttribute
+		} else if score.IsNegative() {
+			errors[name] = ErrNegativeAttribute
+		}
+	}
+
+	if len(errors) > 0 {
+		return multierror.New(errors)
+	}
+
+	return nil
+}
+
+func (vr ValidatorRewardRatio) ValidateBasic() error {
+	errors := make(map[string]error)
+
+	if len(vr.ChainId) == 0 {
+		errors["ChainId"] = ErrUndefinedAttribute
+	}
+
+	if len(vr.ValoperAddress) == 0 {
+		errors["ValoperAddress"] = ErrUndefinedAttribute
+	}
+
+	if vr.Epoch < 0 {
+		errors["Epoch"] = ErrNegativeAttribute
+	}
+
+	if vr.PreviousEpoch < 0 {
+		errors["PreviousEpoch"] = ErrNegativeAttribute
+	} else if vr.PreviousEpoch > vr.Epoch {
+		errors["PreviousEpoch"] = fmt.Errorf("previous epoch %d is after epoch %d", vr.PreviousEpoch, vr.Epoch)
+	}
+
+	for name, coins := range map[string]sdk.DecCoins{
+		"Ratio":         vr.Ratio,
+		"PreviousRatio": vr.PreviousRatio,
+		"Current":       vr.Current,
+	} {
+		if !coins.IsValid() && !coins.Empty() {
+			errors[name] = fmt.Errorf("invalid coins %s", coins)
+		}
+	}
+
+	if len(errors) > 0 {
+		return multierror.New(errors)
+	}
+
+	return nil
+}
+
 func (pd ProtocolData) ValidateBasic() error {
 	errors := make(map[string]error)
 
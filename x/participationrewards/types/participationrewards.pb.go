@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -293,6 +294,178 @@ func (m *ProtocolData) GetData() encoding_json.RawMessage {
 	return nil
 }
 
+// ValidatorScore is the record of a zone validator's scores for a given epoch,
+// as calculated for validator selection rewards.
+type ValidatorScore struct {
+	ChainId           string                                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch             int64                                  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValoperAddress    string                                 `protobuf:"bytes,3,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	PowerPercentage   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=power_percentage,json=powerPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_percentage"`
+	DistributionScore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=distribution_score,json=distributionScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"distribution_score"`
+	PerformanceScore  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=performance_score,json=performanceScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"performance_score"`
+	Score             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+	Height            int64                                  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ValidatorScore) Reset()         { *m = ValidatorScore{} }
+func (m *ValidatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScore) ProtoMessage()    {}
+func (*ValidatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{5}
+}
+func (m *ValidatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScore.Merge(m, src)
+}
+func (m *ValidatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorScore proto.InternalMessageInfo
+
+func (m *ValidatorScore) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ValidatorScore) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorScore) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
+	}
+	return ""
+}
+
+func (m *ValidatorScore) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ValidatorRewardRatio is the cumulative reward ratio, the rewards per token
+// delegated, of a zone validator as proven from the distribution store of the
+// zone at the given epoch. The ratio of the preceding epoch is retained to
+// determine the rewards per token earned between the two.
+type ValidatorRewardRatio struct {
+	ChainId        string                                      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ValoperAddress string                                      `protobuf:"bytes,2,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	Ratio          github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=ratio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"ratio"`
+	Epoch          int64                                       `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PreviousRatio  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=previous_ratio,json=previousRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"previous_ratio"`
+	PreviousEpoch  int64                                       `protobuf:"varint,6,opt,name=previous_epoch,json=previousEpoch,proto3" json:"previous_epoch,omitempty"`
+	// current is the rewards per token of the current period of the validator,
+	// held until the ratio of the preceding period is proven.
+	Current github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=current,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"current"`
+}
+
+func (m *ValidatorRewardRatio) Reset()         { *m = ValidatorRewardRatio{} }
+func (m *ValidatorRewardRatio) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardRatio) ProtoMessage()    {}
+func (*ValidatorRewardRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{6}
+}
+func (m *ValidatorRewardRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardRatio.Merge(m, src)
+}
+func (m *ValidatorRewardRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardRatio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardRatio proto.InternalMessageInfo
+
+func (m *ValidatorRewardRatio) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ValidatorRewardRatio) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRewardRatio) GetRatio() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Ratio
+	}
+	return nil
+}
+
+func (m *ValidatorRewardRatio) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewardRatio) GetPreviousRatio() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.PreviousRatio
+	}
+	return nil
+}
+
+func (m *ValidatorRewardRatio) GetPreviousEpoch() int64 {
+	if m != nil {
+		return m.PreviousEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewardRatio) GetCurrent() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("quicksilver.participationrewards.v1.ProtocolDataType", ProtocolDataType_name, ProtocolDataType_value)
 	proto.RegisterType((*DistributionProportions)(nil), "quicksilver.participationrewards.v1.DistributionProportions")
@@ -300,6 +473,8 @@ func init() {
 	proto.RegisterType((*Params)(nil), "quicksilver.participationrewards.v1.Params")
 	proto.RegisterType((*KeyedProtocolData)(nil), "quicksilver.participationrewards.v1.KeyedProtocolData")
 	proto.RegisterType((*ProtocolData)(nil), "quicksilver.participationrewards.v1.ProtocolData")
+	proto.RegisterType((*ValidatorScore)(nil), "quicksilver.participationrewards.v1.ValidatorScore")
+	proto.RegisterType((*ValidatorRewardRatio)(nil), "quicksilver.participationrewards.v1.ValidatorRewardRatio")
 }
 
 func init() {
@@ -307,47 +482,65 @@ func init() {
 }
 
 var fileDescriptor_d4fb4e5bb851c124 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xc1, 0x4f, 0xd4, 0x4e,
-	0x14, 0x6e, 0x97, 0xfe, 0x08, 0x0c, 0xf0, 0x4b, 0x19, 0x4d, 0x80, 0x15, 0xba, 0x88, 0xd1, 0x18,
-	0x13, 0x5a, 0x17, 0x6f, 0x84, 0x98, 0xb8, 0xac, 0x27, 0x35, 0x92, 0x82, 0x1e, 0x4c, 0x4c, 0x33,
-	0x3b, 0x33, 0x94, 0x71, 0x67, 0x67, 0x4a, 0xa7, 0x5d, 0xdc, 0x03, 0x89, 0xf1, 0xc4, 0xd1, 0x83,
-	0x07, 0x8f, 0x26, 0xfe, 0x0b, 0x1e, 0xfc, 0x13, 0x38, 0x12, 0x4f, 0xc6, 0x03, 0x31, 0xf0, 0x5f,
-	0x78, 0x30, 0x66, 0xda, 0x5d, 0xac, 0x6b, 0x4d, 0x38, 0x90, 0x78, 0xea, 0x9b, 0xf7, 0xbe, 0xf9,
-	0xbe, 0x37, 0xfd, 0xde, 0x0c, 0xb8, 0xbb, 0x9b, 0x32, 0xdc, 0x56, 0x8c, 0x77, 0x69, 0xec, 0x45,
-	0x28, 0x4e, 0x18, 0x66, 0x11, 0x4a, 0x98, 0x14, 0x31, 0xdd, 0x43, 0x31, 0x51, 0x5e, 0xb7, 0x5e,
-	0x9a, 0x77, 0xa3, 0x58, 0x26, 0x12, 0x5e, 0x2b, 0xec, 0x77, 0x4b, 0x71, 0xdd, 0x7a, 0xf5, 0x72,
-	0x28, 0x43, 0x99, 0xe1, 0x3d, 0x1d, 0xe5, 0x5b, 0xab, 0x73, 0x58, 0xaa, 0x8e, 0x54, 0x41, 0x5e,
-	0xc8, 0x17, 0x79, 0x69, 0xe9, 0x47, 0x05, 0xcc, 0x34, 0x99, 0x4a, 0x62, 0xd6, 0x4a, 0x35, 0xd7,
-	0x46, 0x2c, 0x23, 0x19, 0xeb, 0x48, 0xc1, 0xd7, 0x26, 0x70, 0xba, 0x88, 0x33, 0x82, 0x12, 0x19,
-	0x07, 0x8a, 0x72, 0x8a, 0x75, 0x21, 0x40, 0x9c, 0x4b, 0x9c, 0x29, 0xcf, 0x9a, 0x8b, 0xe6, 0xcd,
-	0xf1, 0xc6, 0xda, 0xe1, 0x71, 0xcd, 0xf8, 0x7a, 0x5c, 0xbb, 0x11, 0xb2, 0x64, 0x27, 0x6d, 0xb9,
-	0x58, 0x76, 0xfa, 0x2a, 0xfd, 0xcf, 0xb2, 0x22, 0x6d, 0x2f, 0xe9, 0x45, 0x54, 0xb9, 0x4d, 0x8a,
-	0x3f, 0x7f, 0x5c, 0x06, 0xfd, 0x26, 0x9a, 0x14, 0xfb, 0xf3, 0x67, 0x1a, 0x9b, 0x03, 0x89, 0x7b,
-	0x67, 0x0a, 0xb0, 0x03, 0x2e, 0xed, 0x48, 0x4e, 0x98, 0x08, 0x55, 0x51, 0xb8, 0x72, 0x01, 0xc2,
-	0x70, 0x40, 0x5c, 0x90, 0x63, 0x60, 0x9a, 0x4b, 0xdc, 0x4e, 0xa3, 0xa2, 0xd8, 0xc8, 0x05, 0x88,
-	0xd9, 0x39, 0xed, 0x2f, 0xa9, 0x55, 0xeb, 0xe0, 0x7d, 0xcd, 0x58, 0x7a, 0x6b, 0x82, 0xf1, 0x0d,
-	0x14, 0xa3, 0x8e, 0x0a, 0xba, 0x75, 0xb8, 0x0f, 0x66, 0x49, 0xc1, 0x0d, 0xed, 0xd8, 0xc0, 0x8e,
-	0xec, 0x5f, 0x4f, 0xac, 0xac, 0xb9, 0xe7, 0x98, 0x03, 0xf7, 0x2f, 0x96, 0x36, 0x2c, 0x7d, 0x06,
-	0x7f, 0x86, 0x94, 0x97, 0x57, 0xc7, 0x74, 0x4b, 0xef, 0x74, 0x5b, 0x9f, 0x4c, 0x30, 0x9a, 0xb7,
-	0xf5, 0x8f, 0x7b, 0x82, 0xd7, 0xc1, 0xff, 0x98, 0x23, 0xd6, 0x51, 0x01, 0x15, 0xa8, 0xc5, 0x29,
-	0xc9, 0xbc, 0x1f, 0xf3, 0xa7, 0xf2, 0xec, 0xfd, 0x3c, 0x59, 0x68, 0x7d, 0x1f, 0x4c, 0x3f, 0xa0,
-	0x3d, 0x4a, 0x36, 0xf4, 0x80, 0x63, 0xc9, 0x9b, 0x28, 0x41, 0xd0, 0x06, 0x23, 0x6d, 0xda, 0xcb,
-	0xe7, 0xd5, 0xd7, 0x21, 0x7c, 0x0a, 0xa6, 0xa2, 0x3e, 0x22, 0x20, 0x28, 0x41, 0x19, 0xed, 0xc4,
-	0x4a, 0xfd, 0x5c, 0x67, 0x29, 0x72, 0xfb, 0x93, 0x51, 0x61, 0xb5, 0xb4, 0x05, 0x26, 0x7f, 0x53,
-	0x86, 0xc0, 0xd2, 0x33, 0xd1, 0x97, 0xce, 0x62, 0x78, 0x1b, 0x58, 0x67, 0x92, 0x93, 0x8d, 0xf9,
-	0xef, 0xc7, 0xb5, 0x59, 0x2a, 0xb0, 0xd4, 0xc3, 0xe8, 0xbd, 0x50, 0x52, 0xb8, 0x3e, 0xda, 0x7b,
-	0x44, 0x95, 0x42, 0x21, 0xf5, 0x33, 0xe4, 0xad, 0x57, 0x15, 0x60, 0x17, 0x69, 0xb7, 0x34, 0xcd,
-	0x02, 0x98, 0x1b, 0xce, 0x3d, 0x11, 0x84, 0x6e, 0x33, 0x41, 0x89, 0x6d, 0x40, 0x07, 0x54, 0x87,
-	0xcb, 0xeb, 0x52, 0x88, 0xfc, 0x8a, 0xd9, 0x26, 0xbc, 0x0a, 0x16, 0x86, 0xeb, 0x8f, 0xf5, 0xbc,
-	0x32, 0x95, 0x3b, 0x6f, 0x57, 0x60, 0x0d, 0x5c, 0x19, 0x86, 0x3c, 0x64, 0xbb, 0x29, 0x23, 0x5b,
-	0xb2, 0x4d, 0x85, 0x3d, 0x52, 0x06, 0x18, 0x70, 0x48, 0xc9, 0x6d, 0x0b, 0x2e, 0x82, 0xf9, 0x3f,
-	0x9a, 0x88, 0xa9, 0xc2, 0x54, 0x24, 0x19, 0xe2, 0xbf, 0x32, 0xc4, 0x26, 0xdb, 0xc6, 0x3b, 0x88,
-	0x89, 0x0c, 0x31, 0x5a, 0xb5, 0x0e, 0x3e, 0x38, 0x46, 0xe3, 0xf9, 0xe1, 0x89, 0x63, 0x1e, 0x9d,
-	0x38, 0xe6, 0xb7, 0x13, 0xc7, 0x7c, 0x73, 0xea, 0x18, 0x47, 0xa7, 0x8e, 0xf1, 0xe5, 0xd4, 0x31,
-	0x9e, 0xad, 0x17, 0x6e, 0x24, 0x13, 0x21, 0x15, 0x29, 0x4b, 0x7a, 0xcb, 0xad, 0x94, 0x71, 0xe2,
-	0x15, 0x5f, 0xdd, 0x97, 0xe5, 0xef, 0x6e, 0x76, 0x65, 0x5b, 0xa3, 0x99, 0x8b, 0x77, 0x7e, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x1b, 0x06, 0xcc, 0xcf, 0xa8, 0x05, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x1c, 0xf5, 0xda, 0x6b, 0x27, 0x9d, 0xfc, 0xe9, 0x66, 0x88, 0xa8, 0x13, 0xd2, 0x75, 0x30, 0x2a,
+	0x54, 0xa0, 0xec, 0xe2, 0xf4, 0x56, 0x55, 0x48, 0x75, 0xd2, 0x03, 0x02, 0x44, 0xb4, 0x0d, 0x3d,
+	0x20, 0xa1, 0xd5, 0x78, 0x76, 0xb2, 0x1e, 0xbc, 0x9e, 0xd9, 0xce, 0xec, 0x3a, 0xcd, 0xa1, 0x12,
+	0xe2, 0xd4, 0x23, 0x07, 0x24, 0x38, 0x22, 0x71, 0xe3, 0xcc, 0x81, 0x8f, 0xd0, 0x63, 0xc5, 0x09,
+	0x71, 0x08, 0x28, 0x39, 0xf0, 0x1d, 0x38, 0x20, 0x34, 0x33, 0x6b, 0x67, 0x6b, 0x5c, 0x94, 0x83,
+	0xab, 0x9e, 0x3c, 0xbf, 0x3f, 0xfb, 0xde, 0x9b, 0x99, 0x37, 0x33, 0x06, 0x1f, 0x3c, 0xcc, 0x29,
+	0x1e, 0x48, 0x9a, 0x8c, 0x88, 0xf0, 0x53, 0x24, 0x32, 0x8a, 0x69, 0x8a, 0x32, 0xca, 0x99, 0x20,
+	0xc7, 0x48, 0x44, 0xd2, 0x1f, 0x75, 0x66, 0xe6, 0xbd, 0x54, 0xf0, 0x8c, 0xc3, 0xb7, 0x4a, 0xdf,
+	0x7b, 0x33, 0xfb, 0x46, 0x9d, 0xcd, 0xf5, 0x98, 0xc7, 0x5c, 0xf7, 0xfb, 0x6a, 0x64, 0x3e, 0xdd,
+	0xdc, 0xc0, 0x5c, 0x0e, 0xb9, 0x0c, 0x4d, 0xc1, 0x04, 0x45, 0xc9, 0x35, 0x91, 0xdf, 0x43, 0x92,
+	0xf8, 0xa3, 0x4e, 0x8f, 0x64, 0xa8, 0xe3, 0x63, 0x4e, 0x99, 0xa9, 0xb7, 0xff, 0xa9, 0x82, 0x6b,
+	0xfb, 0x54, 0x66, 0x82, 0xf6, 0x72, 0xc5, 0x75, 0x20, 0x78, 0xca, 0x85, 0x1a, 0x49, 0xf8, 0xb5,
+	0x05, 0xdc, 0x11, 0x4a, 0x68, 0x84, 0x32, 0x2e, 0x42, 0x49, 0x12, 0x82, 0x55, 0x21, 0x44, 0x49,
+	0xc2, 0xb1, 0x56, 0xd6, 0xb4, 0xb6, 0xad, 0x9b, 0x57, 0xba, 0x77, 0x9e, 0x9e, 0xb6, 0x2a, 0xbf,
+	0x9f, 0xb6, 0xde, 0x8e, 0x69, 0xd6, 0xcf, 0x7b, 0x1e, 0xe6, 0xc3, 0x42, 0x45, 0xf1, 0xb3, 0x23,
+	0xa3, 0x81, 0x9f, 0x9d, 0xa4, 0x44, 0x7a, 0xfb, 0x04, 0xff, 0xfa, 0xf3, 0x0e, 0x28, 0x44, 0xee,
+	0x13, 0x1c, 0x6c, 0x4d, 0x38, 0xee, 0x8f, 0x29, 0xee, 0x4e, 0x18, 0xe0, 0x10, 0xbc, 0xd6, 0xe7,
+	0x49, 0x44, 0x59, 0x2c, 0xcb, 0xc4, 0xd5, 0x39, 0x10, 0xc3, 0x31, 0x70, 0x89, 0x8e, 0x82, 0xb5,
+	0x84, 0xe3, 0x41, 0x9e, 0x96, 0xc9, 0x6a, 0x73, 0x20, 0x73, 0x0c, 0xec, 0x05, 0xd5, 0x6d, 0xfb,
+	0xc9, 0x0f, 0xad, 0x4a, 0xfb, 0x5b, 0x0b, 0x5c, 0x39, 0x40, 0x02, 0x0d, 0x65, 0x38, 0xea, 0xc0,
+	0xc7, 0xa0, 0x19, 0x95, 0x76, 0x23, 0x4c, 0x2f, 0xb6, 0x43, 0xaf, 0xf5, 0xd2, 0xee, 0x1d, 0xef,
+	0x12, 0x3e, 0xf1, 0x5e, 0xb0, 0xa5, 0x5d, 0x5b, 0xcd, 0x21, 0xb8, 0x16, 0xcd, 0x2e, 0xdf, 0x5e,
+	0x54, 0x92, 0xbe, 0x57, 0xb2, 0x7e, 0xb1, 0x40, 0xc3, 0xc8, 0x7a, 0xc5, 0x9a, 0xe0, 0x0d, 0xb0,
+	0x8a, 0x13, 0x44, 0x87, 0x32, 0x24, 0x0c, 0xf5, 0x12, 0x12, 0xe9, 0xbd, 0x5f, 0x0c, 0x56, 0x4c,
+	0xf6, 0x9e, 0x49, 0x96, 0xa4, 0x3f, 0x06, 0x6b, 0x1f, 0x91, 0x13, 0x12, 0x1d, 0x08, 0x9e, 0x71,
+	0xcc, 0x93, 0x7d, 0x94, 0x21, 0xe8, 0x80, 0xda, 0x80, 0x9c, 0x18, 0xbf, 0x06, 0x6a, 0x08, 0x1f,
+	0x80, 0x95, 0xb4, 0xe8, 0x08, 0x23, 0x94, 0x21, 0x0d, 0xbb, 0xb4, 0xdb, 0xb9, 0xd4, 0x5c, 0xca,
+	0xd8, 0xc1, 0x72, 0x5a, 0x8a, 0xda, 0x87, 0x60, 0xf9, 0x39, 0x66, 0x08, 0x6c, 0xe5, 0x89, 0x82,
+	0x5a, 0x8f, 0xe1, 0xfb, 0xc0, 0x9e, 0x50, 0x2e, 0x77, 0xb7, 0xfe, 0x3e, 0x6d, 0x35, 0x09, 0xc3,
+	0x5c, 0x99, 0xd1, 0xff, 0x52, 0x72, 0xe6, 0x05, 0xe8, 0xf8, 0x13, 0x22, 0x25, 0x8a, 0x49, 0xa0,
+	0x3b, 0xdb, 0xdf, 0xd9, 0x60, 0xf5, 0xc1, 0xe4, 0x9c, 0x60, 0x2e, 0x08, 0xdc, 0x00, 0x8b, 0xb8,
+	0x8f, 0x28, 0x0b, 0x69, 0x54, 0x80, 0x2f, 0xe8, 0xf8, 0xc3, 0x08, 0xae, 0x83, 0x3a, 0x49, 0x39,
+	0xee, 0x6b, 0x82, 0x5a, 0x60, 0x02, 0xf8, 0x0e, 0xb8, 0x3a, 0x42, 0x09, 0x4f, 0x89, 0x08, 0x51,
+	0x14, 0x09, 0x22, 0xa5, 0x71, 0x76, 0xb0, 0x5a, 0xa4, 0xef, 0x9a, 0x2c, 0x8c, 0x81, 0x93, 0xf2,
+	0x63, 0x22, 0xc2, 0x94, 0x08, 0x4c, 0x58, 0x86, 0x62, 0xd2, 0xb4, 0xe7, 0x70, 0x06, 0xae, 0x6a,
+	0xd4, 0x83, 0x09, 0x28, 0x1c, 0x00, 0xf8, 0x9c, 0xb5, 0xa4, 0x9a, 0x58, 0xb3, 0x3e, 0x07, 0xaa,
+	0xb5, 0x32, 0xae, 0x59, 0x2f, 0x0a, 0xd6, 0x52, 0x22, 0x8e, 0xb8, 0x18, 0x22, 0x86, 0x49, 0xc1,
+	0xd5, 0x98, 0xc7, 0xd1, 0x2e, 0xc1, 0x1a, 0xaa, 0x00, 0xd4, 0x0d, 0xfc, 0xc2, 0x1c, 0xe0, 0x0d,
+	0x14, 0x7c, 0x1d, 0x34, 0xfa, 0x84, 0xc6, 0xfd, 0xac, 0xb9, 0xa8, 0x37, 0xb5, 0x88, 0xda, 0x7f,
+	0xd5, 0xc0, 0xfa, 0xc4, 0x19, 0x81, 0xb6, 0x68, 0xa0, 0xec, 0xfa, 0x7f, 0xfe, 0x98, 0xe1, 0x84,
+	0xea, 0x0b, 0x9c, 0x50, 0x17, 0x0a, 0xac, 0x59, 0xdb, 0xae, 0xdd, 0x5c, 0xda, 0xdd, 0xf2, 0x0a,
+	0x5d, 0xea, 0x39, 0xf1, 0x8a, 0xe7, 0x44, 0x89, 0xdc, 0xe3, 0x94, 0x75, 0x6f, 0xa9, 0x69, 0xfe,
+	0xf4, 0x47, 0xeb, 0xbd, 0xcb, 0x4d, 0x53, 0x7d, 0x23, 0x03, 0x83, 0x7f, 0xe1, 0x58, 0xbb, 0xec,
+	0xd8, 0x47, 0x60, 0x35, 0x15, 0x64, 0x44, 0x79, 0x2e, 0x43, 0xa3, 0xa3, 0xfe, 0xb2, 0x74, 0xac,
+	0x8c, 0x89, 0xcc, 0xe2, 0xdd, 0x28, 0x31, 0x1b, 0x61, 0x0d, 0x2d, 0x6c, 0xd2, 0x76, 0x4f, 0x0b,
+	0x1c, 0x80, 0x05, 0x9c, 0x0b, 0x41, 0x58, 0xd6, 0x5c, 0x78, 0x59, 0xca, 0xc6, 0x0c, 0xef, 0x7e,
+	0x55, 0x05, 0x4e, 0xf9, 0x6a, 0x39, 0x54, 0x57, 0xc9, 0x75, 0xb0, 0x31, 0x9d, 0xfb, 0x8c, 0x45,
+	0xe4, 0x88, 0x32, 0x12, 0x39, 0x15, 0xe8, 0x82, 0xcd, 0xe9, 0xf2, 0x1e, 0x67, 0xcc, 0x3c, 0xb3,
+	0x8e, 0x05, 0xdf, 0x04, 0xd7, 0xa7, 0xeb, 0x9f, 0x2a, 0x21, 0x54, 0x9a, 0xdb, 0xdf, 0xa9, 0xc2,
+	0x16, 0x78, 0x63, 0xba, 0xe5, 0x63, 0xfa, 0x30, 0xa7, 0xd1, 0x21, 0x1f, 0x10, 0xe6, 0xd4, 0x66,
+	0x35, 0x8c, 0x31, 0x38, 0x4f, 0x1c, 0x1b, 0x6e, 0x83, 0xad, 0xff, 0x88, 0x10, 0x44, 0xaa, 0x5b,
+	0x40, 0x77, 0xd4, 0x67, 0x75, 0xdc, 0xa7, 0x47, 0xda, 0xae, 0xba, 0xa3, 0xb1, 0x69, 0x3f, 0xf9,
+	0xd1, 0xad, 0x74, 0xbf, 0x78, 0x7a, 0xe6, 0x5a, 0xcf, 0xce, 0x5c, 0xeb, 0xcf, 0x33, 0xd7, 0xfa,
+	0xe6, 0xdc, 0xad, 0x3c, 0x3b, 0x77, 0x2b, 0xbf, 0x9d, 0xbb, 0x95, 0xcf, 0xf7, 0x4a, 0x4b, 0x4a,
+	0x59, 0x4c, 0x58, 0x4e, 0xb3, 0x93, 0x9d, 0x5e, 0x4e, 0x93, 0xc8, 0x2f, 0xff, 0x33, 0x7b, 0x34,
+	0xfb, 0xbf, 0x99, 0x5e, 0xf3, 0x5e, 0x43, 0xdf, 0xe4, 0xb7, 0xfe, 0x1d, 0x00, 0x89, 0x5a, 0x38,
+	0xb9, 0xcc, 0x09, 0x00, 0x00,
 }
 
 func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
@@ -558,6 +751,182 @@ func (m *ProtocolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PerformanceScore.Size()
+		i -= size
+		if _, err := m.PerformanceScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DistributionScore.Size()
+		i -= size
+		if _, err := m.DistributionScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PowerPercentage.Size()
+		i -= size
+		if _, err := m.PowerPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Current) > 0 {
+		for iNdEx := len(m.Current) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Current[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PreviousEpoch != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.PreviousEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PreviousRatio) > 0 {
+		for iNdEx := len(m.PreviousRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Ratio) > 0 {
+		for iNdEx := len(m.Ratio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ratio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParticipationrewards(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParticipationrewards(v)
 	base := offset
@@ -643,6 +1012,78 @@ func (m *ProtocolData) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.Epoch))
+	}
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = m.PowerPercentage.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.DistributionScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.PerformanceScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ValidatorRewardRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if len(m.Ratio) > 0 {
+		for _, e := range m.Ratio {
+			l = e.Size()
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.Epoch))
+	}
+	if len(m.PreviousRatio) > 0 {
+		for _, e := range m.PreviousRatio {
+			l = e.Size()
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	if m.PreviousEpoch != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.PreviousEpoch))
+	}
+	if len(m.Current) > 0 {
+		for _, e := range m.Current {
+			l = e.Size()
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovParticipationrewards(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1221,6 +1662,548 @@ func (m *ProtocolData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerformanceScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratio = append(m.Ratio, types.DecCoin{})
+			if err := m.Ratio[len(m.Ratio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousRatio = append(m.PreviousRatio, types.DecCoin{})
+			if err := m.PreviousRatio[len(m.PreviousRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpoch", wireType)
+			}
+			m.PreviousEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Current = append(m.Current, types.DecCoin{})
+			if err := m.Current[len(m.Current)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParticipationrewards(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -206,3 +206,131 @@ func TestKeyedProtocolData_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorScore_ValidateBasic(t *testing.T) {
+	valid := func() ValidatorScore {
+		return ValidatorScore{
+			ChainId:           "cosmoshub-4",
+			Epoch:             10,
+			ValoperAddress:    "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0",
+			PowerPercentage:   sdk.MustNewDecFromStr("0.1"),
+			DistributionScore: sdk.MustNewDecFromStr("0.8"),
+			PerformanceScore:  sdk.MustNewDecFromStr("0.95"),
+			Score:             sdk.MustNewDecFromStr("0.76"),
+			Height:            100,
+		}
+	}
+	tests := []struct {
+		name     string
+		malleate func(vs *ValidatorScore)
+		wantErr  bool
+	}{
+		{
+			"valid",
+			func(vs *ValidatorScore) {},
+			false,
+		},
+		{
+			"blank",
+			func(vs *ValidatorScore) { *vs = ValidatorScore{} },
+			true,
+		},
+		{
+			"no_chain_id",
+			func(vs *ValidatorScore) { vs.ChainId = "" },
+			true,
+		},
+		{
+			"negative_epoch",
+			func(vs *ValidatorScore) { vs.Epoch = -1 },
+			true,
+		},
+		{
+			"no_valoper",
+			func(vs *ValidatorScore) { vs.ValoperAddress = "" },
+			true,
+		},
+		{
+			"nil_performance_score",
+			func(vs *ValidatorScore) { vs.PerformanceScore = sdk.Dec{} },
+			true,
+		},
+		{
+			"negative_score",
+			func(vs *ValidatorScore) { vs.Score = sdk.MustNewDecFromStr("-0.1") },
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs := valid()
+			tt.malleate(&vs)
+			err := vs.ValidateBasic()
+			if tt.wantErr {
+				t.Logf("Error:\n%v\n", err)
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidatorRewardRatio_ValidateBasic(t *testing.T) {
+	valid := func() ValidatorRewardRatio {
+		return ValidatorRewardRatio{
+			ChainId:        "cosmoshub-4",
+			ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0",
+			Ratio:          sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("1.2"))),
+			Epoch:          10,
+			PreviousRatio:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("1.1"))),
+			PreviousEpoch:  9,
+		}
+	}
+	tests := []struct {
+		name     string
+		malleate func(vr *ValidatorRewardRatio)
+		wantErr  bool
+	}{
+		{
+			"valid",
+			func(vr *ValidatorRewardRatio) {},
+			false,
+		},
+		{
+			"blank",
+			func(vr *ValidatorRewardRatio) { *vr = ValidatorRewardRatio{} },
+			true,
+		},
+		{
+			"no_valoper",
+			func(vr *ValidatorRewardRatio) { vr.ValoperAddress = "" },
+			true,
+		},
+		{
+			"previous_epoch_after_epoch",
+			func(vr *ValidatorRewardRatio) { vr.PreviousEpoch = 11 },
+			true,
+		},
+		{
+			"invalid_ratio",
+			func(vr *ValidatorRewardRatio) {
+				vr.Ratio = sdk.DecCoins{{Denom: "uatom", Amount: sdk.MustNewDecFromStr("-1")}}
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vr := valid()
+			tt.malleate(&vr)
+			err := vr.ValidateBasic()
+			if tt.wantErr {
+				t.Logf("Error:\n%v\n", err)
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	encoding_json "encoding/json"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -212,11 +213,231 @@ func (m *QueryProtocolDataResponse) GetData() []encoding_json.RawMessage {
 	return nil
 }
 
+// QueryValidatorScoresRequest is the request type for querying the score
+// history of a validator.
+type QueryValidatorScoresRequest struct {
+	ChainId        string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ValoperAddress string             `protobuf:"bytes,2,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorScoresRequest) Reset()         { *m = QueryValidatorScoresRequest{} }
+func (m *QueryValidatorScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresRequest) ProtoMessage()    {}
+func (*QueryValidatorScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{4}
+}
+func (m *QueryValidatorScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresRequest.Merge(m, src)
+}
+func (m *QueryValidatorScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryValidatorScoresRequest) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
+	}
+	return ""
+}
+
+func (m *QueryValidatorScoresRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorScoresResponse is the response type for querying the score
+// history of a validator.
+type QueryValidatorScoresResponse struct {
+	Scores     []ValidatorScore    `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorScoresResponse) Reset()         { *m = QueryValidatorScoresResponse{} }
+func (m *QueryValidatorScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresResponse) ProtoMessage()    {}
+func (*QueryValidatorScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{5}
+}
+func (m *QueryValidatorScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresResponse.Merge(m, src)
+}
+func (m *QueryValidatorScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresResponse) GetScores() []ValidatorScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+func (m *QueryValidatorScoresResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryZoneLeaderboardRequest is the request type for querying a zone
+// validator leaderboard.
+type QueryZoneLeaderboardRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryZoneLeaderboardRequest) Reset()         { *m = QueryZoneLeaderboardRequest{} }
+func (m *QueryZoneLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryZoneLeaderboardRequest) ProtoMessage()    {}
+func (*QueryZoneLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{6}
+}
+func (m *QueryZoneLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZoneLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZoneLeaderboardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryZoneLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZoneLeaderboardRequest.Merge(m, src)
+}
+func (m *QueryZoneLeaderboardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZoneLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZoneLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryZoneLeaderboardRequest proto.InternalMessageInfo
+
+func (m *QueryZoneLeaderboardRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// QueryZoneLeaderboardResponse is the response type for querying a zone
+// validator leaderboard.
+type QueryZoneLeaderboardResponse struct {
+	Epoch  int64            `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Scores []ValidatorScore `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores"`
+}
+
+func (m *QueryZoneLeaderboardResponse) Reset()         { *m = QueryZoneLeaderboardResponse{} }
+func (m *QueryZoneLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryZoneLeaderboardResponse) ProtoMessage()    {}
+func (*QueryZoneLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{7}
+}
+func (m *QueryZoneLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZoneLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZoneLeaderboardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryZoneLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZoneLeaderboardResponse.Merge(m, src)
+}
+func (m *QueryZoneLeaderboardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZoneLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZoneLeaderboardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryZoneLeaderboardResponse proto.InternalMessageInfo
+
+func (m *QueryZoneLeaderboardResponse) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryZoneLeaderboardResponse) GetScores() []ValidatorScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "quicksilver.participationrewards.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "quicksilver.participationrewards.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryProtocolDataRequest)(nil), "quicksilver.participationrewards.v1.QueryProtocolDataRequest")
 	proto.RegisterType((*QueryProtocolDataResponse)(nil), "quicksilver.participationrewards.v1.QueryProtocolDataResponse")
+	proto.RegisterType((*QueryValidatorScoresRequest)(nil), "quicksilver.participationrewards.v1.QueryValidatorScoresRequest")
+	proto.RegisterType((*QueryValidatorScoresResponse)(nil), "quicksilver.participationrewards.v1.QueryValidatorScoresResponse")
+	proto.RegisterType((*QueryZoneLeaderboardRequest)(nil), "quicksilver.participationrewards.v1.QueryZoneLeaderboardRequest")
+	proto.RegisterType((*QueryZoneLeaderboardResponse)(nil), "quicksilver.participationrewards.v1.QueryZoneLeaderboardResponse")
 }
 
 func init() {
@@ -224,36 +445,53 @@ func init() {
 }
 
 var fileDescriptor_bc16b3ccc632b3de = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x6a, 0xd4, 0x40,
-	0x1c, 0xc6, 0x33, 0xdd, 0x75, 0xc1, 0x69, 0x0f, 0x32, 0xf6, 0x10, 0x83, 0x64, 0x4b, 0xbc, 0x14,
-	0x96, 0x66, 0x68, 0x7b, 0x50, 0x04, 0xd7, 0xb2, 0x8a, 0xe0, 0x41, 0xd0, 0x1c, 0x45, 0xc1, 0xd9,
-	0x64, 0x18, 0xc7, 0xcd, 0xce, 0x64, 0x33, 0x93, 0xad, 0xa1, 0xf4, 0xe2, 0x13, 0x88, 0xbe, 0x88,
-	0x8f, 0xd1, 0x63, 0x41, 0x04, 0x4f, 0x8b, 0xec, 0xfa, 0x04, 0x1e, 0x3d, 0xc9, 0x4c, 0x72, 0x68,
-	0xd9, 0x1c, 0xb2, 0xde, 0xfe, 0xfc, 0x93, 0xef, 0xfb, 0x7d, 0xdf, 0xfc, 0x21, 0x9e, 0x15, 0x3c,
-	0x9e, 0x28, 0x9e, 0xce, 0x69, 0x8e, 0x33, 0x92, 0x6b, 0x1e, 0xf3, 0x8c, 0x68, 0x2e, 0x45, 0x4e,
-	0x4f, 0x49, 0x9e, 0x28, 0x3c, 0x3f, 0xc4, 0xb3, 0x82, 0xe6, 0x65, 0x98, 0xe5, 0x52, 0x4b, 0x74,
-	0xef, 0x8a, 0x20, 0x6c, 0x12, 0x84, 0xf3, 0x43, 0x6f, 0x97, 0x49, 0x26, 0xed, 0xff, 0xd8, 0x4c,
-	0x95, 0xd4, 0xbb, 0xcb, 0xa4, 0x64, 0x29, 0xc5, 0x24, 0xe3, 0x98, 0x08, 0x21, 0xb5, 0x95, 0xa9,
-	0xfa, 0xeb, 0xb0, 0x4d, 0x92, 0x46, 0xa0, 0xd5, 0x07, 0xbb, 0x10, 0xbd, 0x32, 0x39, 0x5f, 0x92,
-	0x9c, 0x4c, 0x55, 0x44, 0x67, 0x05, 0x55, 0x3a, 0x78, 0x07, 0x6f, 0x5f, 0xdb, 0xaa, 0x4c, 0x0a,
-	0x45, 0xd1, 0x73, 0xd8, 0xcb, 0xec, 0xc6, 0x05, 0x7b, 0x60, 0x7f, 0xfb, 0x68, 0x10, 0xb6, 0xa8,
-	0x15, 0x56, 0x26, 0xa3, 0xee, 0xc5, 0xa2, 0xef, 0x44, 0xb5, 0x41, 0x70, 0x02, 0xdd, 0x8a, 0x60,
-	0x52, 0xc4, 0x32, 0x7d, 0x4a, 0x34, 0xa9, 0xe9, 0x08, 0xc1, 0xae, 0x2e, 0x33, 0x6a, 0x21, 0x37,
-	0x23, 0x3b, 0xa3, 0x5b, 0xb0, 0x33, 0xa1, 0xa5, 0xbb, 0x65, 0x57, 0x66, 0x0c, 0xde, 0xc0, 0x3b,
-	0x0d, 0x0e, 0x75, 0xd2, 0xc7, 0xb0, 0x9b, 0x10, 0x4d, 0x5c, 0xb0, 0xd7, 0xd9, 0xdf, 0x19, 0x0d,
-	0xfe, 0x2c, 0xfa, 0xdb, 0x25, 0x99, 0xa6, 0x0f, 0x03, 0xb3, 0x0d, 0xfe, 0x2e, 0xfa, 0x2e, 0x15,
-	0xb1, 0x4c, 0xb8, 0x60, 0xf8, 0x83, 0x92, 0x22, 0x8c, 0xc8, 0xe9, 0x0b, 0xaa, 0x14, 0x61, 0x34,
-	0xb2, 0xc2, 0xa3, 0x2f, 0x1d, 0x78, 0xc3, 0xda, 0xa3, 0x6f, 0x00, 0xf6, 0xaa, 0x0a, 0xe8, 0x7e,
-	0xab, 0xbe, 0xeb, 0xef, 0xe9, 0x3d, 0xd8, 0x5c, 0x58, 0x15, 0x09, 0x8e, 0x3f, 0x7d, 0xff, 0xfd,
-	0x75, 0xeb, 0x00, 0x0d, 0x70, 0xcb, 0x43, 0x9b, 0x9c, 0x3f, 0x00, 0xdc, 0xb9, 0xfa, 0x2c, 0xe8,
-	0xd1, 0x06, 0xfc, 0xf5, 0x83, 0x78, 0xc3, 0xff, 0x95, 0xd7, 0x25, 0x9e, 0xd9, 0x12, 0x27, 0x68,
-	0xd8, 0xae, 0x44, 0x6d, 0x61, 0xee, 0x80, 0xcf, 0xcc, 0xf5, 0xcf, 0xf1, 0xd9, 0x84, 0x96, 0xe7,
-	0xa3, 0xb7, 0x17, 0x4b, 0x1f, 0x5c, 0x2e, 0x7d, 0xf0, 0x6b, 0xe9, 0x83, 0xcf, 0x2b, 0xdf, 0xb9,
-	0x5c, 0xf9, 0xce, 0xcf, 0x95, 0xef, 0xbc, 0x7e, 0xc2, 0xb8, 0x7e, 0x5f, 0x8c, 0xc3, 0x58, 0x4e,
-	0x31, 0x17, 0x8c, 0x8a, 0x82, 0xeb, 0xf2, 0x60, 0x5c, 0xf0, 0x34, 0xb9, 0xc6, 0xfc, 0xd8, 0x4c,
-	0x35, 0x10, 0x35, 0xee, 0x59, 0xf2, 0xf1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0xcf, 0xde,
-	0xf0, 0xde, 0x03, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0xb6, 0x50, 0x75, 0x20, 0x62, 0x46, 0x0e, 0xa5, 0x92, 0x42, 0xd6, 0x44, 0x88, 0x84,
+	0x9d, 0x14, 0x0e, 0x12, 0x0d, 0xc8, 0x87, 0xc1, 0x90, 0x68, 0x02, 0xab, 0xf1, 0x40, 0x34, 0x38,
+	0xdd, 0x9d, 0x2c, 0x23, 0xdb, 0x9d, 0x65, 0x66, 0x5b, 0x6c, 0x9a, 0x5e, 0xbc, 0x78, 0x35, 0xf1,
+	0x57, 0x78, 0xf3, 0xec, 0x2f, 0xe0, 0x48, 0x62, 0x4c, 0x4c, 0x4c, 0x88, 0x01, 0xfd, 0x03, 0x1c,
+	0x3d, 0x99, 0x9d, 0x99, 0x86, 0x16, 0x36, 0x61, 0x2b, 0xde, 0x66, 0xdf, 0x9d, 0xe7, 0x79, 0x9f,
+	0xe7, 0xfd, 0x18, 0x80, 0x76, 0x6b, 0xd4, 0xd9, 0x11, 0xd4, 0xaf, 0x13, 0x8e, 0x42, 0xcc, 0x23,
+	0xea, 0xd0, 0x10, 0x47, 0x94, 0x05, 0x9c, 0xec, 0x61, 0xee, 0x0a, 0x54, 0x2f, 0xa3, 0xdd, 0x1a,
+	0xe1, 0x0d, 0x2b, 0xe4, 0x2c, 0x62, 0xf0, 0x76, 0x07, 0xc0, 0x4a, 0x02, 0x58, 0xf5, 0x72, 0x71,
+	0xd8, 0x63, 0x1e, 0x93, 0xf7, 0x51, 0x7c, 0x52, 0xd0, 0xe2, 0xa8, 0xc7, 0x98, 0xe7, 0x13, 0x84,
+	0x43, 0x8a, 0x70, 0x10, 0xb0, 0x48, 0xc2, 0x84, 0xfe, 0x7b, 0xd7, 0x61, 0xa2, 0xca, 0x04, 0xaa,
+	0x60, 0x41, 0x54, 0x46, 0x54, 0x2f, 0x57, 0x48, 0x84, 0xcb, 0x28, 0xc4, 0x1e, 0x0d, 0xe4, 0x65,
+	0x7d, 0x77, 0x21, 0x8d, 0xea, 0x44, 0x71, 0x12, 0x6f, 0x0e, 0x03, 0xb8, 0x11, 0x67, 0x58, 0xc7,
+	0x1c, 0x57, 0x85, 0x4d, 0x76, 0x6b, 0x44, 0x44, 0xe6, 0x6b, 0x70, 0xb3, 0x2b, 0x2a, 0x42, 0x16,
+	0x08, 0x02, 0xd7, 0x40, 0x3e, 0x94, 0x91, 0x82, 0x31, 0x6e, 0x4c, 0x0e, 0xcc, 0x4c, 0x59, 0x29,
+	0x4a, 0x60, 0x29, 0x92, 0xe5, 0xbe, 0xfd, 0xc3, 0xb1, 0x8c, 0xad, 0x09, 0xcc, 0x45, 0x50, 0x50,
+	0x19, 0x62, 0x15, 0x0e, 0xf3, 0x1f, 0xe1, 0x08, 0xeb, 0xec, 0x10, 0x82, 0xbe, 0xa8, 0x11, 0x12,
+	0x99, 0xe4, 0x9a, 0x2d, 0xcf, 0xf0, 0x06, 0xc8, 0xed, 0x90, 0x46, 0x21, 0x2b, 0x43, 0xf1, 0xd1,
+	0x7c, 0x09, 0x46, 0x12, 0x18, 0xb4, 0xd2, 0x87, 0xa0, 0xcf, 0xc5, 0x11, 0x2e, 0x18, 0xe3, 0xb9,
+	0xc9, 0xc1, 0xe5, 0xa9, 0x93, 0xc3, 0xb1, 0x81, 0x06, 0xae, 0xfa, 0xf7, 0xcd, 0x38, 0x6a, 0xfe,
+	0x39, 0x1c, 0x2b, 0x90, 0xc0, 0x61, 0x2e, 0x0d, 0x3c, 0xf4, 0x46, 0xb0, 0xc0, 0xb2, 0xf1, 0xde,
+	0x53, 0x22, 0x04, 0xf6, 0x88, 0x2d, 0x81, 0xe6, 0x27, 0x03, 0xdc, 0x92, 0xf4, 0x2f, 0xb0, 0x4f,
+	0x5d, 0x1c, 0x31, 0xfe, 0xcc, 0x61, 0x9c, 0xb4, 0x2b, 0x04, 0x47, 0xc0, 0x55, 0x67, 0x1b, 0xd3,
+	0x60, 0x8b, 0xba, 0x5a, 0xe7, 0x15, 0xf9, 0xbd, 0xe6, 0xc2, 0x09, 0x30, 0x54, 0xc7, 0x3e, 0x0b,
+	0x09, 0xdf, 0xc2, 0xae, 0xcb, 0x89, 0x10, 0x5a, 0xf6, 0x75, 0x1d, 0x5e, 0x52, 0x51, 0xb8, 0x0a,
+	0xc0, 0x69, 0x3f, 0x0b, 0x39, 0x59, 0xd2, 0x3b, 0x96, 0x6a, 0xbe, 0x15, 0x37, 0xdf, 0x52, 0xe3,
+	0xa6, 0x9b, 0x6f, 0xad, 0xc7, 0xf2, 0x54, 0x7e, 0xbb, 0x03, 0x69, 0x7e, 0x31, 0xc0, 0x68, 0xb2,
+	0x56, 0x5d, 0x8d, 0x0d, 0x90, 0x17, 0x32, 0x22, 0xeb, 0x31, 0x30, 0x33, 0x9b, 0xaa, 0x6f, 0xdd,
+	0x6c, 0xed, 0xfe, 0x29, 0x22, 0xf8, 0xb8, 0x4b, 0x7b, 0x56, 0x6a, 0x9f, 0xb8, 0x50, 0xbb, 0xd2,
+	0xd3, 0x25, 0x7e, 0x4e, 0xd7, 0x79, 0x93, 0x05, 0xe4, 0x09, 0xc1, 0x2e, 0xe1, 0x15, 0x86, 0xb9,
+	0x7b, 0x71, 0x9d, 0xcd, 0xf7, 0x6d, 0xdb, 0xe7, 0xa0, 0xda, 0xf6, 0x30, 0xe8, 0x27, 0x21, 0x73,
+	0xb6, 0x25, 0x30, 0x67, 0xab, 0x8f, 0x8e, 0x62, 0x64, 0xff, 0x53, 0x31, 0x66, 0x7e, 0xe7, 0x41,
+	0xbf, 0x54, 0x02, 0x3f, 0x1b, 0x20, 0xaf, 0xe6, 0x1d, 0xde, 0x4b, 0xc5, 0x7b, 0x7e, 0xf9, 0x8a,
+	0x73, 0xbd, 0x03, 0x95, 0x61, 0x73, 0xf6, 0xdd, 0xd7, 0x5f, 0x1f, 0xb3, 0xd3, 0x70, 0x0a, 0xa5,
+	0x7c, 0x15, 0x62, 0x9d, 0xdf, 0x0c, 0x30, 0xd8, 0xb9, 0x43, 0x70, 0xbe, 0x87, 0xfc, 0xe7, 0xb7,
+	0xb7, 0xb8, 0xf0, 0xaf, 0x70, 0x6d, 0x62, 0x55, 0x9a, 0x58, 0x84, 0x0b, 0xe9, 0x4c, 0x68, 0x8a,
+	0x78, 0x69, 0x51, 0x33, 0x7e, 0x2a, 0x5a, 0xa8, 0xb9, 0x43, 0x1a, 0x2d, 0x78, 0x62, 0x80, 0xa1,
+	0x33, 0x0b, 0x01, 0x17, 0xd3, 0x6b, 0x4b, 0xde, 0xfb, 0xe2, 0xd2, 0x25, 0x18, 0xb4, 0xc1, 0x4d,
+	0x69, 0xf0, 0x39, 0xb4, 0x53, 0x19, 0xac, 0xb7, 0x59, 0xb6, 0xd4, 0xb0, 0xa1, 0x66, 0x7b, 0x1f,
+	0x5a, 0xa8, 0x79, 0xe6, 0x9d, 0x69, 0xc1, 0x1f, 0x06, 0x18, 0x3a, 0xb3, 0x0e, 0xbd, 0x98, 0x4e,
+	0x5e, 0xc2, 0xe2, 0xd2, 0x25, 0x18, 0xb4, 0xe9, 0x15, 0x69, 0x7a, 0x1e, 0x3e, 0x48, 0x65, 0xda,
+	0x3f, 0x65, 0xe8, 0xf0, 0xbb, 0xfc, 0x6a, 0xff, 0xa8, 0x64, 0x1c, 0x1c, 0x95, 0x8c, 0x9f, 0x47,
+	0x25, 0xe3, 0xc3, 0x71, 0x29, 0x73, 0x70, 0x5c, 0xca, 0x7c, 0x3f, 0x2e, 0x65, 0x36, 0x57, 0x3c,
+	0x1a, 0x6d, 0xd7, 0x2a, 0x96, 0xc3, 0xaa, 0x88, 0x06, 0x1e, 0x09, 0x6a, 0x34, 0x6a, 0x4c, 0x57,
+	0x6a, 0xd4, 0x77, 0xbb, 0x12, 0xbe, 0x4d, 0x4e, 0x19, 0xcf, 0x8d, 0xa8, 0xe4, 0xe5, 0x30, 0xcd,
+	0xfe, 0x1d, 0x00, 0x7f, 0xcb, 0x90, 0xe8, 0x0a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -272,6 +510,11 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// ProtocolData returns the requested protocol data.
 	ProtocolData(ctx context.Context, in *QueryProtocolDataRequest, opts ...grpc.CallOption) (*QueryProtocolDataResponse, error)
+	// ValidatorScores returns the recorded epoch scores of a zone validator.
+	ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error)
+	// ZoneLeaderboard returns the validator scores of the latest recorded epoch
+	// for a zone, ordered by overall score.
+	ZoneLeaderboard(ctx context.Context, in *QueryZoneLeaderboardRequest, opts ...grpc.CallOption) (*QueryZoneLeaderboardResponse, error)
 }
 
 type queryClient struct {
@@ -300,12 +543,35 @@ func (c *queryClient) ProtocolData(ctx context.Context, in *QueryProtocolDataReq
 	return out, nil
 }
 
+func (c *queryClient) ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error) {
+	out := new(QueryValidatorScoresResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/ValidatorScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ZoneLeaderboard(ctx context.Context, in *QueryZoneLeaderboardRequest, opts ...grpc.CallOption) (*QueryZoneLeaderboardResponse, error) {
+	out := new(QueryZoneLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/ZoneLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of participation rewards parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// ProtocolData returns the requested protocol data.
 	ProtocolData(context.Context, *QueryProtocolDataRequest) (*QueryProtocolDataResponse, error)
+	// ValidatorScores returns the recorded epoch scores of a zone validator.
+	ValidatorScores(context.Context, *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error)
+	// ZoneLeaderboard returns the validator scores of the latest recorded epoch
+	// for a zone, ordered by overall score.
+	ZoneLeaderboard(context.Context, *QueryZoneLeaderboardRequest) (*QueryZoneLeaderboardResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -318,6 +584,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) ProtocolData(ctx context.Context, req *QueryProtocolDataRequest) (*QueryProtocolDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProtocolData not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorScores(ctx context.Context, req *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorScores not implemented")
+}
+func (*UnimplementedQueryServer) ZoneLeaderboard(ctx context.Context, req *QueryZoneLeaderboardRequest) (*QueryZoneLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneLeaderboard not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -359,6 +631,42 @@ func _Query_ProtocolData_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/ValidatorScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorScores(ctx, req.(*QueryValidatorScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ZoneLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZoneLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ZoneLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/ZoneLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZoneLeaderboard(ctx, req.(*QueryZoneLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "quicksilver.participationrewards.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -371,6 +679,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProtocolData",
 			Handler:    _Query_ProtocolData_Handler,
 		},
+		{
+			MethodName: "ValidatorScores",
+			Handler:    _Query_ValidatorScores_Handler,
+		},
+		{
+			MethodName: "ZoneLeaderboard",
+			Handler:    _Query_ZoneLeaderboard_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quicksilver/participationrewards/v1/query.proto",
@@ -501,76 +817,564 @@ func (m *QueryProtocolDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidatorScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryValidatorScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProtocolDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProtocolDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryZoneLeaderboardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZoneLeaderboardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZoneLeaderboardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryZoneLeaderboardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZoneLeaderboardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZoneLeaderboardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProtocolDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Data) > 0 {
 		for _, b := range m.Data {
 			l = len(b)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryValidatorScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZoneLeaderboardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZoneLeaderboardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -593,12 +1397,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -620,7 +1456,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorScoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -643,15 +1479,79 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -678,7 +1578,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -703,7 +1606,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorScoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -726,17 +1629,17 @@ func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProtocolDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProtocolDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -746,27 +1649,115 @@ func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Scores = append(m.Scores, ValidatorScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZoneLeaderboardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZoneLeaderboardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZoneLeaderboardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -794,7 +1785,7 @@ func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -817,7 +1808,7 @@ func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryZoneLeaderboardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -840,17 +1831,36 @@ func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProtocolDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryZoneLeaderboardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProtocolDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryZoneLeaderboardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -860,23 +1870,25 @@ func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
-			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			m.Scores = append(m.Scores, ValidatorScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex